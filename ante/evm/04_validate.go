@@ -84,17 +84,28 @@ func ValidateTx(tx sdktypes.Tx) (*tx.Fee, error) {
 	}
 
 	authInfo := protoTx.AuthInfo
-	if len(authInfo.SignerInfos) > 0 {
-		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo SignerInfos should be empty")
+
+	// NOTE: the fee granter is allowed, since the fees can be paid through a
+	// fee allowance of the granter to the sender of the eth tx.
+	if authInfo.Fee.Payer != "" {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo Fee payer should be empty")
+	}
+
+	// The Ethereum signature does not cover the fee granter, so the sender
+	// signs the Cosmos tx as well when a fee granter is set. The signature is
+	// verified along with the fee grant.
+	maxSigs := 0
+	if authInfo.Fee.Granter != "" {
+		maxSigs = 1
 	}
 
-	if authInfo.Fee.Payer != "" || authInfo.Fee.Granter != "" {
-		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo Fee payer and granter should be empty")
+	if len(authInfo.SignerInfos) > maxSigs {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo SignerInfos should be empty, unless signed by the sender for a fee granter")
 	}
 
 	sigs := protoTx.Signatures
-	if len(sigs) > 0 {
-		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx Signatures should be empty")
+	if len(sigs) > maxSigs {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx Signatures should be empty, unless signed by the sender for a fee granter")
 	}
 
 	return authInfo.Fee, nil
@@ -19,7 +19,7 @@ import (
 // The account will be set to store if it doesn't exist, i.e. cannot be found on store.
 // This method will fail if:
 // - from address is NOT an EOA
-// - account balance is lower than the transaction cost
+// - account balance is lower than the transaction cost, unless the fees are paid by a fee granter
 func VerifyAccountBalance(
 	ctx sdk.Context,
 	evmKeeper anteinterfaces.EVMKeeper,
@@ -27,6 +27,7 @@ func VerifyAccountBalance(
 	account *statedb.Account,
 	from common.Address,
 	ethTx *ethtypes.Transaction,
+	feeGranted bool,
 ) error {
 	// Only EOA are allowed to send transactions.
 	if account != nil && account.IsContract() {
@@ -46,6 +47,12 @@ func VerifyAccountBalance(
 		account = statedb.NewEmptyAccount()
 	}
 
+	// The fee granter pays for the gas, so the sender only needs to cover the
+	// value of the transaction, which is checked by CanTransfer.
+	if feeGranted {
+		return nil
+	}
+
 	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance.ToBig()), ethTx); err != nil {
 		return errorsmod.Wrap(err, "failed to check sender balance")
 	}
@@ -11,9 +11,13 @@ import (
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/feegrant"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 )
 
 // UpdateCumulativeGasWanted updates the cumulative gas wanted
@@ -61,6 +65,137 @@ func ConsumeFeesAndEmitEvent(
 	return nil
 }
 
+// GetFeeGranter returns the fee granter of the Cosmos transaction wrapping the
+// Ethereum transaction of the sender, or nil if the sender pays its own fees.
+//
+// The Ethereum signature does not cover the fee granter, so the wrapping Cosmos
+// transaction must be signed by the sender in SIGN_MODE_DIRECT. Otherwise,
+// anyone relaying the Ethereum transaction could spend any allowance given to
+// the sender. The signature sequence is not checked, since the Ethereum nonce
+// in the signed body already prevents replays.
+func GetFeeGranter(
+	ctx sdktypes.Context,
+	tx sdktypes.Tx,
+	accountKeeper anteinterfaces.AccountKeeper,
+	feegrantKeeper anteinterfaces.FeegrantKeeper,
+	from sdktypes.AccAddress,
+) (sdktypes.AccAddress, error) {
+	feeTx, ok := tx.(sdktypes.FeeTx)
+	if !ok {
+		return nil, nil
+	}
+
+	feeGranter := sdktypes.AccAddress(feeTx.FeeGranter())
+	if feeGranter.Empty() || feeGranter.Equals(from) {
+		return nil, nil
+	}
+
+	if feegrantKeeper == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "fee grants are not enabled")
+	}
+
+	if err := verifyGranteeSignature(ctx, tx, accountKeeper, from); err != nil {
+		return nil, errorsmod.Wrapf(err, "fee granter %s requires the signature of %s", feeGranter, from)
+	}
+
+	return feeGranter, nil
+}
+
+// verifyGranteeSignature verifies that the Cosmos transaction wrapping the
+// Ethereum transaction is signed by the sender in SIGN_MODE_DIRECT.
+func verifyGranteeSignature(
+	ctx sdktypes.Context,
+	tx sdktypes.Tx,
+	accountKeeper anteinterfaces.AccountKeeper,
+	from sdktypes.AccAddress,
+) error {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return errorsmod.Wrapf(errortypes.ErrInvalidType, "tx %T doesn't implement authsigning.SigVerifiableTx", tx)
+	}
+
+	adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
+	if !ok {
+		return errorsmod.Wrapf(errortypes.ErrInvalidType, "tx %T doesn't implement authsigning.V2AdaptableTx", tx)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+
+	if len(sigs) != 1 {
+		return errorsmod.Wrapf(errortypes.ErrNoSignatures, "expected 1 signature, got %d", len(sigs))
+	}
+
+	sig := sigs[0]
+	if sig.PubKey == nil || !from.Equals(sdktypes.AccAddress(sig.PubKey.Address())) {
+		return errorsmod.Wrap(errortypes.ErrInvalidPubKey, "signer is not the sender")
+	}
+
+	sigData, ok := sig.Data.(*signing.SingleSignatureData)
+	if !ok || sigData.SignMode != signing.SignMode_SIGN_MODE_DIRECT {
+		return errorsmod.Wrap(errortypes.ErrNotSupported, "only SIGN_MODE_DIRECT single signatures are supported")
+	}
+
+	acc := accountKeeper.GetAccount(ctx, from)
+	if acc == nil {
+		return errorsmod.Wrapf(errortypes.ErrUnknownAddress, "account %s does not exist", from)
+	}
+
+	txData := adaptableTx.GetSigningTxData()
+	signBytes, err := authtx.DirectSignBytes(txData.BodyBytes, txData.AuthInfoBytes, ctx.ChainID(), acc.GetAccountNumber())
+	if err != nil {
+		return err
+	}
+
+	if !sig.PubKey.VerifySignature(signBytes, sigData.Signature) {
+		return errorsmod.Wrapf(
+			errortypes.ErrUnauthorized,
+			"signature verification failed; please verify account number (%d) and chain-id (%s)",
+			acc.GetAccountNumber(), ctx.ChainID(),
+		)
+	}
+
+	return nil
+}
+
+// UseGrantedFees deducts the fees from the allowance the fee granter gave to
+// the sender. It fails if the allowance does not exist, does not cover the fees
+// or does not allow the messages of the transaction.
+//
+// It returns the grant with the allowance left after the fees were deducted,
+// or without allowance if it was spent and removed, so that the leftover gas
+// can be refunded to the allowance after the execution if it did not change.
+func UseGrantedFees(
+	ctx sdktypes.Context,
+	feegrantKeeper anteinterfaces.FeegrantKeeper,
+	feeGranter sdktypes.AccAddress,
+	from sdktypes.AccAddress,
+	fees sdktypes.Coins,
+	msgs []sdktypes.Msg,
+) (feegrant.Grant, error) {
+	// NOTE: the fees are represented in 18 decimals, while the allowance is
+	// spent in the evm denom, so the amount is rounded up.
+	if err := feegrantKeeper.UseGrantedFees(
+		ctx,
+		feeGranter,
+		from,
+		evmtypes.ConvertCoinsFrom18DecimalsCeil(fees),
+		msgs,
+	); err != nil {
+		return feegrant.Grant{}, errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, from)
+	}
+
+	// the allowance is removed once it is spent
+	allowance, err := feegrantKeeper.GetAllowance(ctx, feeGranter, from)
+	if err != nil {
+		return feegrant.Grant{Granter: feeGranter.String(), Grantee: from.String()}, nil
+	}
+
+	return feegrant.NewGrant(feeGranter, from, allowance)
+}
+
 // deductFee checks if the fee payer has enough funds to pay for the fees and deducts them.
 func deductFees(
 	ctx sdktypes.Context,
@@ -32,6 +32,7 @@ type MonoDecorator struct {
 	accountKeeper   anteinterfaces.AccountKeeper
 	feeMarketKeeper anteinterfaces.FeeMarketKeeper
 	evmKeeper       anteinterfaces.EVMKeeper
+	feegrantKeeper  anteinterfaces.FeegrantKeeper
 	maxGasWanted    uint64
 }
 
@@ -41,16 +42,21 @@ type MonoDecorator struct {
 // This runs all the default checks for EVM transactions enable through Cosmos EVM.
 // Any partner chains can use this in their ante handler logic and build additional EVM
 // decorators using the returned DecoratorUtils
+//
+// The feegrant keeper is optional. When it is nil, Ethereum transactions with a
+// fee granter are rejected.
 func NewEVMMonoDecorator(
 	accountKeeper anteinterfaces.AccountKeeper,
 	feeMarketKeeper anteinterfaces.FeeMarketKeeper,
 	evmKeeper anteinterfaces.EVMKeeper,
+	feegrantKeeper anteinterfaces.FeegrantKeeper,
 	maxGasWanted uint64,
 ) MonoDecorator {
 	return MonoDecorator{
 		accountKeeper:   accountKeeper,
 		feeMarketKeeper: feeMarketKeeper,
 		evmKeeper:       evmKeeper,
+		feegrantKeeper:  feegrantKeeper,
 		maxGasWanted:    maxGasWanted,
 	}
 }
@@ -164,6 +170,13 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	from := ethMsg.GetFrom()
 	fromAddr := common.BytesToAddress(from)
 
+	// The fee granter of the Cosmos tx, if any, pays the fees on behalf of
+	// the sender.
+	feeGranter, err := GetFeeGranter(ctx, tx, md.accountKeeper, md.feegrantKeeper, from)
+	if err != nil {
+		return ctx, err
+	}
+
 	// 6. account balance verification
 	// We get the account with the balance from the EVM keeper because it is
 	// using a wrapper of the bank keeper as a dependency to scale all
@@ -176,6 +189,7 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		account,
 		fromAddr,
 		ethTx,
+		feeGranter != nil,
 	); err != nil {
 		return ctx, err
 	}
@@ -207,11 +221,31 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, err
 	}
 
+	feePayer := from
+	if feeGranter != nil {
+		grant, err := UseGrantedFees(
+			ctx,
+			md.feegrantKeeper,
+			feeGranter,
+			from,
+			msgFees,
+			msgs,
+		)
+		if err != nil {
+			return ctx, err
+		}
+
+		// The leftover gas is refunded to the granter and its allowance after
+		// the execution.
+		md.evmKeeper.SetFeeGrantTransient(ctx, ethTx.Hash(), grant)
+		feePayer = feeGranter
+	}
+
 	err = ConsumeFeesAndEmitEvent(
 		ctx,
 		md.evmKeeper,
 		msgFees,
-		from,
+		feePayer,
 	)
 	if err != nil {
 		return ctx, err
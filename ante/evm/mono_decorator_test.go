@@ -30,6 +30,7 @@ import (
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -66,9 +67,10 @@ func (k *ExtendedEVMKeeper) ResetTransientGasUsed(_ sdk.Context) {}
 func (k *ExtendedEVMKeeper) GetParams(_ sdk.Context) evmsdktypes.Params {
 	return evmsdktypes.DefaultParams()
 }
-func (k *ExtendedEVMKeeper) GetBaseFee(_ sdk.Context) *big.Int           { return big.NewInt(0) }
-func (k *ExtendedEVMKeeper) GetMinGasPrice(_ sdk.Context) math.LegacyDec { return math.LegacyZeroDec() }
-func (k *ExtendedEVMKeeper) GetTxIndexTransient(_ sdk.Context) uint64    { return 0 }
+func (k *ExtendedEVMKeeper) GetBaseFee(_ sdk.Context) *big.Int                                   { return big.NewInt(0) }
+func (k *ExtendedEVMKeeper) GetMinGasPrice(_ sdk.Context) math.LegacyDec                         { return math.LegacyZeroDec() }
+func (k *ExtendedEVMKeeper) GetTxIndexTransient(_ sdk.Context) uint64                            { return 0 }
+func (k *ExtendedEVMKeeper) SetFeeGrantTransient(_ sdk.Context, _ common.Hash, _ feegrant.Grant) {}
 
 // only methods called by EVMMonoDecorator
 type MockFeeMarketKeeper struct{}
@@ -199,7 +201,7 @@ func TestMonoDecorator(t *testing.T) {
 			keeper, cosmosAddr := setupFundedKeeper(t, privKey)
 			accountKeeper := MockAccountKeeper{FundedAddr: cosmosAddr}
 
-			monoDec := evm.NewEVMMonoDecorator(accountKeeper, MockFeeMarketKeeper{}, keeper, 0)
+			monoDec := evm.NewEVMMonoDecorator(accountKeeper, MockFeeMarketKeeper{}, keeper, nil, 0)
 			ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
 			ctx = ctx.WithBlockGasMeter(storetypes.NewGasMeter(1e19))
 
@@ -5,8 +5,10 @@ import (
 	"time"
 
 	addresscodec "cosmossdk.io/core/address"
+	"cosmossdk.io/x/feegrant"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -30,3 +32,10 @@ type BankKeeper interface {
 	SendCoins(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 }
+
+// FeegrantKeeper defines the expected feegrant keeper interface required to pay
+// the fees of Ethereum transactions with a fee allowance.
+type FeegrantKeeper interface {
+	ante.FeegrantKeeper
+	GetAllowance(ctx context.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error)
+}
@@ -0,0 +1,2803 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package mintv1
+
+import (
+	_ "cosmossdk.io/api/amino"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_GenesisState_2_list)(nil)
+
+type _GenesisState_2_list struct {
+	list *[]*MinterAllowance
+}
+
+func (x *_GenesisState_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MinterAllowance)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MinterAllowance)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_2_list) AppendMutable() protoreflect.Value {
+	v := new(MinterAllowance)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_2_list) NewElement() protoreflect.Value {
+	v := new(MinterAllowance)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_3_list)(nil)
+
+type _GenesisState_3_list struct {
+	list *[]*DenomMintLimit
+}
+
+func (x *_GenesisState_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomMintLimit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomMintLimit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_3_list) AppendMutable() protoreflect.Value {
+	v := new(DenomMintLimit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_3_list) NewElement() protoreflect.Value {
+	v := new(DenomMintLimit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*DenomMintStats
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomMintStats)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomMintStats)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(DenomMintStats)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(DenomMintStats)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*MinterMintStats
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MinterMintStats)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MinterMintStats)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(MinterMintStats)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(MinterMintStats)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]string
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field PausedDenoms as it is not of Message kind"))
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*IBCMintRoute
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IBCMintRoute)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*IBCMintRoute)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(IBCMintRoute)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(IBCMintRoute)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*MintProposal
+}
+
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintProposal)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintProposal)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(MintProposal)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(MintProposal)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_14_list)(nil)
+
+type _GenesisState_14_list struct {
+	list *[]*UsedVoucherNonce
+}
+
+func (x *_GenesisState_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UsedVoucherNonce)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UsedVoucherNonce)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_14_list) AppendMutable() protoreflect.Value {
+	v := new(UsedVoucherNonce)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_14_list) NewElement() protoreflect.Value {
+	v := new(UsedVoucherNonce)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_15_list)(nil)
+
+type _GenesisState_15_list struct {
+	list *[]*MintRecord
+}
+
+func (x *_GenesisState_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintRecord)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintRecord)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_15_list) AppendMutable() protoreflect.Value {
+	v := new(MintRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_15_list) NewElement() protoreflect.Value {
+	v := new(MintRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_17_list)(nil)
+
+type _GenesisState_17_list struct {
+	list *[]*DenomAdmin
+}
+
+func (x *_GenesisState_17_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_17_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomAdmin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_17_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomAdmin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_17_list) AppendMutable() protoreflect.Value {
+	v := new(DenomAdmin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_17_list) NewElement() protoreflect.Value {
+	v := new(DenomAdmin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_18_list)(nil)
+
+type _GenesisState_18_list struct {
+	list *[]*EmissionSchedule
+}
+
+func (x *_GenesisState_18_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_18_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EmissionSchedule)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_18_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EmissionSchedule)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_18_list) AppendMutable() protoreflect.Value {
+	v := new(EmissionSchedule)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_18_list) NewElement() protoreflect.Value {
+	v := new(EmissionSchedule)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GenesisState                           protoreflect.MessageDescriptor
+	fd_GenesisState_mint_authority            protoreflect.FieldDescriptor
+	fd_GenesisState_minter_allowances         protoreflect.FieldDescriptor
+	fd_GenesisState_denom_mint_limits         protoreflect.FieldDescriptor
+	fd_GenesisState_params                    protoreflect.FieldDescriptor
+	fd_GenesisState_denom_mint_stats          protoreflect.FieldDescriptor
+	fd_GenesisState_minter_mint_stats         protoreflect.FieldDescriptor
+	fd_GenesisState_guardian                  protoreflect.FieldDescriptor
+	fd_GenesisState_paused                    protoreflect.FieldDescriptor
+	fd_GenesisState_paused_denoms             protoreflect.FieldDescriptor
+	fd_GenesisState_ibc_mint_routes           protoreflect.FieldDescriptor
+	fd_GenesisState_mint_approval_config      protoreflect.FieldDescriptor
+	fd_GenesisState_mint_proposals            protoreflect.FieldDescriptor
+	fd_GenesisState_next_mint_proposal_id     protoreflect.FieldDescriptor
+	fd_GenesisState_used_voucher_nonces       protoreflect.FieldDescriptor
+	fd_GenesisState_mint_history              protoreflect.FieldDescriptor
+	fd_GenesisState_next_mint_record_id       protoreflect.FieldDescriptor
+	fd_GenesisState_denom_admins              protoreflect.FieldDescriptor
+	fd_GenesisState_emission_schedules        protoreflect.FieldDescriptor
+	fd_GenesisState_next_emission_schedule_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_mint_v1_genesis_proto_init()
+	md_GenesisState = File_cosmos_evm_mint_v1_genesis_proto.Messages().ByName("GenesisState")
+	fd_GenesisState_mint_authority = md_GenesisState.Fields().ByName("mint_authority")
+	fd_GenesisState_minter_allowances = md_GenesisState.Fields().ByName("minter_allowances")
+	fd_GenesisState_denom_mint_limits = md_GenesisState.Fields().ByName("denom_mint_limits")
+	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_denom_mint_stats = md_GenesisState.Fields().ByName("denom_mint_stats")
+	fd_GenesisState_minter_mint_stats = md_GenesisState.Fields().ByName("minter_mint_stats")
+	fd_GenesisState_guardian = md_GenesisState.Fields().ByName("guardian")
+	fd_GenesisState_paused = md_GenesisState.Fields().ByName("paused")
+	fd_GenesisState_paused_denoms = md_GenesisState.Fields().ByName("paused_denoms")
+	fd_GenesisState_ibc_mint_routes = md_GenesisState.Fields().ByName("ibc_mint_routes")
+	fd_GenesisState_mint_approval_config = md_GenesisState.Fields().ByName("mint_approval_config")
+	fd_GenesisState_mint_proposals = md_GenesisState.Fields().ByName("mint_proposals")
+	fd_GenesisState_next_mint_proposal_id = md_GenesisState.Fields().ByName("next_mint_proposal_id")
+	fd_GenesisState_used_voucher_nonces = md_GenesisState.Fields().ByName("used_voucher_nonces")
+	fd_GenesisState_mint_history = md_GenesisState.Fields().ByName("mint_history")
+	fd_GenesisState_next_mint_record_id = md_GenesisState.Fields().ByName("next_mint_record_id")
+	fd_GenesisState_denom_admins = md_GenesisState.Fields().ByName("denom_admins")
+	fd_GenesisState_emission_schedules = md_GenesisState.Fields().ByName("emission_schedules")
+	fd_GenesisState_next_emission_schedule_id = md_GenesisState.Fields().ByName("next_emission_schedule_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+
+type fastReflection_GenesisState GenesisState
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisState)(x)
+}
+
+func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_mint_v1_genesis_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+
+type fastReflection_GenesisState_messageType struct{}
+
+func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisState)(nil)
+}
+func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisState) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
+	return (*GenesisState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MintAuthority != "" {
+		value := protoreflect.ValueOfString(x.MintAuthority)
+		if !f(fd_GenesisState_mint_authority, value) {
+			return
+		}
+	}
+	if len(x.MinterAllowances) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.MinterAllowances})
+		if !f(fd_GenesisState_minter_allowances, value) {
+			return
+		}
+	}
+	if len(x.DenomMintLimits) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.DenomMintLimits})
+		if !f(fd_GenesisState_denom_mint_limits, value) {
+			return
+		}
+	}
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_GenesisState_params, value) {
+			return
+		}
+	}
+	if len(x.DenomMintStats) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.DenomMintStats})
+		if !f(fd_GenesisState_denom_mint_stats, value) {
+			return
+		}
+	}
+	if len(x.MinterMintStats) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.MinterMintStats})
+		if !f(fd_GenesisState_minter_mint_stats, value) {
+			return
+		}
+	}
+	if x.Guardian != "" {
+		value := protoreflect.ValueOfString(x.Guardian)
+		if !f(fd_GenesisState_guardian, value) {
+			return
+		}
+	}
+	if x.Paused != false {
+		value := protoreflect.ValueOfBool(x.Paused)
+		if !f(fd_GenesisState_paused, value) {
+			return
+		}
+	}
+	if len(x.PausedDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.PausedDenoms})
+		if !f(fd_GenesisState_paused_denoms, value) {
+			return
+		}
+	}
+	if len(x.IbcMintRoutes) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.IbcMintRoutes})
+		if !f(fd_GenesisState_ibc_mint_routes, value) {
+			return
+		}
+	}
+	if x.MintApprovalConfig != nil {
+		value := protoreflect.ValueOfMessage(x.MintApprovalConfig.ProtoReflect())
+		if !f(fd_GenesisState_mint_approval_config, value) {
+			return
+		}
+	}
+	if len(x.MintProposals) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.MintProposals})
+		if !f(fd_GenesisState_mint_proposals, value) {
+			return
+		}
+	}
+	if x.NextMintProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextMintProposalId)
+		if !f(fd_GenesisState_next_mint_proposal_id, value) {
+			return
+		}
+	}
+	if len(x.UsedVoucherNonces) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_14_list{list: &x.UsedVoucherNonces})
+		if !f(fd_GenesisState_used_voucher_nonces, value) {
+			return
+		}
+	}
+	if len(x.MintHistory) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_15_list{list: &x.MintHistory})
+		if !f(fd_GenesisState_mint_history, value) {
+			return
+		}
+	}
+	if x.NextMintRecordId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextMintRecordId)
+		if !f(fd_GenesisState_next_mint_record_id, value) {
+			return
+		}
+	}
+	if len(x.DenomAdmins) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_17_list{list: &x.DenomAdmins})
+		if !f(fd_GenesisState_denom_admins, value) {
+			return
+		}
+	}
+	if len(x.EmissionSchedules) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_18_list{list: &x.EmissionSchedules})
+		if !f(fd_GenesisState_emission_schedules, value) {
+			return
+		}
+	}
+	if x.NextEmissionScheduleId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextEmissionScheduleId)
+		if !f(fd_GenesisState_next_emission_schedule_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.mint.v1.GenesisState.mint_authority":
+		return x.MintAuthority != ""
+	case "cosmos.evm.mint.v1.GenesisState.minter_allowances":
+		return len(x.MinterAllowances) != 0
+	case "cosmos.evm.mint.v1.GenesisState.denom_mint_limits":
+		return len(x.DenomMintLimits) != 0
+	case "cosmos.evm.mint.v1.GenesisState.params":
+		return x.Params != nil
+	case "cosmos.evm.mint.v1.GenesisState.denom_mint_stats":
+		return len(x.DenomMintStats) != 0
+	case "cosmos.evm.mint.v1.GenesisState.minter_mint_stats":
+		return len(x.MinterMintStats) != 0
+	case "cosmos.evm.mint.v1.GenesisState.guardian":
+		return x.Guardian != ""
+	case "cosmos.evm.mint.v1.GenesisState.paused":
+		return x.Paused != false
+	case "cosmos.evm.mint.v1.GenesisState.paused_denoms":
+		return len(x.PausedDenoms) != 0
+	case "cosmos.evm.mint.v1.GenesisState.ibc_mint_routes":
+		return len(x.IbcMintRoutes) != 0
+	case "cosmos.evm.mint.v1.GenesisState.mint_approval_config":
+		return x.MintApprovalConfig != nil
+	case "cosmos.evm.mint.v1.GenesisState.mint_proposals":
+		return len(x.MintProposals) != 0
+	case "cosmos.evm.mint.v1.GenesisState.next_mint_proposal_id":
+		return x.NextMintProposalId != uint64(0)
+	case "cosmos.evm.mint.v1.GenesisState.used_voucher_nonces":
+		return len(x.UsedVoucherNonces) != 0
+	case "cosmos.evm.mint.v1.GenesisState.mint_history":
+		return len(x.MintHistory) != 0
+	case "cosmos.evm.mint.v1.GenesisState.next_mint_record_id":
+		return x.NextMintRecordId != uint64(0)
+	case "cosmos.evm.mint.v1.GenesisState.denom_admins":
+		return len(x.DenomAdmins) != 0
+	case "cosmos.evm.mint.v1.GenesisState.emission_schedules":
+		return len(x.EmissionSchedules) != 0
+	case "cosmos.evm.mint.v1.GenesisState.next_emission_schedule_id":
+		return x.NextEmissionScheduleId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.mint.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.mint.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.mint.v1.GenesisState.mint_authority":
+		x.MintAuthority = ""
+	case "cosmos.evm.mint.v1.GenesisState.minter_allowances":
+		x.MinterAllowances = nil
+	case "cosmos.evm.mint.v1.GenesisState.denom_mint_limits":
+		x.DenomMintLimits = nil
+	case "cosmos.evm.mint.v1.GenesisState.params":
+		x.Params = nil
+	case "cosmos.evm.mint.v1.GenesisState.denom_mint_stats":
+		x.DenomMintStats = nil
+	case "cosmos.evm.mint.v1.GenesisState.minter_mint_stats":
+		x.MinterMintStats = nil
+	case "cosmos.evm.mint.v1.GenesisState.guardian":
+		x.Guardian = ""
+	case "cosmos.evm.mint.v1.GenesisState.paused":
+		x.Paused = false
+	case "cosmos.evm.mint.v1.GenesisState.paused_denoms":
+		x.PausedDenoms = nil
+	case "cosmos.evm.mint.v1.GenesisState.ibc_mint_routes":
+		x.IbcMintRoutes = nil
+	case "cosmos.evm.mint.v1.GenesisState.mint_approval_config":
+		x.MintApprovalConfig = nil
+	case "cosmos.evm.mint.v1.GenesisState.mint_proposals":
+		x.MintProposals = nil
+	case "cosmos.evm.mint.v1.GenesisState.next_mint_proposal_id":
+		x.NextMintProposalId = uint64(0)
+	case "cosmos.evm.mint.v1.GenesisState.used_voucher_nonces":
+		x.UsedVoucherNonces = nil
+	case "cosmos.evm.mint.v1.GenesisState.mint_history":
+		x.MintHistory = nil
+	case "cosmos.evm.mint.v1.GenesisState.next_mint_record_id":
+		x.NextMintRecordId = uint64(0)
+	case "cosmos.evm.mint.v1.GenesisState.denom_admins":
+		x.DenomAdmins = nil
+	case "cosmos.evm.mint.v1.GenesisState.emission_schedules":
+		x.EmissionSchedules = nil
+	case "cosmos.evm.mint.v1.GenesisState.next_emission_schedule_id":
+		x.NextEmissionScheduleId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.mint.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.mint.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.mint.v1.GenesisState.mint_authority":
+		value := x.MintAuthority
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.mint.v1.GenesisState.minter_allowances":
+		if len(x.MinterAllowances) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_2_list{})
+		}
+		listValue := &_GenesisState_2_list{list: &x.MinterAllowances}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.mint.v1.GenesisState.denom_mint_limits":
+		if len(x.DenomMintLimits) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_3_list{})
+		}
+		listValue := &_GenesisState_3_list{list: &x.DenomMintLimits}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.mint.v1.GenesisState.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.mint.v1.GenesisState.denom_mint_stats":
+		if len(x.DenomMintStats) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.DenomMintStats}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.mint.v1.GenesisState.minter_mint_stats":
+		if len(x.MinterMintStats) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.MinterMintStats}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.mint.v1.GenesisState.guardian":
+		value := x.Guardian
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.mint.v1.GenesisState.paused":
+		value := x.Paused
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.evm.mint.v1.GenesisState.paused_denoms":
+		if len(x.PausedDenoms) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.PausedDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.mint.v1.GenesisState.ibc_mint_routes":
+		if len(x.IbcMintRoutes) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.IbcMintRoutes}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.mint.v1.GenesisState.mint_approval_config":
+		value := x.MintApprovalConfig
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.mint.v1.GenesisState.mint_proposals":
+		if len(x.MintProposals) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.MintProposals}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.mint.v1.GenesisState.next_mint_proposal_id":
+		value := x.NextMintProposalId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.mint.v1.GenesisState.used_voucher_nonces":
+		if len(x.UsedVoucherNonces) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_14_list{})
+		}
+		listValue := &_GenesisState_14_list{list: &x.UsedVoucherNonces}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.mint.v1.GenesisState.mint_history":
+		if len(x.MintHistory) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_15_list{})
+		}
+		listValue := &_GenesisState_15_list{list: &x.MintHistory}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.mint.v1.GenesisState.next_mint_record_id":
+		value := x.NextMintRecordId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.mint.v1.GenesisState.denom_admins":
+		if len(x.DenomAdmins) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_17_list{})
+		}
+		listValue := &_GenesisState_17_list{list: &x.DenomAdmins}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.mint.v1.GenesisState.emission_schedules":
+		if len(x.EmissionSchedules) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_18_list{})
+		}
+		listValue := &_GenesisState_18_list{list: &x.EmissionSchedules}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.mint.v1.GenesisState.next_emission_schedule_id":
+		value := x.NextEmissionScheduleId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.mint.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.mint.v1.GenesisState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.mint.v1.GenesisState.mint_authority":
+		x.MintAuthority = value.Interface().(string)
+	case "cosmos.evm.mint.v1.GenesisState.minter_allowances":
+		lv := value.List()
+		clv := lv.(*_GenesisState_2_list)
+		x.MinterAllowances = *clv.list
+	case "cosmos.evm.mint.v1.GenesisState.denom_mint_limits":
+		lv := value.List()
+		clv := lv.(*_GenesisState_3_list)
+		x.DenomMintLimits = *clv.list
+	case "cosmos.evm.mint.v1.GenesisState.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "cosmos.evm.mint.v1.GenesisState.denom_mint_stats":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.DenomMintStats = *clv.list
+	case "cosmos.evm.mint.v1.GenesisState.minter_mint_stats":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.MinterMintStats = *clv.list
+	case "cosmos.evm.mint.v1.GenesisState.guardian":
+		x.Guardian = value.Interface().(string)
+	case "cosmos.evm.mint.v1.GenesisState.paused":
+		x.Paused = value.Bool()
+	case "cosmos.evm.mint.v1.GenesisState.paused_denoms":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.PausedDenoms = *clv.list
+	case "cosmos.evm.mint.v1.GenesisState.ibc_mint_routes":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.IbcMintRoutes = *clv.list
+	case "cosmos.evm.mint.v1.GenesisState.mint_approval_config":
+		x.MintApprovalConfig = value.Message().Interface().(*MintApprovalConfig)
+	case "cosmos.evm.mint.v1.GenesisState.mint_proposals":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.MintProposals = *clv.list
+	case "cosmos.evm.mint.v1.GenesisState.next_mint_proposal_id":
+		x.NextMintProposalId = value.Uint()
+	case "cosmos.evm.mint.v1.GenesisState.used_voucher_nonces":
+		lv := value.List()
+		clv := lv.(*_GenesisState_14_list)
+		x.UsedVoucherNonces = *clv.list
+	case "cosmos.evm.mint.v1.GenesisState.mint_history":
+		lv := value.List()
+		clv := lv.(*_GenesisState_15_list)
+		x.MintHistory = *clv.list
+	case "cosmos.evm.mint.v1.GenesisState.next_mint_record_id":
+		x.NextMintRecordId = value.Uint()
+	case "cosmos.evm.mint.v1.GenesisState.denom_admins":
+		lv := value.List()
+		clv := lv.(*_GenesisState_17_list)
+		x.DenomAdmins = *clv.list
+	case "cosmos.evm.mint.v1.GenesisState.emission_schedules":
+		lv := value.List()
+		clv := lv.(*_GenesisState_18_list)
+		x.EmissionSchedules = *clv.list
+	case "cosmos.evm.mint.v1.GenesisState.next_emission_schedule_id":
+		x.NextEmissionScheduleId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.mint.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.mint.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.mint.v1.GenesisState.minter_allowances":
+		if x.MinterAllowances == nil {
+			x.MinterAllowances = []*MinterAllowance{}
+		}
+		value := &_GenesisState_2_list{list: &x.MinterAllowances}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.mint.v1.GenesisState.denom_mint_limits":
+		if x.DenomMintLimits == nil {
+			x.DenomMintLimits = []*DenomMintLimit{}
+		}
+		value := &_GenesisState_3_list{list: &x.DenomMintLimits}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.mint.v1.GenesisState.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "cosmos.evm.mint.v1.GenesisState.denom_mint_stats":
+		if x.DenomMintStats == nil {
+			x.DenomMintStats = []*DenomMintStats{}
+		}
+		value := &_GenesisState_5_list{list: &x.DenomMintStats}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.mint.v1.GenesisState.minter_mint_stats":
+		if x.MinterMintStats == nil {
+			x.MinterMintStats = []*MinterMintStats{}
+		}
+		value := &_GenesisState_6_list{list: &x.MinterMintStats}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.mint.v1.GenesisState.paused_denoms":
+		if x.PausedDenoms == nil {
+			x.PausedDenoms = []string{}
+		}
+		value := &_GenesisState_9_list{list: &x.PausedDenoms}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.mint.v1.GenesisState.ibc_mint_routes":
+		if x.IbcMintRoutes == nil {
+			x.IbcMintRoutes = []*IBCMintRoute{}
+		}
+		value := &_GenesisState_10_list{list: &x.IbcMintRoutes}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.mint.v1.GenesisState.mint_approval_config":
+		if x.MintApprovalConfig == nil {
+			x.MintApprovalConfig = new(MintApprovalConfig)
+		}
+		return protoreflect.ValueOfMessage(x.MintApprovalConfig.ProtoReflect())
+	case "cosmos.evm.mint.v1.GenesisState.mint_proposals":
+		if x.MintProposals == nil {
+			x.MintProposals = []*MintProposal{}
+		}
+		value := &_GenesisState_12_list{list: &x.MintProposals}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.mint.v1.GenesisState.used_voucher_nonces":
+		if x.UsedVoucherNonces == nil {
+			x.UsedVoucherNonces = []*UsedVoucherNonce{}
+		}
+		value := &_GenesisState_14_list{list: &x.UsedVoucherNonces}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.mint.v1.GenesisState.mint_history":
+		if x.MintHistory == nil {
+			x.MintHistory = []*MintRecord{}
+		}
+		value := &_GenesisState_15_list{list: &x.MintHistory}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.mint.v1.GenesisState.denom_admins":
+		if x.DenomAdmins == nil {
+			x.DenomAdmins = []*DenomAdmin{}
+		}
+		value := &_GenesisState_17_list{list: &x.DenomAdmins}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.mint.v1.GenesisState.emission_schedules":
+		if x.EmissionSchedules == nil {
+			x.EmissionSchedules = []*EmissionSchedule{}
+		}
+		value := &_GenesisState_18_list{list: &x.EmissionSchedules}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.mint.v1.GenesisState.mint_authority":
+		panic(fmt.Errorf("field mint_authority of message cosmos.evm.mint.v1.GenesisState is not mutable"))
+	case "cosmos.evm.mint.v1.GenesisState.guardian":
+		panic(fmt.Errorf("field guardian of message cosmos.evm.mint.v1.GenesisState is not mutable"))
+	case "cosmos.evm.mint.v1.GenesisState.paused":
+		panic(fmt.Errorf("field paused of message cosmos.evm.mint.v1.GenesisState is not mutable"))
+	case "cosmos.evm.mint.v1.GenesisState.next_mint_proposal_id":
+		panic(fmt.Errorf("field next_mint_proposal_id of message cosmos.evm.mint.v1.GenesisState is not mutable"))
+	case "cosmos.evm.mint.v1.GenesisState.next_mint_record_id":
+		panic(fmt.Errorf("field next_mint_record_id of message cosmos.evm.mint.v1.GenesisState is not mutable"))
+	case "cosmos.evm.mint.v1.GenesisState.next_emission_schedule_id":
+		panic(fmt.Errorf("field next_emission_schedule_id of message cosmos.evm.mint.v1.GenesisState is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.mint.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.mint.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.mint.v1.GenesisState.mint_authority":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.mint.v1.GenesisState.minter_allowances":
+		list := []*MinterAllowance{}
+		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "cosmos.evm.mint.v1.GenesisState.denom_mint_limits":
+		list := []*DenomMintLimit{}
+		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "cosmos.evm.mint.v1.GenesisState.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.mint.v1.GenesisState.denom_mint_stats":
+		list := []*DenomMintStats{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "cosmos.evm.mint.v1.GenesisState.minter_mint_stats":
+		list := []*MinterMintStats{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "cosmos.evm.mint.v1.GenesisState.guardian":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.mint.v1.GenesisState.paused":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.evm.mint.v1.GenesisState.paused_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "cosmos.evm.mint.v1.GenesisState.ibc_mint_routes":
+		list := []*IBCMintRoute{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "cosmos.evm.mint.v1.GenesisState.mint_approval_config":
+		m := new(MintApprovalConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.mint.v1.GenesisState.mint_proposals":
+		list := []*MintProposal{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "cosmos.evm.mint.v1.GenesisState.next_mint_proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.mint.v1.GenesisState.used_voucher_nonces":
+		list := []*UsedVoucherNonce{}
+		return protoreflect.ValueOfList(&_GenesisState_14_list{list: &list})
+	case "cosmos.evm.mint.v1.GenesisState.mint_history":
+		list := []*MintRecord{}
+		return protoreflect.ValueOfList(&_GenesisState_15_list{list: &list})
+	case "cosmos.evm.mint.v1.GenesisState.next_mint_record_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.mint.v1.GenesisState.denom_admins":
+		list := []*DenomAdmin{}
+		return protoreflect.ValueOfList(&_GenesisState_17_list{list: &list})
+	case "cosmos.evm.mint.v1.GenesisState.emission_schedules":
+		list := []*EmissionSchedule{}
+		return protoreflect.ValueOfList(&_GenesisState_18_list{list: &list})
+	case "cosmos.evm.mint.v1.GenesisState.next_emission_schedule_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.mint.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.mint.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.mint.v1.GenesisState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MintAuthority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.MinterAllowances) > 0 {
+			for _, e := range x.MinterAllowances {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DenomMintLimits) > 0 {
+			for _, e := range x.DenomMintLimits {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.DenomMintStats) > 0 {
+			for _, e := range x.DenomMintStats {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MinterMintStats) > 0 {
+			for _, e := range x.MinterMintStats {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Guardian)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Paused {
+			n += 2
+		}
+		if len(x.PausedDenoms) > 0 {
+			for _, s := range x.PausedDenoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.IbcMintRoutes) > 0 {
+			for _, e := range x.IbcMintRoutes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MintApprovalConfig != nil {
+			l = options.Size(x.MintApprovalConfig)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.MintProposals) > 0 {
+			for _, e := range x.MintProposals {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextMintProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextMintProposalId))
+		}
+		if len(x.UsedVoucherNonces) > 0 {
+			for _, e := range x.UsedVoucherNonces {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MintHistory) > 0 {
+			for _, e := range x.MintHistory {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextMintRecordId != 0 {
+			n += 2 + runtime.Sov(uint64(x.NextMintRecordId))
+		}
+		if len(x.DenomAdmins) > 0 {
+			for _, e := range x.DenomAdmins {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.EmissionSchedules) > 0 {
+			for _, e := range x.EmissionSchedules {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextEmissionScheduleId != 0 {
+			n += 2 + runtime.Sov(uint64(x.NextEmissionScheduleId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.NextEmissionScheduleId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextEmissionScheduleId))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x98
+		}
+		if len(x.EmissionSchedules) > 0 {
+			for iNdEx := len(x.EmissionSchedules) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EmissionSchedules[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if len(x.DenomAdmins) > 0 {
+			for iNdEx := len(x.DenomAdmins) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DenomAdmins[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
+		if x.NextMintRecordId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextMintRecordId))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if len(x.MintHistory) > 0 {
+			for iNdEx := len(x.MintHistory) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MintHistory[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if len(x.UsedVoucherNonces) > 0 {
+			for iNdEx := len(x.UsedVoucherNonces) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.UsedVoucherNonces[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if x.NextMintProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextMintProposalId))
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.MintProposals) > 0 {
+			for iNdEx := len(x.MintProposals) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MintProposals[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if x.MintApprovalConfig != nil {
+			encoded, err := options.Marshal(x.MintApprovalConfig)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.IbcMintRoutes) > 0 {
+			for iNdEx := len(x.IbcMintRoutes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.IbcMintRoutes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.PausedDenoms) > 0 {
+			for iNdEx := len(x.PausedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.PausedDenoms[iNdEx])
+				copy(dAtA[i:], x.PausedDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PausedDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if x.Paused {
+			i--
+			if x.Paused {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.Guardian) > 0 {
+			i -= len(x.Guardian)
+			copy(dAtA[i:], x.Guardian)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Guardian)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.MinterMintStats) > 0 {
+			for iNdEx := len(x.MinterMintStats) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MinterMintStats[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.DenomMintStats) > 0 {
+			for iNdEx := len(x.DenomMintStats) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DenomMintStats[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.DenomMintLimits) > 0 {
+			for iNdEx := len(x.DenomMintLimits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DenomMintLimits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.MinterAllowances) > 0 {
+			for iNdEx := len(x.MinterAllowances) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MinterAllowances[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.MintAuthority) > 0 {
+			i -= len(x.MintAuthority)
+			copy(dAtA[i:], x.MintAuthority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MintAuthority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintAuthority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MintAuthority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinterAllowances", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinterAllowances = append(x.MinterAllowances, &MinterAllowance{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinterAllowances[len(x.MinterAllowances)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomMintLimits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DenomMintLimits = append(x.DenomMintLimits, &DenomMintLimit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomMintLimits[len(x.DenomMintLimits)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomMintStats", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DenomMintStats = append(x.DenomMintStats, &DenomMintStats{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomMintStats[len(x.DenomMintStats)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinterMintStats", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinterMintStats = append(x.MinterMintStats, &MinterMintStats{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinterMintStats[len(x.MinterMintStats)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Guardian = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Paused = bool(v != 0)
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PausedDenoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PausedDenoms = append(x.PausedDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IbcMintRoutes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IbcMintRoutes = append(x.IbcMintRoutes, &IBCMintRoute{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IbcMintRoutes[len(x.IbcMintRoutes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintApprovalConfig", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MintApprovalConfig == nil {
+					x.MintApprovalConfig = &MintApprovalConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MintApprovalConfig); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintProposals", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MintProposals = append(x.MintProposals, &MintProposal{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MintProposals[len(x.MintProposals)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextMintProposalId", wireType)
+				}
+				x.NextMintProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextMintProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UsedVoucherNonces", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UsedVoucherNonces = append(x.UsedVoucherNonces, &UsedVoucherNonce{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UsedVoucherNonces[len(x.UsedVoucherNonces)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintHistory", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MintHistory = append(x.MintHistory, &MintRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MintHistory[len(x.MintHistory)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextMintRecordId", wireType)
+				}
+				x.NextMintRecordId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextMintRecordId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomAdmins", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DenomAdmins = append(x.DenomAdmins, &DenomAdmin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomAdmins[len(x.DenomAdmins)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EmissionSchedules", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EmissionSchedules = append(x.EmissionSchedules, &EmissionSchedule{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EmissionSchedules[len(x.EmissionSchedules)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 19:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextEmissionScheduleId", wireType)
+				}
+				x.NextEmissionScheduleId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextEmissionScheduleId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cosmos/evm/mint/v1/genesis.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GenesisState defines the module's genesis state.
+type GenesisState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// mint_authority is the bech32 address of the account allowed to mint
+	// native tokens through the mint precompile. An empty value disables
+	// minting.
+	MintAuthority string `protobuf:"bytes,1,opt,name=mint_authority,json=mintAuthority,proto3" json:"mint_authority,omitempty"`
+	// minter_allowances is a slice of the registered minter allowances at
+	// genesis
+	MinterAllowances []*MinterAllowance `protobuf:"bytes,2,rep,name=minter_allowances,json=minterAllowances,proto3" json:"minter_allowances,omitempty"`
+	// denom_mint_limits is a slice of the supply caps and rate limits of each
+	// denom at genesis
+	DenomMintLimits []*DenomMintLimit `protobuf:"bytes,3,rep,name=denom_mint_limits,json=denomMintLimits,proto3" json:"denom_mint_limits,omitempty"`
+	// params are the mint module parameters at genesis
+	Params *Params `protobuf:"bytes,4,opt,name=params,proto3" json:"params,omitempty"`
+	// denom_mint_stats is a slice of the totals minted and burned of each denom
+	// at genesis
+	DenomMintStats []*DenomMintStats `protobuf:"bytes,5,rep,name=denom_mint_stats,json=denomMintStats,proto3" json:"denom_mint_stats,omitempty"`
+	// minter_mint_stats is a slice of the totals minted by each minter at
+	// genesis
+	MinterMintStats []*MinterMintStats `protobuf:"bytes,6,rep,name=minter_mint_stats,json=minterMintStats,proto3" json:"minter_mint_stats,omitempty"`
+	// guardian is the bech32 address of the account allowed to pause minting
+	// and burning through the mint precompile. An empty value disables the
+	// guardian.
+	Guardian string `protobuf:"bytes,7,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// paused defines if minting and burning of all denoms is paused at genesis
+	Paused bool `protobuf:"varint,8,opt,name=paused,proto3" json:"paused,omitempty"`
+	// paused_denoms is a slice of the denoms whose minting and burning is paused
+	// at genesis
+	PausedDenoms []string `protobuf:"bytes,9,rep,name=paused_denoms,json=pausedDenoms,proto3" json:"paused_denoms,omitempty"`
+	// ibc_mint_routes is a slice of the IBC channels whose tokens are minted as
+	// a canonical native denom at genesis
+	IbcMintRoutes []*IBCMintRoute `protobuf:"bytes,10,rep,name=ibc_mint_routes,json=ibcMintRoutes,proto3" json:"ibc_mint_routes,omitempty"`
+	// mint_approval_config defines the approvers of mint proposals and the
+	// mints that require one at genesis
+	MintApprovalConfig *MintApprovalConfig `protobuf:"bytes,11,opt,name=mint_approval_config,json=mintApprovalConfig,proto3" json:"mint_approval_config,omitempty"`
+	// mint_proposals is a slice of the pending mint proposals at genesis
+	MintProposals []*MintProposal `protobuf:"bytes,12,rep,name=mint_proposals,json=mintProposals,proto3" json:"mint_proposals,omitempty"`
+	// next_mint_proposal_id is the identifier of the next mint proposal
+	NextMintProposalId uint64 `protobuf:"varint,13,opt,name=next_mint_proposal_id,json=nextMintProposalId,proto3" json:"next_mint_proposal_id,omitempty"`
+	// used_voucher_nonces is a slice of the nonces of the signed mint vouchers
+	// redeemed before genesis
+	UsedVoucherNonces []*UsedVoucherNonce `protobuf:"bytes,14,rep,name=used_voucher_nonces,json=usedVoucherNonces,proto3" json:"used_voucher_nonces,omitempty"`
+	// mint_history is a slice of the mint records kept at genesis
+	MintHistory []*MintRecord `protobuf:"bytes,15,rep,name=mint_history,json=mintHistory,proto3" json:"mint_history,omitempty"`
+	// next_mint_record_id is the identifier of the next mint record
+	NextMintRecordId uint64 `protobuf:"varint,16,opt,name=next_mint_record_id,json=nextMintRecordId,proto3" json:"next_mint_record_id,omitempty"`
+	// denom_admins is a slice of the denoms created through the mint precompile
+	// and their admins at genesis
+	DenomAdmins []*DenomAdmin `protobuf:"bytes,17,rep,name=denom_admins,json=denomAdmins,proto3" json:"denom_admins,omitempty"`
+	// emission_schedules is a slice of the emission schedules that still have
+	// tokens to emit at genesis
+	EmissionSchedules []*EmissionSchedule `protobuf:"bytes,18,rep,name=emission_schedules,json=emissionSchedules,proto3" json:"emission_schedules,omitempty"`
+	// next_emission_schedule_id is the identifier of the next emission schedule
+	NextEmissionScheduleId uint64 `protobuf:"varint,19,opt,name=next_emission_schedule_id,json=nextEmissionScheduleId,proto3" json:"next_emission_schedule_id,omitempty"`
+}
+
+func (x *GenesisState) Reset() {
+	*x = GenesisState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_mint_v1_genesis_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisState) ProtoMessage() {}
+
+// Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_mint_v1_genesis_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GenesisState) GetMintAuthority() string {
+	if x != nil {
+		return x.MintAuthority
+	}
+	return ""
+}
+
+func (x *GenesisState) GetMinterAllowances() []*MinterAllowance {
+	if x != nil {
+		return x.MinterAllowances
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDenomMintLimits() []*DenomMintLimit {
+	if x != nil {
+		return x.DenomMintLimits
+	}
+	return nil
+}
+
+func (x *GenesisState) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDenomMintStats() []*DenomMintStats {
+	if x != nil {
+		return x.DenomMintStats
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMinterMintStats() []*MinterMintStats {
+	if x != nil {
+		return x.MinterMintStats
+	}
+	return nil
+}
+
+func (x *GenesisState) GetGuardian() string {
+	if x != nil {
+		return x.Guardian
+	}
+	return ""
+}
+
+func (x *GenesisState) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *GenesisState) GetPausedDenoms() []string {
+	if x != nil {
+		return x.PausedDenoms
+	}
+	return nil
+}
+
+func (x *GenesisState) GetIbcMintRoutes() []*IBCMintRoute {
+	if x != nil {
+		return x.IbcMintRoutes
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMintApprovalConfig() *MintApprovalConfig {
+	if x != nil {
+		return x.MintApprovalConfig
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMintProposals() []*MintProposal {
+	if x != nil {
+		return x.MintProposals
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextMintProposalId() uint64 {
+	if x != nil {
+		return x.NextMintProposalId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetUsedVoucherNonces() []*UsedVoucherNonce {
+	if x != nil {
+		return x.UsedVoucherNonces
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMintHistory() []*MintRecord {
+	if x != nil {
+		return x.MintHistory
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextMintRecordId() uint64 {
+	if x != nil {
+		return x.NextMintRecordId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetDenomAdmins() []*DenomAdmin {
+	if x != nil {
+		return x.DenomAdmins
+	}
+	return nil
+}
+
+func (x *GenesisState) GetEmissionSchedules() []*EmissionSchedule {
+	if x != nil {
+		return x.EmissionSchedules
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextEmissionScheduleId() uint64 {
+	if x != nil {
+		return x.NextEmissionScheduleId
+	}
+	return 0
+}
+
+var File_cosmos_evm_mint_v1_genesis_proto protoreflect.FileDescriptor
+
+var file_cosmos_evm_mint_v1_genesis_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x6d, 0x69, 0x6e,
+	0x74, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x12, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x6d,
+	0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d,
+	0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69,
+	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
+	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd1, 0x0b, 0x0a, 0x0c, 0x47, 0x65,
+	0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x0e, 0x6d, 0x69,
+	0x6e, 0x74, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x6d, 0x69,
+	0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x5b, 0x0a, 0x11, 0x6d,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x65, 0x76, 0x6d, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f,
+	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x59, 0x0a, 0x11, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
+	0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4d, 0x69,
+	0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x4d, 0x69, 0x6e, 0x74, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
+	0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
+	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x57, 0x0a, 0x10, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x6d, 0x69, 0x6e, 0x74,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4d, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x4d, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x5a, 0x0a, 0x11, 0x6d,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x65, 0x76, 0x6d, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x4d, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f,
+	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x4d, 0x69,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x69, 0x61, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x08, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x5f,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61,
+	0x75, 0x73, 0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12, 0x64, 0x0a, 0x0f, 0x69, 0x62,
+	0x63, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
+	0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x42, 0x43, 0x4d, 0x69, 0x6e, 0x74,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x42, 0x1a, 0xc8, 0xde, 0x1f, 0x00, 0xe2, 0xde, 0x1f, 0x0d, 0x49,
+	0x42, 0x43, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x0d, 0x69, 0x62, 0x63, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73,
+	0x12, 0x63, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x12, 0x6d, 0x69, 0x6e, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x52, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x42,
+	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x74,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x49, 0x0a, 0x15, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x42, 0x16, 0xe2, 0xde, 0x1f, 0x12, 0x4e, 0x65,
+	0x78, 0x74, 0x4d, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x44,
+	0x52, 0x12, 0x6e, 0x65, 0x78, 0x74, 0x4d, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x49, 0x64, 0x12, 0x5f, 0x0a, 0x13, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x76, 0x6f, 0x75,
+	0x63, 0x68, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x6d,
+	0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x64, 0x56, 0x6f, 0x75, 0x63, 0x68,
+	0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x11, 0x75, 0x73, 0x65, 0x64, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x4e,
+	0x6f, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x09, 0xc8, 0xde, 0x1f,
+	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x43, 0x0a, 0x13, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x74,
+	0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x14, 0xe2, 0xde, 0x1f, 0x10, 0x4e, 0x65, 0x78, 0x74, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x49, 0x44, 0x52, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x4d, 0x69, 0x6e, 0x74,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x4c, 0x0a, 0x0c, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x09,
+	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x12, 0x5e, 0x0a, 0x12, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x12, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
+	0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x11, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x55, 0x0a, 0x19, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x65,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x16, 0x4e,
+	0x65, 0x78, 0x74, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x49, 0x44, 0x52, 0x16, 0x6e, 0x65, 0x78, 0x74, 0x45, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x42, 0xbd, 0x01,
+	0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
+	0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x69,
+	0x6e, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x45, 0x4d, 0xaa, 0x02, 0x12, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x2e, 0x56, 0x31, 0xca,
+	0x02, 0x12, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x4d, 0x69, 0x6e,
+	0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1e, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76,
+	0x6d, 0x5c, 0x4d, 0x69, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a,
+	0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x4d, 0x69, 0x6e, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cosmos_evm_mint_v1_genesis_proto_rawDescOnce sync.Once
+	file_cosmos_evm_mint_v1_genesis_proto_rawDescData = file_cosmos_evm_mint_v1_genesis_proto_rawDesc
+)
+
+func file_cosmos_evm_mint_v1_genesis_proto_rawDescGZIP() []byte {
+	file_cosmos_evm_mint_v1_genesis_proto_rawDescOnce.Do(func() {
+		file_cosmos_evm_mint_v1_genesis_proto_rawDescData = protoimpl.X.CompressGZIP(file_cosmos_evm_mint_v1_genesis_proto_rawDescData)
+	})
+	return file_cosmos_evm_mint_v1_genesis_proto_rawDescData
+}
+
+var file_cosmos_evm_mint_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cosmos_evm_mint_v1_genesis_proto_goTypes = []interface{}{
+	(*GenesisState)(nil),       // 0: cosmos.evm.mint.v1.GenesisState
+	(*MinterAllowance)(nil),    // 1: cosmos.evm.mint.v1.MinterAllowance
+	(*DenomMintLimit)(nil),     // 2: cosmos.evm.mint.v1.DenomMintLimit
+	(*Params)(nil),             // 3: cosmos.evm.mint.v1.Params
+	(*DenomMintStats)(nil),     // 4: cosmos.evm.mint.v1.DenomMintStats
+	(*MinterMintStats)(nil),    // 5: cosmos.evm.mint.v1.MinterMintStats
+	(*IBCMintRoute)(nil),       // 6: cosmos.evm.mint.v1.IBCMintRoute
+	(*MintApprovalConfig)(nil), // 7: cosmos.evm.mint.v1.MintApprovalConfig
+	(*MintProposal)(nil),       // 8: cosmos.evm.mint.v1.MintProposal
+	(*UsedVoucherNonce)(nil),   // 9: cosmos.evm.mint.v1.UsedVoucherNonce
+	(*MintRecord)(nil),         // 10: cosmos.evm.mint.v1.MintRecord
+	(*DenomAdmin)(nil),         // 11: cosmos.evm.mint.v1.DenomAdmin
+	(*EmissionSchedule)(nil),   // 12: cosmos.evm.mint.v1.EmissionSchedule
+}
+var file_cosmos_evm_mint_v1_genesis_proto_depIdxs = []int32{
+	1,  // 0: cosmos.evm.mint.v1.GenesisState.minter_allowances:type_name -> cosmos.evm.mint.v1.MinterAllowance
+	2,  // 1: cosmos.evm.mint.v1.GenesisState.denom_mint_limits:type_name -> cosmos.evm.mint.v1.DenomMintLimit
+	3,  // 2: cosmos.evm.mint.v1.GenesisState.params:type_name -> cosmos.evm.mint.v1.Params
+	4,  // 3: cosmos.evm.mint.v1.GenesisState.denom_mint_stats:type_name -> cosmos.evm.mint.v1.DenomMintStats
+	5,  // 4: cosmos.evm.mint.v1.GenesisState.minter_mint_stats:type_name -> cosmos.evm.mint.v1.MinterMintStats
+	6,  // 5: cosmos.evm.mint.v1.GenesisState.ibc_mint_routes:type_name -> cosmos.evm.mint.v1.IBCMintRoute
+	7,  // 6: cosmos.evm.mint.v1.GenesisState.mint_approval_config:type_name -> cosmos.evm.mint.v1.MintApprovalConfig
+	8,  // 7: cosmos.evm.mint.v1.GenesisState.mint_proposals:type_name -> cosmos.evm.mint.v1.MintProposal
+	9,  // 8: cosmos.evm.mint.v1.GenesisState.used_voucher_nonces:type_name -> cosmos.evm.mint.v1.UsedVoucherNonce
+	10, // 9: cosmos.evm.mint.v1.GenesisState.mint_history:type_name -> cosmos.evm.mint.v1.MintRecord
+	11, // 10: cosmos.evm.mint.v1.GenesisState.denom_admins:type_name -> cosmos.evm.mint.v1.DenomAdmin
+	12, // 11: cosmos.evm.mint.v1.GenesisState.emission_schedules:type_name -> cosmos.evm.mint.v1.EmissionSchedule
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_cosmos_evm_mint_v1_genesis_proto_init() }
+func file_cosmos_evm_mint_v1_genesis_proto_init() {
+	if File_cosmos_evm_mint_v1_genesis_proto != nil {
+		return
+	}
+	file_cosmos_evm_mint_v1_mint_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_cosmos_evm_mint_v1_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenesisState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_cosmos_evm_mint_v1_genesis_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cosmos_evm_mint_v1_genesis_proto_goTypes,
+		DependencyIndexes: file_cosmos_evm_mint_v1_genesis_proto_depIdxs,
+		MessageInfos:      file_cosmos_evm_mint_v1_genesis_proto_msgTypes,
+	}.Build()
+	File_cosmos_evm_mint_v1_genesis_proto = out.File
+	file_cosmos_evm_mint_v1_genesis_proto_rawDesc = nil
+	file_cosmos_evm_mint_v1_genesis_proto_goTypes = nil
+	file_cosmos_evm_mint_v1_genesis_proto_depIdxs = nil
+}
@@ -0,0 +1,679 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/evm/mint/v1/query.proto
+
+package mintv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_MintAuthority_FullMethodName      = "/cosmos.evm.mint.v1.Query/MintAuthority"
+	Query_DenomMintLimit_FullMethodName     = "/cosmos.evm.mint.v1.Query/DenomMintLimit"
+	Query_DenomMintStats_FullMethodName     = "/cosmos.evm.mint.v1.Query/DenomMintStats"
+	Query_MinterMintStats_FullMethodName    = "/cosmos.evm.mint.v1.Query/MinterMintStats"
+	Query_Guardian_FullMethodName           = "/cosmos.evm.mint.v1.Query/Guardian"
+	Query_PauseStatus_FullMethodName        = "/cosmos.evm.mint.v1.Query/PauseStatus"
+	Query_IBCMintRoutes_FullMethodName      = "/cosmos.evm.mint.v1.Query/IBCMintRoutes"
+	Query_MintApprovalConfig_FullMethodName = "/cosmos.evm.mint.v1.Query/MintApprovalConfig"
+	Query_MintProposal_FullMethodName       = "/cosmos.evm.mint.v1.Query/MintProposal"
+	Query_MintProposals_FullMethodName      = "/cosmos.evm.mint.v1.Query/MintProposals"
+	Query_MintHistory_FullMethodName        = "/cosmos.evm.mint.v1.Query/MintHistory"
+	Query_DenomAdmin_FullMethodName         = "/cosmos.evm.mint.v1.Query/DenomAdmin"
+	Query_EmissionSchedule_FullMethodName   = "/cosmos.evm.mint.v1.Query/EmissionSchedule"
+	Query_EmissionSchedules_FullMethodName  = "/cosmos.evm.mint.v1.Query/EmissionSchedules"
+	Query_Params_FullMethodName             = "/cosmos.evm.mint.v1.Query/Params"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// MintAuthority queries the account allowed to mint native tokens through
+	// the mint precompile.
+	MintAuthority(ctx context.Context, in *QueryMintAuthorityRequest, opts ...grpc.CallOption) (*QueryMintAuthorityResponse, error)
+	// DenomMintLimit queries the supply cap and rate limit of a denom, together
+	// with the amount that can still be minted under each of them.
+	DenomMintLimit(ctx context.Context, in *QueryDenomMintLimitRequest, opts ...grpc.CallOption) (*QueryDenomMintLimitResponse, error)
+	// DenomMintStats queries the total amount of a denom minted and burned
+	// through the mint precompile.
+	DenomMintStats(ctx context.Context, in *QueryDenomMintStatsRequest, opts ...grpc.CallOption) (*QueryDenomMintStatsResponse, error)
+	// MinterMintStats queries the total amount of a denom a minter minted
+	// through the mint precompile.
+	MinterMintStats(ctx context.Context, in *QueryMinterMintStatsRequest, opts ...grpc.CallOption) (*QueryMinterMintStatsResponse, error)
+	// Guardian queries the account allowed to pause minting and burning through
+	// the mint precompile.
+	Guardian(ctx context.Context, in *QueryGuardianRequest, opts ...grpc.CallOption) (*QueryGuardianResponse, error)
+	// PauseStatus queries if minting and burning of a denom is paused.
+	PauseStatus(ctx context.Context, in *QueryPauseStatusRequest, opts ...grpc.CallOption) (*QueryPauseStatusResponse, error)
+	// IBCMintRoutes queries the IBC channels whose tokens are minted as a
+	// canonical native denom.
+	IBCMintRoutes(ctx context.Context, in *QueryIBCMintRoutesRequest, opts ...grpc.CallOption) (*QueryIBCMintRoutesResponse, error)
+	// MintApprovalConfig queries the approvers of mint proposals and the mints
+	// that require one.
+	MintApprovalConfig(ctx context.Context, in *QueryMintApprovalConfigRequest, opts ...grpc.CallOption) (*QueryMintApprovalConfigResponse, error)
+	// MintProposal queries a pending mint proposal.
+	MintProposal(ctx context.Context, in *QueryMintProposalRequest, opts ...grpc.CallOption) (*QueryMintProposalResponse, error)
+	// MintProposals queries all the pending mint proposals.
+	MintProposals(ctx context.Context, in *QueryMintProposalsRequest, opts ...grpc.CallOption) (*QueryMintProposalsResponse, error)
+	// MintHistory queries the mints through the mint precompile kept in the
+	// mint history, oldest first, optionally filtered by denom and minter.
+	MintHistory(ctx context.Context, in *QueryMintHistoryRequest, opts ...grpc.CallOption) (*QueryMintHistoryResponse, error)
+	// DenomAdmin queries the admin of a denom created through the mint
+	// precompile.
+	DenomAdmin(ctx context.Context, in *QueryDenomAdminRequest, opts ...grpc.CallOption) (*QueryDenomAdminResponse, error)
+	// EmissionSchedule queries an emission schedule that still has tokens to
+	// emit.
+	EmissionSchedule(ctx context.Context, in *QueryEmissionScheduleRequest, opts ...grpc.CallOption) (*QueryEmissionScheduleResponse, error)
+	// EmissionSchedules queries all the emission schedules that still have
+	// tokens to emit.
+	EmissionSchedules(ctx context.Context, in *QueryEmissionSchedulesRequest, opts ...grpc.CallOption) (*QueryEmissionSchedulesResponse, error)
+	// Params retrieves the mint module params
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) MintAuthority(ctx context.Context, in *QueryMintAuthorityRequest, opts ...grpc.CallOption) (*QueryMintAuthorityResponse, error) {
+	out := new(QueryMintAuthorityResponse)
+	err := c.cc.Invoke(ctx, Query_MintAuthority_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomMintLimit(ctx context.Context, in *QueryDenomMintLimitRequest, opts ...grpc.CallOption) (*QueryDenomMintLimitResponse, error) {
+	out := new(QueryDenomMintLimitResponse)
+	err := c.cc.Invoke(ctx, Query_DenomMintLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomMintStats(ctx context.Context, in *QueryDenomMintStatsRequest, opts ...grpc.CallOption) (*QueryDenomMintStatsResponse, error) {
+	out := new(QueryDenomMintStatsResponse)
+	err := c.cc.Invoke(ctx, Query_DenomMintStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MinterMintStats(ctx context.Context, in *QueryMinterMintStatsRequest, opts ...grpc.CallOption) (*QueryMinterMintStatsResponse, error) {
+	out := new(QueryMinterMintStatsResponse)
+	err := c.cc.Invoke(ctx, Query_MinterMintStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Guardian(ctx context.Context, in *QueryGuardianRequest, opts ...grpc.CallOption) (*QueryGuardianResponse, error) {
+	out := new(QueryGuardianResponse)
+	err := c.cc.Invoke(ctx, Query_Guardian_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PauseStatus(ctx context.Context, in *QueryPauseStatusRequest, opts ...grpc.CallOption) (*QueryPauseStatusResponse, error) {
+	out := new(QueryPauseStatusResponse)
+	err := c.cc.Invoke(ctx, Query_PauseStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IBCMintRoutes(ctx context.Context, in *QueryIBCMintRoutesRequest, opts ...grpc.CallOption) (*QueryIBCMintRoutesResponse, error) {
+	out := new(QueryIBCMintRoutesResponse)
+	err := c.cc.Invoke(ctx, Query_IBCMintRoutes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintApprovalConfig(ctx context.Context, in *QueryMintApprovalConfigRequest, opts ...grpc.CallOption) (*QueryMintApprovalConfigResponse, error) {
+	out := new(QueryMintApprovalConfigResponse)
+	err := c.cc.Invoke(ctx, Query_MintApprovalConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintProposal(ctx context.Context, in *QueryMintProposalRequest, opts ...grpc.CallOption) (*QueryMintProposalResponse, error) {
+	out := new(QueryMintProposalResponse)
+	err := c.cc.Invoke(ctx, Query_MintProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintProposals(ctx context.Context, in *QueryMintProposalsRequest, opts ...grpc.CallOption) (*QueryMintProposalsResponse, error) {
+	out := new(QueryMintProposalsResponse)
+	err := c.cc.Invoke(ctx, Query_MintProposals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintHistory(ctx context.Context, in *QueryMintHistoryRequest, opts ...grpc.CallOption) (*QueryMintHistoryResponse, error) {
+	out := new(QueryMintHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_MintHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomAdmin(ctx context.Context, in *QueryDenomAdminRequest, opts ...grpc.CallOption) (*QueryDenomAdminResponse, error) {
+	out := new(QueryDenomAdminResponse)
+	err := c.cc.Invoke(ctx, Query_DenomAdmin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EmissionSchedule(ctx context.Context, in *QueryEmissionScheduleRequest, opts ...grpc.CallOption) (*QueryEmissionScheduleResponse, error) {
+	out := new(QueryEmissionScheduleResponse)
+	err := c.cc.Invoke(ctx, Query_EmissionSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EmissionSchedules(ctx context.Context, in *QueryEmissionSchedulesRequest, opts ...grpc.CallOption) (*QueryEmissionSchedulesResponse, error) {
+	out := new(QueryEmissionSchedulesResponse)
+	err := c.cc.Invoke(ctx, Query_EmissionSchedules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// MintAuthority queries the account allowed to mint native tokens through
+	// the mint precompile.
+	MintAuthority(context.Context, *QueryMintAuthorityRequest) (*QueryMintAuthorityResponse, error)
+	// DenomMintLimit queries the supply cap and rate limit of a denom, together
+	// with the amount that can still be minted under each of them.
+	DenomMintLimit(context.Context, *QueryDenomMintLimitRequest) (*QueryDenomMintLimitResponse, error)
+	// DenomMintStats queries the total amount of a denom minted and burned
+	// through the mint precompile.
+	DenomMintStats(context.Context, *QueryDenomMintStatsRequest) (*QueryDenomMintStatsResponse, error)
+	// MinterMintStats queries the total amount of a denom a minter minted
+	// through the mint precompile.
+	MinterMintStats(context.Context, *QueryMinterMintStatsRequest) (*QueryMinterMintStatsResponse, error)
+	// Guardian queries the account allowed to pause minting and burning through
+	// the mint precompile.
+	Guardian(context.Context, *QueryGuardianRequest) (*QueryGuardianResponse, error)
+	// PauseStatus queries if minting and burning of a denom is paused.
+	PauseStatus(context.Context, *QueryPauseStatusRequest) (*QueryPauseStatusResponse, error)
+	// IBCMintRoutes queries the IBC channels whose tokens are minted as a
+	// canonical native denom.
+	IBCMintRoutes(context.Context, *QueryIBCMintRoutesRequest) (*QueryIBCMintRoutesResponse, error)
+	// MintApprovalConfig queries the approvers of mint proposals and the mints
+	// that require one.
+	MintApprovalConfig(context.Context, *QueryMintApprovalConfigRequest) (*QueryMintApprovalConfigResponse, error)
+	// MintProposal queries a pending mint proposal.
+	MintProposal(context.Context, *QueryMintProposalRequest) (*QueryMintProposalResponse, error)
+	// MintProposals queries all the pending mint proposals.
+	MintProposals(context.Context, *QueryMintProposalsRequest) (*QueryMintProposalsResponse, error)
+	// MintHistory queries the mints through the mint precompile kept in the
+	// mint history, oldest first, optionally filtered by denom and minter.
+	MintHistory(context.Context, *QueryMintHistoryRequest) (*QueryMintHistoryResponse, error)
+	// DenomAdmin queries the admin of a denom created through the mint
+	// precompile.
+	DenomAdmin(context.Context, *QueryDenomAdminRequest) (*QueryDenomAdminResponse, error)
+	// EmissionSchedule queries an emission schedule that still has tokens to
+	// emit.
+	EmissionSchedule(context.Context, *QueryEmissionScheduleRequest) (*QueryEmissionScheduleResponse, error)
+	// EmissionSchedules queries all the emission schedules that still have
+	// tokens to emit.
+	EmissionSchedules(context.Context, *QueryEmissionSchedulesRequest) (*QueryEmissionSchedulesResponse, error)
+	// Params retrieves the mint module params
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) MintAuthority(context.Context, *QueryMintAuthorityRequest) (*QueryMintAuthorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintAuthority not implemented")
+}
+func (UnimplementedQueryServer) DenomMintLimit(context.Context, *QueryDenomMintLimitRequest) (*QueryDenomMintLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomMintLimit not implemented")
+}
+func (UnimplementedQueryServer) DenomMintStats(context.Context, *QueryDenomMintStatsRequest) (*QueryDenomMintStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomMintStats not implemented")
+}
+func (UnimplementedQueryServer) MinterMintStats(context.Context, *QueryMinterMintStatsRequest) (*QueryMinterMintStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinterMintStats not implemented")
+}
+func (UnimplementedQueryServer) Guardian(context.Context, *QueryGuardianRequest) (*QueryGuardianResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Guardian not implemented")
+}
+func (UnimplementedQueryServer) PauseStatus(context.Context, *QueryPauseStatusRequest) (*QueryPauseStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseStatus not implemented")
+}
+func (UnimplementedQueryServer) IBCMintRoutes(context.Context, *QueryIBCMintRoutesRequest) (*QueryIBCMintRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCMintRoutes not implemented")
+}
+func (UnimplementedQueryServer) MintApprovalConfig(context.Context, *QueryMintApprovalConfigRequest) (*QueryMintApprovalConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintApprovalConfig not implemented")
+}
+func (UnimplementedQueryServer) MintProposal(context.Context, *QueryMintProposalRequest) (*QueryMintProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintProposal not implemented")
+}
+func (UnimplementedQueryServer) MintProposals(context.Context, *QueryMintProposalsRequest) (*QueryMintProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintProposals not implemented")
+}
+func (UnimplementedQueryServer) MintHistory(context.Context, *QueryMintHistoryRequest) (*QueryMintHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintHistory not implemented")
+}
+func (UnimplementedQueryServer) DenomAdmin(context.Context, *QueryDenomAdminRequest) (*QueryDenomAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomAdmin not implemented")
+}
+func (UnimplementedQueryServer) EmissionSchedule(context.Context, *QueryEmissionScheduleRequest) (*QueryEmissionScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmissionSchedule not implemented")
+}
+func (UnimplementedQueryServer) EmissionSchedules(context.Context, *QueryEmissionSchedulesRequest) (*QueryEmissionSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmissionSchedules not implemented")
+}
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_MintAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintAuthorityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintAuthority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MintAuthority_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintAuthority(ctx, req.(*QueryMintAuthorityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomMintLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomMintLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomMintLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomMintLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomMintLimit(ctx, req.(*QueryDenomMintLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomMintStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomMintStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomMintStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomMintStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomMintStats(ctx, req.(*QueryDenomMintStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MinterMintStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinterMintStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinterMintStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MinterMintStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinterMintStats(ctx, req.(*QueryMinterMintStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Guardian_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGuardianRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Guardian(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Guardian_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Guardian(ctx, req.(*QueryGuardianRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PauseStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPauseStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PauseStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PauseStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PauseStatus(ctx, req.(*QueryPauseStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IBCMintRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIBCMintRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IBCMintRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_IBCMintRoutes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IBCMintRoutes(ctx, req.(*QueryIBCMintRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintApprovalConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintApprovalConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintApprovalConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MintApprovalConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintApprovalConfig(ctx, req.(*QueryMintApprovalConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MintProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintProposal(ctx, req.(*QueryMintProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MintProposals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintProposals(ctx, req.(*QueryMintProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MintHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintHistory(ctx, req.(*QueryMintHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomAdmin(ctx, req.(*QueryDenomAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EmissionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmissionScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmissionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EmissionSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmissionSchedule(ctx, req.(*QueryEmissionScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EmissionSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmissionSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmissionSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EmissionSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmissionSchedules(ctx, req.(*QueryEmissionSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.evm.mint.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MintAuthority",
+			Handler:    _Query_MintAuthority_Handler,
+		},
+		{
+			MethodName: "DenomMintLimit",
+			Handler:    _Query_DenomMintLimit_Handler,
+		},
+		{
+			MethodName: "DenomMintStats",
+			Handler:    _Query_DenomMintStats_Handler,
+		},
+		{
+			MethodName: "MinterMintStats",
+			Handler:    _Query_MinterMintStats_Handler,
+		},
+		{
+			MethodName: "Guardian",
+			Handler:    _Query_Guardian_Handler,
+		},
+		{
+			MethodName: "PauseStatus",
+			Handler:    _Query_PauseStatus_Handler,
+		},
+		{
+			MethodName: "IBCMintRoutes",
+			Handler:    _Query_IBCMintRoutes_Handler,
+		},
+		{
+			MethodName: "MintApprovalConfig",
+			Handler:    _Query_MintApprovalConfig_Handler,
+		},
+		{
+			MethodName: "MintProposal",
+			Handler:    _Query_MintProposal_Handler,
+		},
+		{
+			MethodName: "MintProposals",
+			Handler:    _Query_MintProposals_Handler,
+		},
+		{
+			MethodName: "MintHistory",
+			Handler:    _Query_MintHistory_Handler,
+		},
+		{
+			MethodName: "DenomAdmin",
+			Handler:    _Query_DenomAdmin_Handler,
+		},
+		{
+			MethodName: "EmissionSchedule",
+			Handler:    _Query_EmissionSchedule_Handler,
+		},
+		{
+			MethodName: "EmissionSchedules",
+			Handler:    _Query_EmissionSchedules_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/evm/mint/v1/query.proto",
+}
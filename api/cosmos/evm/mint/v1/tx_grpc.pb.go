@@ -0,0 +1,459 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/evm/mint/v1/tx.proto
+
+package mintv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Msg_UpdateMintAuthority_FullMethodName    = "/cosmos.evm.mint.v1.Msg/UpdateMintAuthority"
+	Msg_SetDenomMintLimit_FullMethodName      = "/cosmos.evm.mint.v1.Msg/SetDenomMintLimit"
+	Msg_UpdateGuardian_FullMethodName         = "/cosmos.evm.mint.v1.Msg/UpdateGuardian"
+	Msg_Unpause_FullMethodName                = "/cosmos.evm.mint.v1.Msg/Unpause"
+	Msg_SetIBCMintRoute_FullMethodName        = "/cosmos.evm.mint.v1.Msg/SetIBCMintRoute"
+	Msg_SetMintApprovalConfig_FullMethodName  = "/cosmos.evm.mint.v1.Msg/SetMintApprovalConfig"
+	Msg_CreateEmissionSchedule_FullMethodName = "/cosmos.evm.mint.v1.Msg/CreateEmissionSchedule"
+	Msg_CancelEmissionSchedule_FullMethodName = "/cosmos.evm.mint.v1.Msg/CancelEmissionSchedule"
+	Msg_UpdateParams_FullMethodName           = "/cosmos.evm.mint.v1.Msg/UpdateParams"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateMintAuthority defines a governance operation for rotating the account
+	// allowed to mint native tokens through the mint precompile. The authority
+	// is hard-coded to the Cosmos SDK x/gov module account
+	UpdateMintAuthority(ctx context.Context, in *MsgUpdateMintAuthority, opts ...grpc.CallOption) (*MsgUpdateMintAuthorityResponse, error)
+	// SetDenomMintLimit defines a governance operation for setting the supply
+	// cap and rate limit of a denom. The authority is hard-coded to the Cosmos
+	// SDK x/gov module account
+	SetDenomMintLimit(ctx context.Context, in *MsgSetDenomMintLimit, opts ...grpc.CallOption) (*MsgSetDenomMintLimitResponse, error)
+	// UpdateGuardian defines a governance operation for rotating the account
+	// allowed to pause minting and burning through the mint precompile. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateGuardian(ctx context.Context, in *MsgUpdateGuardian, opts ...grpc.CallOption) (*MsgUpdateGuardianResponse, error)
+	// Unpause defines a governance operation for lifting a pause set by the
+	// guardian. The authority is hard-coded to the Cosmos SDK x/gov module
+	// account
+	Unpause(ctx context.Context, in *MsgUnpause, opts ...grpc.CallOption) (*MsgUnpauseResponse, error)
+	// SetIBCMintRoute defines a governance operation for routing the tokens
+	// received over an IBC channel to a canonical native denom. The authority is
+	// hard-coded to the Cosmos SDK x/gov module account
+	SetIBCMintRoute(ctx context.Context, in *MsgSetIBCMintRoute, opts ...grpc.CallOption) (*MsgSetIBCMintRouteResponse, error)
+	// SetMintApprovalConfig defines a governance operation for setting the
+	// approvers of mint proposals and the mints that require one. The authority
+	// is hard-coded to the Cosmos SDK x/gov module account
+	SetMintApprovalConfig(ctx context.Context, in *MsgSetMintApprovalConfig, opts ...grpc.CallOption) (*MsgSetMintApprovalConfigResponse, error)
+	// CreateEmissionSchedule defines a governance operation for registering a
+	// programmatic issuance of a denom, minted by the x/mint BeginBlocker. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	CreateEmissionSchedule(ctx context.Context, in *MsgCreateEmissionSchedule, opts ...grpc.CallOption) (*MsgCreateEmissionScheduleResponse, error)
+	// CancelEmissionSchedule defines a governance operation for stopping an
+	// emission schedule before it emitted all its tokens. The authority is
+	// hard-coded to the Cosmos SDK x/gov module account
+	CancelEmissionSchedule(ctx context.Context, in *MsgCancelEmissionSchedule, opts ...grpc.CallOption) (*MsgCancelEmissionScheduleResponse, error)
+	// UpdateParams defines a governance operation for updating the x/mint module
+	// parameters. The authority is hard-coded to the Cosmos SDK x/gov module
+	// account
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateMintAuthority(ctx context.Context, in *MsgUpdateMintAuthority, opts ...grpc.CallOption) (*MsgUpdateMintAuthorityResponse, error) {
+	out := new(MsgUpdateMintAuthorityResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateMintAuthority_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomMintLimit(ctx context.Context, in *MsgSetDenomMintLimit, opts ...grpc.CallOption) (*MsgSetDenomMintLimitResponse, error) {
+	out := new(MsgSetDenomMintLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDenomMintLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateGuardian(ctx context.Context, in *MsgUpdateGuardian, opts ...grpc.CallOption) (*MsgUpdateGuardianResponse, error) {
+	out := new(MsgUpdateGuardianResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateGuardian_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Unpause(ctx context.Context, in *MsgUnpause, opts ...grpc.CallOption) (*MsgUnpauseResponse, error) {
+	out := new(MsgUnpauseResponse)
+	err := c.cc.Invoke(ctx, Msg_Unpause_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetIBCMintRoute(ctx context.Context, in *MsgSetIBCMintRoute, opts ...grpc.CallOption) (*MsgSetIBCMintRouteResponse, error) {
+	out := new(MsgSetIBCMintRouteResponse)
+	err := c.cc.Invoke(ctx, Msg_SetIBCMintRoute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMintApprovalConfig(ctx context.Context, in *MsgSetMintApprovalConfig, opts ...grpc.CallOption) (*MsgSetMintApprovalConfigResponse, error) {
+	out := new(MsgSetMintApprovalConfigResponse)
+	err := c.cc.Invoke(ctx, Msg_SetMintApprovalConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateEmissionSchedule(ctx context.Context, in *MsgCreateEmissionSchedule, opts ...grpc.CallOption) (*MsgCreateEmissionScheduleResponse, error) {
+	out := new(MsgCreateEmissionScheduleResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateEmissionSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelEmissionSchedule(ctx context.Context, in *MsgCancelEmissionSchedule, opts ...grpc.CallOption) (*MsgCancelEmissionScheduleResponse, error) {
+	out := new(MsgCancelEmissionScheduleResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelEmissionSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility
+type MsgServer interface {
+	// UpdateMintAuthority defines a governance operation for rotating the account
+	// allowed to mint native tokens through the mint precompile. The authority
+	// is hard-coded to the Cosmos SDK x/gov module account
+	UpdateMintAuthority(context.Context, *MsgUpdateMintAuthority) (*MsgUpdateMintAuthorityResponse, error)
+	// SetDenomMintLimit defines a governance operation for setting the supply
+	// cap and rate limit of a denom. The authority is hard-coded to the Cosmos
+	// SDK x/gov module account
+	SetDenomMintLimit(context.Context, *MsgSetDenomMintLimit) (*MsgSetDenomMintLimitResponse, error)
+	// UpdateGuardian defines a governance operation for rotating the account
+	// allowed to pause minting and burning through the mint precompile. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateGuardian(context.Context, *MsgUpdateGuardian) (*MsgUpdateGuardianResponse, error)
+	// Unpause defines a governance operation for lifting a pause set by the
+	// guardian. The authority is hard-coded to the Cosmos SDK x/gov module
+	// account
+	Unpause(context.Context, *MsgUnpause) (*MsgUnpauseResponse, error)
+	// SetIBCMintRoute defines a governance operation for routing the tokens
+	// received over an IBC channel to a canonical native denom. The authority is
+	// hard-coded to the Cosmos SDK x/gov module account
+	SetIBCMintRoute(context.Context, *MsgSetIBCMintRoute) (*MsgSetIBCMintRouteResponse, error)
+	// SetMintApprovalConfig defines a governance operation for setting the
+	// approvers of mint proposals and the mints that require one. The authority
+	// is hard-coded to the Cosmos SDK x/gov module account
+	SetMintApprovalConfig(context.Context, *MsgSetMintApprovalConfig) (*MsgSetMintApprovalConfigResponse, error)
+	// CreateEmissionSchedule defines a governance operation for registering a
+	// programmatic issuance of a denom, minted by the x/mint BeginBlocker. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	CreateEmissionSchedule(context.Context, *MsgCreateEmissionSchedule) (*MsgCreateEmissionScheduleResponse, error)
+	// CancelEmissionSchedule defines a governance operation for stopping an
+	// emission schedule before it emitted all its tokens. The authority is
+	// hard-coded to the Cosmos SDK x/gov module account
+	CancelEmissionSchedule(context.Context, *MsgCancelEmissionSchedule) (*MsgCancelEmissionScheduleResponse, error)
+	// UpdateParams defines a governance operation for updating the x/mint module
+	// parameters. The authority is hard-coded to the Cosmos SDK x/gov module
+	// account
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (UnimplementedMsgServer) UpdateMintAuthority(context.Context, *MsgUpdateMintAuthority) (*MsgUpdateMintAuthorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMintAuthority not implemented")
+}
+func (UnimplementedMsgServer) SetDenomMintLimit(context.Context, *MsgSetDenomMintLimit) (*MsgSetDenomMintLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMintLimit not implemented")
+}
+func (UnimplementedMsgServer) UpdateGuardian(context.Context, *MsgUpdateGuardian) (*MsgUpdateGuardianResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGuardian not implemented")
+}
+func (UnimplementedMsgServer) Unpause(context.Context, *MsgUnpause) (*MsgUnpauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unpause not implemented")
+}
+func (UnimplementedMsgServer) SetIBCMintRoute(context.Context, *MsgSetIBCMintRoute) (*MsgSetIBCMintRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIBCMintRoute not implemented")
+}
+func (UnimplementedMsgServer) SetMintApprovalConfig(context.Context, *MsgSetMintApprovalConfig) (*MsgSetMintApprovalConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMintApprovalConfig not implemented")
+}
+func (UnimplementedMsgServer) CreateEmissionSchedule(context.Context, *MsgCreateEmissionSchedule) (*MsgCreateEmissionScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEmissionSchedule not implemented")
+}
+func (UnimplementedMsgServer) CancelEmissionSchedule(context.Context, *MsgCancelEmissionSchedule) (*MsgCancelEmissionScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelEmissionSchedule not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_UpdateMintAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMintAuthority)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMintAuthority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateMintAuthority_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMintAuthority(ctx, req.(*MsgUpdateMintAuthority))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomMintLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomMintLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomMintLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDenomMintLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomMintLimit(ctx, req.(*MsgSetDenomMintLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateGuardian_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGuardian)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGuardian(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateGuardian_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGuardian(ctx, req.(*MsgUpdateGuardian))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Unpause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpause)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unpause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Unpause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unpause(ctx, req.(*MsgUnpause))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetIBCMintRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetIBCMintRoute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetIBCMintRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetIBCMintRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetIBCMintRoute(ctx, req.(*MsgSetIBCMintRoute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMintApprovalConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMintApprovalConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMintApprovalConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetMintApprovalConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMintApprovalConfig(ctx, req.(*MsgSetMintApprovalConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateEmissionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateEmissionSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateEmissionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateEmissionSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateEmissionSchedule(ctx, req.(*MsgCreateEmissionSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelEmissionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelEmissionSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelEmissionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelEmissionSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelEmissionSchedule(ctx, req.(*MsgCancelEmissionSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.evm.mint.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateMintAuthority",
+			Handler:    _Msg_UpdateMintAuthority_Handler,
+		},
+		{
+			MethodName: "SetDenomMintLimit",
+			Handler:    _Msg_SetDenomMintLimit_Handler,
+		},
+		{
+			MethodName: "UpdateGuardian",
+			Handler:    _Msg_UpdateGuardian_Handler,
+		},
+		{
+			MethodName: "Unpause",
+			Handler:    _Msg_Unpause_Handler,
+		},
+		{
+			MethodName: "SetIBCMintRoute",
+			Handler:    _Msg_SetIBCMintRoute_Handler,
+		},
+		{
+			MethodName: "SetMintApprovalConfig",
+			Handler:    _Msg_SetMintApprovalConfig_Handler,
+		},
+		{
+			MethodName: "CreateEmissionSchedule",
+			Handler:    _Msg_CreateEmissionSchedule_Handler,
+		},
+		{
+			MethodName: "CancelEmissionSchedule",
+			Handler:    _Msg_CancelEmissionSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/evm/mint/v1/tx.proto",
+}
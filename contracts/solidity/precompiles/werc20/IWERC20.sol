@@ -33,4 +33,19 @@ interface IWERC20 is IERC20Metadata {
     /// @dev Emits a Withdrawal Event.
     /// @param wad The amount of native tokens to be withdrawn.
     function withdraw(uint256 wad) external;
+
+    /// @dev Mints native tokens to an account. The caller must be a minter of the
+    /// @dev native denom registered in the mint module, and the amount is drawn
+    /// @dev from its minter allowance.
+    /// @dev Emits a Transfer Event from the zero address.
+    /// @param to The account that receives the minted tokens.
+    /// @param amount The amount of tokens to mint.
+    function mint(address to, uint256 amount) external;
+
+    /// @dev Burns native tokens from an account. The caller must be the account
+    /// @dev itself or the mint authority.
+    /// @dev Emits a Transfer Event to the zero address.
+    /// @param from The account the tokens are burned from.
+    /// @param amount The amount of tokens to burn.
+    function burn(address from, uint256 amount) external;
 }
@@ -14,6 +14,7 @@ func newMonoEVMAnteHandler(options ante.HandlerOptions) sdk.AnteHandler {
 			options.AccountKeeper,
 			options.FeeMarketKeeper,
 			options.EvmKeeper,
+			options.FeegrantKeeper,
 			options.MaxTxGasWanted,
 		),
 		ante.NewTxListenerDecorator(options.PendingTxListener),
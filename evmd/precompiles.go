@@ -9,11 +9,14 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 
+	authzprecompile "github.com/cosmos/evm/precompiles/authz"
 	bankprecompile "github.com/cosmos/evm/precompiles/bank"
 	"github.com/cosmos/evm/precompiles/bech32"
 	cmn "github.com/cosmos/evm/precompiles/common"
 	distprecompile "github.com/cosmos/evm/precompiles/distribution"
+	feegrantprecompile "github.com/cosmos/evm/precompiles/feegrant"
 	govprecompile "github.com/cosmos/evm/precompiles/gov"
+	icaprecompile "github.com/cosmos/evm/precompiles/ica"
 	ics20precompile "github.com/cosmos/evm/precompiles/ics20"
 	mintprecompile "github.com/cosmos/evm/precompiles/mint"
 	"github.com/cosmos/evm/precompiles/p256"
@@ -21,11 +24,16 @@ import (
 	stakingprecompile "github.com/cosmos/evm/precompiles/staking"
 	erc20Keeper "github.com/cosmos/evm/x/erc20/keeper"
 	transferkeeper "github.com/cosmos/evm/x/ibc/transfer/keeper"
+	evmmintkeeper "github.com/cosmos/evm/x/mint/keeper"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
 	channelkeeper "github.com/cosmos/ibc-go/v10/modules/core/04-channel/keeper"
 
 	"cosmossdk.io/core/address"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 	"github.com/cosmos/cosmos-sdk/codec"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
@@ -36,7 +44,7 @@ import (
 // Extend this struct, add a sane default to defaultOptionals, and an Option function to provide users with a non-breaking
 // way to provide custom args to certain precompiles.
 type Optionals struct {
-	AddressCodec       address.Codec // used by gov/staking
+	AddressCodec       address.Codec // used by gov/staking/authz/feegrant/ica
 	ValidatorAddrCodec address.Codec // used by slashing
 	ConsensusAddrCodec address.Codec // used by slashing
 }
@@ -74,16 +82,25 @@ const bech32PrecompileBaseGas = 6_000
 // NewAvailableStaticPrecompiles returns the list of all available static precompiled contracts from Cosmos EVM.
 //
 // NOTE: this should only be used during initialization of the Keeper.
+//
+// The bank message server is built from the x/bank keeper, while the bank
+// keeper can be the precisebank keeper, which keeps the fractional balances of
+// the EVM denom.
 func NewAvailableStaticPrecompiles(
 	stakingKeeper stakingkeeper.Keeper,
 	distributionKeeper distributionkeeper.Keeper,
 	bankKeeper cmn.BankKeeper,
+	bankMsgServer banktypes.MsgServer,
 	erc20Keeper erc20Keeper.Keeper,
 	transferKeeper transferkeeper.Keeper,
 	channelKeeper *channelkeeper.Keeper,
 	evmKeeper *evmkeeper.Keeper,
 	govKeeper govkeeper.Keeper,
 	slashingKeeper slashingkeeper.Keeper,
+	authzKeeper authzkeeper.Keeper,
+	feegrantKeeper feegrantkeeper.Keeper,
+	icaControllerKeeper *icacontrollerkeeper.Keeper,
+	evmMintKeeper evmmintkeeper.Keeper,
 	codec codec.Codec,
 	opts ...Option,
 ) map[common.Address]vm.PrecompiledContract {
@@ -135,7 +152,7 @@ func NewAvailableStaticPrecompiles(
 		panic(fmt.Errorf("failed to instantiate ICS20 precompile: %w", err))
 	}
 
-	bankPrecompile, err := bankprecompile.NewPrecompile(bankKeeper, erc20Keeper)
+	bankPrecompile, err := bankprecompile.NewPrecompile(bankKeeper, bankMsgServer, erc20Keeper)
 	if err != nil {
 		panic(fmt.Errorf("failed to instantiate bank precompile: %w", err))
 	}
@@ -162,9 +179,42 @@ func NewAvailableStaticPrecompiles(
 		panic(fmt.Errorf("failed to instantiate slashing precompile: %w", err))
 	}
 
+	authzPrecompile, err := authzprecompile.NewPrecompile(
+		authzKeeper,
+		authzKeeper,
+		bankKeeper,
+		codec,
+		options.AddressCodec,
+	)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate authz precompile: %w", err))
+	}
+
+	feegrantPrecompile, err := feegrantprecompile.NewPrecompile(
+		feegrantkeeper.NewMsgServerImpl(feegrantKeeper),
+		feegrantKeeper,
+		bankKeeper,
+		codec,
+		options.AddressCodec,
+	)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate feegrant precompile: %w", err))
+	}
+
+	icaPrecompile, err := icaprecompile.NewPrecompile(
+		icacontrollerkeeper.NewMsgServerImpl(icaControllerKeeper),
+		icaControllerKeeper,
+		bankKeeper,
+		options.AddressCodec,
+	)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate ICA precompile: %w", err))
+	}
+
 	mintPrecompile, err := mintprecompile.NewPrecompile(
-		"cosmos1wskv7h9wrxdkzr8npq8g4juh4etm9khqxvcje4", // todo: hardcoded random for tests, update this.
+		evmMintKeeper,
 		bankKeeper,
+		erc20Keeper,
 	)
 	if err != nil {
 		panic(fmt.Errorf("failed to instantiate mint precompile: %w", err))
@@ -182,6 +232,9 @@ func NewAvailableStaticPrecompiles(
 	precompiles[govPrecompile.Address()] = govPrecompile
 	precompiles[slashingPrecompile.Address()] = slashingPrecompile
 	precompiles[mintPrecompile.Address()] = mintPrecompile
+	precompiles[authzPrecompile.Address()] = authzPrecompile
+	precompiles[feegrantPrecompile.Address()] = feegrantPrecompile
+	precompiles[icaPrecompile.Address()] = icaPrecompile
 
 	return precompiles
 }
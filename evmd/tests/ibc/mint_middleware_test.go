@@ -0,0 +1,420 @@
+package ibc
+
+import (
+	"errors"
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/evmd"
+	"github.com/cosmos/evm/evmd/tests/integration"
+	"github.com/cosmos/evm/ibc"
+	evmibctesting "github.com/cosmos/evm/testutil/ibc"
+	evmmint "github.com/cosmos/evm/x/mint"
+	mintkeeper "github.com/cosmos/evm/x/mint/keeper"
+	minttypes "github.com/cosmos/evm/x/mint/types"
+	ibctransfer "github.com/cosmos/ibc-go/v10/modules/apps/transfer"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const canonicalDenom = "ustake"
+
+// MintMiddlewareTestSuite tests the IBC middleware of the mint module.
+type MintMiddlewareTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *evmibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	evmChainA *evmibctesting.TestChain
+	chainB    *evmibctesting.TestChain
+	chainC    *evmibctesting.TestChain
+
+	// pathB connects evmChainA to chainB, pathC connects evmChainA to chainC
+	pathB *evmibctesting.Path
+	pathC *evmibctesting.Path
+}
+
+// SetupTest initializes the coordinator and test chains before each test, and
+// routes the bond denom of chainB and chainC to the same canonical denom.
+func (suite *MintMiddlewareTestSuite) SetupTest() {
+	suite.coordinator = evmibctesting.NewCoordinator(suite.T(), 1, 2, integration.SetupEvmd)
+	suite.evmChainA = suite.coordinator.GetChain(evmibctesting.GetEvmChainID(1))
+	suite.chainB = suite.coordinator.GetChain(evmibctesting.GetChainID(2))
+	suite.chainC = suite.coordinator.GetChain(evmibctesting.GetChainID(3))
+
+	suite.pathB = evmibctesting.NewTransferPath(suite.evmChainA, suite.chainB)
+	suite.pathB.Setup()
+	suite.pathC = evmibctesting.NewTransferPath(suite.evmChainA, suite.chainC)
+	suite.pathC.Setup()
+
+	evmApp := suite.evmChainA.App.(*evmd.EVMD)
+	ctxA := suite.evmChainA.GetContext()
+	for _, path := range []*evmibctesting.Path{suite.pathB, suite.pathC} {
+		route := minttypes.NewIBCMintRoute(path.EndpointA.ChannelID, sdk.DefaultBondDenom, canonicalDenom)
+		suite.Require().NoError(evmApp.EVMMintKeeper.UpdateIBCMintRoute(ctxA, route))
+	}
+}
+
+func TestMintMiddlewareTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(MintMiddlewareTestSuite))
+}
+
+// voucherDenom returns the denom of the vouchers credited for the bond denom
+// received over the given path.
+func (suite *MintMiddlewareTestSuite) voucherDenom(path *evmibctesting.Path) string {
+	return minttypes.NewIBCMintRoute(path.EndpointA.ChannelID, sdk.DefaultBondDenom, canonicalDenom).VoucherDenom()
+}
+
+// sendToEvmChain transfers the bond denom of the counterparty of the path to
+// the receiver on evmChainA and relays the packet.
+func (suite *MintMiddlewareTestSuite) sendToEvmChain(path *evmibctesting.Path, receiver sdk.AccAddress, amount math.Int) {
+	counterparty := path.EndpointB.Chain
+	msg := transfertypes.NewMsgTransfer(
+		path.EndpointB.ChannelConfig.PortID,
+		path.EndpointB.ChannelID,
+		sdk.NewCoin(sdk.DefaultBondDenom, amount),
+		counterparty.SenderAccount.GetAddress().String(),
+		receiver.String(),
+		clienttypes.NewHeight(1, 110), 0, "",
+	)
+
+	res, err := counterparty.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err := evmibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err) // relay committed
+}
+
+func (suite *MintMiddlewareTestSuite) TestNewIBCMiddleware() {
+	testCases := []struct {
+		name          string
+		instantiateFn func()
+		expError      error
+	}{
+		{
+			"success",
+			func() {
+				_ = evmmint.NewIBCMiddleware(mintkeeper.Keeper{}, ibctransfer.IBCModule{})
+			},
+			nil,
+		},
+		{
+			"panics with nil underlying app",
+			func() {
+				_ = evmmint.NewIBCMiddleware(mintkeeper.Keeper{}, nil)
+			},
+			errors.New("underlying application cannot be nil"),
+		},
+		{
+			"panics with nil mint keeper",
+			func() {
+				_ = evmmint.NewIBCMiddleware(nil, ibc.Module{})
+			},
+			errors.New("mint keeper cannot be nil"),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			if tc.expError == nil {
+				suite.Require().NotPanics(
+					tc.instantiateFn,
+					"unexpected panic: NewIBCMiddleware",
+				)
+			} else {
+				suite.Require().PanicsWithError(
+					tc.expError.Error(),
+					tc.instantiateFn,
+					"expected panic with error: ", tc.expError.Error(),
+				)
+			}
+		})
+	}
+}
+
+// TestOnRecvPacket checks that the vouchers of a routed channel and base denom
+// are swapped for the canonical denom on receive.
+func (suite *MintMiddlewareTestSuite) TestOnRecvPacket() {
+	var (
+		packetData transfertypes.FungibleTokenPacketData
+		ctxA       sdk.Context
+	)
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		expCanonical bool
+		expError     string
+	}{
+		{
+			name:         "pass - routed base denom is minted as canonical denom",
+			malleate:     nil,
+			expCanonical: true,
+		},
+		{
+			name: "pass - base denom without route is credited as voucher",
+			malleate: func() {
+				evmApp := suite.evmChainA.App.(*evmd.EVMD)
+				route := minttypes.NewIBCMintRoute(suite.pathB.EndpointA.ChannelID, sdk.DefaultBondDenom, "")
+				suite.Require().NoError(evmApp.EVMMintKeeper.UpdateIBCMintRoute(ctxA, route))
+			},
+			expCanonical: false,
+		},
+		{
+			name: "pass - token with denom trace is credited as voucher",
+			malleate: func() {
+				packetData.Denom = "transfer/channel-9/" + sdk.DefaultBondDenom
+			},
+			expCanonical: false,
+		},
+		{
+			name: "fail - canonical denom is paused",
+			malleate: func() {
+				evmApp := suite.evmChainA.App.(*evmd.EVMD)
+				suite.Require().NoError(evmApp.EVMMintKeeper.SetDenomPaused(ctxA, canonicalDenom, true))
+			},
+			// error acknowledgements only carry the code of minttypes.ErrPaused
+			expError: "ABCI code: 12",
+		},
+		{
+			name: "fail - canonical denom supply cap exceeded",
+			malleate: func() {
+				evmApp := suite.evmChainA.App.(*evmd.EVMD)
+				supply := evmApp.BankKeeper.GetSupply(ctxA, canonicalDenom).Amount
+				limit := minttypes.NewDenomMintLimit(canonicalDenom, supply.AddRaw(1), math.ZeroInt(), 0)
+				suite.Require().NoError(evmApp.EVMMintKeeper.UpdateDenomMintLimit(ctxA, limit))
+			},
+			// error acknowledgements only carry the code of minttypes.ErrMaxSupplyExceeded
+			expError: "ABCI code: 7",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctxA = suite.evmChainA.GetContext()
+			evmApp := suite.evmChainA.App.(*evmd.EVMD)
+			sendAmt := ibctesting.DefaultCoinAmount
+			receiver := suite.evmChainA.SenderAccount.GetAddress()
+
+			path := suite.pathB
+			packetData = transfertypes.NewFungibleTokenPacketData(
+				sdk.DefaultBondDenom,
+				sendAmt.String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				receiver.String(),
+				"",
+			)
+
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+
+			packet := channeltypes.Packet{
+				Sequence:           1,
+				SourcePort:         path.EndpointB.ChannelConfig.PortID,
+				SourceChannel:      path.EndpointB.ChannelID,
+				DestinationPort:    path.EndpointA.ChannelConfig.PortID,
+				DestinationChannel: path.EndpointA.ChannelID,
+				Data:               packetData.GetBytes(),
+				TimeoutHeight:      suite.evmChainA.GetTimeoutHeight(),
+				TimeoutTimestamp:   0,
+			}
+
+			transferStack, ok := evmApp.GetIBCKeeper().PortKeeper.Route(transfertypes.ModuleName)
+			suite.Require().True(ok)
+
+			ack := transferStack.OnRecvPacket(ctxA, path.EndpointB.GetChannel().Version, packet, receiver)
+			if tc.expError != "" {
+				suite.Require().False(ack.Success())
+
+				ackObj, ok := ack.(channeltypes.Acknowledgement)
+				suite.Require().True(ok)
+				ackErr, ok := ackObj.Response.(*channeltypes.Acknowledgement_Error)
+				suite.Require().True(ok)
+				suite.Require().Contains(ackErr.Error, tc.expError)
+				return
+			}
+			suite.Require().True(ack.Success())
+
+			data, err := transfertypes.UnmarshalPacketData(packetData.GetBytes(), transfertypes.V1, "")
+			suite.Require().NoError(err)
+			voucherDenom := ibc.GetReceivedCoin(packet, data.Token).Denom
+
+			moduleAddr := authtypes.NewModuleAddress(minttypes.ModuleName)
+			canonicalBalance := evmApp.BankKeeper.GetBalance(ctxA, receiver, canonicalDenom)
+			voucherBalance := evmApp.BankKeeper.GetBalance(ctxA, receiver, voucherDenom)
+			escrowBalance := evmApp.BankKeeper.GetBalance(ctxA, moduleAddr, voucherDenom)
+			if tc.expCanonical {
+				suite.Require().Equal(sendAmt.String(), canonicalBalance.Amount.String())
+				suite.Require().True(voucherBalance.IsZero())
+				suite.Require().Equal(sendAmt.String(), escrowBalance.Amount.String())
+
+				// the conversion is recorded like the other mints
+				suite.Require().Equal(sendAmt.String(), evmApp.EVMMintKeeper.GetDenomMintStats(ctxA, canonicalDenom).TotalMinted.String())
+				history := evmApp.EVMMintKeeper.GetMintHistory(ctxA)
+				suite.Require().Len(history, 1)
+				suite.Require().Equal(moduleAddr.String(), history[0].Minter)
+			} else {
+				suite.Require().True(canonicalBalance.IsZero())
+				suite.Require().Equal(sendAmt.String(), voucherBalance.Amount.String())
+				suite.Require().True(escrowBalance.IsZero())
+			}
+		})
+	}
+}
+
+// TestCanonicalDenomRoundTrip receives the bond denom of chainB and chainC as a
+// single canonical denom and sends it back to each of them.
+func (suite *MintMiddlewareTestSuite) TestCanonicalDenomRoundTrip() {
+	suite.SetupTest()
+
+	evmApp := suite.evmChainA.App.(*evmd.EVMD)
+	account := suite.evmChainA.SenderAccount.GetAddress()
+	moduleAddr := authtypes.NewModuleAddress(minttypes.ModuleName)
+	amountB := math.NewInt(1000)
+	amountC := math.NewInt(400)
+
+	suite.sendToEvmChain(suite.pathB, account, amountB)
+	suite.sendToEvmChain(suite.pathC, account, amountC)
+
+	// the tokens received over both channels are fungible
+	ctxA := suite.evmChainA.GetContext()
+	suite.Require().Equal(amountB.Add(amountC).String(), evmApp.BankKeeper.GetBalance(ctxA, account, canonicalDenom).Amount.String())
+	suite.Require().Equal(amountB.Add(amountC).String(), evmApp.BankKeeper.GetSupply(ctxA, canonicalDenom).Amount.String())
+	suite.Require().Equal(amountB.String(), evmApp.BankKeeper.GetBalance(ctxA, moduleAddr, suite.voucherDenom(suite.pathB)).Amount.String())
+	suite.Require().Equal(amountC.String(), evmApp.BankKeeper.GetBalance(ctxA, moduleAddr, suite.voucherDenom(suite.pathC)).Amount.String())
+
+	// chainC cannot be sent more than was received from it
+	receiverC := suite.chainC.SenderAccount.GetAddress()
+	msg := transfertypes.NewMsgTransfer(
+		suite.pathC.EndpointA.ChannelConfig.PortID,
+		suite.pathC.EndpointA.ChannelID,
+		sdk.NewCoin(canonicalDenom, amountC.AddRaw(1)),
+		account.String(),
+		receiverC.String(),
+		clienttypes.NewHeight(1, 110), 0, "",
+	)
+	_, err := suite.evmChainA.SendMsgs(msg)
+	suite.Require().ErrorContains(err, minttypes.ErrInsufficientIBCEscrow.Error())
+
+	// send the canonical denom back to chainC
+	balanceC := suite.chainC.GetSimApp().BankKeeper.GetBalance(suite.chainC.GetContext(), receiverC, sdk.DefaultBondDenom)
+	msg.Token.Amount = amountC
+	res, err := suite.evmChainA.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err := evmibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+	err = suite.pathC.RelayPacket(packet)
+	suite.Require().NoError(err) // relay committed
+
+	ctxA = suite.evmChainA.GetContext()
+	suite.Require().Equal(amountB.String(), evmApp.BankKeeper.GetBalance(ctxA, account, canonicalDenom).Amount.String())
+	suite.Require().Equal(amountB.String(), evmApp.BankKeeper.GetSupply(ctxA, canonicalDenom).Amount.String())
+	suite.Require().True(evmApp.BankKeeper.GetBalance(ctxA, account, suite.voucherDenom(suite.pathC)).IsZero())
+	suite.Require().True(evmApp.BankKeeper.GetSupply(ctxA, suite.voucherDenom(suite.pathC)).IsZero())
+	suite.Require().Equal(amountC.String(), evmApp.EVMMintKeeper.GetDenomMintStats(ctxA, canonicalDenom).TotalBurned.String())
+
+	afterBalanceC := suite.chainC.GetSimApp().BankKeeper.GetBalance(suite.chainC.GetContext(), receiverC, sdk.DefaultBondDenom)
+	suite.Require().Equal(balanceC.Amount.Add(amountC).String(), afterBalanceC.Amount.String())
+}
+
+// TestCanonicalDenomDrain checks that the canonical denom cannot be minted
+// otherwise than in exchange for vouchers, so that a local mint cannot be
+// converted into the vouchers escrowed through the routes.
+func (suite *MintMiddlewareTestSuite) TestCanonicalDenomDrain() {
+	suite.SetupTest()
+
+	evmApp := suite.evmChainA.App.(*evmd.EVMD)
+	attacker := suite.evmChainA.SenderAccounts[1].SenderAccount.GetAddress()
+	moduleAddr := authtypes.NewModuleAddress(minttypes.ModuleName)
+	amount := math.NewInt(1000)
+
+	suite.sendToEvmChain(suite.pathB, suite.evmChainA.SenderAccount.GetAddress(), amount)
+
+	ctxA := suite.evmChainA.GetContext()
+	k := evmApp.EVMMintKeeper
+	mintAuthority := suite.evmChainA.SenderAccount.GetAddress()
+	k.SetMintAuthority(ctxA, mintAuthority)
+
+	err := k.Mint(ctxA, mintAuthority, attacker, sdk.NewCoin(canonicalDenom, amount))
+	suite.Require().ErrorIs(err, minttypes.ErrIBCMintRouteDenom)
+
+	err = k.SetMinterAllowance(ctxA, attacker, canonicalDenom, amount)
+	suite.Require().ErrorIs(err, minttypes.ErrIBCMintRouteDenom)
+
+	start := uint64(ctxA.BlockHeight()) + 1 //nolint:gosec // G115
+	_, err = k.AddEmissionSchedule(ctxA, minttypes.NewEmissionSchedule(
+		0, canonicalDenom, attacker, minttypes.EmissionCurveLinear, amount, start, start+1, 0,
+	))
+	suite.Require().ErrorIs(err, minttypes.ErrIBCMintRouteDenom)
+
+	// without canonical coins, the attacker cannot release the escrowed vouchers
+	route, found := k.GetIBCMintRoute(ctxA, suite.pathB.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	err = k.ConvertCanonicalToVoucher(ctxA, attacker, route, amount)
+	suite.Require().Error(err)
+
+	suite.Require().True(evmApp.BankKeeper.GetBalance(ctxA, attacker, canonicalDenom).IsZero())
+	suite.Require().True(evmApp.BankKeeper.GetBalance(ctxA, attacker, suite.voucherDenom(suite.pathB)).IsZero())
+	suite.Require().Equal(amount.String(), evmApp.BankKeeper.GetBalance(ctxA, moduleAddr, suite.voucherDenom(suite.pathB)).Amount.String())
+}
+
+// TestOnTimeoutPacket checks that the vouchers refunded on timeout are
+// converted back to the canonical denom.
+func (suite *MintMiddlewareTestSuite) TestOnTimeoutPacket() {
+	suite.SetupTest()
+
+	evmApp := suite.evmChainA.App.(*evmd.EVMD)
+	sender := suite.evmChainA.SenderAccount.GetAddress()
+	receiver := suite.chainB.SenderAccount.GetAddress()
+	sendAmt := math.NewInt(1000)
+	path := suite.pathB
+
+	suite.sendToEvmChain(path, sender, sendAmt)
+
+	msg := transfertypes.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		sdk.NewCoin(canonicalDenom, sendAmt),
+		sender.String(),
+		receiver.String(),
+		clienttypes.NewHeight(1, 110), 0, "",
+	)
+	res, err := suite.evmChainA.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	ctxA := suite.evmChainA.GetContext()
+	suite.Require().True(evmApp.BankKeeper.GetBalance(ctxA, sender, canonicalDenom).IsZero())
+	suite.Require().True(evmApp.BankKeeper.GetBalance(ctxA, sender, suite.voucherDenom(path)).IsZero())
+
+	packet, err := evmibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	transferStack, ok := evmApp.GetIBCKeeper().PortKeeper.Route(transfertypes.ModuleName)
+	suite.Require().True(ok)
+
+	err = transferStack.OnTimeoutPacket(ctxA, path.EndpointA.GetChannel().Version, packet, sender)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(sendAmt.String(), evmApp.BankKeeper.GetBalance(ctxA, sender, canonicalDenom).Amount.String())
+	suite.Require().True(evmApp.BankKeeper.GetBalance(ctxA, sender, suite.voucherDenom(path)).IsZero())
+	suite.Require().Equal(
+		sendAmt.String(),
+		evmApp.BankKeeper.GetBalance(ctxA, authtypes.NewModuleAddress(minttypes.ModuleName), suite.voucherDenom(path)).Amount.String(),
+	)
+}
@@ -0,0 +1,150 @@
+package ibc
+
+import (
+	"errors"
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/evmd"
+	"github.com/cosmos/evm/evmd/tests/integration"
+	evmibctesting "github.com/cosmos/evm/testutil/ibc"
+	mintkeeper "github.com/cosmos/evm/x/mint/keeper"
+	minttypes "github.com/cosmos/evm/x/mint/types"
+	v2 "github.com/cosmos/evm/x/mint/v2"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	ibcmockv2 "github.com/cosmos/ibc-go/v10/testing/mock/v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MintMiddlewareV2TestSuite tests the v2 IBC middleware of the mint module.
+type MintMiddlewareV2TestSuite struct {
+	testifysuite.Suite
+
+	coordinator *evmibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	evmChainA *evmibctesting.TestChain
+	chainB    *evmibctesting.TestChain
+
+	// chainB to evmChainA for testing OnRecvPacket
+	pathBToA *evmibctesting.Path
+}
+
+func (suite *MintMiddlewareV2TestSuite) SetupTest() {
+	suite.coordinator = evmibctesting.NewCoordinator(suite.T(), 1, 1, integration.SetupEvmd)
+	suite.evmChainA = suite.coordinator.GetChain(evmibctesting.GetEvmChainID(1))
+	suite.chainB = suite.coordinator.GetChain(evmibctesting.GetChainID(2))
+
+	// path.EndpointA = endpoint on chainB
+	// path.EndpointB = endpoint on evmChainA
+	suite.pathBToA = evmibctesting.NewPath(suite.chainB, suite.evmChainA)
+	suite.pathBToA.SetupV2()
+}
+
+func TestMintMiddlewareV2TestSuite(t *testing.T) {
+	testifysuite.Run(t, new(MintMiddlewareV2TestSuite))
+}
+
+func (suite *MintMiddlewareV2TestSuite) TestNewIBCMiddleware() {
+	testCases := []struct {
+		name          string
+		instantiateFn func()
+		expError      error
+	}{
+		{
+			"success",
+			func() {
+				_ = v2.NewIBCMiddleware(ibcmockv2.IBCModule{}, &mintkeeper.Keeper{})
+			},
+			nil,
+		},
+		{
+			"panics with nil underlying app",
+			func() {
+				_ = v2.NewIBCMiddleware(nil, &mintkeeper.Keeper{})
+			},
+			errors.New("underlying application cannot be nil"),
+		},
+		{
+			"panics with nil mint keeper",
+			func() {
+				_ = v2.NewIBCMiddleware(ibcmockv2.IBCModule{}, nil)
+			},
+			errors.New("mint keeper cannot be nil"),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			if tc.expError == nil {
+				suite.Require().NotPanics(tc.instantiateFn)
+			} else {
+				suite.Require().PanicsWithError(tc.expError.Error(), tc.instantiateFn)
+			}
+		})
+	}
+}
+
+func (suite *MintMiddlewareV2TestSuite) TestOnRecvPacket() {
+	testCases := []struct {
+		name         string
+		routed       bool
+		expCanonical bool
+	}{
+		{"routed base denom is minted as canonical denom", true, true},
+		{"no route keeps the voucher", false, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			evmApp := suite.evmChainA.App.(*evmd.EVMD)
+			destClient := suite.pathBToA.EndpointB.ClientID
+			route := minttypes.NewIBCMintRoute(destClient, sdk.DefaultBondDenom, canonicalDenom)
+			if tc.routed {
+				suite.Require().NoError(evmApp.EVMMintKeeper.UpdateIBCMintRoute(suite.evmChainA.GetContext(), route))
+			}
+
+			receiver := suite.evmChainA.SenderAccount.GetAddress()
+			sendAmt := ibctesting.DefaultCoinAmount
+			packetData := transfertypes.NewFungibleTokenPacketData(
+				sdk.DefaultBondDenom,
+				sendAmt.String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				receiver.String(),
+				"",
+			)
+			payload := channeltypesv2.NewPayload(
+				transfertypes.PortID, transfertypes.PortID,
+				transfertypes.V1, transfertypes.EncodingJSON,
+				packetData.GetBytes(),
+			)
+
+			ctx := suite.evmChainA.GetContext()
+			transferStack := evmApp.GetIBCKeeper().ChannelKeeperV2.Router.Route(ibctesting.TransferPort)
+			recvResult := transferStack.OnRecvPacket(
+				ctx,
+				suite.pathBToA.EndpointA.ClientID,
+				destClient,
+				1,
+				payload,
+				receiver,
+			)
+			suite.Require().Equal(channeltypesv2.PacketStatus_Success, recvResult.Status)
+
+			canonical := evmApp.BankKeeper.GetBalance(ctx, receiver, canonicalDenom)
+			voucher := evmApp.BankKeeper.GetBalance(ctx, receiver, route.VoucherDenom())
+			if tc.expCanonical {
+				suite.Require().Equal(sendAmt.String(), canonical.Amount.String())
+				suite.Require().True(voucher.IsZero())
+			} else {
+				suite.Require().True(canonical.IsZero())
+				suite.Require().Equal(sendAmt.String(), voucher.Amount.String())
+			}
+		})
+	}
+}
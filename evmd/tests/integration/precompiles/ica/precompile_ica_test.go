@@ -0,0 +1,15 @@
+package ica
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/evmd/tests/integration"
+	"github.com/cosmos/evm/tests/integration/precompiles/ica"
+)
+
+func TestICAPrecompileTestSuite(t *testing.T) {
+	s := ica.NewPrecompileTestSuite(t, integration.SetupEvmd)
+	suite.Run(t, s)
+}
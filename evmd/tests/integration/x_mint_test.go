@@ -0,0 +1,14 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/tests/integration/x/mint"
+)
+
+func TestMintKeeperTestSuite(t *testing.T) {
+	s := mint.NewKeeperTestSuite(CreateEvmd)
+	suite.Run(t, s)
+}
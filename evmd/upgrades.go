@@ -3,6 +3,9 @@ package evmd
 import (
 	"context"
 
+	evmminttypes "github.com/cosmos/evm/x/mint/types"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 
@@ -34,7 +37,12 @@ func (app EVMD) RegisterUpgradeHandlers() {
 
 	if upgradeInfo.Name == UpgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
 		storeUpgrades := storetypes.StoreUpgrades{
-			Added: []string{},
+			Added: []string{
+				// the mint authority, minters and mint limits of the evm mint module
+				evmminttypes.StoreKey,
+				// the interchain accounts registered by the ICS-27 controller precompile
+				icacontrollertypes.StoreKey,
+			},
 		}
 		// configure store loader that checks if version == upgradeHeight and applies store upgrades
 		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
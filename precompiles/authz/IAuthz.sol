@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IAuthz contract's address.
+address constant AUTHZ_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000808;
+
+/// @dev The IAuthz contract's instance.
+IAuthz constant AUTHZ_CONTRACT = IAuthz(AUTHZ_PRECOMPILE_ADDRESS);
+
+/// @dev Grant represents an authorization a granter gave to a grantee.
+struct Grant {
+    /// authorizationType is the type URL of the authorization,
+    /// e.g. /cosmos.authz.v1beta1.GenericAuthorization.
+    string authorizationType;
+    /// msgTypeUrl is the type URL of the msg the authorization allows to execute.
+    string msgTypeUrl;
+    /// expiration is the unix timestamp in seconds at which the grant expires,
+    /// or zero if it does not expire.
+    uint64 expiration;
+}
+
+/**
+ * @author Evmos Team
+ * @title Authz Precompiled Contract
+ * @dev The interface through which solidity contracts interact with the x/authz module,
+ * to grant, revoke and execute authorizations.
+ */
+interface IAuthz {
+    /// @dev Grant defines an Event emitted when a granter grants an authorization to a grantee.
+    /// @param granter the address granting the authorization
+    /// @param grantee the address receiving the authorization
+    /// @param msgTypeUrl the type URL of the msg the authorization allows to execute
+    /// @param expiration the unix timestamp at which the grant expires, or zero
+    event Grant(
+        address indexed granter,
+        address indexed grantee,
+        string msgTypeUrl,
+        uint64 expiration
+    );
+
+    /// @dev Revoke defines an Event emitted when a granter revokes an authorization.
+    /// @param granter the address that granted the authorization
+    /// @param grantee the address that received the authorization
+    /// @param msgTypeUrl the type URL of the msg of the revoked authorization
+    event Revoke(
+        address indexed granter,
+        address indexed grantee,
+        string msgTypeUrl
+    );
+
+    /// @dev Exec defines an Event emitted when a grantee executes messages.
+    /// @param grantee the address executing the messages
+    /// @param msgTypeUrls the type URLs of the executed messages
+    event Exec(address indexed grantee, string[] msgTypeUrls);
+
+    /// @dev grant defines a method to grant the grantee a generic authorization to
+    /// execute messages of the given type on behalf of the caller.
+    /// @param grantee the address receiving the authorization
+    /// @param msgTypeUrl the type URL of the msg, e.g. /cosmos.staking.v1beta1.MsgDelegate
+    /// @param expiration the unix timestamp in seconds at which the grant expires,
+    /// or zero for a grant that does not expire
+    /// @return success true if the authorization was granted
+    function grant(
+        address grantee,
+        string calldata msgTypeUrl,
+        uint64 expiration
+    ) external returns (bool success);
+
+    /// @dev revoke defines a method to revoke the authorization of the given msg type
+    /// the caller granted to the grantee.
+    /// @param grantee the address that received the authorization
+    /// @param msgTypeUrl the type URL of the msg of the authorization
+    /// @return success true if the authorization was revoked
+    function revoke(
+        address grantee,
+        string calldata msgTypeUrl
+    ) external returns (bool success);
+
+    /// @dev exec defines a method to execute messages with the caller as grantee.
+    /// Messages signed by the caller are executed without an authorization.
+    /// @param anyMsgs the messages to execute, each one a protobuf encoded google.protobuf.Any
+    /// @return results the protobuf encoded results of the messages
+    function exec(
+        bytes[] calldata anyMsgs
+    ) external returns (bytes[] memory results);
+
+    /// @dev grants returns the grants of a granter to a grantee.
+    /// @param granter the address that granted the authorizations
+    /// @param grantee the address that received the authorizations
+    /// @param msgTypeUrl the type URL of the msg to filter the grants by, or an empty string for all of them
+    /// @param pageRequest the pagination of the grants
+    /// @return grants the grants of the granter to the grantee
+    /// @return pageResponse the pagination response
+    function grants(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (Grant[] memory grants, PageResponse memory pageResponse);
+}
@@ -0,0 +1,17 @@
+package authz
+
+const (
+	// ErrInvalidGrantee is raised when the grantee address is not valid.
+	ErrInvalidGrantee = "invalid grantee address: %v"
+	// ErrInvalidGranter is raised when the granter address is not valid.
+	ErrInvalidGranter = "invalid granter address: %v"
+	// ErrInvalidMsgTypeURL is raised when the msg type URL is not valid.
+	ErrInvalidMsgTypeURL = "invalid msg type url: %v"
+	// ErrInvalidExpiration is raised when the expiration is not a valid timestamp.
+	ErrInvalidExpiration = "invalid expiration: %v"
+	// ErrInvalidMsgs is raised when the messages to execute are not valid.
+	ErrInvalidMsgs = "invalid messages: %v"
+	// ErrDisabledMsgType is raised when a message type cannot be granted or
+	// executed through the precompile.
+	ErrDisabledMsgType = "msg type %s cannot be granted or executed through the authz precompile"
+)
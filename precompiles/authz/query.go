@@ -0,0 +1,46 @@
+package authz
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+const (
+	// GrantsMethod defines the method name for the grants precompile request.
+	GrantsMethod = "grants"
+)
+
+// Grants implements the query logic for getting the grants of a granter to a
+// grantee, optionally filtered by msg type.
+func (p *Precompile) Grants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseGrantsArgs(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.authzQuerier.Grants(ctx, req)
+	switch {
+	case errors.Is(err, authztypes.ErrNoAuthorizationFound):
+		// a missing grant of the msg type is not an error for the caller
+		res = &authztypes.QueryGrantsResponse{}
+	case err != nil:
+		return nil, err
+	}
+
+	output, err := new(GrantsOutput).FromResponse(res, p.codec)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(output.Grants, output.PageResponse)
+}
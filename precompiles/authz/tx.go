@@ -0,0 +1,109 @@
+package authz
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// GrantMethod defines the ABI method name for the authz Grant transaction.
+	GrantMethod = "grant"
+	// RevokeMethod defines the ABI method name for the authz Revoke transaction.
+	RevokeMethod = "revoke"
+	// ExecMethod defines the ABI method name for the authz Exec transaction.
+	ExecMethod = "exec"
+)
+
+// Grant defines a method to grant the grantee a generic authorization to
+// execute messages of the given type on behalf of the caller.
+func (p *Precompile) Grant(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	granter := contract.Caller()
+
+	msg, grantee, err := NewMsgGrant(args, granter, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	authorization, err := msg.GetAuthorization()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = p.authzMsgServer.Grant(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	var expiration uint64
+	if msg.Grant.Expiration != nil {
+		expiration = uint64(msg.Grant.Expiration.Unix()) //nolint:gosec // G115 // checked when parsing the args
+	}
+
+	if err = p.EmitGrantEvent(ctx, stateDB, granter, grantee, authorization.MsgTypeURL(), expiration); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Revoke defines a method to revoke the authorization of the given msg type the
+// caller granted to the grantee.
+func (p *Precompile) Revoke(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	granter := contract.Caller()
+
+	msg, grantee, err := NewMsgRevoke(args, granter, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = p.authzMsgServer.Revoke(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRevokeEvent(ctx, stateDB, granter, grantee, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Exec defines a method to execute messages on behalf of their signers, with
+// the caller as grantee. Messages signed by the caller need no authorization.
+func (p *Precompile) Exec(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	grantee := contract.Caller()
+
+	msg, msgTypeURLs, err := NewMsgExec(args, grantee, p.codec, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.authzMsgServer.Exec(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitExecEvent(ctx, stateDB, grantee, msgTypeURLs); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Results)
+}
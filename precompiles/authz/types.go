@@ -0,0 +1,325 @@
+package authz
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+
+	"cosmossdk.io/core/address"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// DisabledMsgTypes are the msg types that cannot be granted or executed
+// through the precompile. Ethereum transactions, ERC20 conversions and
+// registrations, which query the token contract, and IBC transfers, which
+// convert ERC20 tokens before sending them, would re-enter the EVM from within
+// a precompile call. Vesting accounts of any kind cannot be created through
+// the precompile, extending the authz limiter of the Cosmos ante handler.
+var DisabledMsgTypes = []string{
+	sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
+	sdk.MsgTypeURL(&erc20types.MsgConvertERC20{}),
+	sdk.MsgTypeURL(&erc20types.MsgConvertCoin{}),
+	sdk.MsgTypeURL(&erc20types.MsgRegisterERC20{}),
+	sdk.MsgTypeURL(&transfertypes.MsgTransfer{}),
+	sdk.MsgTypeURL(&sdkvesting.MsgCreateVestingAccount{}),
+	sdk.MsgTypeURL(&sdkvesting.MsgCreatePeriodicVestingAccount{}),
+	sdk.MsgTypeURL(&sdkvesting.MsgCreatePermanentLockedAccount{}),
+}
+
+// EventGrant defines the event data for the authz Grant event.
+type EventGrant struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeUrl string //nolint:revive
+	Expiration uint64
+}
+
+// EventRevoke defines the event data for the authz Revoke event.
+type EventRevoke struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeUrl string //nolint:revive
+}
+
+// EventExec defines the event data for the authz Exec event.
+type EventExec struct {
+	Grantee     common.Address
+	MsgTypeUrls []string //nolint:revive
+}
+
+// Grant defines the authorization a granter gave to a grantee, as returned by
+// the grants query.
+type Grant struct {
+	AuthorizationType string
+	MsgTypeUrl        string //nolint:revive
+	Expiration        uint64
+}
+
+// GrantsInput defines the input for the Grants query.
+type GrantsInput struct {
+	Granter     common.Address
+	Grantee     common.Address
+	MsgTypeUrl  string //nolint:revive
+	PageRequest query.PageRequest
+}
+
+// GrantsOutput defines the output for the Grants query.
+type GrantsOutput struct {
+	Grants       []Grant
+	PageResponse query.PageResponse
+}
+
+// NewMsgGrant creates a new MsgGrant of a generic authorization from the
+// granter to the grantee of the call arguments. A zero expiration grants the
+// authorization without expiration.
+func NewMsgGrant(args []interface{}, granter common.Address, addrCdc address.Codec) (*authztypes.MsgGrant, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	msgTypeURL, ok := args[1].(string)
+	if !ok || msgTypeURL == "" {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMsgTypeURL, args[1])
+	}
+
+	if err := checkMsgTypeURL(msgTypeURL); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	expiration, ok := args[2].(uint64)
+	if !ok || expiration > math.MaxInt64 {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidExpiration, args[2])
+	}
+
+	var expirationTime *time.Time
+	if expiration != 0 {
+		t := time.Unix(int64(expiration), 0).UTC()
+		expirationTime = &t
+	}
+
+	granterAddr, err := addrCdc.BytesToString(granter.Bytes())
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("failed to decode granter address: %w", err)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	authorization, err := codectypes.NewAnyWithValue(authztypes.NewGenericAuthorization(msgTypeURL))
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return &authztypes.MsgGrant{
+		Granter: granterAddr,
+		Grantee: granteeAddr,
+		Grant: authztypes.Grant{
+			Authorization: authorization,
+			Expiration:    expirationTime,
+		},
+	}, grantee, nil
+}
+
+// NewMsgRevoke creates a new MsgRevoke of the authorization of the msg type the
+// granter gave to the grantee of the call arguments.
+func NewMsgRevoke(args []interface{}, granter common.Address, addrCdc address.Codec) (*authztypes.MsgRevoke, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	msgTypeURL, ok := args[1].(string)
+	if !ok || msgTypeURL == "" {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMsgTypeURL, args[1])
+	}
+
+	granterAddr, err := addrCdc.BytesToString(granter.Bytes())
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("failed to decode granter address: %w", err)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	return &authztypes.MsgRevoke{
+		Granter:    granterAddr,
+		Grantee:    granteeAddr,
+		MsgTypeUrl: msgTypeURL,
+	}, grantee, nil
+}
+
+// NewMsgExec creates a new MsgExec of the grantee from the call arguments, which
+// are the messages to execute encoded as protobuf Any values. It returns the
+// type URLs of the messages along with the MsgExec.
+func NewMsgExec(args []interface{}, grantee common.Address, cdc codec.Codec, addrCdc address.Codec) (*authztypes.MsgExec, []string, error) {
+	if len(args) != 1 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	anyMsgs, ok := args[0].([][]byte)
+	if !ok || len(anyMsgs) == 0 {
+		return nil, nil, fmt.Errorf(ErrInvalidMsgs, "expected a non-empty list of messages")
+	}
+
+	msgs := make([]*codectypes.Any, len(anyMsgs))
+	msgTypeURLs := make([]string, len(anyMsgs))
+	for i, bz := range anyMsgs {
+		var anyMsg codectypes.Any
+		if err := cdc.Unmarshal(bz, &anyMsg); err != nil {
+			return nil, nil, fmt.Errorf(ErrInvalidMsgs, err)
+		}
+
+		var msg sdk.Msg
+		if err := cdc.UnpackAny(&anyMsg, &msg); err != nil {
+			return nil, nil, fmt.Errorf(ErrInvalidMsgs, err)
+		}
+
+		if err := checkMsg(msg); err != nil {
+			return nil, nil, err
+		}
+
+		msgs[i] = &anyMsg
+		msgTypeURLs[i] = anyMsg.TypeUrl
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	return &authztypes.MsgExec{
+		Grantee: granteeAddr,
+		Msgs:    msgs,
+	}, msgTypeURLs, nil
+}
+
+// ParseGrantsArgs parses the arguments for the Grants query.
+func ParseGrantsArgs(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authztypes.QueryGrantsRequest, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input GrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GrantsInput: %s", err)
+	}
+
+	granter, err := addrCdc.BytesToString(input.Granter.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidGranter, err)
+	}
+
+	grantee, err := addrCdc.BytesToString(input.Grantee.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidGrantee, err)
+	}
+
+	return &authztypes.QueryGrantsRequest{
+		Granter:    granter,
+		Grantee:    grantee,
+		MsgTypeUrl: input.MsgTypeUrl,
+		Pagination: &input.PageRequest,
+	}, nil
+}
+
+// FromResponse populates the GrantsOutput from a QueryGrantsResponse.
+func (o *GrantsOutput) FromResponse(res *authztypes.QueryGrantsResponse, cdc codec.Codec) (*GrantsOutput, error) {
+	o.Grants = make([]Grant, len(res.Grants))
+	for i, grant := range res.Grants {
+		var authorization authztypes.Authorization
+		if err := cdc.UnpackAny(grant.Authorization, &authorization); err != nil {
+			return nil, err
+		}
+
+		var expiration uint64
+		if grant.Expiration != nil {
+			expiration = uint64(grant.Expiration.Unix()) //nolint:gosec // G115 // grants cannot expire before the epoch
+		}
+
+		o.Grants[i] = Grant{
+			AuthorizationType: grant.Authorization.TypeUrl,
+			MsgTypeUrl:        authorization.MsgTypeURL(),
+			Expiration:        expiration,
+		}
+	}
+
+	if res.Pagination != nil {
+		o.PageResponse = *res.Pagination
+	}
+
+	return o, nil
+}
+
+// checkMsgTypeURL returns an error if the msg type is disabled in the precompile.
+func checkMsgTypeURL(msgTypeURL string) error {
+	for _, disabled := range DisabledMsgTypes {
+		if msgTypeURL == disabled {
+			return fmt.Errorf(ErrDisabledMsgType, msgTypeURL)
+		}
+	}
+
+	return nil
+}
+
+// checkMsg returns an error if the message, or any message it grants or
+// executes through authz, is of a disabled msg type.
+func checkMsg(msg sdk.Msg) error {
+	if err := checkMsgTypeURL(sdk.MsgTypeURL(msg)); err != nil {
+		return err
+	}
+
+	switch msg := msg.(type) {
+	case *authztypes.MsgExec:
+		innerMsgs, err := msg.GetMessages()
+		if err != nil {
+			return err
+		}
+
+		for _, innerMsg := range innerMsgs {
+			if err := checkMsg(innerMsg); err != nil {
+				return err
+			}
+		}
+	case *authztypes.MsgGrant:
+		authorization, err := msg.GetAuthorization()
+		if err != nil {
+			return err
+		}
+
+		if authorization == nil {
+			return errors.New("missing authorization")
+		}
+
+		return checkMsgTypeURL(authorization.MsgTypeURL())
+	}
+
+	return nil
+}
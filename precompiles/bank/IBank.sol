@@ -1,6 +1,8 @@
 // SPDX-License-Identifier: LGPL-3.0-only
 pragma solidity >=0.8.18;
 
+import "../common/Types.sol";
+
 /// @dev The IBank contract's address.
 address constant IBANK_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000804;
 
@@ -15,12 +17,63 @@ struct Balance {
     uint256 amount;
 }
 
+/// @dev Input specifies an account and the native coins it sends in a multiSend.
+struct Input {
+    /// addr defines the sender address, which must be the caller.
+    address addr;
+    /// coins defines the native coins sent.
+    Coin[] coins;
+}
+
+/// @dev Output specifies an account and the native coins it receives in a multiSend.
+struct Output {
+    /// addr defines the recipient address.
+    address addr;
+    /// coins defines the native coins received.
+    Coin[] coins;
+}
+
 /**
  * @author Evmos Team
  * @title Bank Interface
- * @dev Interface for querying balances and supply from the Bank module.
+ * @dev Interface for querying balances and supply from the Bank module,
+ * and for sending native coins of any denom.
  */
 interface IBank {
+    /// @dev Transfer defines an Event emitted for each native coin sent.
+    /// @param from the address sending the coin
+    /// @param to the address receiving the coin
+    /// @param denom the denomination of the coin
+    /// @param amount the amount sent
+    event Transfer(
+        address indexed from,
+        address indexed to,
+        string denom,
+        uint256 amount
+    );
+
+    /// @dev send defines a method for sending native coins of any denom from
+    /// the caller to the given address, through the x/bank message server.
+    /// @param to the address receiving the coins.
+    /// @param amount the coins to send, in the original decimals of the x/bank.
+    /// @return success true if the coins were sent.
+    function send(
+        address to,
+        Coin[] calldata amount
+    ) external returns (bool success);
+
+    /// @dev multiSend defines a method for sending native coins of any denom
+    /// from the caller to several addresses, through the x/bank message server.
+    /// The x/bank module accepts a single input, sent by the caller, and the
+    /// coins of the input must equal the sum of the coins of the outputs.
+    /// @param inputs the accounts sending coins.
+    /// @param outputs the accounts receiving coins.
+    /// @return success true if the coins were sent.
+    function multiSend(
+        Input[] calldata inputs,
+        Output[] calldata outputs
+    ) external returns (bool success);
+
     /// @dev balances defines a method for retrieving all the native token balances
     /// for a given account.
     /// @param account the address of the account to query balances for.
@@ -1,7 +1,8 @@
 //
 // The bank package contains the implementation of the x/bank module precompile.
 // The precompiles returns all bank's information in the original decimals
-// representation stored in the module.
+// representation stored in the module, and sends native coins of any denom
+// through the x/bank message server.
 
 package bank
 
@@ -18,6 +19,8 @@ import (
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	storetypes "cosmossdk.io/store/types"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 const (
@@ -29,6 +32,17 @@ const (
 
 	// GasSupplyOf defines the gas cost for a single ERC-20 supplyOf query, taken from totalSupply of ERC20
 	GasSupplyOf = 2_477
+
+	// GasSendBase defines the base gas cost of the send and multiSend
+	// transactions, on top of the per-word cost.
+	GasSendBase = 10_000
+
+	// GasSendPerWord defines the gas cost charged for each 32-byte word of the
+	// arguments of a send or multiSend transaction. The store gas is not
+	// metered, as for the queries, so it covers the balance updates of the
+	// sender and recipients. A coin whose denom fits in a word is encoded in
+	// five words, so it is charged 20,000 gas.
+	GasSendPerWord = 4_000
 )
 
 var _ vm.PrecompiledContract = &Precompile{}
@@ -41,14 +55,16 @@ var f embed.FS
 // Precompile defines the bank precompile
 type Precompile struct {
 	cmn.Precompile
-	bankKeeper  cmn.BankKeeper
-	erc20Keeper cmn.ERC20Keeper
+	bankKeeper    cmn.BankKeeper
+	bankMsgServer banktypes.MsgServer
+	erc20Keeper   cmn.ERC20Keeper
 }
 
 // NewPrecompile creates a new bank Precompile instance implementing the
 // PrecompiledContract interface.
 func NewPrecompile(
 	bankKeeper cmn.BankKeeper,
+	bankMsgServer banktypes.MsgServer,
 	erc20Keeper cmn.ERC20Keeper,
 ) (*Precompile, error) {
 	newABI, err := cmn.LoadABI(f, "abi.json")
@@ -64,13 +80,17 @@ func NewPrecompile(
 			KvGasConfig:          storetypes.GasConfig{},
 			TransientKVGasConfig: storetypes.GasConfig{},
 		},
-		bankKeeper:  bankKeeper,
-		erc20Keeper: erc20Keeper,
+		bankKeeper:    bankKeeper,
+		bankMsgServer: bankMsgServer,
+		erc20Keeper:   erc20Keeper,
 	}
 
 	// SetAddress defines the address of the bank compile contract.
 	p.SetAddress(common.HexToAddress(evmtypes.BankPrecompileAddress))
 
+	// Set the balance handler for the precompile.
+	p.SetBalanceHandler(bankKeeper)
+
 	return p, nil
 }
 
@@ -96,23 +116,41 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasTotalSupply
 	case SupplyOfMethod:
 		return GasSupplyOf
+	case SendMethod, MultiSendMethod:
+		return GasSendBase + GasSendPerWord*argumentWords(input[4:])
 	}
 
 	return 0
 }
 
-// Run executes the precompiled contract bank query methods defined in the ABI.
+// argumentWords returns the number of 32-byte words of the ABI encoded
+// arguments of a send or multiSend call, rounded up. The arguments are charged
+// by their size rather than by the number of coins they hold, so that the
+// caller-controlled arrays are not decoded before any gas is charged.
+func argumentWords(input []byte) uint64 {
+	return (uint64(len(input)) + 31) / 32
+}
+
+// Run executes the precompiled contract bank methods defined in the ABI.
 func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
-	ctx, _, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	ctx, stateDB, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
 	if err != nil {
 		return nil, err
 	}
 
-	// This handles any out of gas errors that may occur during the execution of a precompile query.
+	// Start the balance change handler before executing the precompile.
+	p.GetBalanceHandler().BeforeBalanceChange(ctx)
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
 	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
 	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
 
 	switch method.Name {
+	// Bank transactions
+	case SendMethod:
+		bz, err = p.Send(ctx, contract, stateDB, method, args)
+	case MultiSendMethod:
+		bz, err = p.MultiSend(ctx, contract, stateDB, method, args)
 	// Bank queries
 	case BalancesMethod:
 		bz, err = p.Balances(ctx, contract, method, args)
@@ -134,11 +172,24 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		return nil, vm.ErrOutOfGas
 	}
 
+	// Process the native balance changes after the method execution.
+	if err = p.GetBalanceHandler().AfterBalanceChange(ctx, stateDB); err != nil {
+		return nil, err
+	}
+
 	return bz, nil
 }
 
 // IsTransaction checks if the given method name corresponds to a transaction or query.
-// It returns false since all bank methods are queries.
-func (Precompile) IsTransaction(_ *abi.Method) bool {
-	return false
+//
+// Available bank transactions are:
+//   - Send
+//   - MultiSend
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case SendMethod, MultiSendMethod:
+		return true
+	default:
+		return false
+	}
 }
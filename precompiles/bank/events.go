@@ -0,0 +1,59 @@
+package bank
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeTransfer defines the event type for the bank Send and MultiSend
+	// transactions.
+	EventTypeTransfer = "Transfer"
+)
+
+// EmitTransferEvent creates a new Transfer event emitted per Coin sent from the
+// sender to the recipient.
+func (p Precompile) EmitTransferEvent(ctx sdk.Context, stateDB vm.StateDB, from, to common.Address, coins sdk.Coins) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeTransfer]
+
+	for _, coin := range coins {
+		topics := make([]common.Hash, 3)
+
+		// The first topic is always the signature of the event.
+		topics[0] = event.ID
+
+		var err error
+		topics[1], err = cmn.MakeTopic(from)
+		if err != nil {
+			return err
+		}
+
+		topics[2], err = cmn.MakeTopic(to)
+		if err != nil {
+			return err
+		}
+
+		// Encode denom and amount as event data, as in Transfer(address,address,string,uint256)
+		data, err := event.Inputs.NonIndexed().Pack(coin.Denom, coin.Amount.BigInt())
+		if err != nil {
+			return fmt.Errorf("failed to pack event data: %w", err)
+		}
+
+		stateDB.AddLog(&ethtypes.Log{
+			Address:     p.Address(),
+			Topics:      topics,
+			Data:        data,
+			BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+		})
+	}
+
+	return nil
+}
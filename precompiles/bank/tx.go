@@ -0,0 +1,79 @@
+package bank
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// SendMethod defines the ABI method name for the bank Send transaction.
+	SendMethod = "send"
+	// MultiSendMethod defines the ABI method name for the bank MultiSend
+	// transaction.
+	MultiSendMethod = "multiSend"
+)
+
+// Send sends native coins of any denom from the caller to the given address
+// through the x/bank message server. The amounts have the original decimals
+// precision stored in the x/bank.
+func (p Precompile) Send(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	sender := contract.Caller()
+
+	msg, err := NewMsgSend(method, sender, args)
+	if err != nil {
+		return nil, fmt.Errorf("error calling send in bank precompile: %s", err)
+	}
+
+	if _, err := p.bankMsgServer.Send(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	recipient := common.BytesToAddress(sdk.MustAccAddressFromBech32(msg.ToAddress))
+	if err := p.EmitTransferEvent(ctx, stateDB, sender, recipient, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// MultiSend sends native coins of any denom from the caller to several
+// addresses through the x/bank message server. The caller must be the address
+// of every input.
+func (p Precompile) MultiSend(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	sender := contract.Caller()
+
+	msg, err := NewMsgMultiSend(method, sender, args)
+	if err != nil {
+		return nil, fmt.Errorf("error calling multiSend in bank precompile: %s", err)
+	}
+
+	if _, err := p.bankMsgServer.MultiSend(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	for _, out := range msg.Outputs {
+		recipient := common.BytesToAddress(sdk.MustAccAddressFromBech32(out.Address))
+		if err := p.EmitTransferEvent(ctx, stateDB, sender, recipient, out.Coins); err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 
 	cmn "github.com/cosmos/evm/precompiles/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // Balance contains the amount for a corresponding ERC-20 contract address.
@@ -17,6 +19,39 @@ type Balance struct {
 	Amount          *big.Int
 }
 
+// EventTransfer defines the event data for the bank Transfer events.
+type EventTransfer struct {
+	From   common.Address
+	To     common.Address
+	Denom  string
+	Amount *big.Int
+}
+
+// Input defines an account and the coins it sends in a multiSend transaction.
+type Input struct {
+	Addr  common.Address
+	Coins []cmn.Coin
+}
+
+// Output defines an account and the coins it receives in a multiSend
+// transaction.
+type Output struct {
+	Addr  common.Address
+	Coins []cmn.Coin
+}
+
+// SendInput defines the arguments of the send transaction.
+type SendInput struct {
+	To     common.Address
+	Amount []cmn.Coin
+}
+
+// MultiSendInput defines the arguments of the multiSend transaction.
+type MultiSendInput struct {
+	Inputs  []Input
+	Outputs []Output
+}
+
 // ParseBalancesArgs parses the call arguments for the bank Balances query.
 func ParseBalancesArgs(args []interface{}) (sdk.AccAddress, error) {
 	if len(args) != 1 {
@@ -44,3 +79,65 @@ func ParseSupplyOfArgs(args []interface{}) (common.Address, error) {
 
 	return erc20Address, nil
 }
+
+// NewMsgSend creates a new MsgSend instance from the sender and the call
+// arguments of the send transaction.
+func NewMsgSend(method *abi.Method, sender common.Address, args []interface{}) (*banktypes.MsgSend, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input SendInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to SendInput: %s", err)
+	}
+
+	amount, err := cmn.NewSdkCoinsFromCoins(input.Amount)
+	if err != nil {
+		return nil, fmt.Errorf(cmn.ErrInvalidAmount, err.Error())
+	}
+
+	return banktypes.NewMsgSend(sender.Bytes(), input.To.Bytes(), amount), nil
+}
+
+// NewMsgMultiSend creates a new MsgMultiSend instance from the call arguments of
+// the multiSend transaction. Every input must be sent by the sender.
+func NewMsgMultiSend(method *abi.Method, sender common.Address, args []interface{}) (*banktypes.MsgMultiSend, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input MultiSendInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to MultiSendInput: %s", err)
+	}
+
+	inputs := make([]banktypes.Input, len(input.Inputs))
+	for i, in := range input.Inputs {
+		if in.Addr != sender {
+			return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, sender.String(), in.Addr.String())
+		}
+
+		coins, err := cmn.NewSdkCoinsFromCoins(in.Coins)
+		if err != nil {
+			return nil, fmt.Errorf(cmn.ErrInvalidAmount, err.Error())
+		}
+
+		inputs[i] = banktypes.NewInput(in.Addr.Bytes(), coins)
+	}
+
+	outputs := make([]banktypes.Output, len(input.Outputs))
+	for i, out := range input.Outputs {
+		coins, err := cmn.NewSdkCoinsFromCoins(out.Coins)
+		if err != nil {
+			return nil, fmt.Errorf(cmn.ErrInvalidAmount, err.Error())
+		}
+
+		outputs[i] = banktypes.NewOutput(out.Addr.Bytes(), coins)
+	}
+
+	return &banktypes.MsgMultiSend{
+		Inputs:  inputs,
+		Outputs: outputs,
+	}, nil
+}
@@ -6,11 +6,15 @@ import (
 	ethcommon "github.com/ethereum/go-ethereum/common"
 
 	erc20types "github.com/cosmos/evm/x/erc20/types"
+	minttypes "github.com/cosmos/evm/x/mint/types"
 	ibctypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -26,9 +30,11 @@ type BankKeeper interface {
 	SendCoins(ctx context.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 	SpendableCoin(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	BlockedAddr(addr sdk.AccAddress) bool
-	// minting capability for mint precompile.
+	// minting and burning capability for mint precompile.
 	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 }
 
 type TransferKeeper interface {
@@ -61,8 +67,51 @@ type SlashingKeeper interface {
 	SigningInfos(ctx context.Context, req *slashingtypes.QuerySigningInfosRequest) (*slashingtypes.QuerySigningInfosResponse, error)
 }
 
+type MintKeeper interface {
+	GetMintAuthority(ctx sdk.Context) sdk.AccAddress
+	IsMintAuthority(ctx sdk.Context, addr sdk.AccAddress) bool
+	GetMinterAllowance(ctx sdk.Context, minter sdk.AccAddress, denom string) (math.Int, bool)
+	SetMinterAllowance(ctx sdk.Context, minter sdk.AccAddress, denom string, value math.Int) error
+	DeleteMinterAllowance(ctx sdk.Context, minter sdk.AccAddress, denom string) error
+	RemainingSupply(ctx sdk.Context, denom string) (math.Int, bool)
+	RemainingRateLimit(ctx sdk.Context, denom string) (math.Int, bool)
+	ValidateRecipient(ctx sdk.Context, recipient sdk.AccAddress, denom string) error
+	GetParams(ctx sdk.Context) minttypes.Params
+	Mint(ctx sdk.Context, minter, recipient sdk.AccAddress, coin sdk.Coin) error
+	Burn(ctx sdk.Context, from sdk.AccAddress, coin sdk.Coin) error
+	GetDenomMintStats(ctx sdk.Context, denom string) minttypes.DenomMintStats
+	GetMinterMintStats(ctx sdk.Context, minter sdk.AccAddress, denom string) minttypes.MinterMintStats
+	IsGuardian(ctx sdk.Context, addr sdk.AccAddress) bool
+	SetPaused(ctx sdk.Context, paused bool)
+	SetDenomPaused(ctx sdk.Context, denom string, paused bool) error
+	IsDenomPaused(ctx sdk.Context, denom string) bool
+	LiftPause(ctx sdk.Context, denom string) error
+	CheckNotPaused(ctx sdk.Context, denom string) error
+	GetMintApprovalConfig(ctx sdk.Context) minttypes.MintApprovalConfig
+	TrackLargeMint(ctx sdk.Context, denom string, amount math.Int) error
+	SubmitMintProposal(ctx sdk.Context, proposer, recipient sdk.AccAddress, denom string, amount math.Int, delay uint64) (minttypes.MintProposal, error)
+	ApproveMintProposal(ctx sdk.Context, id uint64, approver sdk.AccAddress) (minttypes.MintProposal, error)
+	CheckMintProposalExecutable(ctx sdk.Context, proposal minttypes.MintProposal) error
+	GetMintProposal(ctx sdk.Context, id uint64) (minttypes.MintProposal, bool)
+	DeleteMintProposal(ctx sdk.Context, id uint64) error
+	IsMintApprover(ctx sdk.Context, addr sdk.AccAddress) bool
+	IsVoucherNonceUsed(ctx sdk.Context, minter sdk.AccAddress, nonce math.Int) bool
+	UseVoucherNonce(ctx sdk.Context, minter sdk.AccAddress, nonce math.Int) error
+	AddContinuousVesting(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins, start, end int64) error
+	AddPeriodicVesting(ctx sdk.Context, addr sdk.AccAddress, start int64, periods vestingtypes.Periods) error
+	CreateDenom(ctx sdk.Context, creator sdk.AccAddress, subdenom string) (string, error)
+	GetDenomAdmin(ctx sdk.Context, denom string) (sdk.AccAddress, bool)
+	ChangeDenomAdmin(ctx sdk.Context, denom string, newAdmin sdk.AccAddress) error
+	IsDenomAdmin(ctx sdk.Context, denom string, addr sdk.AccAddress) bool
+	IsDenomManager(ctx sdk.Context, denom string, addr sdk.AccAddress) bool
+	GetEmissionSchedule(ctx sdk.Context, id uint64) (minttypes.EmissionSchedule, bool)
+	GetEmissionSchedules(ctx sdk.Context) []minttypes.EmissionSchedule
+}
+
 type ERC20Keeper interface {
 	GetCoinAddress(ctx sdk.Context, denom string) (ethcommon.Address, error)
 	GetERC20Map(ctx sdk.Context, erc20 ethcommon.Address) []byte
 	GetTokenPair(ctx sdk.Context, id []byte) (erc20types.TokenPair, bool)
+	IsDenomRegistered(ctx sdk.Context, denom string) bool
+	RegisterERC20Extension(ctx sdk.Context, denom string) (*erc20types.TokenPair, error)
 }
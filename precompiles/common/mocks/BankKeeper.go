@@ -35,6 +35,24 @@ func (_m *BankKeeper) BlockedAddr(addr types.AccAddress) bool {
 	return r0
 }
 
+// BurnCoins provides a mock function with given fields: ctx, moduleName, amt
+func (_m *BankKeeper) BurnCoins(ctx context.Context, moduleName string, amt types.Coins) error {
+	ret := _m.Called(ctx, moduleName, amt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BurnCoins")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, types.Coins) error); ok {
+		r0 = rf(ctx, moduleName, amt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetBalance provides a mock function with given fields: ctx, addr, denom
 func (_m *BankKeeper) GetBalance(ctx context.Context, addr types.AccAddress, denom string) types.Coin {
 	ret := _m.Called(ctx, addr, denom)
@@ -109,6 +127,24 @@ func (_m *BankKeeper) IterateTotalSupply(ctx context.Context, cb func(types.Coin
 	_m.Called(ctx, cb)
 }
 
+// MintCoins provides a mock function with given fields: ctx, moduleName, amt
+func (_m *BankKeeper) MintCoins(ctx context.Context, moduleName string, amt types.Coins) error {
+	ret := _m.Called(ctx, moduleName, amt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MintCoins")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, types.Coins) error); ok {
+		r0 = rf(ctx, moduleName, amt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SendCoins provides a mock function with given fields: ctx, fromAddr, toAddr, amt
 func (_m *BankKeeper) SendCoins(ctx context.Context, fromAddr types.AccAddress, toAddr types.AccAddress, amt types.Coins) error {
 	ret := _m.Called(ctx, fromAddr, toAddr, amt)
@@ -127,6 +163,42 @@ func (_m *BankKeeper) SendCoins(ctx context.Context, fromAddr types.AccAddress,
 	return r0
 }
 
+// SendCoinsFromAccountToModule provides a mock function with given fields: ctx, senderAddr, recipientModule, amt
+func (_m *BankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr types.AccAddress, recipientModule string, amt types.Coins) error {
+	ret := _m.Called(ctx, senderAddr, recipientModule, amt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendCoinsFromAccountToModule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.AccAddress, string, types.Coins) error); ok {
+		r0 = rf(ctx, senderAddr, recipientModule, amt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SendCoinsFromModuleToAccount provides a mock function with given fields: ctx, senderModule, recipientAddr, amt
+func (_m *BankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr types.AccAddress, amt types.Coins) error {
+	ret := _m.Called(ctx, senderModule, recipientAddr, amt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendCoinsFromModuleToAccount")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, types.AccAddress, types.Coins) error); ok {
+		r0 = rf(ctx, senderModule, recipientAddr, amt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetDenomMetaData provides a mock function with given fields: ctx, denomMetaData
 func (_m *BankKeeper) SetDenomMetaData(ctx context.Context, denomMetaData banktypes.Metadata) {
 	_m.Called(ctx, denomMetaData)
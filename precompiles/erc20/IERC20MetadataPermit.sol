@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "./IERC20Metadata.sol";
+import "./IERC20Permit.sol";
+
+/**
+ * @author Evmos Team
+ * @title ERC20 Precompile Interface
+ * @dev Interface of the ERC20 precompiles, which extends the ERC20 metadata interface
+ * with EIP-2612 permits and relative allowance updates.
+ */
+interface IERC20MetadataPermit is IERC20Metadata, IERC20Permit {
+    /// @dev Atomically increases the allowance granted to `spender` by the caller.
+    /// @dev Emits an Approval event indicating the updated allowance.
+    /// @param spender The address which will spend the funds.
+    /// @param addedValue The amount of tokens added to the allowance. Must be positive.
+    /// @return approved Boolean value to indicate if the operation succeeded.
+    function increaseAllowance(
+        address spender,
+        uint256 addedValue
+    ) external returns (bool approved);
+
+    /// @dev Atomically decreases the allowance granted to `spender` by the caller.
+    /// @dev Emits an Approval event indicating the updated allowance.
+    /// @param spender The address which will spend the funds.
+    /// @param subtractedValue The amount of tokens removed from the allowance. Must be
+    /// positive and not greater than the current allowance.
+    /// @return approved Boolean value to indicate if the operation succeeded.
+    function decreaseAllowance(
+        address spender,
+        uint256 subtractedValue
+    ) external returns (bool approved);
+}
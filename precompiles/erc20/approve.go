@@ -70,6 +70,93 @@ func (p Precompile) Approve(
 	return method.Outputs.Pack(true)
 }
 
+// IncreaseAllowance increases the allowance of the spender address over the
+// caller’s tokens by the given added value. It returns a boolean value
+// indicating whether the operation succeeded and emits the Approval event with
+// the new allowance on success.
+func (p Precompile) IncreaseAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	spender, addedValue, err := ParseApproveArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if addedValue.Sign() <= 0 {
+		return nil, ErrIncreaseNonPositiveValue
+	}
+
+	owner := contract.Caller()
+
+	allowance, err := p.erc20Keeper.GetAllowance(ctx, p.Address(), owner, spender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, fmt.Sprintf(ErrNoAllowanceForToken, p.tokenPair.Denom))
+	}
+
+	amount := new(big.Int).Add(allowance, addedValue)
+	if err := p.setAllowance(ctx, owner, spender, amount); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, owner, spender, amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// DecreaseAllowance decreases the allowance of the spender address over the
+// caller’s tokens by the given subtracted value. It returns a boolean value
+// indicating whether the operation succeeded and emits the Approval event with
+// the new allowance on success. The allowance is deleted when it reaches zero.
+func (p Precompile) DecreaseAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	spender, subtractedValue, err := ParseApproveArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if subtractedValue.Sign() <= 0 {
+		return nil, ErrDecreaseNonPositiveValue
+	}
+
+	owner := contract.Caller()
+
+	allowance, err := p.erc20Keeper.GetAllowance(ctx, p.Address(), owner, spender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, fmt.Sprintf(ErrNoAllowanceForToken, p.tokenPair.Denom))
+	}
+
+	amount := new(big.Int).Sub(allowance, subtractedValue)
+	switch amount.Sign() {
+	case -1:
+		return nil, ConvertErrToERC20Error(fmt.Errorf(ErrSubtractMoreThanAllowance, p.tokenPair.Denom, subtractedValue, allowance))
+	case 0:
+		err = p.erc20Keeper.DeleteAllowance(ctx, p.Address(), owner, spender)
+	default:
+		err = p.setAllowance(ctx, owner, spender, amount)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, owner, spender, amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
 func (p Precompile) setAllowance(
 	ctx sdk.Context,
 	owner, spender common.Address,
@@ -28,15 +28,20 @@ const (
 	// The results can be inspected here:
 	// https://github.com/evmos/evmos/blob/malte/erc20-gas-tests/precompiles/erc20/plot_gas_values.ipynb
 
-	GasTransfer     = 9_000
-	GasTransferFrom = 30_500
-	GasApprove      = 8_100
-	GasName         = 3_421
-	GasSymbol       = 3_464
-	GasDecimals     = 427
-	GasTotalSupply  = 2_480
-	GasBalanceOf    = 2_870
-	GasAllowance    = 3_225
+	GasTransfer          = 9_000
+	GasTransferFrom      = 30_500
+	GasApprove           = 8_100
+	GasIncreaseAllowance = 8_340
+	GasDecreaseAllowance = 8_343
+	GasPermit            = 16_100
+	GasName              = 3_421
+	GasSymbol            = 3_464
+	GasDecimals          = 427
+	GasTotalSupply       = 2_480
+	GasBalanceOf         = 2_870
+	GasAllowance         = 3_225
+	GasNonces            = 2_870
+	GasDomainSeparator   = 3_421
 )
 
 // Embed abi json file to the executable binary. Needed when importing as dependency.
@@ -113,6 +118,12 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasTransferFrom
 	case ApproveMethod:
 		return GasApprove
+	case IncreaseAllowanceMethod:
+		return GasIncreaseAllowance
+	case DecreaseAllowanceMethod:
+		return GasDecreaseAllowance
+	case PermitMethod:
+		return GasPermit
 	// ERC-20 queries
 	case NameMethod:
 		return GasName
@@ -126,6 +137,10 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasBalanceOf
 	case AllowanceMethod:
 		return GasAllowance
+	case NoncesMethod:
+		return GasNonces
+	case DomainSeparatorMethod:
+		return GasDomainSeparator
 	default:
 		return 0
 	}
@@ -186,7 +201,10 @@ func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
 	case TransferMethod,
 		TransferFromMethod,
-		ApproveMethod:
+		ApproveMethod,
+		IncreaseAllowanceMethod,
+		DecreaseAllowanceMethod,
+		PermitMethod:
 		return true
 	default:
 		return false
@@ -209,6 +227,12 @@ func (p *Precompile) HandleMethod(
 		bz, err = p.TransferFrom(ctx, contract, stateDB, method, args)
 	case ApproveMethod:
 		bz, err = p.Approve(ctx, contract, stateDB, method, args)
+	case IncreaseAllowanceMethod:
+		bz, err = p.IncreaseAllowance(ctx, contract, stateDB, method, args)
+	case DecreaseAllowanceMethod:
+		bz, err = p.DecreaseAllowance(ctx, contract, stateDB, method, args)
+	case PermitMethod:
+		bz, err = p.Permit(ctx, contract, stateDB, method, args)
 	// ERC-20 queries
 	case NameMethod:
 		bz, err = p.Name(ctx, contract, stateDB, method, args)
@@ -222,6 +246,10 @@ func (p *Precompile) HandleMethod(
 		bz, err = p.BalanceOf(ctx, contract, stateDB, method, args)
 	case AllowanceMethod:
 		bz, err = p.Allowance(ctx, contract, stateDB, method, args)
+	case NoncesMethod:
+		bz, err = p.Nonces(ctx, contract, stateDB, method, args)
+	case DomainSeparatorMethod:
+		bz, err = p.DomainSeparator(ctx, contract, stateDB, method, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
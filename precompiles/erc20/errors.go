@@ -31,6 +31,8 @@ var (
 	ErrDecreasedAllowanceBelowZero  = errors.New("ERC20: decreased allowance below zero")
 	ErrInsufficientAllowance        = errors.New("ERC20: insufficient allowance")
 	ErrTransferAmountExceedsBalance = errors.New("ERC20: transfer amount exceeds balance")
+	ErrPermitExpired                = errors.New("ERC20Permit: expired deadline")
+	ErrPermitInvalidSignature       = errors.New("ERC20Permit: invalid signature")
 )
 
 // ConvertErrToERC20Error is a helper function which maps errors raised by the Cosmos SDK stack
@@ -13,4 +13,6 @@ type Erc20Keeper interface {
 	GetAllowance(ctx sdk.Context, erc20 common.Address, owner common.Address, spender common.Address) (*big.Int, error)
 	SetAllowance(ctx sdk.Context, erc20 common.Address, owner common.Address, spender common.Address, value *big.Int) error
 	DeleteAllowance(ctx sdk.Context, erc20 common.Address, owner common.Address, spender common.Address) error
+	GetNonce(ctx sdk.Context, erc20 common.Address, owner common.Address) *big.Int
+	UseNonce(ctx sdk.Context, erc20 common.Address, owner common.Address) (*big.Int, error)
 }
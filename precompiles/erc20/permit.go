@@ -0,0 +1,166 @@
+package erc20
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	gethmath "github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// PermitDomainVersion is the version of the EIP-712 signing domain of
+	// permits. The name of the domain is the name of the token.
+	PermitDomainVersion = "1"
+	// PermitPrimaryType is the EIP-712 type of permits.
+	PermitPrimaryType = "Permit"
+)
+
+// permitTypes are the EIP-2612 types of a permit, signed by an owner to set
+// the allowance of a spender over its tokens to `value` until `deadline`.
+var permitTypes = apitypes.Types{
+	"EIP712Domain": {
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+		{Name: "verifyingContract", Type: "address"},
+	},
+	PermitPrimaryType: {
+		{Name: "owner", Type: "address"},
+		{Name: "spender", Type: "address"},
+		{Name: "value", Type: "uint256"},
+		{Name: "nonce", Type: "uint256"},
+		{Name: "deadline", Type: "uint256"},
+	},
+}
+
+// Permit sets the given value as the allowance of the spender over the owner’s
+// tokens, as approved by the owner with an EIP-2612 signature. Anyone can
+// submit the permit, which can only be used once and before its deadline. It
+// emits the Approval event on success.
+func (p Precompile) Permit(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	input, err := ParsePermitArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Deadline.Cmp(big.NewInt(ctx.BlockTime().Unix())) < 0 {
+		return nil, ErrPermitExpired
+	}
+
+	name, err := p.tokenName(ctx)
+	if err != nil {
+		return nil, ConvertErrToERC20Error(err)
+	}
+
+	nonce := p.erc20Keeper.GetNonce(ctx, p.Address(), input.Owner)
+	typedData := PermitTypedData(name, p.Address(), input.Owner, input.Spender, input.Value, nonce, input.Deadline)
+
+	signer, err := RecoverPermitSigner(typedData, input.V, input.R, input.S)
+	if err != nil || signer != input.Owner {
+		return nil, ErrPermitInvalidSignature
+	}
+
+	if _, err := p.erc20Keeper.UseNonce(ctx, p.Address(), input.Owner); err != nil {
+		return nil, err
+	}
+
+	if input.Value.Sign() == 0 {
+		err = p.erc20Keeper.DeleteAllowance(ctx, p.Address(), input.Owner, input.Spender)
+	} else {
+		err = p.setAllowance(ctx, input.Owner, input.Spender, input.Value)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, input.Owner, input.Spender, input.Value); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// PermitTypedData returns the EIP-712 typed data of a permit for the ERC-20
+// precompile with the given name at the given address on the current chain.
+func PermitTypedData(
+	name string,
+	verifyingContract, owner, spender common.Address,
+	value, nonce, deadline *big.Int,
+) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types:       permitTypes,
+		PrimaryType: PermitPrimaryType,
+		Domain: apitypes.TypedDataDomain{
+			Name:              name,
+			Version:           PermitDomainVersion,
+			ChainId:           (*gethmath.HexOrDecimal256)(evmtypes.GetEthChainConfig().ChainID),
+			VerifyingContract: verifyingContract.Hex(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"owner":    owner.Hex(),
+			"spender":  spender.Hex(),
+			"value":    value,
+			"nonce":    nonce,
+			"deadline": deadline,
+		},
+	}
+}
+
+// PermitDomainSeparator returns the EIP-712 domain separator of permits for
+// the ERC-20 precompile with the given name at the given address on the
+// current chain.
+func PermitDomainSeparator(name string, verifyingContract common.Address) (common.Hash, error) {
+	typedData := PermitTypedData(name, verifyingContract, common.Address{}, common.Address{}, new(big.Int), new(big.Int), new(big.Int))
+
+	separator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return common.BytesToHash(separator), nil
+}
+
+// RecoverPermitSigner returns the address that signed the given typed data of
+// a permit with the (v, r, s) signature, with v being 27 or 28. Malleable
+// signatures with a high S value are rejected.
+func RecoverPermitSigner(typedData apitypes.TypedData, v uint8, r, s [32]byte) (common.Address, error) {
+	if v != 27 && v != 28 {
+		return common.Address{}, ErrPermitInvalidSignature
+	}
+
+	if !crypto.ValidateSignatureValues(v-27, new(big.Int).SetBytes(r[:]), new(big.Int).SetBytes(s[:]), true) {
+		return common.Address{}, ErrPermitInvalidSignature
+	}
+
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig[:32], r[:])
+	copy(sig[32:64], s[:])
+	sig[crypto.RecoveryIDOffset] = v - 27
+
+	pubKey, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
@@ -33,6 +33,12 @@ const (
 	// AllowanceMethod defines the ABI method name for the Allowance
 	// query.
 	AllowanceMethod = "allowance"
+	// NoncesMethod defines the ABI method name for the EIP-2612 Nonces
+	// query.
+	NoncesMethod = "nonces"
+	// DomainSeparatorMethod defines the ABI method name for the EIP-2612
+	// DOMAIN_SEPARATOR query.
+	DomainSeparatorMethod = "DOMAIN_SEPARATOR"
 )
 
 // Name returns the name of the token. If the token metadata is registered in the
@@ -45,18 +51,27 @@ func (p Precompile) Name(
 	method *abi.Method,
 	_ []interface{},
 ) ([]byte, error) {
+	name, err := p.tokenName(ctx)
+	if err != nil {
+		return nil, ConvertErrToERC20Error(err)
+	}
+
+	return method.Outputs.Pack(name)
+}
+
+// tokenName returns the name of the token, as returned by the Name query.
+func (p Precompile) tokenName(ctx sdk.Context) (string, error) {
 	metadata, found := p.BankKeeper.GetDenomMetaData(ctx, p.tokenPair.Denom)
 	if found {
-		return method.Outputs.Pack(metadata.Name)
+		return metadata.Name, nil
 	}
 
 	baseDenom, err := p.getBaseDenomFromIBCVoucher(ctx, p.tokenPair.Denom)
 	if err != nil {
-		return nil, ConvertErrToERC20Error(err)
+		return "", err
 	}
 
-	name := strings.ToUpper(string(baseDenom[1])) + baseDenom[2:]
-	return method.Outputs.Pack(name)
+	return strings.ToUpper(string(baseDenom[1])) + baseDenom[2:], nil
 }
 
 // Symbol returns the symbol of the token. If the token metadata is registered in the
@@ -200,6 +215,47 @@ func (p Precompile) Allowance(
 	return method.Outputs.Pack(allowance)
 }
 
+// Nonces returns the current EIP-2612 permit nonce of the given owner, which
+// must be included in its next permit signature.
+func (p Precompile) Nonces(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, err := ParseNoncesArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := p.erc20Keeper.GetNonce(ctx, p.Address(), owner)
+
+	return method.Outputs.Pack(nonce)
+}
+
+// DomainSeparator returns the EIP-712 domain separator of the permit
+// signatures of the token on the current chain.
+func (p Precompile) DomainSeparator(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	name, err := p.tokenName(ctx)
+	if err != nil {
+		return nil, ConvertErrToERC20Error(err)
+	}
+
+	separator, err := PermitDomainSeparator(name, p.Address())
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(separator)
+}
+
 // getBaseDenomFromIBCVoucher returns the base denomination from the given IBC voucher denomination.
 func (p Precompile) getBaseDenomFromIBCVoucher(ctx sdk.Context, voucherDenom string) (string, error) {
 	// Infer the denomination name from the coin denomination base voucherDenom
@@ -23,6 +23,15 @@ const (
 	// ApproveMethod defines the ABI method name for ERC-20 Approve
 	// transaction.
 	ApproveMethod = "approve"
+	// IncreaseAllowanceMethod defines the ABI method name for the IncreaseAllowance
+	// transaction.
+	IncreaseAllowanceMethod = "increaseAllowance"
+	// DecreaseAllowanceMethod defines the ABI method name for the DecreaseAllowance
+	// transaction.
+	DecreaseAllowanceMethod = "decreaseAllowance"
+	// PermitMethod defines the ABI method name for the EIP-2612 Permit
+	// transaction.
+	PermitMethod = "permit"
 )
 
 // Transfer executes a direct transfer from the caller address to the
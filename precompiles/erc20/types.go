@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -127,3 +128,43 @@ func ParseBalanceOfArgs(args []interface{}) (common.Address, error) {
 
 	return account, nil
 }
+
+// PermitInput defines the input arguments of the EIP-2612 permit method.
+type PermitInput struct {
+	Owner    common.Address
+	Spender  common.Address
+	Value    *big.Int
+	Deadline *big.Int
+	V        uint8
+	R        [32]byte
+	S        [32]byte
+}
+
+// ParsePermitArgs parses the permit arguments and returns the owner and the
+// spender addresses, the value, the deadline and the signature of the permit.
+func ParsePermitArgs(method *abi.Method, args []interface{}) (*PermitInput, error) {
+	if len(args) != 7 {
+		return nil, fmt.Errorf("invalid number of arguments; expected 7; got: %d", len(args))
+	}
+
+	var input PermitInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to PermitInput: %s", err)
+	}
+
+	return &input, nil
+}
+
+// ParseNoncesArgs parses the nonces arguments and returns the owner address.
+func ParseNoncesArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf("invalid number of arguments; expected 1; got: %d", len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf("invalid owner address: %v", args[0])
+	}
+
+	return owner, nil
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IFeegrant contract's address.
+address constant FEEGRANT_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000809;
+
+/// @dev The IFeegrant contract's instance.
+IFeegrant constant FEEGRANT_CONTRACT = IFeegrant(FEEGRANT_PRECOMPILE_ADDRESS);
+
+/// @dev BasicAllowance represents a fee allowance a granter gave to a grantee.
+struct BasicAllowance {
+    /// spendLimit is the maximum amount of fees the grantee can spend,
+    /// or an empty list for an unlimited amount.
+    Coin[] spendLimit;
+    /// expiration is the unix timestamp in seconds at which the allowance expires,
+    /// or zero if it does not expire.
+    uint64 expiration;
+}
+
+/**
+ * @author Evmos Team
+ * @title Feegrant Precompiled Contract
+ * @dev The interface through which solidity contracts interact with the x/feegrant module,
+ * to let grantees pay their transaction fees from the balance of a granter.
+ */
+interface IFeegrant {
+    /// @dev GrantAllowance defines an Event emitted when a granter grants a fee allowance to a grantee.
+    /// @param granter the address paying the fees
+    /// @param grantee the address whose fees are paid
+    /// @param spendLimit the maximum amount of fees the grantee can spend, empty if unlimited
+    /// @param expiration the unix timestamp at which the allowance expires, or zero
+    event GrantAllowance(
+        address indexed granter,
+        address indexed grantee,
+        Coin[] spendLimit,
+        uint64 expiration
+    );
+
+    /// @dev RevokeAllowance defines an Event emitted when a granter revokes a fee allowance.
+    /// @param granter the address that granted the allowance
+    /// @param grantee the address that received the allowance
+    event RevokeAllowance(address indexed granter, address indexed grantee);
+
+    /// @dev grantAllowance defines a method to grant the grantee an allowance to pay
+    /// fees from the balance of the caller.
+    /// @param grantee the address whose fees are paid
+    /// @param allowance the spend limit and expiration of the allowance
+    /// @return success true if the allowance was granted
+    function grantAllowance(
+        address grantee,
+        BasicAllowance calldata allowance
+    ) external returns (bool success);
+
+    /// @dev revokeAllowance defines a method to revoke the allowance the caller
+    /// granted to the grantee.
+    /// @param grantee the address that received the allowance
+    /// @return success true if the allowance was revoked
+    function revokeAllowance(address grantee) external returns (bool success);
+
+    /// @dev allowance returns the allowance a granter gave to a grantee.
+    /// It reverts if there is no allowance or it is not a basic allowance.
+    /// @param granter the address paying the fees
+    /// @param grantee the address whose fees are paid
+    /// @return allowance the remaining spend limit and the expiration of the allowance
+    function allowance(
+        address granter,
+        address grantee
+    ) external view returns (BasicAllowance memory allowance);
+}
@@ -0,0 +1,13 @@
+package feegrant
+
+const (
+	// ErrInvalidGrantee is raised when the grantee address is not valid.
+	ErrInvalidGrantee = "invalid grantee address: %v"
+	// ErrInvalidGranter is raised when the granter address is not valid.
+	ErrInvalidGranter = "invalid granter address: %v"
+	// ErrInvalidAllowance is raised when the allowance is not valid.
+	ErrInvalidAllowance = "invalid allowance: %v"
+	// ErrUnsupportedAllowance is raised when an allowance cannot be represented
+	// as a basic allowance.
+	ErrUnsupportedAllowance = "unsupported allowance type %s"
+)
@@ -0,0 +1,69 @@
+package feegrant
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// GrantAllowanceMethod defines the ABI method name for the feegrant GrantAllowance transaction.
+	GrantAllowanceMethod = "grantAllowance"
+	// RevokeAllowanceMethod defines the ABI method name for the feegrant RevokeAllowance transaction.
+	RevokeAllowanceMethod = "revokeAllowance"
+)
+
+// GrantAllowance defines a method to grant the grantee a basic allowance to
+// pay fees from the balance of the caller.
+func (p *Precompile) GrantAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	granter := contract.Caller()
+
+	msg, input, err := NewMsgGrantAllowance(method, args, granter, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = p.feegrantMsgServer.GrantAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitGrantAllowanceEvent(ctx, stateDB, granter, input.Grantee, input.Allowance); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// RevokeAllowance defines a method to revoke the allowance the caller granted
+// to the grantee.
+func (p *Precompile) RevokeAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	granter := contract.Caller()
+
+	msg, grantee, err := NewMsgRevokeAllowance(args, granter, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = p.feegrantMsgServer.RevokeAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRevokeAllowanceEvent(ctx, stateDB, granter, grantee); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
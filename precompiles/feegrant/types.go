@@ -0,0 +1,200 @@
+package feegrant
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// BasicAllowance defines a fee allowance with an optional spend limit and
+// expiration, as the x/feegrant BasicAllowance. An empty spend limit allows
+// an unlimited amount of fees and a zero expiration never expires.
+type BasicAllowance struct {
+	SpendLimit []cmn.Coin
+	Expiration uint64
+}
+
+// GrantAllowanceInput defines the input for the GrantAllowance transaction.
+type GrantAllowanceInput struct {
+	Grantee   common.Address
+	Allowance BasicAllowance
+}
+
+// AllowanceOutput defines the output for the Allowance query.
+type AllowanceOutput struct {
+	Allowance BasicAllowance `abi:"allowance"`
+}
+
+// EventGrantAllowance defines the event data for the feegrant GrantAllowance event.
+type EventGrantAllowance struct {
+	Granter    common.Address
+	Grantee    common.Address
+	SpendLimit []cmn.Coin
+	Expiration uint64
+}
+
+// EventRevokeAllowance defines the event data for the feegrant RevokeAllowance event.
+type EventRevokeAllowance struct {
+	Granter common.Address
+	Grantee common.Address
+}
+
+// NewMsgGrantAllowance creates a new MsgGrantAllowance of a basic allowance
+// from the granter to the grantee of the call arguments.
+func NewMsgGrantAllowance(
+	method *abi.Method,
+	args []interface{},
+	granter common.Address,
+	addrCdc address.Codec,
+) (*feegrant.MsgGrantAllowance, *GrantAllowanceInput, error) {
+	if len(args) != 2 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GrantAllowanceInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, nil, fmt.Errorf("error while unpacking args to GrantAllowanceInput: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	spendLimit, err := cmn.NewSdkCoinsFromCoins(input.Allowance.SpendLimit)
+	if err != nil {
+		return nil, nil, fmt.Errorf(ErrInvalidAllowance, err)
+	}
+
+	if input.Allowance.Expiration > math.MaxInt64 {
+		return nil, nil, fmt.Errorf(ErrInvalidAllowance, "expiration overflows int64")
+	}
+
+	allowance := &feegrant.BasicAllowance{}
+	if !spendLimit.Empty() {
+		allowance.SpendLimit = spendLimit
+	}
+	if input.Allowance.Expiration != 0 {
+		t := time.Unix(int64(input.Allowance.Expiration), 0).UTC()
+		allowance.Expiration = &t
+	}
+	if err := allowance.ValidateBasic(); err != nil {
+		return nil, nil, fmt.Errorf(ErrInvalidAllowance, err)
+	}
+
+	granterAddr, err := addrCdc.BytesToString(granter.Bytes())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode granter address: %w", err)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(input.Grantee.Bytes())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	allowanceAny, err := codectypes.NewAnyWithValue(allowance)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &feegrant.MsgGrantAllowance{
+		Granter:   granterAddr,
+		Grantee:   granteeAddr,
+		Allowance: allowanceAny,
+	}, &input, nil
+}
+
+// NewMsgRevokeAllowance creates a new MsgRevokeAllowance of the allowance the
+// granter gave to the grantee of the call arguments.
+func NewMsgRevokeAllowance(args []interface{}, granter common.Address, addrCdc address.Codec) (*feegrant.MsgRevokeAllowance, common.Address, error) {
+	if len(args) != 1 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	granterAddr, err := addrCdc.BytesToString(granter.Bytes())
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("failed to decode granter address: %w", err)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	return &feegrant.MsgRevokeAllowance{
+		Granter: granterAddr,
+		Grantee: granteeAddr,
+	}, grantee, nil
+}
+
+// ParseAllowanceArgs parses the arguments for the Allowance query.
+func ParseAllowanceArgs(args []interface{}, addrCdc address.Codec) (*feegrant.QueryAllowanceRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidGranter, args[0])
+	}
+
+	grantee, ok := args[1].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidGrantee, args[1])
+	}
+
+	granterAddr, err := addrCdc.BytesToString(granter.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidGranter, err)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidGrantee, err)
+	}
+
+	return &feegrant.QueryAllowanceRequest{
+		Granter: granterAddr,
+		Grantee: granteeAddr,
+	}, nil
+}
+
+// NewBasicAllowanceResponse returns the BasicAllowance of a QueryAllowanceResponse.
+// Allowances of other types, granted through Cosmos transactions, are not
+// supported since they cannot be represented as a basic allowance.
+func NewBasicAllowanceResponse(res *feegrant.QueryAllowanceResponse, cdc codec.Codec) (BasicAllowance, error) {
+	var allowance feegrant.FeeAllowanceI
+	if err := cdc.UnpackAny(res.Allowance.Allowance, &allowance); err != nil {
+		return BasicAllowance{}, err
+	}
+
+	basic, ok := allowance.(*feegrant.BasicAllowance)
+	if !ok {
+		return BasicAllowance{}, fmt.Errorf(ErrUnsupportedAllowance, res.Allowance.Allowance.TypeUrl)
+	}
+
+	var expiration uint64
+	if basic.Expiration != nil {
+		expiration = uint64(basic.Expiration.Unix()) //nolint:gosec // G115 // allowances cannot expire before the epoch
+	}
+
+	return BasicAllowance{
+		SpendLimit: cmn.NewCoinsResponse(basic.SpendLimit),
+		Expiration: expiration,
+	}, nil
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev The IICA contract's address.
+address constant ICA_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000080a;
+
+/// @dev The IICA contract's instance.
+IICA constant ICA_CONTRACT = IICA(ICA_PRECOMPILE_ADDRESS);
+
+/**
+ * @author Evmos Team
+ * @title Interchain Accounts Precompiled Contract
+ * @dev The interface through which solidity contracts control interchain accounts
+ * on other IBC chains, through the ICS-27 controller submodule.
+ */
+interface IICA {
+    /// @dev RegisterInterchainAccount defines an Event emitted when an owner starts
+    /// the registration of an interchain account.
+    /// @param owner the address owning the interchain account
+    /// @param connectionId the connection to the host chain
+    /// @param channelId the channel opened for the interchain account
+    /// @param portId the controller port of the owner
+    event RegisterInterchainAccount(
+        address indexed owner,
+        string connectionId,
+        string channelId,
+        string portId
+    );
+
+    /// @dev SendTx defines an Event emitted when an owner sends messages to execute
+    /// with its interchain account.
+    /// @param owner the address owning the interchain account
+    /// @param connectionId the connection to the host chain
+    /// @param sequence the sequence of the sent packet
+    event SendTx(address indexed owner, string connectionId, uint64 sequence);
+
+    /// @dev registerInterchainAccount defines a method to register an interchain account
+    /// owned by the caller on the host chain of the connection. The account is
+    /// available once the channel handshake completes.
+    /// @param connectionId the connection to the host chain
+    /// @param version the channel version, or an empty string for the default version
+    /// @return channelId the channel opened for the interchain account
+    function registerInterchainAccount(
+        string calldata connectionId,
+        string calldata version
+    ) external returns (string memory channelId);
+
+    /// @dev sendTx defines a method to execute messages with the interchain account
+    /// of the caller. When the caller is a contract, the acknowledgement or timeout of
+    /// the packet is delivered to it through the ICallbacks interface.
+    /// @param connectionId the connection to the host chain
+    /// @param msgs the protobuf encoded google.protobuf.Any messages to execute
+    /// @param timeout the timeout of the packet in nanoseconds, relative to the block time
+    /// @return sequence the sequence of the sent packet
+    function sendTx(
+        string calldata connectionId,
+        bytes[] calldata msgs,
+        uint64 timeout
+    ) external returns (uint64 sequence);
+
+    /// @dev interchainAccountAddress returns the address of the interchain account of
+    /// the owner on the host chain of the connection. It reverts if there is no account.
+    /// @param connectionId the connection to the host chain
+    /// @param owner the address owning the interchain account
+    /// @return accountAddress the address of the interchain account on the host chain
+    function interchainAccountAddress(
+        string calldata connectionId,
+        address owner
+    ) external view returns (string memory accountAddress);
+}
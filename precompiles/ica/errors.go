@@ -0,0 +1,13 @@
+package ica
+
+const (
+	// ErrInvalidOwner is raised when the owner address is not valid.
+	ErrInvalidOwner = "invalid owner address: %v"
+	// ErrInvalidConnectionID is raised when the connection identifier is not valid.
+	ErrInvalidConnectionID = "invalid connection id: %v"
+	// ErrInvalidMsg is raised when a message to execute on the host chain
+	// is not an encoded protobuf Any.
+	ErrInvalidMsg = "invalid message at index %d: %v"
+	// ErrEmptyMsgs is raised when no message to execute on the host chain is given.
+	ErrEmptyMsgs = "messages cannot be empty"
+)
@@ -0,0 +1,35 @@
+package ica
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// InterchainAccountAddressMethod defines the method name for the interchainAccountAddress
+	// precompile request.
+	InterchainAccountAddressMethod = "interchainAccountAddress"
+)
+
+// InterchainAccountAddress implements the query logic for getting the address
+// of the interchain account of an owner on the host chain of a connection.
+func (p *Precompile) InterchainAccountAddress(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseInterchainAccountAddressArgs(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.icaQuerier.InterchainAccount(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Address)
+}
@@ -0,0 +1,80 @@
+package ica
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// RegisterInterchainAccountMethod defines the ABI method name for the interchain accounts
+	// RegisterInterchainAccount transaction.
+	RegisterInterchainAccountMethod = "registerInterchainAccount"
+	// SendTxMethod defines the ABI method name for the interchain accounts SendTx transaction.
+	SendTxMethod = "sendTx"
+)
+
+// RegisterInterchainAccount defines a method to open a channel on the given
+// connection to register an interchain account owned by the caller.
+func (p *Precompile) RegisterInterchainAccount(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner := contract.Caller()
+
+	msg, err := NewMsgRegisterInterchainAccount(args, owner, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.icaMsgServer.RegisterInterchainAccount(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRegisterInterchainAccountEvent(ctx, stateDB, owner, msg.ConnectionId, res.ChannelId, res.PortId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.ChannelId)
+}
+
+// SendTx defines a method to execute the given messages with the interchain
+// account of the caller on the host chain of the given connection.
+//
+// The acknowledgement or timeout of the packet is delivered to the caller
+// through the ICallbacks interface when the caller is a contract.
+func (p *Precompile) SendTx(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner := contract.Caller()
+
+	var memo string
+	if stateDB.GetCodeSize(owner) > 0 {
+		memo = NewCallbackMemo(owner)
+	}
+
+	msg, err := NewMsgSendTx(args, owner, memo, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.icaMsgServer.SendTx(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitSendTxEvent(ctx, stateDB, owner, msg.ConnectionId, res.Sequence); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Sequence)
+}
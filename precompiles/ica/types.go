@@ -0,0 +1,171 @@
+package ica
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	callbacktypes "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"cosmossdk.io/core/address"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// EventRegisterInterchainAccount defines the event data for the RegisterInterchainAccount event.
+type EventRegisterInterchainAccount struct {
+	Owner        common.Address
+	ConnectionID string `abi:"connectionId"`
+	ChannelID    string `abi:"channelId"`
+	PortID       string `abi:"portId"`
+}
+
+// EventSendTx defines the event data for the SendTx event.
+type EventSendTx struct {
+	Owner        common.Address
+	ConnectionID string `abi:"connectionId"`
+	Sequence     uint64
+}
+
+// NewCallbackMemo returns the packet memo requesting the IBC callbacks
+// middleware to deliver the acknowledgement or timeout of the packet to the
+// given contract.
+func NewCallbackMemo(contract common.Address) string {
+	return fmt.Sprintf(`{"%s":{"address":"%s"}}`, callbacktypes.SourceCallbackKey, contract.Hex())
+}
+
+// NewMsgRegisterInterchainAccount creates a new MsgRegisterInterchainAccount
+// registering an unordered interchain account channel for the owner.
+func NewMsgRegisterInterchainAccount(args []interface{}, owner common.Address, addrCdc address.Codec) (*icacontrollertypes.MsgRegisterInterchainAccount, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	connectionID, err := parseConnectionID(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	version, ok := args[1].(string)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "version", "", args[1])
+	}
+
+	ownerAddr, err := addrCdc.BytesToString(owner.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidOwner, err)
+	}
+
+	msg := icacontrollertypes.NewMsgRegisterInterchainAccount(connectionID, ownerAddr, version, channeltypes.UNORDERED)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// NewMsgSendTx creates a new MsgSendTx executing the messages of the call
+// arguments with the interchain account of the owner.
+//
+// Each message is a protobuf encoded Any, and the messages are serialized
+// into the packet data with the protobuf encoding.
+func NewMsgSendTx(args []interface{}, owner common.Address, memo string, addrCdc address.Codec) (*icacontrollertypes.MsgSendTx, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	connectionID, err := parseConnectionID(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, ok := args[1].([][]byte)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "msgs", [][]byte{}, args[1])
+	}
+	if len(msgs) == 0 {
+		return nil, fmt.Errorf(ErrEmptyMsgs)
+	}
+
+	timeout, ok := args[2].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "timeout", uint64(0), args[2])
+	}
+
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, bz := range msgs {
+		anys[i] = &codectypes.Any{}
+		if err := anys[i].Unmarshal(bz); err != nil {
+			return nil, fmt.Errorf(ErrInvalidMsg, i, err)
+		}
+		if anys[i].TypeUrl == "" {
+			return nil, fmt.Errorf(ErrInvalidMsg, i, "empty type url")
+		}
+	}
+
+	cosmosTx := &icatypes.CosmosTx{Messages: anys}
+	data, err := cosmosTx.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	ownerAddr, err := addrCdc.BytesToString(owner.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidOwner, err)
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: memo,
+	}
+
+	msg := icacontrollertypes.NewMsgSendTx(ownerAddr, connectionID, timeout, packetData)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// ParseInterchainAccountAddressArgs parses the arguments for the InterchainAccountAddress query.
+func ParseInterchainAccountAddressArgs(args []interface{}, addrCdc address.Codec) (*icacontrollertypes.QueryInterchainAccountRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	connectionID, err := parseConnectionID(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	owner, ok := args[1].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidOwner, args[1])
+	}
+
+	ownerAddr, err := addrCdc.BytesToString(owner.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidOwner, err)
+	}
+
+	return &icacontrollertypes.QueryInterchainAccountRequest{
+		Owner:        ownerAddr,
+		ConnectionId: connectionID,
+	}, nil
+}
+
+// parseConnectionID parses a non-empty connection identifier argument.
+func parseConnectionID(arg interface{}) (string, error) {
+	connectionID, ok := arg.(string)
+	if !ok || strings.TrimSpace(connectionID) == "" {
+		return "", fmt.Errorf(ErrInvalidConnectionID, arg)
+	}
+
+	return connectionID, nil
+}
@@ -0,0 +1,86 @@
+package ica
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestNewMsgSendTx(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	owner := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	expectedOwner, err := addrCodec.BytesToString(owner.Bytes())
+	require.NoError(t, err)
+
+	msgAny, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{FromAddress: "from", ToAddress: "to"})
+	require.NoError(t, err)
+	msgBz, err := msgAny.Marshal()
+	require.NoError(t, err)
+
+	memo := NewCallbackMemo(owner)
+	require.Equal(t, `{"src_callback":{"address":"0x1234567890123456789012345678901234567890"}}`, memo)
+
+	tests := []struct {
+		name   string
+		args   []any
+		errMsg string
+	}{
+		{
+			name: "valid messages",
+			args: []any{"connection-0", [][]byte{msgBz}, uint64(1)},
+		},
+		{
+			name:   "no arguments",
+			args:   []any{},
+			errMsg: fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			name:   "empty connection id",
+			args:   []any{"", [][]byte{msgBz}, uint64(1)},
+			errMsg: fmt.Sprintf(ErrInvalidConnectionID, ""),
+		},
+		{
+			name:   "empty messages",
+			args:   []any{"connection-0", [][]byte{}, uint64(1)},
+			errMsg: ErrEmptyMsgs,
+		},
+		{
+			name:   "message without type url",
+			args:   []any{"connection-0", [][]byte{{}}, uint64(1)},
+			errMsg: fmt.Sprintf(ErrInvalidMsg, 0, "empty type url"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := NewMsgSendTx(tt.args, owner, memo, addrCodec)
+			if tt.errMsg != "" {
+				require.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, expectedOwner, msg.Owner)
+			require.Equal(t, "connection-0", msg.ConnectionId)
+			require.Equal(t, uint64(1), msg.RelativeTimeout)
+			require.Equal(t, icatypes.EXECUTE_TX, msg.PacketData.Type)
+			require.Equal(t, memo, msg.PacketData.Memo)
+
+			var cosmosTx icatypes.CosmosTx
+			require.NoError(t, cosmosTx.Unmarshal(msg.PacketData.Data))
+			require.Len(t, cosmosTx.Messages, 1)
+			require.Equal(t, msgAny.TypeUrl, cosmosTx.Messages[0].TypeUrl)
+			require.Equal(t, msgAny.Value, cosmosTx.Messages[0].Value)
+		})
+	}
+}
@@ -1,9 +1,16 @@
 // SPDX-License-Identifier: MIT
 pragma solidity ^0.8.0;
 
+/// @dev The IMint contract's address.
+address constant IMINT_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000807;
+
+/// @dev The IMint contract's instance.
+IMint constant IMINT_CONTRACT = IMint(IMINT_PRECOMPILE_ADDRESS);
+
 /**
  * @dev Interface for the Mint precompile contract.
- * This precompile allows authorized accounts to mint native Cosmos tokens.
+ * This precompile allows registered minters to mint native Cosmos tokens, up to
+ * the per-denom allowance granted to them by the mint authority.
  */
 interface IMint {
     /**
@@ -14,22 +21,598 @@ interface IMint {
      */
     event Mint(address indexed to, string token, uint256 value);
 
+    /**
+     * @dev Emitted when tokens are burned from an account.
+     * @param from The address the tokens were burned from
+     * @param token The denomination of the token that was burned
+     * @param value The amount of tokens that were burned
+     */
+    event Burn(address indexed from, string token, uint256 value);
+
+    /**
+     * @dev Emitted when the mint authority registers a minter for a denom.
+     * @param minter The address allowed to mint
+     * @param denom The denomination the minter is allowed to mint
+     * @param allowance The amount the minter is allowed to mint
+     */
+    event MinterAdded(address indexed minter, string denom, uint256 allowance);
+
+    /**
+     * @dev Emitted when the mint authority removes a minter for a denom.
+     * @param minter The address that is no longer allowed to mint
+     * @param denom The denomination the minter was allowed to mint
+     */
+    event MinterRemoved(address indexed minter, string denom);
+
+    /**
+     * @dev Emitted when the mint authority sets the metadata of a denom.
+     * @param denom The denomination the metadata belongs to
+     * @param name The name of the token
+     * @param symbol The symbol of the token
+     * @param decimals The number of decimals of the display unit
+     */
+    event DenomMetadataSet(string denom, string name, string symbol, uint8 decimals);
+
+    /**
+     * @dev Emitted when a denom is registered as an ERC20 token pair on its first mint.
+     * @param denom The minted denomination
+     * @param erc20 The address of the ERC20 precompile of the denom
+     */
+    event MintedTokenRegistered(string denom, address indexed erc20);
+
+    /**
+     * @dev Emitted when the guardian pauses minting and burning of all denoms.
+     * @param guardian The address of the guardian
+     */
+    event Paused(address indexed guardian);
+
+    /**
+     * @dev Emitted when the guardian lifts every pause.
+     * @param guardian The address of the guardian
+     */
+    event Unpaused(address indexed guardian);
+
+    /**
+     * @dev Emitted when the guardian pauses minting and burning of a denom.
+     * @param guardian The address of the guardian
+     * @param denom The paused denomination
+     */
+    event DenomPaused(address indexed guardian, string denom);
+
+    /**
+     * @dev Emitted when a minter proposes a mint that requires approvals.
+     * @param id The id of the mint proposal
+     * @param proposer The address of the minter that proposed the mint
+     * @param to The address to receive the minted tokens
+     * @param denom The denomination to mint
+     * @param value The amount of tokens to mint
+     * @param executableHeight The first block height the mint can be executed at
+     */
+    event MintProposed(
+        uint256 indexed id,
+        address indexed proposer,
+        address indexed to,
+        string denom,
+        uint256 value,
+        uint64 executableHeight
+    );
+
+    /**
+     * @dev Emitted when an approver approves a mint proposal.
+     * @param id The id of the mint proposal
+     * @param approver The address of the approver
+     * @param approvals The number of approvals of the proposal
+     */
+    event MintApproved(uint256 indexed id, address indexed approver, uint256 approvals);
+
+    /**
+     * @dev Emitted when a mint proposal is executed.
+     * @param id The id of the mint proposal
+     * @param executor The address that executed the proposal
+     */
+    event MintExecuted(uint256 indexed id, address indexed executor);
+
+    /**
+     * @dev Emitted when a mint proposal is cancelled.
+     * @param id The id of the mint proposal
+     * @param caller The address that cancelled the proposal
+     */
+    event MintCancelled(uint256 indexed id, address indexed caller);
+
+    /**
+     * @dev Emitted when a signed mint voucher is redeemed.
+     * @param minter The address of the minter that signed the voucher
+     * @param to The address that received the minted tokens
+     * @param nonce The nonce of the voucher
+     */
+    event MintVoucherRedeemed(address indexed minter, address indexed to, uint256 indexed nonce);
+
+    /**
+     * @dev Emitted when tokens are minted locked in a vesting schedule.
+     * @param to The address that received the locked tokens
+     * @param denom The token denomination that was minted
+     * @param value The amount of tokens that was minted
+     * @param start The unix time the schedule starts vesting at
+     * @param end The unix time the schedule is fully vested at
+     */
+    event VestingMinted(address indexed to, string denom, uint256 value, uint64 start, uint64 end);
+
+    /**
+     * @dev Emitted when a denom is created with {createDenom}.
+     * @param creator The address that created the denom and became its admin
+     * @param denom The created denomination, as factory/{creator}/{subdenom}
+     */
+    event DenomCreated(address indexed creator, string denom);
+
+    /**
+     * @dev Emitted when the admin of a created denom changes.
+     * @param denom The created denomination
+     * @param previousAdmin The address of the previous admin
+     * @param newAdmin The address of the new admin, zero if the admin was renounced
+     */
+    event DenomAdminChanged(string denom, address indexed previousAdmin, address indexed newAdmin);
+
     /**
      * @dev Mint native tokens to the specified address.
-     * Can only be called by the authorized admin account.
+     * Can only be called by a registered minter for `token`. The minted
+     * amount is deducted from the caller's allowance.
      *
      * @param to The address to receive the minted tokens
      * @param token The token denomination to mint (e.g., "umint", "uatom")
      * @param value The amount of tokens to mint
      *
      * Requirements:
-     * - Caller must be the authorized admin
+     * - Caller must be a registered minter for `token`
+     * - Minting of `token` cannot be paused
+     * - `value` cannot exceed the caller's remaining allowance
+     * - `value` cannot exceed the remaining supply and rate limit of `token`
      * - `to` cannot be the zero address
-     * - `to` cannot be a smart contract or module account
+     * - `to` cannot be a module account, an active precompile or a blocked address
+     * - `to` can only be a smart contract if governance allows it for `token`
      * - `token` must be a valid denomination
      * - `value` must be greater than zero
      *
-     * Emits a {Mint} event.
+     * Emits a {Mint} event. If governance enabled `auto_register_erc20`, the
+     * first mint of `token` registers it in `x/erc20` and emits a
+     * {MintedTokenRegistered} event.
      */
     function mint(address to, string calldata token, uint256 value) external;
-}
\ No newline at end of file
+
+    /**
+     * @dev Mint native tokens to each of the `to` addresses in a single
+     * all-or-nothing transaction. `values[i]` is minted to `to[i]`.
+     * The total is deducted from the caller's allowance for `token`.
+     *
+     * Requirements:
+     * - `to` and `values` must be non-empty and have the same length
+     * - every entry must satisfy the requirements of {mint}
+     *
+     * Emits a {Mint} event per entry.
+     */
+    function mintBatch(address[] calldata to, string calldata token, uint256[] calldata values) external;
+
+    /**
+     * @dev Burn native tokens from the caller's balance.
+     *
+     * @param token The token denomination to burn
+     * @param amount The amount of tokens to burn
+     *
+     * Requirements:
+     * - `token` must be a valid denomination
+     * - `amount` must be greater than zero
+     * - the caller must hold at least `amount` of `token`
+     * - Burning of `token` cannot be paused
+     *
+     * Emits a {Burn} event.
+     */
+    function burn(string calldata token, uint256 amount) external;
+
+    /**
+     * @dev Burn native tokens from the balance of `from`.
+     * Can only be called by the mint authority.
+     *
+     * @param from The address to burn the tokens from
+     * @param token The token denomination to burn
+     * @param amount The amount of tokens to burn
+     *
+     * Requirements:
+     * - Caller must be the mint authority
+     * - `from` must hold at least `amount` of `token`
+     * - Burning of `token` cannot be paused
+     *
+     * Emits a {Burn} event.
+     */
+    function burnFrom(address from, string calldata token, uint256 amount) external;
+
+    /**
+     * @dev Burn native tokens from each of the `from` addresses in a single
+     * all-or-nothing transaction. `values[i]` is burned from `from[i]`.
+     * Can only be called by the mint authority.
+     *
+     * Requirements:
+     * - Caller must be the mint authority
+     * - `from` and `values` must be non-empty and have the same length
+     * - every `from[i]` must hold at least `values[i]` of `token`
+     * - Burning of `token` cannot be paused
+     *
+     * Emits a {Burn} event per entry.
+     */
+    function burnBatch(address[] calldata from, string calldata token, uint256[] calldata values) external;
+
+    /**
+     * @dev Register `minter` for `denom` with the given allowance. Replaces
+     * any previous allowance of the minter for that denom.
+     *
+     * Requirements:
+     * - Caller must be the mint authority
+     * - `minter` cannot be the zero address
+     * - `denom` must be a valid denomination
+     * - `allowance` must be greater than zero
+     *
+     * Emits a {MinterAdded} event.
+     */
+    function addMinter(address minter, string calldata denom, uint256 allowance) external;
+
+    /**
+     * @dev Remove `minter` for `denom`.
+     *
+     * Requirements:
+     * - Caller must be the mint authority
+     * - `minter` must be registered for `denom`
+     *
+     * Emits a {MinterRemoved} event.
+     */
+    function removeMinter(address minter, string calldata denom) external;
+
+    /**
+     * @dev Returns the amount of `denom` that `minter` is still allowed to
+     * mint. Returns zero if the minter is not registered.
+     */
+    function minterAllowance(address minter, string calldata denom) external view returns (uint256);
+
+    /**
+     * @dev Set the bank metadata of `denom`, so that x/erc20 and wallets can
+     * display it. The display unit is the lowercase `symbol`, with `decimals`
+     * as exponent. Replaces any previous name, symbol and decimals.
+     *
+     * Requirements:
+     * - Caller must be the mint authority
+     * - `denom` must be a valid denomination other than the EVM denom
+     * - `name` and `symbol` cannot be blank
+     *
+     * Emits a {DenomMetadataSet} event.
+     */
+    function setDenomMetadata(
+        string calldata denom,
+        string calldata name,
+        string calldata symbol,
+        uint8 decimals
+    ) external;
+
+    /**
+     * @dev Returns the name, symbol and decimals of `denom`. Reverts if the
+     * denom has no metadata.
+     */
+    function denomMetadata(string calldata denom)
+        external
+        view
+        returns (string memory name, string memory symbol, uint8 decimals);
+
+    /**
+     * @dev Returns the amount of `denom` that can still be minted before
+     * reaching its max supply. Returns `type(uint256).max` if governance set
+     * no supply cap for `denom`.
+     */
+    function remainingSupply(string calldata denom) external view returns (uint256);
+
+    /**
+     * @dev Returns the amount of `denom` that can still be minted in the
+     * current rate limit window. Returns `type(uint256).max` if governance set
+     * no rate limit for `denom`.
+     */
+    function remainingRateLimit(string calldata denom) external view returns (uint256);
+
+    /**
+     * @dev Returns the current mint authority, or the zero address if
+     * minting is disabled.
+     */
+    function authority() external view returns (address);
+
+    /**
+     * @dev Returns the total amount of `denom` minted through this
+     * precompile since genesis.
+     */
+    function totalMinted(string calldata denom) external view returns (uint256);
+
+    /**
+     * @dev Returns the total amount of `denom` burned through this
+     * precompile since genesis.
+     */
+    function totalBurned(string calldata denom) external view returns (uint256);
+
+    /**
+     * @dev Returns the total amount of `denom` that `minter` minted through
+     * this precompile since genesis.
+     */
+    function mintedBy(address minter, string calldata denom) external view returns (uint256);
+
+    /**
+     * @dev Pause minting and burning of all denoms.
+     *
+     * Requirements:
+     * - Caller must be the guardian
+     *
+     * Emits a {Paused} event.
+     */
+    function pause() external;
+
+    /**
+     * @dev Lift the global pause and the pauses of every denom. Governance
+     * can do the same with `MsgUnpause`.
+     *
+     * Requirements:
+     * - Caller must be the guardian
+     *
+     * Emits an {Unpaused} event.
+     */
+    function unpause() external;
+
+    /**
+     * @dev Pause minting and burning of a single denom.
+     *
+     * Requirements:
+     * - Caller must be the guardian
+     * - `denom` must be a valid denomination
+     *
+     * Emits a {DenomPaused} event.
+     */
+    function pauseDenom(string calldata denom) external;
+
+    /**
+     * @dev Returns true if minting and burning of `denom` is paused, either
+     * on its own or because all denoms are paused.
+     */
+    function isPaused(string calldata denom) external view returns (bool);
+
+    /**
+     * @dev Propose a mint that requires the approval of the approvers set by
+     * governance. Mints above the large mint threshold of a denom can only
+     * be made this way.
+     *
+     * @param to The address to receive the minted tokens
+     * @param denom The denomination to mint
+     * @param value The amount of tokens to mint
+     * @param delayBlocks The number of blocks before the mint can be executed
+     * @return id The id of the mint proposal
+     *
+     * Requirements:
+     * - Caller must be a registered minter for `denom`
+     * - Governance must have set the approvers
+     * - `delayBlocks` cannot be lower than the minimum delay set by governance
+     * - `to` must be a valid recipient for `denom`
+     * - `denom` must be a valid denomination
+     * - `value` must be greater than zero
+     *
+     * Emits a {MintProposed} event.
+     */
+    function proposeMint(
+        address to,
+        string calldata denom,
+        uint256 value,
+        uint64 delayBlocks
+    ) external returns (uint256 id);
+
+    /**
+     * @dev Approve a mint proposal.
+     *
+     * Requirements:
+     * - Caller must be an approver
+     * - The proposal cannot be expired
+     * - Caller cannot have approved the proposal already
+     *
+     * Emits a {MintApproved} event.
+     */
+    function approveMint(uint256 id) external;
+
+    /**
+     * @dev Mint the tokens of a mint proposal and remove it. The minted
+     * amount is deducted from the proposer's allowance.
+     *
+     * Requirements:
+     * - Caller must be the proposer or an approver
+     * - The delay of the proposal must have passed and it cannot be expired
+     * - The proposal must have at least as many approvals as the threshold
+     * - The mint must satisfy the requirements of {mint} for the proposer
+     *
+     * Emits a {MintExecuted} and a {Mint} event.
+     */
+    function executeMint(uint256 id) external;
+
+    /**
+     * @dev Remove a mint proposal without minting.
+     *
+     * Requirements:
+     * - Caller must be the proposer or the mint authority
+     *
+     * Emits a {MintCancelled} event.
+     */
+    function cancelMint(uint256 id) external;
+
+    /**
+     * @dev Returns a pending mint proposal. `approvals` only counts the
+     * approvals of the current approvers.
+     */
+    function mintProposal(uint256 id)
+        external
+        view
+        returns (
+            address proposer,
+            address to,
+            string memory denom,
+            uint256 value,
+            uint64 executableHeight,
+            uint64 expiryHeight,
+            uint256 approvals
+        );
+
+    /**
+     * @dev Mint native tokens with a voucher signed offline by a minter.
+     * The voucher is the EIP-712 typed data
+     * `MintVoucher(address to,string denom,uint256 value,uint256 nonce,uint256 deadline)`
+     * in the domain returned by {DOMAIN_SEPARATOR}, with the name "Mint",
+     * the version "1", the chain id and the address of this precompile.
+     * Anyone can redeem a voucher, usually its recipient, who pays for the
+     * gas. The minted amount is deducted from the signer's allowance.
+     *
+     * @param to The address to receive the minted tokens
+     * @param denom The denomination to mint
+     * @param value The amount of tokens to mint
+     * @param nonce The nonce of the voucher, unique per minter
+     * @param deadline The last block timestamp the voucher can be redeemed at
+     * @param signature The 65 bytes signature of the minter
+     *
+     * Requirements:
+     * - The signer must be a registered minter for `denom`
+     * - `nonce` cannot have been redeemed by the signer before
+     * - The block timestamp cannot be after `deadline`
+     * - The mint must satisfy the requirements of {mint} for the signer
+     *
+     * Emits a {MintVoucherRedeemed} and a {Mint} event.
+     */
+    function mintWithSignature(
+        address to,
+        string calldata denom,
+        uint256 value,
+        uint256 nonce,
+        uint256 deadline,
+        bytes calldata signature
+    ) external;
+
+    /**
+     * @dev Returns true if the voucher with `nonce` signed by `minter` was
+     * already redeemed.
+     */
+    function isVoucherNonceUsed(address minter, uint256 nonce) external view returns (bool);
+
+    /**
+     * @dev Returns the EIP-712 domain separator mint vouchers are signed with.
+     */
+    function DOMAIN_SEPARATOR() external view returns (bytes32);
+
+    /**
+     * @dev Mint native tokens to `to` locked in a continuous vesting
+     * schedule, which vests linearly from `start` to `end`. `to` becomes an
+     * `x/auth/vesting` continuous vesting account, so the locked tokens are
+     * not spendable in the bank module nor part of the EVM balance of `to`.
+     *
+     * @param to The address to receive the locked tokens
+     * @param denom The token denomination to mint
+     * @param value The amount of tokens to mint
+     * @param start The unix time the schedule starts vesting at
+     * @param end The unix time the schedule is fully vested at
+     *
+     * Requirements:
+     * - The mint must satisfy the requirements of {mint}
+     * - `start` must be before `end`
+     * - `to` must be a plain account, or a continuous vesting account with
+     *   the same `start` and `end`
+     * - `denom` cannot be the 18 decimals extended denom of a chain whose EVM
+     *   denom has fewer decimals
+     *
+     * Emits a {VestingMinted} and a {Mint} event.
+     */
+    function mintVesting(address to, string calldata denom, uint256 value, uint64 start, uint64 end) external;
+
+    /**
+     * @dev Mint native tokens to `to` locked in a periodic vesting schedule
+     * starting at `start`. `amounts[i]` vests `lengths[i]` seconds after the
+     * previous period ends, and the sum of `amounts` is minted. `to` becomes
+     * an `x/auth/vesting` periodic vesting account, or the schedule is merged
+     * into its own if it already is one.
+     *
+     * @param to The address to receive the locked tokens
+     * @param denom The token denomination to mint
+     * @param start The unix time the first period starts at
+     * @param lengths The length in seconds of each period
+     * @param amounts The amount of tokens vesting at the end of each period
+     *
+     * Requirements:
+     * - The mint of the sum of `amounts` must satisfy the requirements of {mint}
+     * - `lengths` and `amounts` must be non-empty and have the same length
+     * - Each period must have a positive length and amount
+     * - `to` must be a plain account or a periodic vesting account
+     *
+     * Emits a {VestingMinted} and a {Mint} event.
+     */
+    function mintPeriodicVesting(
+        address to,
+        string calldata denom,
+        uint64 start,
+        uint64[] calldata lengths,
+        uint256[] calldata amounts
+    ) external;
+
+    /**
+     * @dev Create the denom `factory/{creator}/{subdenom}`, where `creator`
+     * is the bech32 address of the caller, and make the caller its admin.
+     * The admin mints the denom without an allowance, registers its minters
+     * with {addMinter}/{removeMinter} and sets its metadata with
+     * {setDenomMetadata}, in place of the mint authority.
+     *
+     * @param subdenom The name of the denom within the caller's namespace
+     * @return denom The created denomination
+     *
+     * Requirements:
+     * - `subdenom` must be 1 to 44 characters long and form a valid denomination
+     * - The denom cannot have been created already
+     *
+     * Emits a {DenomCreated} event.
+     */
+    function createDenom(string calldata subdenom) external returns (string memory denom);
+
+    /**
+     * @dev Hand the admin of a created denom over to `newAdmin`. Passing the
+     * zero address renounces the admin for good.
+     *
+     * Requirements:
+     * - Caller must be the admin of `denom`
+     *
+     * Emits a {DenomAdminChanged} event.
+     */
+    function changeAdmin(string calldata denom, address newAdmin) external;
+
+    /**
+     * @dev Returns the admin of a created denom, or the zero address if the
+     * denom was not created or its admin was renounced.
+     */
+    function denomAdmin(string calldata denom) external view returns (address);
+
+    /**
+     * @dev Returns the ids of the emission schedules that have not completed.
+     * Schedules are registered by governance with `MsgCreateEmissionSchedule`
+     * and minted by the `x/mint` BeginBlocker.
+     */
+    function emissionSchedules() external view returns (uint256[] memory ids);
+
+    /**
+     * @dev Returns an emission schedule. `curve` is 1 for linear and 2 for
+     * halving. The linear curve spreads `amount` evenly from `startHeight` to
+     * `endHeight`; the halving curve emits `amount` each block and halves it
+     * every `halvingInterval` blocks. `remaining` is what the schedule still
+     * has to emit.
+     */
+    function emissionSchedule(uint256 id)
+        external
+        view
+        returns (
+            string memory denom,
+            address recipient,
+            uint8 curve,
+            uint256 amount,
+            uint64 startHeight,
+            uint64 endHeight,
+            uint64 halvingInterval,
+            uint256 emitted,
+            uint256 remaining
+        );
+}
@@ -3,15 +3,47 @@ package mint
 import "errors"
 
 const (
-	ErrCannotReceiveFunds = "cannot receive funds, received: %s"
-	ErrInvalidRecipient   = "invalid recipient address: %s"
-	ErrInvalidDenom       = "invalid token denomination: %s"
-	ErrMintFailed         = "failed to mint tokens: %s"
-	ErrTransferFailed     = "failed to transfer tokens: %s"
+	ErrCannotReceiveFunds  = "cannot receive funds, received: %s"
+	ErrInvalidDenom        = "invalid token denomination: %s"
+	ErrInvalidMinter       = "invalid minter address: %s"
+	ErrBatchLength         = "mismatched batch lengths: %d addresses and %d values"
+	ErrBatchEntryFailed    = "batch entry %d failed: %s"
+	ErrBatchTotalOverflow  = "batch total %s overflows 256 bits"
+	ErrRegisterERC20Failed = "failed to register %s as an ERC20 token pair: %s"
+
+	ErrInvalidDenomMetadata   = "invalid denom metadata: %s"
+	ErrDenomMetadataNotFound  = "denom metadata not found: %s"
+	ErrProtectedDenomMetadata = "cannot set the metadata of the EVM denom %s"
+
+	ErrInvalidProposalID    = "invalid mint proposal id: %s"
+	ErrMintProposalNotFound = "mint proposal %d not found"
+
+	ErrInvalidSignature = "invalid mint voucher signature: %s"
+	ErrVoucherExpired   = "mint voucher expired at %s, block time is %d"
+
+	ErrInvalidVestingTime   = "invalid vesting time %d: must fit in a signed 64 bit integer"
+	ErrVestingPeriodLength  = "mismatched vesting periods: %d lengths and %d amounts"
+	ErrVestingExtendedDenom = "cannot mint the extended denom %s locked, mint the EVM denom instead"
+
+	ErrCreateDenomFailed = "failed to create denom: %s"
+
+	ErrInvalidEmissionScheduleID = "invalid emission schedule id: %s"
+	ErrEmissionScheduleNotFound  = "emission schedule %d not found"
 )
 
 var (
-	ErrUnauthorized   = errors.New("caller is not authorized to mint tokens")
-	ErrZeroAmount     = errors.New("cannot mint zero amount of tokens")
-	ErrNegativeAmount = errors.New("cannot mint negative amount of tokens")
+	ErrUnauthorized        = errors.New("caller is not authorized to mint tokens")
+	ErrZeroAmount          = errors.New("cannot mint zero amount of tokens")
+	ErrNegativeAmount      = errors.New("cannot mint negative amount of tokens")
+	ErrZeroBurnAmount      = errors.New("cannot burn zero amount of tokens")
+	ErrNegativeBurnAmount  = errors.New("cannot burn negative amount of tokens")
+	ErrNotMintAuthority    = errors.New("caller is not the mint authority")
+	ErrNotGuardian         = errors.New("caller is not the guardian")
+	ErrZeroAllowance       = errors.New("minter allowance must be greater than zero")
+	ErrEmptyBatch          = errors.New("batch cannot be empty")
+	ErrNotProposalParty    = errors.New("caller is not the proposer or an approver of the mint proposal")
+	ErrCannotCancel        = errors.New("caller is not the proposer of the mint proposal or the mint authority")
+	ErrEmptyVestingPeriods = errors.New("vesting periods cannot be empty")
+	ErrZeroVestingPeriod   = errors.New("vesting periods must have a positive length and amount")
+	ErrNotDenomAdmin       = errors.New("caller is not the admin of the denom")
 )
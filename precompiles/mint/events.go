@@ -16,6 +16,38 @@ import (
 const (
 	// EventTypeMint defines the event type for the Mint transaction
 	EventTypeMint = "Mint"
+	// EventTypeBurn defines the event type for the burn and burnFrom transactions
+	EventTypeBurn = "Burn"
+	// EventTypeMinterAdded defines the event type for the addMinter transaction
+	EventTypeMinterAdded = "MinterAdded"
+	// EventTypeMinterRemoved defines the event type for the removeMinter transaction
+	EventTypeMinterRemoved = "MinterRemoved"
+	// EventTypeDenomMetadataSet defines the event type for the setDenomMetadata transaction
+	EventTypeDenomMetadataSet = "DenomMetadataSet"
+	// EventTypeMintedTokenRegistered defines the event type for the ERC20 registration of a newly minted denom
+	EventTypeMintedTokenRegistered = "MintedTokenRegistered"
+	// EventTypePaused defines the event type for the pause transaction
+	EventTypePaused = "Paused"
+	// EventTypeUnpaused defines the event type for the unpause transaction
+	EventTypeUnpaused = "Unpaused"
+	// EventTypeDenomPaused defines the event type for the pauseDenom transaction
+	EventTypeDenomPaused = "DenomPaused"
+	// EventTypeMintProposed defines the event type for the proposeMint transaction
+	EventTypeMintProposed = "MintProposed"
+	// EventTypeMintApproved defines the event type for the approveMint transaction
+	EventTypeMintApproved = "MintApproved"
+	// EventTypeMintExecuted defines the event type for the executeMint transaction
+	EventTypeMintExecuted = "MintExecuted"
+	// EventTypeMintCancelled defines the event type for the cancelMint transaction
+	EventTypeMintCancelled = "MintCancelled"
+	// EventTypeMintVoucherRedeemed defines the event type for the mintWithSignature transaction
+	EventTypeMintVoucherRedeemed = "MintVoucherRedeemed"
+	// EventTypeVestingMinted defines the event type for the mintVesting and mintPeriodicVesting transactions
+	EventTypeVestingMinted = "VestingMinted"
+	// EventTypeDenomCreated defines the event type for the createDenom transaction
+	EventTypeDenomCreated = "DenomCreated"
+	// EventTypeDenomAdminChanged defines the event type for the changeAdmin transaction
+	EventTypeDenomAdminChanged = "DenomAdminChanged"
 )
 
 // EmitMintEvent creates a new Mint event emitted on mint transactions
@@ -49,3 +81,439 @@ func (p *Precompile) EmitMintEvent(ctx sdk.Context, stateDB vm.StateDB, to commo
 
 	return nil
 }
+
+// EmitBurnEvent creates a new Burn event emitted on burn and burnFrom transactions
+func (p *Precompile) EmitBurnEvent(ctx sdk.Context, stateDB vm.StateDB, from common.Address, token string, value *big.Int) error {
+	event := p.Events[EventTypeBurn]
+	topics := make([]common.Hash, 2)
+
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(from)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2]}
+	packed, err := arguments.Pack(token, value)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
+
+// EmitMinterAddedEvent creates a new MinterAdded event emitted on addMinter transactions
+func (p *Precompile) EmitMinterAddedEvent(ctx sdk.Context, stateDB vm.StateDB, minter common.Address, denom string, allowance *big.Int) error {
+	event := p.Events[EventTypeMinterAdded]
+	topics := make([]common.Hash, 2)
+
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(minter)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2]}
+	packed, err := arguments.Pack(denom, allowance)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
+
+// EmitMinterRemovedEvent creates a new MinterRemoved event emitted on removeMinter transactions
+func (p *Precompile) EmitMinterRemovedEvent(ctx sdk.Context, stateDB vm.StateDB, minter common.Address, denom string) error {
+	event := p.Events[EventTypeMinterRemoved]
+	topics := make([]common.Hash, 2)
+
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(minter)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[1]}
+	packed, err := arguments.Pack(denom)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
+
+// EmitDenomMetadataSetEvent creates a new DenomMetadataSet event emitted on setDenomMetadata transactions
+func (p *Precompile) EmitDenomMetadataSetEvent(ctx sdk.Context, stateDB vm.StateDB, denom, name, symbol string, decimals uint8) error {
+	event := p.Events[EventTypeDenomMetadataSet]
+	topics := []common.Hash{event.ID}
+
+	packed, err := event.Inputs.Pack(denom, name, symbol, decimals)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
+
+// EmitMintedTokenRegisteredEvent creates a new MintedTokenRegistered event emitted when a minted denom is registered as an ERC20 token pair
+func (p *Precompile) EmitMintedTokenRegisteredEvent(ctx sdk.Context, stateDB vm.StateDB, denom string, erc20 common.Address) error {
+	event := p.Events[EventTypeMintedTokenRegistered]
+	topics := make([]common.Hash, 2)
+
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(erc20)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[0]}
+	packed, err := arguments.Pack(denom)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
+
+// EmitPausedEvent creates a new Paused event emitted on pause transactions
+func (p *Precompile) EmitPausedEvent(ctx sdk.Context, stateDB vm.StateDB, guardian common.Address) error {
+	return p.emitGuardianEvent(ctx, stateDB, EventTypePaused, guardian)
+}
+
+// EmitUnpausedEvent creates a new Unpaused event emitted on unpause transactions
+func (p *Precompile) EmitUnpausedEvent(ctx sdk.Context, stateDB vm.StateDB, guardian common.Address) error {
+	return p.emitGuardianEvent(ctx, stateDB, EventTypeUnpaused, guardian)
+}
+
+// emitGuardianEvent emits an event whose only argument is the indexed guardian
+// address.
+func (p *Precompile) emitGuardianEvent(ctx sdk.Context, stateDB vm.StateDB, eventType string, guardian common.Address) error {
+	event := p.Events[eventType]
+	topics := make([]common.Hash, 2)
+
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(guardian)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        nil,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
+
+// EmitDenomPausedEvent creates a new DenomPaused event emitted on pauseDenom transactions
+func (p *Precompile) EmitDenomPausedEvent(ctx sdk.Context, stateDB vm.StateDB, guardian common.Address, denom string) error {
+	event := p.Events[EventTypeDenomPaused]
+	topics := make([]common.Hash, 2)
+
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(guardian)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[1]}
+	packed, err := arguments.Pack(denom)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
+
+// EmitMintProposedEvent creates a new MintProposed event emitted on proposeMint transactions
+func (p *Precompile) EmitMintProposedEvent(ctx sdk.Context, stateDB vm.StateDB, id *big.Int, proposer, to common.Address, denom string, value *big.Int, executableHeight uint64) error {
+	event := p.Events[EventTypeMintProposed]
+	topics := make([]common.Hash, 4)
+
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(id)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(proposer)
+	if err != nil {
+		return err
+	}
+
+	topics[3], err = cmn.MakeTopic(to)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[3], event.Inputs[4], event.Inputs[5]}
+	packed, err := arguments.Pack(denom, value, executableHeight)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
+
+// EmitMintApprovedEvent creates a new MintApproved event emitted on approveMint transactions
+func (p *Precompile) EmitMintApprovedEvent(ctx sdk.Context, stateDB vm.StateDB, id *big.Int, approver common.Address, approvals *big.Int) error {
+	event := p.Events[EventTypeMintApproved]
+	topics := make([]common.Hash, 3)
+
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(id)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(approver)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(approvals)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
+
+// EmitMintExecutedEvent creates a new MintExecuted event emitted on executeMint transactions
+func (p *Precompile) EmitMintExecutedEvent(ctx sdk.Context, stateDB vm.StateDB, id *big.Int, executor common.Address) error {
+	return p.emitProposalEvent(ctx, stateDB, EventTypeMintExecuted, id, executor)
+}
+
+// EmitMintCancelledEvent creates a new MintCancelled event emitted on cancelMint transactions
+func (p *Precompile) EmitMintCancelledEvent(ctx sdk.Context, stateDB vm.StateDB, id *big.Int, caller common.Address) error {
+	return p.emitProposalEvent(ctx, stateDB, EventTypeMintCancelled, id, caller)
+}
+
+// emitProposalEvent emits an event whose only arguments are the indexed
+// proposal id and caller address.
+func (p *Precompile) emitProposalEvent(ctx sdk.Context, stateDB vm.StateDB, eventType string, id *big.Int, caller common.Address) error {
+	event := p.Events[eventType]
+	topics := make([]common.Hash, 3)
+
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(id)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(caller)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        nil,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
+
+// EmitMintVoucherRedeemedEvent creates a new MintVoucherRedeemed event emitted on mintWithSignature transactions
+func (p *Precompile) EmitMintVoucherRedeemedEvent(ctx sdk.Context, stateDB vm.StateDB, minter, to common.Address, nonce *big.Int) error {
+	event := p.Events[EventTypeMintVoucherRedeemed]
+	topics := make([]common.Hash, 4)
+
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(minter)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(to)
+	if err != nil {
+		return err
+	}
+
+	topics[3], err = cmn.MakeTopic(nonce)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        nil,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
+
+// EmitVestingMintedEvent creates a new VestingMinted event emitted on mintVesting and mintPeriodicVesting transactions
+func (p *Precompile) EmitVestingMintedEvent(ctx sdk.Context, stateDB vm.StateDB, to common.Address, denom string, value *big.Int, start, end uint64) error {
+	event := p.Events[EventTypeVestingMinted]
+	topics := make([]common.Hash, 2)
+
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(to)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2], event.Inputs[3], event.Inputs[4]}
+	packed, err := arguments.Pack(denom, value, start, end)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
+
+// EmitDenomCreatedEvent creates a new DenomCreated event emitted on createDenom transactions
+func (p *Precompile) EmitDenomCreatedEvent(ctx sdk.Context, stateDB vm.StateDB, creator common.Address, denom string) error {
+	event := p.Events[EventTypeDenomCreated]
+	topics := make([]common.Hash, 2)
+
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(creator)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[1]}
+	packed, err := arguments.Pack(denom)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
+
+// EmitDenomAdminChangedEvent creates a new DenomAdminChanged event emitted on changeAdmin transactions
+func (p *Precompile) EmitDenomAdminChangedEvent(ctx sdk.Context, stateDB vm.StateDB, denom string, previousAdmin, newAdmin common.Address) error {
+	event := p.Events[EventTypeDenomAdminChanged]
+	topics := make([]common.Hash, 3)
+
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(previousAdmin)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(newAdmin)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[0]}
+	packed, err := arguments.Pack(denom)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
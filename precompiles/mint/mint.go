@@ -7,6 +7,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	cmn "github.com/cosmos/evm/precompiles/common"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/tracing"
@@ -16,13 +17,60 @@ import (
 // Precompile defines the precompiled contract for Mint.
 type Precompile struct {
 	cmn.Precompile
-	authority  string
-	bankKeeper cmn.BankKeeper
+	mintKeeper  cmn.MintKeeper
+	bankKeeper  cmn.BankKeeper
+	erc20Keeper cmn.ERC20Keeper
 }
 
 const (
-	abiPath = "abi.json"
-	GasMint = 25_000
+	abiPath               = "abi.json"
+	GasMint               = 25_000
+	GasBurn               = 25_000
+	GasBurnFrom           = 30_000
+	GasAddMinter          = 20_000
+	GasRemoveMinter       = 10_000
+	GasMinterAllowance    = 3_000
+	GasRemainingSupply    = 3_000
+	GasRemainingRateLimit = 5_000
+	GasSetDenomMetadata   = 20_000
+	GasDenomMetadata      = 3_000
+	GasAuthority          = 2_000
+	GasTotalMinted        = 3_000
+	GasTotalBurned        = 3_000
+	GasMintedBy           = 3_000
+	GasPause              = 10_000
+	GasUnpause            = 10_000
+	GasPauseDenom         = 10_000
+	GasIsPaused           = 3_000
+	GasProposeMint        = 30_000
+	GasApproveMint        = 15_000
+	GasExecuteMint        = 35_000
+	GasCancelMint         = 10_000
+	GasMintProposal       = 3_000
+	GasMintWithSignature  = 40_000
+	GasIsVoucherNonceUsed = 3_000
+	GasDomainSeparator    = 1_000
+	GasMintVesting        = 40_000
+	// GasMintPeriodicVestingPerWord is charged for each 32-byte word of the
+	// arguments of a mintPeriodicVesting call, on top of GasMintVesting. A
+	// vesting period is encoded in two words.
+	GasMintPeriodicVestingPerWord = 1_000
+	// GasCreateDenom is higher than the other methods, to deter spamming the
+	// chain with denoms.
+	GasCreateDenom = 100_000
+	GasChangeAdmin = 15_000
+	GasDenomAdmin  = 3_000
+	// GasEmissionSchedules is charged per emissionSchedules call. The number of
+	// schedules is bounded by governance, which registers them.
+	GasEmissionSchedules = 5_000
+	GasEmissionSchedule  = 3_000
+
+	// GasBatchBase is charged once per mintBatch and burnBatch call, on top of
+	// the per-word cost.
+	GasBatchBase = 10_000
+	// GasBatchPerWord is charged for each 32-byte word of the arguments of a
+	// mintBatch or burnBatch call. A batch entry is encoded in two words.
+	GasBatchPerWord = 10_000
 )
 
 // Embed abi json file to the executable binary.
@@ -33,7 +81,14 @@ var f embed.FS
 var _ vm.PrecompiledContract = &Precompile{}
 
 // NewPrecompile creates a new Mint Precompile instance as a PrecompiledContract interface.
-func NewPrecompile(authority string, bankKeeper cmn.BankKeeper) (*Precompile, error) {
+// The bank keeper should be the x/precisebank keeper, so that mints and burns of
+// the EVM extended denom keep track of fractional balances. The mint authority
+// is resolved by the x/mint keeper.
+func NewPrecompile(
+	mintKeeper cmn.MintKeeper,
+	bankKeeper cmn.BankKeeper,
+	erc20Keeper cmn.ERC20Keeper,
+) (*Precompile, error) {
 	newABI, err := cmn.LoadABI(f, abiPath)
 	if err != nil {
 		return nil, err
@@ -45,13 +100,17 @@ func NewPrecompile(authority string, bankKeeper cmn.BankKeeper) (*Precompile, er
 			KvGasConfig:          storetypes.GasConfig{},
 			TransientKVGasConfig: storetypes.GasConfig{},
 		},
-		authority:  authority,
-		bankKeeper: bankKeeper,
+		mintKeeper:  mintKeeper,
+		bankKeeper:  bankKeeper,
+		erc20Keeper: erc20Keeper,
 	}
 
-	// Set the address of the Mint precompile contract. would use 0x1111 for easy testing
-	// TODO:
-	p.SetAddress(common.HexToAddress("0x0000000000000000000000000000000000001111"))
+	// SetAddress defines the address of the Mint precompile contract.
+	p.SetAddress(common.HexToAddress(evmtypes.MintPrecompileAddress))
+
+	// Set the balance handler for the precompile, so that mints and burns of
+	// the EVM denom are reflected in the StateDB.
+	p.SetBalanceHandler(bankKeeper)
 
 	return p, nil
 }
@@ -71,11 +130,85 @@ func (p *Precompile) RequiredGas(input []byte) uint64 {
 	switch method.Name {
 	case MintMethod:
 		return GasMint
+	case BurnMethod:
+		return GasBurn
+	case BurnFromMethod:
+		return GasBurnFrom
+	case MintBatchMethod, BurnBatchMethod:
+		return GasBatchBase + GasBatchPerWord*argumentWords(input[4:])
+	case AddMinterMethod:
+		return GasAddMinter
+	case RemoveMinterMethod:
+		return GasRemoveMinter
+	case MinterAllowanceMethod:
+		return GasMinterAllowance
+	case RemainingSupplyMethod:
+		return GasRemainingSupply
+	case RemainingRateLimitMethod:
+		return GasRemainingRateLimit
+	case SetDenomMetadataMethod:
+		return GasSetDenomMetadata
+	case DenomMetadataMethod:
+		return GasDenomMetadata
+	case AuthorityMethod:
+		return GasAuthority
+	case TotalMintedMethod:
+		return GasTotalMinted
+	case TotalBurnedMethod:
+		return GasTotalBurned
+	case MintedByMethod:
+		return GasMintedBy
+	case PauseMethod:
+		return GasPause
+	case UnpauseMethod:
+		return GasUnpause
+	case PauseDenomMethod:
+		return GasPauseDenom
+	case IsPausedMethod:
+		return GasIsPaused
+	case ProposeMintMethod:
+		return GasProposeMint
+	case ApproveMintMethod:
+		return GasApproveMint
+	case ExecuteMintMethod:
+		return GasExecuteMint
+	case CancelMintMethod:
+		return GasCancelMint
+	case MintProposalMethod:
+		return GasMintProposal
+	case MintWithSignatureMethod:
+		return GasMintWithSignature
+	case IsVoucherNonceUsedMethod:
+		return GasIsVoucherNonceUsed
+	case DomainSeparatorMethod:
+		return GasDomainSeparator
+	case MintVestingMethod:
+		return GasMintVesting
+	case MintPeriodicVestingMethod:
+		return GasMintVesting + GasMintPeriodicVestingPerWord*argumentWords(input[4:])
+	case CreateDenomMethod:
+		return GasCreateDenom
+	case ChangeAdminMethod:
+		return GasChangeAdmin
+	case DenomAdminMethod:
+		return GasDenomAdmin
+	case EmissionSchedulesMethod:
+		return GasEmissionSchedules
+	case EmissionScheduleMethod:
+		return GasEmissionSchedule
 	default:
 		return 0
 	}
 }
 
+// argumentWords returns the number of 32-byte words of the ABI encoded
+// arguments of a call, rounded up. The batch and vesting period arrays are
+// charged by their size rather than by their number of entries, so that the
+// caller-controlled arrays are not decoded before any gas is charged.
+func argumentWords(input []byte) uint64 {
+	return (uint64(len(input)) + 31) / 32
+}
+
 // Run executes the precompiled contract Mint method defined in the ABI.
 func (p *Precompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) (bz []byte, err error) {
 	bz, err = p.run(evm, contract, readonly)
@@ -97,6 +230,9 @@ func (p *Precompile) run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]b
 		return nil, err
 	}
 
+	// Start the balance change handler before executing the precompile.
+	p.GetBalanceHandler().BeforeBalanceChange(ctx)
+
 	// If there's any error so far during execution, don't panic, just return OOG error so EVM can continue running.
 	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
 
@@ -111,13 +247,38 @@ func (p *Precompile) run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]b
 		return nil, vm.ErrOutOfGas
 	}
 
+	// Process the native balance changes after the method execution, so that
+	// the cached balances of the StateDB match the minted and burned coins.
+	if err = p.GetBalanceHandler().AfterBalanceChange(ctx, stateDB); err != nil {
+		return nil, err
+	}
+
 	return bz, nil
 }
 
 // IsTransaction checks if the given method name corresponds to a transaction or query.
 func (p *Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
-	case MintMethod:
+	case MintMethod,
+		BurnMethod,
+		BurnFromMethod,
+		MintBatchMethod,
+		BurnBatchMethod,
+		AddMinterMethod,
+		RemoveMinterMethod,
+		SetDenomMetadataMethod,
+		PauseMethod,
+		UnpauseMethod,
+		PauseDenomMethod,
+		ProposeMintMethod,
+		ApproveMintMethod,
+		ExecuteMintMethod,
+		CancelMintMethod,
+		MintWithSignatureMethod,
+		MintVestingMethod,
+		MintPeriodicVestingMethod,
+		CreateDenomMethod,
+		ChangeAdminMethod:
 		return true
 	default:
 		return false
@@ -133,16 +294,81 @@ func (p *Precompile) HandleMethod(
 	args []interface{},
 ) (bz []byte, err error) {
 	switch method.Name {
+	// Mint transactions
 	case MintMethod:
 		bz, err = p.Mint(ctx, contract, stateDB, method, args)
+	case BurnMethod:
+		bz, err = p.Burn(ctx, contract, stateDB, method, args)
+	case BurnFromMethod:
+		bz, err = p.BurnFrom(ctx, contract, stateDB, method, args)
+	case MintBatchMethod:
+		bz, err = p.MintBatch(ctx, contract, stateDB, method, args)
+	case BurnBatchMethod:
+		bz, err = p.BurnBatch(ctx, contract, stateDB, method, args)
+	case AddMinterMethod:
+		bz, err = p.AddMinter(ctx, contract, stateDB, method, args)
+	case RemoveMinterMethod:
+		bz, err = p.RemoveMinter(ctx, contract, stateDB, method, args)
+	case SetDenomMetadataMethod:
+		bz, err = p.SetDenomMetadata(ctx, contract, stateDB, method, args)
+	case PauseMethod:
+		bz, err = p.Pause(ctx, contract, stateDB, method, args)
+	case UnpauseMethod:
+		bz, err = p.Unpause(ctx, contract, stateDB, method, args)
+	case PauseDenomMethod:
+		bz, err = p.PauseDenom(ctx, contract, stateDB, method, args)
+	case ProposeMintMethod:
+		bz, err = p.ProposeMint(ctx, contract, stateDB, method, args)
+	case ApproveMintMethod:
+		bz, err = p.ApproveMint(ctx, contract, stateDB, method, args)
+	case ExecuteMintMethod:
+		bz, err = p.ExecuteMint(ctx, contract, stateDB, method, args)
+	case CancelMintMethod:
+		bz, err = p.CancelMint(ctx, contract, stateDB, method, args)
+	case MintWithSignatureMethod:
+		bz, err = p.MintWithSignature(ctx, stateDB, method, args)
+	case MintVestingMethod:
+		bz, err = p.MintVesting(ctx, contract, stateDB, method, args)
+	case MintPeriodicVestingMethod:
+		bz, err = p.MintPeriodicVesting(ctx, contract, stateDB, method, args)
+	case CreateDenomMethod:
+		bz, err = p.CreateDenom(ctx, contract, stateDB, method, args)
+	case ChangeAdminMethod:
+		bz, err = p.ChangeAdmin(ctx, contract, stateDB, method, args)
+	// Mint queries
+	case MinterAllowanceMethod:
+		bz, err = p.MinterAllowance(ctx, method, args)
+	case RemainingSupplyMethod:
+		bz, err = p.RemainingSupply(ctx, method, args)
+	case RemainingRateLimitMethod:
+		bz, err = p.RemainingRateLimit(ctx, method, args)
+	case DenomMetadataMethod:
+		bz, err = p.DenomMetadata(ctx, method, args)
+	case AuthorityMethod:
+		bz, err = p.Authority(ctx, method, args)
+	case TotalMintedMethod:
+		bz, err = p.TotalMinted(ctx, method, args)
+	case TotalBurnedMethod:
+		bz, err = p.TotalBurned(ctx, method, args)
+	case MintedByMethod:
+		bz, err = p.MintedBy(ctx, method, args)
+	case IsPausedMethod:
+		bz, err = p.IsPaused(ctx, method, args)
+	case MintProposalMethod:
+		bz, err = p.MintProposal(ctx, method, args)
+	case IsVoucherNonceUsedMethod:
+		bz, err = p.IsVoucherNonceUsed(ctx, method, args)
+	case DomainSeparatorMethod:
+		bz, err = p.DomainSeparator(method, args)
+	case DenomAdminMethod:
+		bz, err = p.DenomAdmin(ctx, method, args)
+	case EmissionSchedulesMethod:
+		bz, err = p.EmissionSchedules(ctx, method, args)
+	case EmissionScheduleMethod:
+		bz, err = p.EmissionSchedule(ctx, method, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
 
 	return bz, err
 }
-
-// GetAuthority returns the authority address for testing purposes
-func (p *Precompile) GetAuthority() string {
-	return p.authority
-}
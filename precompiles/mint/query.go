@@ -0,0 +1,288 @@
+package mint
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MinterAllowanceMethod defines the ABI method name for the minterAllowance query
+	MinterAllowanceMethod = "minterAllowance"
+	// RemainingSupplyMethod defines the ABI method name for the remainingSupply query
+	RemainingSupplyMethod = "remainingSupply"
+	// RemainingRateLimitMethod defines the ABI method name for the remainingRateLimit query
+	RemainingRateLimitMethod = "remainingRateLimit"
+	// DenomMetadataMethod defines the ABI method name for the denomMetadata query
+	DenomMetadataMethod = "denomMetadata"
+	// AuthorityMethod defines the ABI method name for the authority query
+	AuthorityMethod = "authority"
+	// TotalMintedMethod defines the ABI method name for the totalMinted query
+	TotalMintedMethod = "totalMinted"
+	// TotalBurnedMethod defines the ABI method name for the totalBurned query
+	TotalBurnedMethod = "totalBurned"
+	// MintedByMethod defines the ABI method name for the mintedBy query
+	MintedByMethod = "mintedBy"
+	// IsPausedMethod defines the ABI method name for the isPaused query
+	IsPausedMethod = "isPaused"
+	// MintProposalMethod defines the ABI method name for the mintProposal query
+	MintProposalMethod = "mintProposal"
+	// IsVoucherNonceUsedMethod defines the ABI method name for the isVoucherNonceUsed query
+	IsVoucherNonceUsedMethod = "isVoucherNonceUsed"
+	// DomainSeparatorMethod defines the ABI method name for the DOMAIN_SEPARATOR query
+	DomainSeparatorMethod = "DOMAIN_SEPARATOR"
+	// DenomAdminMethod defines the ABI method name for the denomAdmin query
+	DenomAdminMethod = "denomAdmin"
+	// EmissionSchedulesMethod defines the ABI method name for the emissionSchedules query
+	EmissionSchedulesMethod = "emissionSchedules"
+	// EmissionScheduleMethod defines the ABI method name for the emissionSchedule query
+	EmissionScheduleMethod = "emissionSchedule"
+)
+
+// MinterAllowance returns the amount of a denom a minter is still allowed to
+// mint. It returns zero if the minter is not registered for the denom.
+func (p *Precompile) MinterAllowance(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	minter, denom, err := ParseMinterArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	allowance, _ := p.mintKeeper.GetMinterAllowance(ctx, minter.Bytes(), denom)
+
+	return method.Outputs.Pack(allowance.BigInt())
+}
+
+// RemainingSupply returns the amount of a denom that can still be minted before
+// reaching the max supply set by governance. It returns the max uint256 value
+// if the denom has no supply cap.
+func (p *Precompile) RemainingSupply(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	denom, err := ParseDenomArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining, capped := p.mintKeeper.RemainingSupply(ctx, denom)
+	if !capped {
+		return method.Outputs.Pack(math.MaxBig256)
+	}
+
+	return method.Outputs.Pack(remaining.BigInt())
+}
+
+// RemainingRateLimit returns the amount of a denom that can still be minted in
+// the current rate limit window. It returns the max uint256 value if the denom
+// has no rate limit.
+func (p *Precompile) RemainingRateLimit(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	denom, err := ParseDenomArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining, limited := p.mintKeeper.RemainingRateLimit(ctx, denom)
+	if !limited {
+		return method.Outputs.Pack(math.MaxBig256)
+	}
+
+	return method.Outputs.Pack(remaining.BigInt())
+}
+
+// DenomMetadata returns the name, symbol and decimals of a denom, as set in
+// its bank metadata. It fails if the denom has no metadata.
+func (p *Precompile) DenomMetadata(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	denom, err := ParseDenomArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, found := p.bankKeeper.GetDenomMetaData(ctx, denom)
+	if !found {
+		return nil, fmt.Errorf(ErrDenomMetadataNotFound, denom)
+	}
+
+	decimals, err := MetadataDecimals(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(metadata.Name, metadata.Symbol, decimals)
+}
+
+// Authority returns the current mint authority. It returns the zero address if
+// no authority is set.
+func (p *Precompile) Authority(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	authority := p.mintKeeper.GetMintAuthority(ctx)
+
+	return method.Outputs.Pack(common.BytesToAddress(authority))
+}
+
+// TotalMinted returns the total amount of a denom minted through the
+// precompile.
+func (p *Precompile) TotalMinted(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	denom, err := ParseDenomArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := p.mintKeeper.GetDenomMintStats(ctx, denom)
+
+	return method.Outputs.Pack(stats.TotalMinted.BigInt())
+}
+
+// TotalBurned returns the total amount of a denom burned through the
+// precompile.
+func (p *Precompile) TotalBurned(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	denom, err := ParseDenomArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := p.mintKeeper.GetDenomMintStats(ctx, denom)
+
+	return method.Outputs.Pack(stats.TotalBurned.BigInt())
+}
+
+// MintedBy returns the total amount of a denom a minter minted through the
+// precompile.
+func (p *Precompile) MintedBy(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	minter, denom, err := ParseMinterArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := p.mintKeeper.GetMinterMintStats(ctx, minter.Bytes(), denom)
+
+	return method.Outputs.Pack(stats.Minted.BigInt())
+}
+
+// IsPaused returns true if minting and burning of a denom is paused, either on
+// its own or because all denoms are paused.
+func (p *Precompile) IsPaused(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	denom, err := ParseDenomArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(p.mintKeeper.IsDenomPaused(ctx, denom))
+}
+
+// MintProposal returns a pending mint proposal, with the number of its
+// approvals that count towards the current threshold. It fails if the proposal
+// does not exist.
+func (p *Precompile) MintProposal(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	id, err := ParseProposalIDArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, found := p.mintKeeper.GetMintProposal(ctx, id)
+	if !found {
+		return nil, fmt.Errorf(ErrMintProposalNotFound, id)
+	}
+
+	approvals := proposal.CountApprovals(p.mintKeeper.GetMintApprovalConfig(ctx))
+
+	return method.Outputs.Pack(
+		common.BytesToAddress(sdk.MustAccAddressFromBech32(proposal.Proposer)),
+		common.BytesToAddress(sdk.MustAccAddressFromBech32(proposal.Recipient)),
+		proposal.Denom,
+		proposal.Amount.BigInt(),
+		proposal.ExecutableHeight,
+		proposal.ExpiryHeight,
+		new(big.Int).SetUint64(uint64(approvals)),
+	)
+}
+
+// IsVoucherNonceUsed returns true if the mint voucher with the given nonce
+// signed by the given minter was already redeemed.
+func (p *Precompile) IsVoucherNonceUsed(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	minter, nonce, err := ParseIsVoucherNonceUsedArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(p.mintKeeper.IsVoucherNonceUsed(ctx, minter.Bytes(), sdkmath.NewIntFromBigInt(nonce)))
+}
+
+// DomainSeparator returns the EIP-712 domain separator mint vouchers are
+// signed with.
+func (p *Precompile) DomainSeparator(method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf("invalid number of arguments; expected 0; got: %d", len(args))
+	}
+
+	separator, err := VoucherDomainSeparator(p.Address())
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(separator)
+}
+
+// DenomAdmin returns the admin of a denom created with createDenom. It returns
+// the zero address if the denom was not created or its admin was renounced.
+func (p *Precompile) DenomAdmin(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	denom, err := ParseDenomArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	admin, _ := p.mintKeeper.GetDenomAdmin(ctx, denom)
+
+	return method.Outputs.Pack(common.BytesToAddress(admin))
+}
+
+// EmissionSchedules returns the identifiers of the emission schedules that have
+// not completed.
+func (p *Precompile) EmissionSchedules(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf("invalid number of arguments; expected 0; got: %d", len(args))
+	}
+
+	schedules := p.mintKeeper.GetEmissionSchedules(ctx)
+	ids := make([]*big.Int, len(schedules))
+	for i, schedule := range schedules {
+		ids[i] = new(big.Int).SetUint64(schedule.ID)
+	}
+
+	return method.Outputs.Pack(ids)
+}
+
+// EmissionSchedule returns an emission schedule, with the amount it emitted so
+// far and the amount it still has to emit. It fails if the schedule does not
+// exist.
+func (p *Precompile) EmissionSchedule(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	id, err := ParseEmissionScheduleIDArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, found := p.mintKeeper.GetEmissionSchedule(ctx, id)
+	if !found {
+		return nil, fmt.Errorf(ErrEmissionScheduleNotFound, id)
+	}
+
+	return method.Outputs.Pack(
+		schedule.Denom,
+		common.BytesToAddress(sdk.MustAccAddressFromBech32(schedule.Recipient)),
+		uint8(schedule.Curve), //nolint:gosec // G115 // the curve is a small enum
+		schedule.Amount.BigInt(),
+		schedule.StartHeight,
+		schedule.EndHeight,
+		schedule.HalvingInterval,
+		schedule.Emitted.BigInt(),
+		schedule.Remaining().BigInt(),
+	)
+}
@@ -1,21 +1,72 @@
 package mint
 
 import (
+	"errors"
 	"fmt"
+	gomath "math"
+	"math/big"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	minttypes "github.com/cosmos/evm/x/mint/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/holiman/uint256"
 )
 
 const (
 	// MintMethod defines the ABI method name for the mint transaction
 	MintMethod = "mint"
+	// BurnMethod defines the ABI method name for the burn transaction
+	BurnMethod = "burn"
+	// BurnFromMethod defines the ABI method name for the burnFrom transaction
+	BurnFromMethod = "burnFrom"
+	// MintBatchMethod defines the ABI method name for the mintBatch transaction
+	MintBatchMethod = "mintBatch"
+	// BurnBatchMethod defines the ABI method name for the burnBatch transaction
+	BurnBatchMethod = "burnBatch"
+	// AddMinterMethod defines the ABI method name for the addMinter transaction
+	AddMinterMethod = "addMinter"
+	// RemoveMinterMethod defines the ABI method name for the removeMinter transaction
+	RemoveMinterMethod = "removeMinter"
+	// SetDenomMetadataMethod defines the ABI method name for the setDenomMetadata transaction
+	SetDenomMetadataMethod = "setDenomMetadata"
+	// PauseMethod defines the ABI method name for the pause transaction
+	PauseMethod = "pause"
+	// UnpauseMethod defines the ABI method name for the unpause transaction
+	UnpauseMethod = "unpause"
+	// PauseDenomMethod defines the ABI method name for the pauseDenom transaction
+	PauseDenomMethod = "pauseDenom"
+	// ProposeMintMethod defines the ABI method name for the proposeMint transaction
+	ProposeMintMethod = "proposeMint"
+	// ApproveMintMethod defines the ABI method name for the approveMint transaction
+	ApproveMintMethod = "approveMint"
+	// ExecuteMintMethod defines the ABI method name for the executeMint transaction
+	ExecuteMintMethod = "executeMint"
+	// CancelMintMethod defines the ABI method name for the cancelMint transaction
+	CancelMintMethod = "cancelMint"
+	// MintWithSignatureMethod defines the ABI method name for the mintWithSignature transaction
+	MintWithSignatureMethod = "mintWithSignature"
+	// MintVestingMethod defines the ABI method name for the mintVesting transaction
+	MintVestingMethod = "mintVesting"
+	// MintPeriodicVestingMethod defines the ABI method name for the mintPeriodicVesting transaction
+	MintPeriodicVestingMethod = "mintPeriodicVesting"
+	// CreateDenomMethod defines the ABI method name for the createDenom transaction
+	CreateDenomMethod = "createDenom"
+	// ChangeAdminMethod defines the ABI method name for the changeAdmin transaction
+	ChangeAdminMethod = "changeAdmin"
 )
 
-// Mint mints native tokens to the specified address
+// Mint mints native tokens to the specified address and deducts the amount
+// from the caller's minter allowance for the denom. The mint authority, and
+// the admin of a denom created with createDenom, mint without an allowance.
+// Amounts that bring the mints of the block above the large mint threshold of
+// the denom must go through proposeMint instead.
 func (p *Precompile) Mint(ctx sdk.Context, contract *vm.Contract, stateDB vm.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
 	// Parse arguments
 	to, token, value, err := ParseMintArgs(args)
@@ -23,82 +74,721 @@ func (p *Precompile) Mint(ctx sdk.Context, contract *vm.Contract, stateDB vm.Sta
 		return nil, err
 	}
 
-	// Validate recipient address
-	if !p.IsValidRecipient(ctx, to) {
-		return nil, fmt.Errorf(ErrInvalidRecipient, to.Hex())
+	if err := p.mintKeeper.TrackLargeMint(ctx, token, math.NewIntFromBigInt(value)); err != nil {
+		return nil, err
+	}
+
+	if err := p.mint(ctx, stateDB, contract.Caller(), to, token, value); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// MintBatch mints native tokens to each of the specified addresses. The whole
+// batch is reverted if any of the entries fails. The large mint threshold of
+// the denom applies to the total of the batch, added to the mints of the block.
+func (p *Precompile) MintBatch(ctx sdk.Context, contract *vm.Contract, stateDB vm.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	recipients, token, values, err := ParseBatchArgs(args)
+	if err != nil {
+		return nil, err
 	}
 
+	total := new(big.Int)
+	for _, value := range values {
+		total.Add(total, value)
+	}
+	if total.BitLen() > math.MaxBitLen {
+		return nil, fmt.Errorf(ErrBatchTotalOverflow, total)
+	}
+	if err := p.mintKeeper.TrackLargeMint(ctx, token, math.NewIntFromBigInt(total)); err != nil {
+		return nil, err
+	}
+
+	caller := contract.Caller()
+	for i, to := range recipients {
+		if err := p.mint(ctx, stateDB, caller, to, token, values[i]); err != nil {
+			return nil, fmt.Errorf(ErrBatchEntryFailed, i, err.Error())
+		}
+	}
+
+	return method.Outputs.Pack()
+}
+
+// mint validates the request and mints the tokens to the recipient through the
+// x/mint keeper, which draws down the minter allowance.
+func (p *Precompile) mint(ctx sdk.Context, stateDB vm.StateDB, minter, to common.Address, token string, value *big.Int) error {
 	// Validating token denomination first before creating coin to avoid panic
 	if token == "" {
-		return nil, fmt.Errorf(ErrInvalidDenom, token)
+		return fmt.Errorf(ErrInvalidDenom, token)
 	}
 
 	// Convert amount to SDK Int and validate
 	amount := math.NewIntFromBigInt(value)
 	if amount.IsZero() {
-		return nil, ErrZeroAmount
+		return ErrZeroAmount
 	}
 	if amount.IsNegative() {
-		return nil, ErrNegativeAmount
+		return ErrNegativeAmount
 	}
 
 	// Create coin to mint and validate denomination
 	coin := sdk.NewCoin(token, amount)
 	if err := coin.Validate(); err != nil {
-		return nil, fmt.Errorf(ErrInvalidDenom, token)
+		return fmt.Errorf(ErrInvalidDenom, token)
+	}
+
+	// the keeper checks the pause, the recipient, the minter allowance and the
+	// limits of the denom before minting, and records the mint
+	if err := p.mintKeeper.Mint(ctx, minter.Bytes(), to.Bytes(), coin); err != nil {
+		if errors.Is(err, minttypes.ErrMinterNotFound) {
+			return ErrUnauthorized
+		}
+		return err
+	}
+
+	// Emit mint event
+	if err := p.EmitMintEvent(ctx, stateDB, to, token, value); err != nil {
+		return err
+	}
+
+	return p.registerMintedToken(ctx, stateDB, token)
+}
+
+// registerMintedToken registers the denom as an ERC20 token pair the first time
+// it is minted, when governance enabled the auto registration. The EVM denom is
+// skipped, since it is the native currency of the EVM.
+func (p *Precompile) registerMintedToken(ctx sdk.Context, stateDB vm.StateDB, token string) error {
+	if !p.mintKeeper.GetParams(ctx).AutoRegisterERC20 || isEVMDenom(token) || p.erc20Keeper.IsDenomRegistered(ctx, token) {
+		return nil
+	}
+
+	pair, err := p.erc20Keeper.RegisterERC20Extension(ctx, token)
+	if err != nil {
+		return fmt.Errorf(ErrRegisterERC20Failed, token, err.Error())
+	}
+
+	return p.EmitMintedTokenRegisteredEvent(ctx, stateDB, token, pair.GetERC20Contract())
+}
+
+// Burn burns native tokens from the caller's balance.
+func (p *Precompile) Burn(ctx sdk.Context, contract *vm.Contract, stateDB vm.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	token, value, err := ParseBurnArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.burn(ctx, stateDB, contract.Caller(), token, value); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// BurnFrom burns native tokens from the given account. Only the mint authority
+// can call it.
+func (p *Precompile) BurnFrom(ctx sdk.Context, contract *vm.Contract, stateDB vm.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	from, token, value, err := ParseBurnFromArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.IsAuthorized(ctx, contract.Caller()) {
+		return nil, ErrNotMintAuthority
+	}
+
+	if err := p.burn(ctx, stateDB, from, token, value); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// BurnBatch burns native tokens from each of the specified accounts. Only the
+// mint authority can call it. The whole batch is reverted if any of the
+// entries fails.
+func (p *Precompile) BurnBatch(ctx sdk.Context, contract *vm.Contract, stateDB vm.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	accounts, token, values, err := ParseBatchArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.IsAuthorized(ctx, contract.Caller()) {
+		return nil, ErrNotMintAuthority
+	}
+
+	for i, from := range accounts {
+		if err := p.burn(ctx, stateDB, from, token, values[i]); err != nil {
+			return nil, fmt.Errorf(ErrBatchEntryFailed, i, err.Error())
+		}
+	}
+
+	return method.Outputs.Pack()
+}
+
+// burn validates the request and burns the tokens from the given account
+// through the x/mint keeper.
+func (p *Precompile) burn(ctx sdk.Context, stateDB vm.StateDB, from common.Address, token string, value *big.Int) error {
+	if token == "" {
+		return fmt.Errorf(ErrInvalidDenom, token)
+	}
+
+	amount := math.NewIntFromBigInt(value)
+	if amount.IsZero() {
+		return ErrZeroBurnAmount
+	}
+	if amount.IsNegative() {
+		return ErrNegativeBurnAmount
+	}
+
+	coin := sdk.NewCoin(token, amount)
+	if err := coin.Validate(); err != nil {
+		return fmt.Errorf(ErrInvalidDenom, token)
+	}
+
+	if err := p.mintKeeper.Burn(ctx, from.Bytes(), coin); err != nil {
+		return err
+	}
+
+	return p.EmitBurnEvent(ctx, stateDB, from, token, value)
+}
+
+// AddMinter registers a minter for a denom with the given allowance. Only the
+// mint authority can call it, or the admin for a denom created with
+// createDenom.
+func (p *Precompile) AddMinter(ctx sdk.Context, contract *vm.Contract, stateDB vm.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	minter, denom, allowance, err := ParseAddMinterArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if minter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidMinter, minter.Hex())
+	}
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, fmt.Errorf(ErrInvalidDenom, denom)
+	}
+
+	if allowance.Sign() <= 0 {
+		return nil, ErrZeroAllowance
+	}
+
+	if err := p.checkDenomManager(ctx, contract.Caller(), denom); err != nil {
+		return nil, err
+	}
+
+	if err := p.mintKeeper.SetMinterAllowance(ctx, minter.Bytes(), denom, math.NewIntFromBigInt(allowance)); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitMinterAddedEvent(ctx, stateDB, minter, denom, allowance); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// RemoveMinter removes a minter for a denom. Only the mint authority can call
+// it, or the admin for a denom created with createDenom.
+func (p *Precompile) RemoveMinter(ctx sdk.Context, contract *vm.Contract, stateDB vm.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	minter, denom, err := ParseMinterArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.checkDenomManager(ctx, contract.Caller(), denom); err != nil {
+		return nil, err
+	}
+
+	if err := p.mintKeeper.DeleteMinterAllowance(ctx, minter.Bytes(), denom); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitMinterRemovedEvent(ctx, stateDB, minter, denom); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// SetDenomMetadata sets the bank metadata of a denom, so that its name, symbol
+// and decimals are known to x/erc20 and wallets. Only the mint authority can
+// call it, or the admin for a denom created with createDenom.
+func (p *Precompile) SetDenomMetadata(ctx sdk.Context, contract *vm.Contract, stateDB vm.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	denom, name, symbol, decimals, err := ParseSetDenomMetadataArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, fmt.Errorf(ErrInvalidDenom, denom)
+	}
+
+	if denom == evmtypes.GetEVMCoinDenom() {
+		return nil, fmt.Errorf(ErrProtectedDenomMetadata, denom)
+	}
+
+	if err := p.checkDenomManager(ctx, contract.Caller(), denom); err != nil {
+		return nil, err
+	}
+
+	metadata := NewDenomMetadata(denom, name, symbol, decimals)
+	if existing, found := p.bankKeeper.GetDenomMetaData(ctx, denom); found {
+		metadata.Description = existing.Description
+		metadata.URI = existing.URI
+		metadata.URIHash = existing.URIHash
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return nil, fmt.Errorf(ErrInvalidDenomMetadata, err.Error())
+	}
+
+	p.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	if err := p.EmitDenomMetadataSetEvent(ctx, stateDB, denom, name, symbol, decimals); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// Pause pauses minting and burning of all denoms. Only the guardian can call
+// it.
+func (p *Precompile) Pause(ctx sdk.Context, contract *vm.Contract, stateDB vm.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	if !p.mintKeeper.IsGuardian(ctx, contract.Caller().Bytes()) {
+		return nil, ErrNotGuardian
+	}
+
+	p.mintKeeper.SetPaused(ctx, true)
+
+	if err := p.EmitPausedEvent(ctx, stateDB, contract.Caller()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// Unpause lifts the global pause and the pauses of every denom. Only the
+// guardian can call it.
+func (p *Precompile) Unpause(ctx sdk.Context, contract *vm.Contract, stateDB vm.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	if !p.mintKeeper.IsGuardian(ctx, contract.Caller().Bytes()) {
+		return nil, ErrNotGuardian
+	}
+
+	if err := p.mintKeeper.LiftPause(ctx, ""); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitUnpausedEvent(ctx, stateDB, contract.Caller()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// PauseDenom pauses minting and burning of a single denom. Only the guardian
+// can call it.
+func (p *Precompile) PauseDenom(ctx sdk.Context, contract *vm.Contract, stateDB vm.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	denom, err := ParseDenomArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, fmt.Errorf(ErrInvalidDenom, denom)
+	}
+
+	if !p.mintKeeper.IsGuardian(ctx, contract.Caller().Bytes()) {
+		return nil, ErrNotGuardian
+	}
+
+	if err := p.mintKeeper.SetDenomPaused(ctx, denom, true); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitDenomPausedEvent(ctx, stateDB, contract.Caller(), denom); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// ProposeMint submits a mint proposal, which is executed with executeMint once
+// enough approvers approved it and the delay has passed. Only a registered
+// minter for the denom can call it, and the minted amount is deducted from the
+// minter allowance on execution. The mint authority, and the admin of a denom
+// created with createDenom, propose without an allowance.
+func (p *Precompile) ProposeMint(ctx sdk.Context, contract *vm.Contract, stateDB vm.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	to, denom, value, delay, err := ParseProposeMintArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, fmt.Errorf(ErrInvalidDenom, denom)
+	}
+
+	if value.Sign() <= 0 {
+		return nil, ErrZeroAmount
+	}
+
+	if err := p.mintKeeper.ValidateRecipient(ctx, to.Bytes(), denom); err != nil {
+		return nil, err
 	}
 
-	// now we can check if the caller is authorized (after all validation passes)
 	caller := contract.Caller()
-	if !p.IsAuthorized(ctx, caller) {
-		return nil, ErrUnauthorized
+	if !p.mintKeeper.IsDenomManager(ctx, denom, caller.Bytes()) {
+		if _, found := p.mintKeeper.GetMinterAllowance(ctx, caller.Bytes(), denom); !found {
+			return nil, ErrUnauthorized
+		}
+	}
+
+	proposal, err := p.mintKeeper.SubmitMintProposal(ctx, caller.Bytes(), to.Bytes(), denom, math.NewIntFromBigInt(value), delay)
+	if err != nil {
+		return nil, err
+	}
+
+	id := new(big.Int).SetUint64(proposal.ID)
+	if err := p.EmitMintProposedEvent(ctx, stateDB, id, caller, to, denom, value, proposal.ExecutableHeight); err != nil {
+		return nil, err
 	}
 
-	// now we create the coins to mint.
-	coins := sdk.NewCoins(coin)
+	return method.Outputs.Pack(id)
+}
 
-	// MINTING TOKENS USING THE BANK KEEPER
+// ApproveMint approves a pending mint proposal. Only the approvers set by
+// governance can call it, once per proposal.
+func (p *Precompile) ApproveMint(ctx sdk.Context, contract *vm.Contract, stateDB vm.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	id, err := ParseProposalIDArgs(args)
+	if err != nil {
+		return nil, err
+	}
 
-	// Mint coins to temporary module account.
-	if err := p.bankKeeper.MintCoins(ctx, "mint", coins); err != nil {
-		return nil, fmt.Errorf(ErrMintFailed, err.Error())
+	proposal, err := p.mintKeeper.ApproveMintProposal(ctx, id, contract.Caller().Bytes())
+	if err != nil {
+		return nil, err
 	}
 
-	// Send minted coins to recipient
-	recipientAddr := sdk.AccAddress(to.Bytes())
-	if err := p.bankKeeper.SendCoinsFromModuleToAccount(ctx, "mint", recipientAddr, coins); err != nil {
-		return nil, fmt.Errorf(ErrTransferFailed, err.Error())
+	approvals := new(big.Int).SetUint64(uint64(len(proposal.Approvals)))
+	if err := p.EmitMintApprovedEvent(ctx, stateDB, new(big.Int).SetUint64(id), contract.Caller(), approvals); err != nil {
+		return nil, err
 	}
 
-	// Emit mint event
-	if err := p.EmitMintEvent(ctx, stateDB, to, token, value); err != nil {
+	return method.Outputs.Pack()
+}
+
+// ExecuteMint mints the tokens of an approved mint proposal whose delay has
+// passed, and removes the proposal. Only the proposer or an approver can call
+// it.
+func (p *Precompile) ExecuteMint(ctx sdk.Context, contract *vm.Contract, stateDB vm.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	id, err := ParseProposalIDArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, found := p.mintKeeper.GetMintProposal(ctx, id)
+	if !found {
+		return nil, fmt.Errorf(ErrMintProposalNotFound, id)
+	}
+
+	caller := contract.Caller()
+	proposer := common.BytesToAddress(sdk.MustAccAddressFromBech32(proposal.Proposer))
+	if caller != proposer && !p.mintKeeper.IsMintApprover(ctx, caller.Bytes()) {
+		return nil, ErrNotProposalParty
+	}
+
+	if err := p.mintKeeper.CheckMintProposalExecutable(ctx, proposal); err != nil {
+		return nil, err
+	}
+
+	if err := p.mintKeeper.DeleteMintProposal(ctx, id); err != nil {
+		return nil, err
+	}
+
+	to := common.BytesToAddress(sdk.MustAccAddressFromBech32(proposal.Recipient))
+	if err := p.mint(ctx, stateDB, proposer, to, proposal.Denom, proposal.Amount.BigInt()); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitMintExecutedEvent(ctx, stateDB, new(big.Int).SetUint64(id), caller); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// CancelMint removes a pending mint proposal. Only the proposer or the mint
+// authority can call it.
+func (p *Precompile) CancelMint(ctx sdk.Context, contract *vm.Contract, stateDB vm.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	id, err := ParseProposalIDArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, found := p.mintKeeper.GetMintProposal(ctx, id)
+	if !found {
+		return nil, fmt.Errorf(ErrMintProposalNotFound, id)
+	}
+
+	caller := contract.Caller()
+	proposer := common.BytesToAddress(sdk.MustAccAddressFromBech32(proposal.Proposer))
+	if caller != proposer && !p.IsAuthorized(ctx, caller) {
+		return nil, ErrCannotCancel
+	}
+
+	if err := p.mintKeeper.DeleteMintProposal(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitMintCancelledEvent(ctx, stateDB, new(big.Int).SetUint64(id), caller); err != nil {
 		return nil, err
 	}
 
 	return method.Outputs.Pack()
 }
 
-// IsAuthorized checks if the caller is the authorized admin.
+// MintWithSignature mints native tokens with a mint voucher: an EIP-712
+// signature of a minter over the recipient, denom, value, nonce and deadline.
+// Anyone can redeem the voucher, usually the recipient, who pays for the gas.
+// The amount is deducted from the signer's allowance, and each nonce of a
+// minter can only be redeemed once.
+func (p *Precompile) MintWithSignature(ctx sdk.Context, stateDB vm.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	to, denom, value, nonce, deadline, signature, err := ParseMintWithSignatureArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if blockTime := ctx.BlockTime().Unix(); deadline.Cmp(big.NewInt(blockTime)) < 0 {
+		return nil, fmt.Errorf(ErrVoucherExpired, deadline, blockTime)
+	}
+
+	minter, err := RecoverVoucherSigner(VoucherTypedData(p.Address(), to, denom, value, nonce, deadline), signature)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.mintKeeper.TrackLargeMint(ctx, denom, math.NewIntFromBigInt(value)); err != nil {
+		return nil, err
+	}
+
+	if err := p.mintKeeper.UseVoucherNonce(ctx, minter.Bytes(), math.NewIntFromBigInt(nonce)); err != nil {
+		return nil, err
+	}
+
+	if err := p.mint(ctx, stateDB, minter, to, denom, value); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitMintVoucherRedeemedEvent(ctx, stateDB, minter, to, nonce); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// MintVesting mints native tokens to the specified address locked in a
+// continuous vesting schedule from start to end, given as unix times. The
+// recipient becomes a continuous vesting account, or the schedule is added to
+// its own if it already vests on the same schedule. The amount is deducted
+// from the caller's minter allowance for the denom.
+func (p *Precompile) MintVesting(ctx sdk.Context, contract *vm.Contract, stateDB vm.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	to, denom, value, start, end, err := ParseMintVestingArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	lock := func(coins sdk.Coins) error {
+		return p.mintKeeper.AddContinuousVesting(ctx, to.Bytes(), coins, start, end)
+	}
+	if err := p.mintVesting(ctx, stateDB, contract.Caller(), to, denom, value, lock); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitVestingMintedEvent(ctx, stateDB, to, denom, value, uint64(start), uint64(end)); err != nil { //#nosec G115 // start and end are non-negative
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// MintPeriodicVesting mints native tokens to the specified address locked in a
+// periodic vesting schedule starting at the given unix time. Each period vests
+// its amount once its length in seconds has passed since the end of the
+// previous one. The recipient becomes a periodic vesting account, or the
+// schedule is merged into its own if it already is one. The total of the
+// periods is deducted from the caller's minter allowance for the denom.
+func (p *Precompile) MintPeriodicVesting(ctx sdk.Context, contract *vm.Contract, stateDB vm.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	to, denom, start, lengths, amounts, err := ParseMintPeriodicVestingArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, fmt.Errorf(ErrInvalidDenom, denom)
+	}
+
+	periods := make(vestingtypes.Periods, len(lengths))
+	value, end := new(big.Int), start
+	for i, length := range lengths {
+		if length == 0 || length > uint64(gomath.MaxInt64-end) || amounts[i] == nil || amounts[i].Sign() <= 0 {
+			return nil, ErrZeroVestingPeriod
+		}
+		end += int64(length) //#nosec G115 // checked above
+		value.Add(value, amounts[i])
+		periods[i] = vestingtypes.Period{
+			Length: int64(length), //#nosec G115 // checked above
+			Amount: sdk.NewCoins(sdk.NewCoin(denom, math.NewIntFromBigInt(amounts[i]))),
+		}
+	}
+
+	lock := func(sdk.Coins) error {
+		return p.mintKeeper.AddPeriodicVesting(ctx, to.Bytes(), start, periods)
+	}
+	if err := p.mintVesting(ctx, stateDB, contract.Caller(), to, denom, value, lock); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitVestingMintedEvent(ctx, stateDB, to, denom, value, uint64(start), uint64(end)); err != nil { //#nosec G115 // start and end are non-negative
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// mintVesting mints the tokens to the recipient and locks them with the given
+// function. The EVM balance of the recipient is its spendable balance, so the
+// locked part of a mint of the EVM denom is taken out of the StateDB balance
+// that the balance handler credits after the call.
+func (p *Precompile) mintVesting(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	minter, to common.Address,
+	denom string,
+	value *big.Int,
+	lock func(coins sdk.Coins) error,
+) error {
+	// vesting accounts lock bank balances, which hold the integer part of the
+	// extended denom only
+	if denom == evmtypes.GetEVMCoinExtendedDenom() && denom != evmtypes.GetEVMCoinDenom() {
+		return fmt.Errorf(ErrVestingExtendedDenom, denom)
+	}
+
+	if err := p.mintKeeper.TrackLargeMint(ctx, denom, math.NewIntFromBigInt(value)); err != nil {
+		return err
+	}
+
+	recipient := sdk.AccAddress(to.Bytes())
+	extendedDenom := evmtypes.GetEVMCoinExtendedDenom()
+	spendableBefore := p.bankKeeper.SpendableCoin(ctx, recipient, extendedDenom).Amount
+
+	if err := p.mint(ctx, stateDB, minter, to, denom, value); err != nil {
+		return err
+	}
+
+	if err := lock(sdk.NewCoins(sdk.NewCoin(denom, math.NewIntFromBigInt(value)))); err != nil {
+		return err
+	}
+
+	if denom != evmtypes.GetEVMCoinDenom() {
+		return nil
+	}
+
+	received := p.bankKeeper.SpendableCoin(ctx, recipient, extendedDenom).Amount.Sub(spendableBefore)
+	locked := evmtypes.ConvertAmountTo18DecimalsBigInt(value)
+	locked.Sub(locked, received.BigInt())
+	if locked.Sign() > 0 {
+		stateDB.SubBalance(to, uint256.MustFromBig(locked), tracing.BalanceChangeUnspecified)
+	}
+
+	return nil
+}
+
+// CreateDenom creates the denom factory/{creator}/{subdenom}, with the caller
+// as creator, and returns it. The caller becomes the admin of the denom: it
+// can mint it without an allowance and manage its minters and metadata.
+func (p *Precompile) CreateDenom(ctx sdk.Context, contract *vm.Contract, stateDB vm.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	subdenom, err := ParseCreateDenomArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	creator := contract.Caller()
+	denom, err := p.mintKeeper.CreateDenom(ctx, creator.Bytes(), subdenom)
+	if err != nil {
+		return nil, fmt.Errorf(ErrCreateDenomFailed, err.Error())
+	}
+
+	if err := p.EmitDenomCreatedEvent(ctx, stateDB, creator, denom); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(denom)
+}
+
+// ChangeAdmin hands the admin of a denom created with createDenom over to a
+// new account. Passing the zero address renounces the admin. Only the current
+// admin can call it.
+func (p *Precompile) ChangeAdmin(ctx sdk.Context, contract *vm.Contract, stateDB vm.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	denom, newAdmin, err := ParseChangeAdminArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	caller := contract.Caller()
+	if !p.mintKeeper.IsDenomAdmin(ctx, denom, caller.Bytes()) {
+		return nil, ErrNotDenomAdmin
+	}
+
+	var admin sdk.AccAddress
+	if newAdmin != (common.Address{}) {
+		admin = newAdmin.Bytes()
+	}
+
+	if err := p.mintKeeper.ChangeDenomAdmin(ctx, denom, admin); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitDenomAdminChangedEvent(ctx, stateDB, denom, caller, newAdmin); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// isEVMDenom returns true if the denom is the EVM coin denom or its 18 decimals
+// extended denom.
+func isEVMDenom(denom string) bool {
+	return denom == evmtypes.GetEVMCoinDenom() || denom == evmtypes.GetEVMCoinExtendedDenom()
+}
+
+// IsAuthorized checks if the caller is the mint authority of the x/mint
+// module.
 func (p *Precompile) IsAuthorized(ctx sdk.Context, caller common.Address) bool {
-	// Convert EVM address to bech32 for comparison
-	callerBech32 := sdk.AccAddress(caller.Bytes()).String()
-
-	// DEBUG: just printing values for debugging
-	fmt.Printf("DEBUG: caller.Hex(): %s\n", caller.Hex())
-	fmt.Printf("DEBUG: callerBech32: %s\n", callerBech32)
-	fmt.Printf("DEBUG: p.authority: %s\n", p.authority)
-	fmt.Printf("DEBUG: hex comparison: %v\n", caller.Hex() == p.authority)
-	fmt.Printf("DEBUG: bech32 comparison: %v\n", callerBech32 == p.authority)
-
-	// Support both hex and bech32 formats
-	result := caller.Hex() == p.authority || callerBech32 == p.authority
-	fmt.Printf("DEBUG: final result: %v\n", result)
-	return result
-}
-
-// IsValidRecipient ensures the recipient is a valid user account and not a contract or module account
-func (p *Precompile) IsValidRecipient(_ctx sdk.Context, to common.Address) bool {
-	// TODO: check if address has contract code...
-	// Could do this better in prod. Like checking against a list of known module addresses.
-	return !to.Big().IsUint64() || to != common.HexToAddress("0x0")
+	return p.mintKeeper.IsMintAuthority(ctx, caller.Bytes())
+}
+
+// checkDenomManager checks that the caller can manage the minters and the
+// metadata of the denom: the admin for the denoms created with createDenom,
+// the mint authority for every other denom.
+func (p *Precompile) checkDenomManager(ctx sdk.Context, caller common.Address, denom string) error {
+	if minttypes.IsFactoryDenom(denom) {
+		if !p.mintKeeper.IsDenomAdmin(ctx, denom, caller.Bytes()) {
+			return ErrNotDenomAdmin
+		}
+		return nil
+	}
+
+	if !p.IsAuthorized(ctx, caller) {
+		return ErrNotMintAuthority
+	}
+	return nil
 }
@@ -2,9 +2,13 @@ package mint
 
 import (
 	"fmt"
+	"math"
 	"math/big"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // EventMint defines the event data for the Mint event
@@ -14,6 +18,110 @@ type EventMint struct {
 	Value *big.Int
 }
 
+// EventBurn defines the event data for the Burn event
+type EventBurn struct {
+	From  common.Address
+	Token string
+	Value *big.Int
+}
+
+// EventMinterAdded defines the event data for the MinterAdded event
+type EventMinterAdded struct {
+	Minter    common.Address
+	Denom     string
+	Allowance *big.Int
+}
+
+// EventDenomMetadataSet defines the event data for the DenomMetadataSet event
+type EventDenomMetadataSet struct {
+	Denom    string
+	Name     string
+	Symbol   string
+	Decimals uint8
+}
+
+// EventMintedTokenRegistered defines the event data for the MintedTokenRegistered event
+type EventMintedTokenRegistered struct {
+	Denom string
+	Erc20 common.Address
+}
+
+// EventMinterRemoved defines the event data for the MinterRemoved event
+type EventMinterRemoved struct {
+	Minter common.Address
+	Denom  string
+}
+
+// EventPaused defines the event data for the Paused and Unpaused events
+type EventPaused struct {
+	Guardian common.Address
+}
+
+// EventDenomPaused defines the event data for the DenomPaused event
+type EventDenomPaused struct {
+	Guardian common.Address
+	Denom    string
+}
+
+// EventMintProposed defines the event data for the MintProposed event
+type EventMintProposed struct {
+	Id               *big.Int //nolint:revive
+	Proposer         common.Address
+	To               common.Address
+	Denom            string
+	Value            *big.Int
+	ExecutableHeight uint64
+}
+
+// EventMintApproved defines the event data for the MintApproved event
+type EventMintApproved struct {
+	Id        *big.Int //nolint:revive
+	Approver  common.Address
+	Approvals *big.Int
+}
+
+// EventMintExecuted defines the event data for the MintExecuted event
+type EventMintExecuted struct {
+	Id       *big.Int //nolint:revive
+	Executor common.Address
+}
+
+// EventMintVoucherRedeemed defines the event data for the MintVoucherRedeemed
+// event
+type EventMintVoucherRedeemed struct {
+	Minter common.Address
+	To     common.Address
+	Nonce  *big.Int
+}
+
+// EventVestingMinted defines the event data for the VestingMinted event
+type EventVestingMinted struct {
+	To    common.Address
+	Denom string
+	Value *big.Int
+	Start uint64
+	End   uint64
+}
+
+// EventMintCancelled defines the event data for the MintCancelled event
+type EventMintCancelled struct {
+	Id     *big.Int //nolint:revive
+	Caller common.Address
+}
+
+// EventDenomCreated defines the event data for the DenomCreated event
+type EventDenomCreated struct {
+	Creator common.Address
+	Denom   string
+}
+
+// EventDenomAdminChanged defines the event data for the DenomAdminChanged event
+type EventDenomAdminChanged struct {
+	Denom         string
+	PreviousAdmin common.Address
+	NewAdmin      common.Address
+}
+
 // ParseMintArgs parses the arguments from the mint method and returns
 // the recipient address, token denomination, and amount.
 func ParseMintArgs(args []interface{}) (
@@ -43,3 +151,502 @@ func ParseMintArgs(args []interface{}) (
 
 	return to, token, value, nil
 }
+
+// ParseBurnArgs parses the arguments from the burn method and returns the
+// token denomination and amount.
+func ParseBurnArgs(args []interface{}) (
+	token string, value *big.Int, err error,
+) {
+	if len(args) != 2 {
+		return "", nil, fmt.Errorf("invalid number of arguments; expected 2; got: %d", len(args))
+	}
+
+	token, ok := args[0].(string)
+	if !ok {
+		return "", nil, fmt.Errorf("invalid token: %v", args[0])
+	}
+
+	value, ok = args[1].(*big.Int)
+	if !ok {
+		return "", nil, fmt.Errorf("invalid amount: %v", args[1])
+	}
+
+	return token, value, nil
+}
+
+// ParseBurnFromArgs parses the arguments from the burnFrom method and returns
+// the address to burn from, token denomination, and amount.
+func ParseBurnFromArgs(args []interface{}) (
+	from common.Address, token string, value *big.Int, err error,
+) {
+	if len(args) != 3 {
+		return common.Address{}, "", nil, fmt.Errorf("invalid number of arguments; expected 3; got: %d", len(args))
+	}
+
+	from, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, "", nil, fmt.Errorf("invalid from address: %v", args[0])
+	}
+
+	token, ok = args[1].(string)
+	if !ok {
+		return common.Address{}, "", nil, fmt.Errorf("invalid token: %v", args[1])
+	}
+
+	value, ok = args[2].(*big.Int)
+	if !ok {
+		return common.Address{}, "", nil, fmt.Errorf("invalid amount: %v", args[2])
+	}
+
+	return from, token, value, nil
+}
+
+// ParseBatchArgs parses the arguments from the mintBatch and burnBatch methods
+// and returns the accounts, token denomination, and amounts. It checks that
+// there is exactly one amount per account.
+func ParseBatchArgs(args []interface{}) (
+	accounts []common.Address, token string, values []*big.Int, err error,
+) {
+	if len(args) != 3 {
+		return nil, "", nil, fmt.Errorf("invalid number of arguments; expected 3; got: %d", len(args))
+	}
+
+	accounts, ok := args[0].([]common.Address)
+	if !ok {
+		return nil, "", nil, fmt.Errorf("invalid addresses: %v", args[0])
+	}
+
+	token, ok = args[1].(string)
+	if !ok {
+		return nil, "", nil, fmt.Errorf("invalid token: %v", args[1])
+	}
+
+	values, ok = args[2].([]*big.Int)
+	if !ok {
+		return nil, "", nil, fmt.Errorf("invalid values: %v", args[2])
+	}
+
+	if len(accounts) == 0 {
+		return nil, "", nil, ErrEmptyBatch
+	}
+
+	if len(accounts) != len(values) {
+		return nil, "", nil, fmt.Errorf(ErrBatchLength, len(accounts), len(values))
+	}
+
+	return accounts, token, values, nil
+}
+
+// ParseAddMinterArgs parses the arguments from the addMinter method and returns
+// the minter address, token denomination, and allowance.
+func ParseAddMinterArgs(args []interface{}) (
+	minter common.Address, denom string, allowance *big.Int, err error,
+) {
+	if len(args) != 3 {
+		return common.Address{}, "", nil, fmt.Errorf("invalid number of arguments; expected 3; got: %d", len(args))
+	}
+
+	minter, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, "", nil, fmt.Errorf("invalid minter address: %v", args[0])
+	}
+
+	denom, ok = args[1].(string)
+	if !ok {
+		return common.Address{}, "", nil, fmt.Errorf("invalid denom: %v", args[1])
+	}
+
+	allowance, ok = args[2].(*big.Int)
+	if !ok {
+		return common.Address{}, "", nil, fmt.Errorf("invalid allowance: %v", args[2])
+	}
+
+	return minter, denom, allowance, nil
+}
+
+// ParseMinterArgs parses the arguments from the removeMinter, minterAllowance
+// and mintedBy methods and returns the minter address and token denomination.
+func ParseMinterArgs(args []interface{}) (
+	minter common.Address, denom string, err error,
+) {
+	if len(args) != 2 {
+		return common.Address{}, "", fmt.Errorf("invalid number of arguments; expected 2; got: %d", len(args))
+	}
+
+	minter, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, "", fmt.Errorf("invalid minter address: %v", args[0])
+	}
+
+	denom, ok = args[1].(string)
+	if !ok {
+		return common.Address{}, "", fmt.Errorf("invalid denom: %v", args[1])
+	}
+
+	return minter, denom, nil
+}
+
+// ParseDenomArgs parses the arguments from the remainingSupply,
+// remainingRateLimit, denomMetadata, totalMinted, totalBurned, pauseDenom,
+// isPaused and denomAdmin methods and returns the token denomination.
+func ParseDenomArgs(args []interface{}) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf("invalid number of arguments; expected 1; got: %d", len(args))
+	}
+
+	denom, ok := args[0].(string)
+	if !ok {
+		return "", fmt.Errorf("invalid denom: %v", args[0])
+	}
+
+	return denom, nil
+}
+
+// ParseCreateDenomArgs parses the arguments from the createDenom method and
+// returns the subdenom.
+func ParseCreateDenomArgs(args []interface{}) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf("invalid number of arguments; expected 1; got: %d", len(args))
+	}
+
+	subdenom, ok := args[0].(string)
+	if !ok {
+		return "", fmt.Errorf("invalid subdenom: %v", args[0])
+	}
+
+	return subdenom, nil
+}
+
+// ParseChangeAdminArgs parses the arguments from the changeAdmin method and
+// returns the token denomination and the new admin address.
+func ParseChangeAdminArgs(args []interface{}) (
+	denom string, newAdmin common.Address, err error,
+) {
+	if len(args) != 2 {
+		return "", common.Address{}, fmt.Errorf("invalid number of arguments; expected 2; got: %d", len(args))
+	}
+
+	denom, ok := args[0].(string)
+	if !ok {
+		return "", common.Address{}, fmt.Errorf("invalid denom: %v", args[0])
+	}
+
+	newAdmin, ok = args[1].(common.Address)
+	if !ok {
+		return "", common.Address{}, fmt.Errorf("invalid new admin address: %v", args[1])
+	}
+
+	return denom, newAdmin, nil
+}
+
+// ParseProposeMintArgs parses the arguments from the proposeMint method and
+// returns the recipient address, token denomination, amount and delay in
+// blocks.
+func ParseProposeMintArgs(args []interface{}) (
+	to common.Address, denom string, value *big.Int, delay uint64, err error,
+) {
+	if len(args) != 4 {
+		return common.Address{}, "", nil, 0, fmt.Errorf("invalid number of arguments; expected 4; got: %d", len(args))
+	}
+
+	to, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, "", nil, 0, fmt.Errorf("invalid to address: %v", args[0])
+	}
+
+	denom, ok = args[1].(string)
+	if !ok {
+		return common.Address{}, "", nil, 0, fmt.Errorf("invalid denom: %v", args[1])
+	}
+
+	value, ok = args[2].(*big.Int)
+	if !ok {
+		return common.Address{}, "", nil, 0, fmt.Errorf("invalid value: %v", args[2])
+	}
+
+	delay, ok = args[3].(uint64)
+	if !ok {
+		return common.Address{}, "", nil, 0, fmt.Errorf("invalid delay: %v", args[3])
+	}
+
+	return to, denom, value, delay, nil
+}
+
+// ParseProposalIDArgs parses the arguments from the approveMint, executeMint,
+// cancelMint and mintProposal methods and returns the proposal id.
+func ParseProposalIDArgs(args []interface{}) (uint64, error) {
+	if len(args) != 1 {
+		return 0, fmt.Errorf("invalid number of arguments; expected 1; got: %d", len(args))
+	}
+
+	id, ok := args[0].(*big.Int)
+	if !ok || id == nil {
+		return 0, fmt.Errorf(ErrInvalidProposalID, args[0])
+	}
+
+	if !id.IsUint64() {
+		return 0, fmt.Errorf(ErrInvalidProposalID, id)
+	}
+
+	return id.Uint64(), nil
+}
+
+// ParseEmissionScheduleIDArgs parses the arguments from the emissionSchedule
+// method and returns the schedule id.
+func ParseEmissionScheduleIDArgs(args []interface{}) (uint64, error) {
+	if len(args) != 1 {
+		return 0, fmt.Errorf("invalid number of arguments; expected 1; got: %d", len(args))
+	}
+
+	id, ok := args[0].(*big.Int)
+	if !ok || id == nil {
+		return 0, fmt.Errorf(ErrInvalidEmissionScheduleID, args[0])
+	}
+
+	if !id.IsUint64() {
+		return 0, fmt.Errorf(ErrInvalidEmissionScheduleID, id)
+	}
+
+	return id.Uint64(), nil
+}
+
+// ParseSetDenomMetadataArgs parses the arguments from the setDenomMetadata
+// method and returns the denom with its name, symbol and decimals.
+func ParseSetDenomMetadataArgs(args []interface{}) (
+	denom, name, symbol string, decimals uint8, err error,
+) {
+	if len(args) != 4 {
+		return "", "", "", 0, fmt.Errorf("invalid number of arguments; expected 4; got: %d", len(args))
+	}
+
+	denom, ok := args[0].(string)
+	if !ok {
+		return "", "", "", 0, fmt.Errorf("invalid denom: %v", args[0])
+	}
+
+	name, ok = args[1].(string)
+	if !ok {
+		return "", "", "", 0, fmt.Errorf("invalid name: %v", args[1])
+	}
+
+	symbol, ok = args[2].(string)
+	if !ok {
+		return "", "", "", 0, fmt.Errorf("invalid symbol: %v", args[2])
+	}
+
+	decimals, ok = args[3].(uint8)
+	if !ok {
+		return "", "", "", 0, fmt.Errorf("invalid decimals: %v", args[3])
+	}
+
+	return denom, name, symbol, decimals, nil
+}
+
+// NewDenomMetadata returns the bank metadata of a denom with the given name,
+// symbol and decimals. The display unit is the lowercase symbol, with the
+// decimals as exponent. Tokens without decimals are displayed in the base
+// denom, as the bank module rejects a second unit with a zero exponent.
+func NewDenomMetadata(denom, name, symbol string, decimals uint8) banktypes.Metadata {
+	metadata := banktypes.Metadata{
+		Base: denom,
+		// NOTE: Denom units MUST be increasing
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    denom,
+				Exponent: 0,
+			},
+		},
+		Name:    name,
+		Symbol:  symbol,
+		Display: denom,
+	}
+
+	if decimals > 0 {
+		display := strings.ToLower(symbol)
+		metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{
+			Denom:    display,
+			Exponent: uint32(decimals),
+		})
+		metadata.Display = display
+	}
+
+	return metadata
+}
+
+// MetadataDecimals returns the exponent of the display unit of the metadata.
+func MetadataDecimals(metadata banktypes.Metadata) (uint8, error) {
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom != metadata.Display {
+			continue
+		}
+
+		if unit.Exponent > math.MaxUint8 {
+			return 0, fmt.Errorf("uint8 overflow: invalid decimals: %d", unit.Exponent)
+		}
+		return uint8(unit.Exponent), nil
+	}
+
+	return 0, fmt.Errorf("display denomination not found for denom: %q", metadata.Base)
+}
+
+// ParseMintWithSignatureArgs parses the arguments from the mintWithSignature
+// method and returns the voucher and its signature.
+func ParseMintWithSignatureArgs(args []interface{}) (
+	to common.Address, denom string, value, nonce, deadline *big.Int, signature []byte, err error,
+) {
+	if len(args) != 6 {
+		return common.Address{}, "", nil, nil, nil, nil, fmt.Errorf("invalid number of arguments; expected 6; got: %d", len(args))
+	}
+
+	to, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, "", nil, nil, nil, nil, fmt.Errorf("invalid to address: %v", args[0])
+	}
+
+	denom, ok = args[1].(string)
+	if !ok {
+		return common.Address{}, "", nil, nil, nil, nil, fmt.Errorf("invalid denom: %v", args[1])
+	}
+
+	value, ok = args[2].(*big.Int)
+	if !ok || value == nil {
+		return common.Address{}, "", nil, nil, nil, nil, fmt.Errorf("invalid value: %v", args[2])
+	}
+
+	nonce, ok = args[3].(*big.Int)
+	if !ok || nonce == nil {
+		return common.Address{}, "", nil, nil, nil, nil, fmt.Errorf("invalid nonce: %v", args[3])
+	}
+
+	deadline, ok = args[4].(*big.Int)
+	if !ok || deadline == nil {
+		return common.Address{}, "", nil, nil, nil, nil, fmt.Errorf("invalid deadline: %v", args[4])
+	}
+
+	signature, ok = args[5].([]byte)
+	if !ok {
+		return common.Address{}, "", nil, nil, nil, nil, fmt.Errorf("invalid signature: %v", args[5])
+	}
+
+	return to, denom, value, nonce, deadline, signature, nil
+}
+
+// ParseIsVoucherNonceUsedArgs parses the arguments from the isVoucherNonceUsed
+// method and returns the minter and the nonce.
+func ParseIsVoucherNonceUsedArgs(args []interface{}) (minter common.Address, nonce *big.Int, err error) {
+	if len(args) != 2 {
+		return common.Address{}, nil, fmt.Errorf("invalid number of arguments; expected 2; got: %d", len(args))
+	}
+
+	minter, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, nil, fmt.Errorf(ErrInvalidMinter, args[0])
+	}
+
+	nonce, ok = args[1].(*big.Int)
+	if !ok || nonce == nil {
+		return common.Address{}, nil, fmt.Errorf("invalid nonce: %v", args[1])
+	}
+
+	return minter, nonce, nil
+}
+
+// ParseMintVestingArgs parses the arguments from the mintVesting method and
+// returns the recipient address, token denomination, amount and the start and
+// end of the vesting schedule.
+func ParseMintVestingArgs(args []interface{}) (
+	to common.Address, denom string, value *big.Int, start, end int64, err error,
+) {
+	if len(args) != 5 {
+		return common.Address{}, "", nil, 0, 0, fmt.Errorf("invalid number of arguments; expected 5; got: %d", len(args))
+	}
+
+	to, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, "", nil, 0, 0, fmt.Errorf("invalid to address: %v", args[0])
+	}
+
+	denom, ok = args[1].(string)
+	if !ok {
+		return common.Address{}, "", nil, 0, 0, fmt.Errorf("invalid denom: %v", args[1])
+	}
+
+	value, ok = args[2].(*big.Int)
+	if !ok || value == nil {
+		return common.Address{}, "", nil, 0, 0, fmt.Errorf("invalid value: %v", args[2])
+	}
+
+	start, err = parseVestingTime(args[3])
+	if err != nil {
+		return common.Address{}, "", nil, 0, 0, err
+	}
+
+	end, err = parseVestingTime(args[4])
+	if err != nil {
+		return common.Address{}, "", nil, 0, 0, err
+	}
+
+	return to, denom, value, start, end, nil
+}
+
+// ParseMintPeriodicVestingArgs parses the arguments from the
+// mintPeriodicVesting method and returns the recipient address, token
+// denomination, the start of the vesting schedule and the length and amount of
+// each of its periods.
+func ParseMintPeriodicVestingArgs(args []interface{}) (
+	to common.Address, denom string, start int64, lengths []uint64, amounts []*big.Int, err error,
+) {
+	if len(args) != 5 {
+		return common.Address{}, "", 0, nil, nil, fmt.Errorf("invalid number of arguments; expected 5; got: %d", len(args))
+	}
+
+	to, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, "", 0, nil, nil, fmt.Errorf("invalid to address: %v", args[0])
+	}
+
+	denom, ok = args[1].(string)
+	if !ok {
+		return common.Address{}, "", 0, nil, nil, fmt.Errorf("invalid denom: %v", args[1])
+	}
+
+	start, err = parseVestingTime(args[2])
+	if err != nil {
+		return common.Address{}, "", 0, nil, nil, err
+	}
+
+	lengths, ok = args[3].([]uint64)
+	if !ok {
+		return common.Address{}, "", 0, nil, nil, fmt.Errorf("invalid lengths: %v", args[3])
+	}
+
+	amounts, ok = args[4].([]*big.Int)
+	if !ok {
+		return common.Address{}, "", 0, nil, nil, fmt.Errorf("invalid amounts: %v", args[4])
+	}
+
+	if len(lengths) != len(amounts) {
+		return common.Address{}, "", 0, nil, nil, fmt.Errorf(ErrVestingPeriodLength, len(lengths), len(amounts))
+	}
+
+	if len(lengths) == 0 {
+		return common.Address{}, "", 0, nil, nil, ErrEmptyVestingPeriods
+	}
+
+	return to, denom, start, lengths, amounts, nil
+}
+
+// parseVestingTime parses a uint64 unix time or period length of a vesting
+// schedule, which the vesting accounts store as an int64.
+func parseVestingTime(arg interface{}) (int64, error) {
+	t, ok := arg.(uint64)
+	if !ok {
+		return 0, fmt.Errorf("invalid vesting time: %v", arg)
+	}
+
+	if t > math.MaxInt64 {
+		return 0, fmt.Errorf(ErrInvalidVestingTime, t)
+	}
+
+	return int64(t), nil
+}
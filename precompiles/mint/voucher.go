@@ -0,0 +1,114 @@
+package mint
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethmath "github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+const (
+	// VoucherDomainName is the name of the EIP-712 signing domain of mint
+	// vouchers.
+	VoucherDomainName = "Mint"
+	// VoucherDomainVersion is the version of the EIP-712 signing domain of
+	// mint vouchers.
+	VoucherDomainVersion = "1"
+	// VoucherPrimaryType is the EIP-712 type of mint vouchers.
+	VoucherPrimaryType = "MintVoucher"
+)
+
+// voucherTypes are the EIP-712 types of a mint voucher, signed by a minter to
+// let anyone mint `value` of `denom` to `to` on its behalf until `deadline`.
+var voucherTypes = apitypes.Types{
+	"EIP712Domain": {
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+		{Name: "verifyingContract", Type: "address"},
+	},
+	VoucherPrimaryType: {
+		{Name: "to", Type: "address"},
+		{Name: "denom", Type: "string"},
+		{Name: "value", Type: "uint256"},
+		{Name: "nonce", Type: "uint256"},
+		{Name: "deadline", Type: "uint256"},
+	},
+}
+
+// VoucherTypedData returns the EIP-712 typed data of a mint voucher for the
+// mint precompile at the given address on the current chain.
+func VoucherTypedData(
+	verifyingContract, to common.Address,
+	denom string,
+	value, nonce, deadline *big.Int,
+) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types:       voucherTypes,
+		PrimaryType: VoucherPrimaryType,
+		Domain: apitypes.TypedDataDomain{
+			Name:              VoucherDomainName,
+			Version:           VoucherDomainVersion,
+			ChainId:           (*gethmath.HexOrDecimal256)(evmtypes.GetEthChainConfig().ChainID),
+			VerifyingContract: verifyingContract.Hex(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"to":       to.Hex(),
+			"denom":    denom,
+			"value":    value,
+			"nonce":    nonce,
+			"deadline": deadline,
+		},
+	}
+}
+
+// VoucherDomainSeparator returns the EIP-712 domain separator of mint vouchers
+// for the mint precompile at the given address on the current chain.
+func VoucherDomainSeparator(verifyingContract common.Address) (common.Hash, error) {
+	typedData := VoucherTypedData(verifyingContract, common.Address{}, "", new(big.Int), new(big.Int), new(big.Int))
+
+	separator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return common.BytesToHash(separator), nil
+}
+
+// RecoverVoucherSigner returns the address that signed the given typed data of
+// a mint voucher. The signature is the 65 bytes [R || S || V] signature, with
+// V being 0, 1, 27 or 28. Malleable signatures with a high S value are
+// rejected.
+func RecoverVoucherSigner(typedData apitypes.TypedData, signature []byte) (common.Address, error) {
+	if len(signature) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf(ErrInvalidSignature, fmt.Sprintf("expected %d bytes, got %d", crypto.SignatureLength, len(signature)))
+	}
+
+	sig := common.CopyBytes(signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:64])
+	if !crypto.ValidateSignatureValues(sig[crypto.RecoveryIDOffset], r, s, true) {
+		return common.Address{}, fmt.Errorf(ErrInvalidSignature, "invalid signature values")
+	}
+
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return common.Address{}, fmt.Errorf(ErrInvalidSignature, err.Error())
+	}
+
+	pubKey, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf(ErrInvalidSignature, err.Error())
+	}
+
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
@@ -1,14 +1,14 @@
 // SPDX-License-Identifier: LGPL-3.0-only
 pragma solidity >=0.8.18;
 
-import "./../erc20/IERC20Metadata.sol";
+import "./../erc20/IERC20MetadataPermit.sol";
 
 /**
  * @author Evmos Team
  * @title Wrapped ERC20 Interface
  * @dev Interface for representing the native EVM token as a wrapped ERC20 standard.
  */
-interface IWERC20 is IERC20Metadata {
+interface IWERC20 is IERC20MetadataPermit {
     /// @dev Emitted when the native tokens are deposited in exchange for the wrapped ERC20.
     /// @param dst The account for which the deposit is made.
     /// @param wad The amount of native tokens deposited.
@@ -33,4 +33,19 @@ interface IWERC20 is IERC20Metadata {
     /// @dev Emits a Withdrawal Event.
     /// @param wad The amount of native tokens to be withdrawn.
     function withdraw(uint256 wad) external;
+
+    /// @dev Mints native tokens to an account. The caller must be a minter of the
+    /// @dev native denom registered in the mint module, and the amount is drawn
+    /// @dev from its minter allowance.
+    /// @dev Emits a Transfer Event from the zero address.
+    /// @param to The account that receives the minted tokens.
+    /// @param amount The amount of tokens to mint.
+    function mint(address to, uint256 amount) external;
+
+    /// @dev Burns native tokens from an account. The caller must be the account
+    /// @dev itself or the mint authority.
+    /// @dev Emits a Transfer Event to the zero address.
+    /// @param from The account the tokens are burned from.
+    /// @param amount The amount of tokens to burn.
+    function burn(address from, uint256 amount) external;
 }
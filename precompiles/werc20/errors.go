@@ -0,0 +1,21 @@
+package werc20
+
+import "errors"
+
+var (
+	// ErrMintingDisabled is returned on mint and burn calls when the precompile
+	// was created without the x/mint keeper.
+	ErrMintingDisabled = errors.New("minting is not enabled for the wrapped coin")
+	// ErrNotMinter is returned when the caller of mint is not a minter of the
+	// native denom.
+	ErrNotMinter = errors.New("caller is not a minter of the wrapped coin")
+	// ErrNotOwnerOrMintAuthority is returned when the caller of burn is neither
+	// the account the tokens are burned from nor the mint authority.
+	ErrNotOwnerOrMintAuthority = errors.New("caller is neither the account owner nor the mint authority")
+	// ErrNonPositiveAmount is returned when minting or burning a zero or
+	// negative amount.
+	ErrNonPositiveAmount = errors.New("amount must be positive")
+	// ErrFractionalMintAmount is returned when minting an amount that is not a
+	// whole number of units of the EVM denom.
+	ErrFractionalMintAmount = errors.New("mint amount must be a whole number of units of the EVM denom")
+)
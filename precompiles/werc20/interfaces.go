@@ -5,6 +5,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -12,4 +14,16 @@ type Erc20Keeper interface {
 	GetAllowance(ctx sdk.Context, erc20 common.Address, owner common.Address, spender common.Address) (*big.Int, error)
 	SetAllowance(ctx sdk.Context, erc20 common.Address, owner common.Address, spender common.Address, value *big.Int) error
 	DeleteAllowance(ctx sdk.Context, erc20 common.Address, owner common.Address, spender common.Address) error
+	GetNonce(ctx sdk.Context, erc20 common.Address, owner common.Address) *big.Int
+	UseNonce(ctx sdk.Context, erc20 common.Address, owner common.Address) (*big.Int, error)
+}
+
+// MintKeeper defines the x/mint keeper methods used to mint and burn the
+// wrapped coin through the same path, minter allowances and mint authority as
+// the mint precompile.
+type MintKeeper interface {
+	IsMintAuthority(ctx sdk.Context, addr sdk.AccAddress) bool
+	TrackLargeMint(ctx sdk.Context, denom string, amount math.Int) error
+	Mint(ctx sdk.Context, minter, recipient sdk.AccAddress, coin sdk.Coin) error
+	Burn(ctx sdk.Context, from sdk.AccAddress, coin sdk.Coin) error
 }
@@ -1,11 +1,16 @@
 package werc20
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/erc20"
+	minttypes "github.com/cosmos/evm/x/mint/types"
 	"github.com/cosmos/evm/x/precisebank/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
@@ -21,6 +26,12 @@ const (
 	// WithdrawMethod defines the ABI method name for the IWERC20 withdraw
 	// transaction.
 	WithdrawMethod = "withdraw"
+	// MintMethod defines the ABI method name for the IWERC20 mint
+	// transaction.
+	MintMethod = "mint"
+	// BurnMethod defines the ABI method name for the IWERC20 burn
+	// transaction.
+	BurnMethod = "burn"
 )
 
 // Deposit handles the payable deposit function. It retrieves the deposited amount
@@ -78,3 +89,96 @@ func (p Precompile) Withdraw(ctx sdk.Context, contract *vm.Contract, stateDB vm.
 	}
 	return nil, nil
 }
+
+// Mint mints the native coin to the given address. The caller must be a minter
+// of the native denom in the x/mint module, or the mint authority, and the
+// mint goes through the same x/mint keeper path as a mint through the mint
+// precompile. On chains where the EVM denom has fewer than 18 decimals, the
+// amount must be a whole number of units of the EVM denom. Since the native coin itself is minted, the WERC20 balances and
+// total supply keep matching the bank ones.
+func (p Precompile) Mint(ctx sdk.Context, contract *vm.Contract, stateDB vm.StateDB, args []interface{}) ([]byte, error) {
+	if p.mintKeeper == nil {
+		return nil, ErrMintingDisabled
+	}
+
+	to, amount, err := parseMintBurnArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// the amounts of the wrapped coin have 18 decimals on every chain, while
+	// the minter allowances, the large mint threshold and the limits of the
+	// x/mint module are set in the EVM denom, so whole units of it are minted
+	conversionFactor := evmtypes.GetEVMCoinDecimals().ConversionFactor()
+	if !amount.Mod(conversionFactor).IsZero() {
+		return nil, ErrFractionalMintAmount
+	}
+	coin := sdk.NewCoin(evmtypes.GetEVMCoinDenom(), amount.Quo(conversionFactor))
+
+	if err := p.mintKeeper.TrackLargeMint(ctx, coin.Denom, coin.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := p.mintKeeper.Mint(ctx, contract.Caller().Bytes(), to.Bytes(), coin); err != nil {
+		if errors.Is(err, minttypes.ErrMinterNotFound) {
+			return nil, ErrNotMinter
+		}
+		return nil, err
+	}
+
+	if err := p.EmitTransferEvent(ctx, stateDB, common.Address{}, to, amount.BigInt()); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Burn burns the native coin from the given address. The caller must be the
+// account itself or the mint authority.
+func (p Precompile) Burn(ctx sdk.Context, contract *vm.Contract, stateDB vm.StateDB, args []interface{}) ([]byte, error) {
+	if p.mintKeeper == nil {
+		return nil, ErrMintingDisabled
+	}
+
+	from, amount, err := parseMintBurnArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	caller := contract.Caller()
+	if caller != from && !p.mintKeeper.IsMintAuthority(ctx, caller.Bytes()) {
+		return nil, ErrNotOwnerOrMintAuthority
+	}
+
+	coin := sdk.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), amount)
+	if err := p.mintKeeper.Burn(ctx, from.Bytes(), coin); err != nil {
+		return nil, erc20.ConvertErrToERC20Error(err)
+	}
+
+	if err := p.EmitTransferEvent(ctx, stateDB, from, common.Address{}, amount.BigInt()); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// parseMintBurnArgs parses the account and amount arguments of the mint and
+// burn methods.
+func parseMintBurnArgs(args []interface{}) (common.Address, math.Int, error) {
+	if len(args) != 2 {
+		return common.Address{}, math.Int{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	account, ok := args[0].(common.Address)
+	if !ok || account == (common.Address{}) {
+		return common.Address{}, math.Int{}, fmt.Errorf("invalid account address: %v", args[0])
+	}
+
+	amount, ok := args[1].(*big.Int)
+	if !ok || amount == nil {
+		return common.Address{}, math.Int{}, fmt.Errorf("invalid argument type: %T", args[1])
+	}
+	if amount.Sign() <= 0 {
+		return common.Address{}, math.Int{}, ErrNonPositiveAmount
+	}
+
+	return account, math.NewIntFromBigInt(amount), nil
+}
@@ -29,6 +29,7 @@ var _ vm.PrecompiledContract = &Precompile{}
 // Precompile defines the precompiled contract for WERC20.
 type Precompile struct {
 	*erc20.Precompile
+	mintKeeper MintKeeper
 }
 
 const (
@@ -36,6 +37,10 @@ const (
 	DepositRequiredGas uint64 = 23_878
 	// WithdrawRequiredGas defines the gas required for the Withdraw transaction.
 	WithdrawRequiredGas uint64 = 9207
+	// MintRequiredGas defines the gas required for the Mint transaction.
+	MintRequiredGas uint64 = 25_000
+	// BurnRequiredGas defines the gas required for the Burn transaction.
+	BurnRequiredGas uint64 = 25_000
 )
 
 // LoadABI loads the IWERC20 ABI from the embedded abi.json file
@@ -46,12 +51,14 @@ func LoadABI() (abi.ABI, error) {
 
 // NewPrecompile creates a new WERC20 Precompile instance implementing the
 // PrecompiledContract interface. This type wraps around the ERC20 Precompile
-// instance to provide additional methods.
+// instance to provide additional methods. The mint and burn methods are
+// disabled when the mint keeper is nil.
 func NewPrecompile(
 	tokenPair erc20types.TokenPair,
 	bankKeeper cmn.BankKeeper,
 	erc20Keeper Erc20Keeper,
 	transferKeeper ibcutils.TransferKeeper,
+	mintKeeper MintKeeper,
 ) (*Precompile, error) {
 	newABI, err := LoadABI()
 	if err != nil {
@@ -68,6 +75,7 @@ func NewPrecompile(
 
 	return &Precompile{
 		Precompile: erc20Precompile,
+		mintKeeper: mintKeeper,
 	}, nil
 }
 
@@ -98,6 +106,10 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return DepositRequiredGas
 	case WithdrawMethod:
 		return WithdrawRequiredGas
+	case MintMethod:
+		return MintRequiredGas
+	case BurnMethod:
+		return BurnRequiredGas
 	default:
 		return p.Precompile.RequiredGas(input)
 	}
@@ -134,6 +146,10 @@ func (p Precompile) run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.Deposit(ctx, contract, stateDB)
 	case method.Name == WithdrawMethod:
 		bz, err = p.Withdraw(ctx, contract, stateDB, args)
+	case method.Name == MintMethod:
+		bz, err = p.Mint(ctx, contract, stateDB, args)
+	case method.Name == BurnMethod:
+		bz, err = p.Burn(ctx, contract, stateDB, args)
 	default:
 		// ERC20 transactions and queries
 		bz, err = p.HandleMethod(ctx, contract, stateDB, method, args)
@@ -160,7 +176,7 @@ func (p Precompile) run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 // IsTransaction returns true if the given method name correspond to a
 // transaction. Returns false otherwise.
 func (p Precompile) IsTransaction(method *abi.Method) bool {
-	txMethodName := []string{DepositMethod, WithdrawMethod}
+	txMethodName := []string{DepositMethod, WithdrawMethod, MintMethod, BurnMethod}
 	txMethodType := []abi.FunctionType{abi.Fallback, abi.Receive}
 
 	if slices.Contains(txMethodName, method.Name) || slices.Contains(txMethodType, method.Type) {
@@ -1,7 +1,9 @@
 package ante
 
 import (
+	"context"
 	"fmt"
+	"math/big"
 
 	evmante "github.com/cosmos/evm/ante/evm"
 	testconstants "github.com/cosmos/evm/testutil/constants"
@@ -10,11 +12,18 @@ import (
 	"github.com/cosmos/evm/testutil/integration/evm/grpc"
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	testkeyring "github.com/cosmos/evm/testutil/keyring"
+	utiltx "github.com/cosmos/evm/testutil/tx"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -94,6 +103,298 @@ func (s *EvmUnitAnteTestSuite) TestUpdateCumulativeGasWanted() {
 	}
 }
 
+func (s *EvmUnitAnteTestSuite) TestGetFeeGranter() {
+	keyring := testkeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		s.create,
+		network.WithChainID(testconstants.ChainID{
+			ChainID:    s.ChainID,
+			EVMChainID: s.EvmChainID,
+		}),
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := testfactory.New(unitNetwork, grpcHandler)
+	sender := keyring.GetKey(0)
+	granter := keyring.GetAccAddr(1)
+	_, relayerKey := utiltx.NewAddrKey()
+
+	// sign signs the Cosmos tx wrapping the Ethereum tx in SIGN_MODE_DIRECT
+	sign := func(txBuilder client.TxBuilder, privKey cryptotypes.PrivKey) {
+		acc, err := grpcHandler.GetAccount(sender.AccAddr.String())
+		s.Require().NoError(err)
+		signMode := signing.SignMode_SIGN_MODE_DIRECT
+		s.Require().NoError(txBuilder.SetSignatures(signing.SignatureV2{
+			PubKey:   privKey.PubKey(),
+			Data:     &signing.SingleSignatureData{SignMode: signMode},
+			Sequence: acc.GetSequence(),
+		}))
+		signerData := authsigning.SignerData{
+			ChainID:       unitNetwork.GetChainID(),
+			AccountNumber: acc.GetAccountNumber(),
+			Sequence:      acc.GetSequence(),
+			Address:       sender.AccAddr.String(),
+			PubKey:        privKey.PubKey(),
+		}
+		sig, err := clienttx.SignWithPrivKey(context.TODO(), signMode, signerData, txBuilder, privKey, unitNetwork.App.GetTxConfig(), acc.GetSequence())
+		s.Require().NoError(err)
+		s.Require().NoError(txBuilder.SetSignatures(sig))
+	}
+
+	testCases := []struct {
+		name          string
+		malleate      func(txBuilder client.TxBuilder)
+		expGranter    sdktypes.AccAddress
+		expectedError string
+	}{
+		{
+			name:     "success: no fee granter",
+			malleate: func(client.TxBuilder) {},
+		},
+		{
+			name: "success: the sender is the fee granter",
+			malleate: func(txBuilder client.TxBuilder) {
+				txBuilder.SetFeeGranter(sender.AccAddr)
+			},
+		},
+		{
+			name: "fail: relayer attaches a fee granter to the signed eth tx of the sender",
+			malleate: func(txBuilder client.TxBuilder) {
+				txBuilder.SetFeeGranter(granter)
+			},
+			expectedError: "expected 1 signature, got 0",
+		},
+		{
+			name: "fail: relayer attaches a fee granter and signs with its own key",
+			malleate: func(txBuilder client.TxBuilder) {
+				txBuilder.SetFeeGranter(granter)
+				sign(txBuilder, relayerKey)
+			},
+			expectedError: "signer is not the sender",
+		},
+		{
+			name: "fail: relayer replaces the fee granter signed by the sender",
+			malleate: func(txBuilder client.TxBuilder) {
+				txBuilder.SetFeeGranter(sender.AccAddr)
+				sign(txBuilder, sender.Priv)
+				txBuilder.SetFeeGranter(granter)
+			},
+			expectedError: "signature verification failed",
+		},
+		{
+			name: "success: the sender signs the fee granter",
+			malleate: func(txBuilder client.TxBuilder) {
+				txBuilder.SetFeeGranter(granter)
+				sign(txBuilder, sender.Priv)
+			},
+			expGranter: granter,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("%v_%v_%v", evmtypes.GetTxTypeName(s.EthTxType), s.ChainID, tc.name), func() {
+			txArgs, err := txFactory.GenerateDefaultTxTypeArgs(sender.Addr, s.EthTxType)
+			s.Require().NoError(err)
+			msg, err := txFactory.GenerateSignedMsgEthereumTx(sender.Priv, txArgs)
+			s.Require().NoError(err)
+
+			txBuilder := unitNetwork.App.GetTxConfig().NewTxBuilder()
+			_, err = msg.BuildTx(txBuilder, evmtypes.GetEVMCoinDenom())
+			s.Require().NoError(err)
+			tc.malleate(txBuilder)
+			tx := txBuilder.GetTx()
+
+			// Function under test
+			feeGranter, err := evmante.GetFeeGranter(
+				unitNetwork.GetContext(),
+				tx,
+				unitNetwork.App.GetAccountKeeper(),
+				unitNetwork.App.GetFeeGrantKeeper(),
+				sender.AccAddr,
+			)
+
+			if tc.expectedError != "" {
+				s.Require().ErrorContains(err, tc.expectedError)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expGranter, feeGranter)
+
+			// the signature of the sender is accepted by the tx validation
+			_, err = evmante.ValidateTx(tx)
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *EvmUnitAnteTestSuite) TestFeeGrantedEthTx() {
+	keyring := testkeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		s.create,
+		network.WithChainID(testconstants.ChainID{
+			ChainID:    s.ChainID,
+			EVMChainID: s.EvmChainID,
+		}),
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := testfactory.New(unitNetwork, grpcHandler)
+	sender := keyring.GetKey(0)
+	granter := keyring.GetKey(1)
+	recipient := utiltx.GenerateAddress()
+	denom := evmtypes.GetEVMCoinDenom()
+	spendLimit := sdktypes.NewCoins(sdktypes.NewCoin(denom, sdkmath.NewInt(1e18)))
+
+	msgGrant, err := feegrant.NewMsgGrantAllowance(&feegrant.BasicAllowance{SpendLimit: spendLimit}, granter.AccAddr, sender.AccAddr)
+	s.Require().NoError(err)
+	res, err := txFactory.CommitCosmosTx(granter.Priv, commonfactory.CosmosTxArgs{Msgs: []sdktypes.Msg{msgGrant}})
+	s.Require().NoError(err)
+	s.Require().True(res.IsOK(), "failed to grant the allowance: %s", res.Log)
+
+	// buildTx builds the Cosmos tx wrapping the eth tx of the sender with the
+	// granter as fee granter
+	buildTx := func(signCosmosTx bool) []byte {
+		msg, err := txFactory.GenerateSignedMsgEthereumTx(sender.Priv, evmtypes.EvmTxArgs{
+			To:       &recipient,
+			Amount:   big.NewInt(1),
+			GasLimit: 100_000,
+			GasPrice: big.NewInt(1e10),
+		})
+		s.Require().NoError(err)
+
+		txBuilder := unitNetwork.App.GetTxConfig().NewTxBuilder()
+		_, err = msg.BuildTx(txBuilder, denom)
+		s.Require().NoError(err)
+		txBuilder.SetFeeGranter(granter.AccAddr)
+		if signCosmosTx {
+			s.Require().NoError(txFactory.SignCosmosTx(sender.Priv, txBuilder))
+		}
+
+		txBytes, err := txFactory.EncodeTx(txBuilder.GetTx())
+		s.Require().NoError(err)
+		return txBytes
+	}
+
+	evmKeeper := unitNetwork.App.GetEVMKeeper()
+	senderBalance := evmKeeper.GetAccount(unitNetwork.GetContext(), sender.Addr).Balance.ToBig()
+	granterBalance := evmKeeper.GetAccount(unitNetwork.GetContext(), granter.Addr).Balance.ToBig()
+
+	// a relayer attaching the fee granter to the signed eth tx is rejected
+	blockRes, err := unitNetwork.NextBlockWithTxs(buildTx(false))
+	s.Require().NoError(err)
+	s.Require().False(blockRes.TxResults[0].IsOK(), "expected the tx without the sender signature to be rejected")
+
+	blockRes, err = unitNetwork.NextBlockWithTxs(buildTx(true))
+	s.Require().NoError(err)
+	res = *blockRes.TxResults[0]
+	s.Require().True(res.IsOK(), "failed to execute the fee granted tx: %s", res.Log)
+
+	ctx := unitNetwork.GetContext()
+	usedFee := new(big.Int).Mul(big.NewInt(res.GasUsed), big.NewInt(1e10))
+
+	// the sender only pays the value of the tx
+	s.Require().Equal(
+		new(big.Int).Sub(senderBalance, big.NewInt(1)),
+		evmKeeper.GetAccount(ctx, sender.Addr).Balance.ToBig(),
+	)
+
+	// the granter pays the fees of the used gas, and the unused gas is
+	// refunded to its allowance
+	s.Require().Equal(
+		new(big.Int).Sub(granterBalance, usedFee),
+		evmKeeper.GetAccount(ctx, granter.Addr).Balance.ToBig(),
+	)
+	allowance, err := unitNetwork.App.GetFeeGrantKeeper().GetAllowance(ctx, granter.AccAddr, sender.AccAddr)
+	s.Require().NoError(err)
+	usedFees := evmtypes.ConvertCoinsFrom18DecimalsCeil(sdktypes.NewCoins(sdktypes.NewCoin(denom, sdkmath.NewIntFromBigInt(usedFee))))
+	s.Require().Equal(spendLimit.Sub(usedFees...), allowance.(*feegrant.BasicAllowance).SpendLimit)
+}
+
+func (s *EvmUnitAnteTestSuite) TestUseGrantedFees() {
+	keyring := testkeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		s.create,
+		network.WithChainID(testconstants.ChainID{
+			ChainID:    s.ChainID,
+			EVMChainID: s.EvmChainID,
+		}),
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grantee := keyring.GetAccAddr(0)
+	granter := keyring.GetAccAddr(1)
+	msgs := []sdktypes.Msg{&evmtypes.MsgEthereumTx{}}
+	denom := evmtypes.GetEVMCoinDenom()
+	// the fees are represented in 18 decimals, while the allowance is spent in
+	// the evm denom
+	fees := sdktypes.NewCoins(sdktypes.NewCoin(denom, sdkmath.NewIntFromBigInt(evmtypes.ConvertAmountTo18DecimalsBigInt(big.NewInt(1000)))))
+
+	testCases := []struct {
+		name          string
+		spendLimit    sdktypes.Coins
+		noAllowance   bool
+		expRemaining  sdkmath.Int
+		expectedError string
+	}{
+		{
+			name:          "fail: no allowance",
+			noAllowance:   true,
+			expectedError: "fee-grant not found",
+		},
+		{
+			name:          "fail: allowance lower than the fees",
+			spendLimit:    sdktypes.NewCoins(sdktypes.NewCoin(denom, sdkmath.NewInt(999))),
+			expectedError: "basic allowance",
+		},
+		{
+			name:         "success: allowance is spent in the evm denom",
+			spendLimit:   sdktypes.NewCoins(sdktypes.NewCoin(denom, sdkmath.NewInt(1500))),
+			expRemaining: sdkmath.NewInt(500),
+		},
+		{
+			name:       "success: allowance is removed once spent",
+			spendLimit: sdktypes.NewCoins(sdktypes.NewCoin(denom, sdkmath.NewInt(1000))),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("%v_%v_%v", evmtypes.GetTxTypeName(s.EthTxType), s.ChainID, tc.name), func() {
+			ctx, _ := unitNetwork.GetContext().CacheContext()
+			feegrantKeeper := unitNetwork.App.GetFeeGrantKeeper()
+			if !tc.noAllowance {
+				err := feegrantKeeper.GrantAllowance(ctx, granter, grantee, &feegrant.BasicAllowance{SpendLimit: tc.spendLimit})
+				s.Require().NoError(err)
+			}
+
+			// Function under test
+			grant, err := evmante.UseGrantedFees(ctx, feegrantKeeper, granter, grantee, fees, msgs)
+
+			if tc.expectedError != "" {
+				s.Require().ErrorContains(err, tc.expectedError)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(granter.String(), grant.Granter)
+			s.Require().Equal(grantee.String(), grant.Grantee)
+
+			allowance, err := feegrantKeeper.GetAllowance(ctx, granter, grantee)
+			if tc.expRemaining.IsNil() {
+				s.Require().Error(err)
+				s.Require().Nil(grant.Allowance)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expRemaining, allowance.(*feegrant.BasicAllowance).SpendLimit.AmountOf(denom))
+
+			// the returned grant holds the allowance left after the fees were used
+			charged, err := grant.GetGrant()
+			s.Require().NoError(err)
+			s.Require().Equal(allowance, charged)
+		})
+	}
+}
+
 // NOTE: claim rewards are not tested since there is an independent suite to test just that
 func (s *EvmUnitAnteTestSuite) TestConsumeGasAndEmitEvent() {
 	keyring := testkeyring.New(1)
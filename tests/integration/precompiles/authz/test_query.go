@@ -0,0 +1,62 @@
+package authz
+
+import (
+	"time"
+
+	"github.com/cosmos/evm/precompiles/authz"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *PrecompileTestSuite) TestGrants() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	method := s.precompile.Methods[authz.GrantsMethod]
+	granter, grantee := s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1)
+	msgDelegateTypeURL := sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+	expiration := ctx.BlockTime().Add(time.Hour).Truncate(time.Second)
+
+	s.grantMsgSend(ctx, granter, grantee)
+	err := s.network.App.GetAuthzKeeper().SaveGrant(ctx, grantee, granter, authztypes.NewGenericAuthorization(msgDelegateTypeURL), &expiration)
+	s.Require().NoError(err)
+
+	grants := func(msgTypeURL string, pagination query.PageRequest) authz.GrantsOutput {
+		args := []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), msgTypeURL, pagination}
+		bz, err := s.precompile.Grants(ctx, &method, nil, args)
+		s.Require().NoError(err)
+
+		var out authz.GrantsOutput
+		s.Require().NoError(method.Outputs.Copy(&out, must(method.Outputs.Unpack(bz))))
+		return out
+	}
+
+	genericType := sdk.MsgTypeURL(&authztypes.GenericAuthorization{})
+	sendGrant := authz.Grant{AuthorizationType: genericType, MsgTypeUrl: msgSendTypeURL}
+	delegateGrant := authz.Grant{AuthorizationType: genericType, MsgTypeUrl: msgDelegateTypeURL, Expiration: uint64(expiration.Unix())} //nolint:gosec // G115
+
+	out := grants("", query.PageRequest{CountTotal: true})
+	s.Require().ElementsMatch([]authz.Grant{sendGrant, delegateGrant}, out.Grants)
+	s.Require().Equal(uint64(2), out.PageResponse.Total)
+
+	out = grants("", query.PageRequest{Limit: 1})
+	s.Require().Len(out.Grants, 1)
+	s.Require().NotEmpty(out.PageResponse.NextKey)
+
+	out = grants(msgDelegateTypeURL, query.PageRequest{})
+	s.Require().Equal([]authz.Grant{delegateGrant}, out.Grants)
+
+	// a missing grant of the msg type is returned as no grants
+	s.Require().NoError(s.network.App.GetAuthzKeeper().DeleteGrant(ctx, grantee, granter, msgSendTypeURL))
+	out = grants(msgSendTypeURL, query.PageRequest{})
+	s.Require().Empty(out.Grants)
+}
+
+func must[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,373 @@
+package authz
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/cosmos/evm/precompiles/authz"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var msgSendTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+// anyMsgs encodes the messages as protobuf Any values, as expected by exec.
+func (s *PrecompileTestSuite) anyMsgs(msgs ...sdk.Msg) [][]byte {
+	anyMsgs := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		anyMsg, err := codectypes.NewAnyWithValue(msg)
+		s.Require().NoError(err)
+
+		anyMsgs[i], err = s.network.App.AppCodec().Marshal(anyMsg)
+		s.Require().NoError(err)
+	}
+
+	return anyMsgs
+}
+
+// grantMsgSend grants the grantee an authorization to send coins on behalf of
+// the granter.
+func (s *PrecompileTestSuite) grantMsgSend(ctx sdk.Context, granter, grantee sdk.AccAddress) {
+	err := s.network.App.GetAuthzKeeper().SaveGrant(ctx, grantee, granter, authztypes.NewGenericAuthorization(msgSendTypeURL), nil)
+	s.Require().NoError(err)
+}
+
+func (s *PrecompileTestSuite) TestGrant() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[authz.GrantMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1)}
+			},
+			func() {},
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 1),
+		},
+		{
+			"fail - empty grantee",
+			func() []interface{} {
+				return []interface{}{common.Address{}, msgSendTypeURL, uint64(0)}
+			},
+			func() {},
+			"invalid grantee address",
+		},
+		{
+			"fail - grantee is the granter",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), msgSendTypeURL, uint64(0)}
+			},
+			func() {},
+			authztypes.ErrGranteeIsGranter.Error(),
+		},
+		{
+			"fail - unknown msg type",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), "/cosmos.unknown.MsgUnknown", uint64(0)}
+			},
+			func() {},
+			"doesn't exist",
+		},
+		{
+			"fail - disabled msg type",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}), uint64(0)}
+			},
+			func() {},
+			fmt.Sprintf(authz.ErrDisabledMsgType, sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})),
+		},
+		{
+			"fail - expired grant",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), msgSendTypeURL, uint64(ctx.BlockTime().Unix() - 1)} //nolint:gosec // G115
+			},
+			func() {},
+			"expiration must be after the current block time",
+		},
+		{
+			"pass - grant with an expiration",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), msgSendTypeURL, uint64(ctx.BlockTime().Add(time.Hour).Unix())} //nolint:gosec // G115
+			},
+			func() {
+				authorization, expiration := s.network.App.GetAuthzKeeper().GetAuthorization(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), msgSendTypeURL)
+				s.Require().NotNil(authorization)
+				s.Require().NotNil(expiration)
+				s.Require().Equal(ctx.BlockTime().Add(time.Hour).Unix(), expiration.Unix())
+			},
+			"",
+		},
+		{
+			"pass - grant without expiration",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), msgSendTypeURL, uint64(0)}
+			},
+			func() {
+				authorization, expiration := s.network.App.GetAuthzKeeper().GetAuthorization(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), msgSendTypeURL)
+				s.Require().NotNil(authorization)
+				s.Require().Nil(expiration)
+			},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+			stateDB := s.network.GetStateDB()
+
+			bz, err := s.precompile.Grant(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Equal(true, out[0])
+			tc.postCheck()
+
+			s.Require().Len(stateDB.Logs(), 1)
+			var event authz.EventGrant
+			s.Require().NoError(cmn.UnpackLog(s.precompile.ABI, &event, authz.EventTypeGrant, *stateDB.Logs()[0]))
+			s.Require().Equal(s.keyring.GetAddr(0), event.Granter)
+			s.Require().Equal(s.keyring.GetAddr(1), event.Grantee)
+			s.Require().Equal(msgSendTypeURL, event.MsgTypeUrl)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevoke() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	method := s.precompile.Methods[authz.RevokeMethod]
+
+	var contract *vm.Contract
+	contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+	_, err := s.precompile.Revoke(ctx, contract, s.network.GetStateDB(), &method, []interface{}{s.keyring.GetAddr(1), msgSendTypeURL})
+	s.Require().ErrorContains(err, "authorization not found")
+
+	s.grantMsgSend(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1))
+
+	stateDB := s.network.GetStateDB()
+	_, err = s.precompile.Revoke(ctx, contract, stateDB, &method, []interface{}{s.keyring.GetAddr(1), msgSendTypeURL})
+	s.Require().NoError(err)
+
+	authorization, _ := s.network.App.GetAuthzKeeper().GetAuthorization(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), msgSendTypeURL)
+	s.Require().Nil(authorization)
+
+	s.Require().Len(stateDB.Logs(), 1)
+	var event authz.EventRevoke
+	s.Require().NoError(cmn.UnpackLog(s.precompile.ABI, &event, authz.EventTypeRevoke, *stateDB.Logs()[0]))
+	s.Require().Equal(authz.EventRevoke{Granter: s.keyring.GetAddr(0), Grantee: s.keyring.GetAddr(1), MsgTypeUrl: msgSendTypeURL}, event)
+}
+
+func (s *PrecompileTestSuite) TestExec() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[authz.ExecMethod]
+	recipient := s.keyring.GetAccAddr(2)
+	amount := math.NewInt(1_000)
+
+	sendFrom := func(from sdk.AccAddress) sdk.Msg {
+		return banktypes.NewMsgSend(from, recipient, sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), amount)))
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expSent     math.Int
+		errContains string
+	}{
+		{
+			"fail - no messages",
+			func() []interface{} {
+				return []interface{}{[][]byte{}}
+			},
+			math.ZeroInt(),
+			"expected a non-empty list of messages",
+		},
+		{
+			"fail - invalid message encoding",
+			func() []interface{} {
+				return []interface{}{[][]byte{{0x1, 0x2}}}
+			},
+			math.ZeroInt(),
+			"invalid messages",
+		},
+		{
+			"fail - no authorization",
+			func() []interface{} {
+				return []interface{}{s.anyMsgs(sendFrom(s.keyring.GetAccAddr(1)))}
+			},
+			math.ZeroInt(),
+			"authorization not found",
+		},
+		{
+			"fail - disabled msg type",
+			func() []interface{} {
+				return []interface{}{s.anyMsgs(&erc20types.MsgConvertERC20{
+					ContractAddress: s.keyring.GetAddr(2).Hex(),
+					Amount:          math.OneInt(),
+					Receiver:        s.keyring.GetAccAddr(0).String(),
+					Sender:          s.keyring.GetAddr(0).Hex(),
+				})}
+			},
+			math.ZeroInt(),
+			fmt.Sprintf(authz.ErrDisabledMsgType, sdk.MsgTypeURL(&erc20types.MsgConvertERC20{})),
+		},
+		{
+			"fail - IBC transfer is disabled",
+			func() []interface{} {
+				return []interface{}{s.anyMsgs(transfertypes.NewMsgTransfer(
+					transfertypes.PortID, "channel-0", sdk.NewCoin(sdk.DefaultBondDenom, math.OneInt()),
+					s.keyring.GetAccAddr(1).String(), s.keyring.GetAccAddr(0).String(),
+					clienttypes.NewHeight(1, 100), 0, "",
+				))}
+			},
+			math.ZeroInt(),
+			fmt.Sprintf(authz.ErrDisabledMsgType, sdk.MsgTypeURL(&transfertypes.MsgTransfer{})),
+		},
+		{
+			"fail - ERC20 registration is disabled",
+			func() []interface{} {
+				return []interface{}{s.anyMsgs(&erc20types.MsgRegisterERC20{
+					Signer:         s.keyring.GetAccAddr(0).String(),
+					Erc20Addresses: []string{s.keyring.GetAddr(2).Hex()},
+				})}
+			},
+			math.ZeroInt(),
+			fmt.Sprintf(authz.ErrDisabledMsgType, sdk.MsgTypeURL(&erc20types.MsgRegisterERC20{})),
+		},
+		{
+			"fail - periodic vesting account creation is disabled",
+			func() []interface{} {
+				return []interface{}{s.anyMsgs(sdkvesting.NewMsgCreatePeriodicVestingAccount(
+					s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(2), ctx.BlockTime().Unix(),
+					[]sdkvesting.Period{{Length: 100, Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.OneInt()))}},
+				))}
+			},
+			math.ZeroInt(),
+			fmt.Sprintf(authz.ErrDisabledMsgType, sdk.MsgTypeURL(&sdkvesting.MsgCreatePeriodicVestingAccount{})),
+		},
+		{
+			"fail - permanent locked account creation is disabled",
+			func() []interface{} {
+				return []interface{}{s.anyMsgs(sdkvesting.NewMsgCreatePermanentLockedAccount(
+					s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(2), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.OneInt())),
+				))}
+			},
+			math.ZeroInt(),
+			fmt.Sprintf(authz.ErrDisabledMsgType, sdk.MsgTypeURL(&sdkvesting.MsgCreatePermanentLockedAccount{})),
+		},
+		{
+			"fail - disabled msg type nested in a MsgExec",
+			func() []interface{} {
+				nested := authztypes.NewMsgExec(s.keyring.GetAccAddr(0), []sdk.Msg{&evmtypes.MsgEthereumTx{}})
+				return []interface{}{s.anyMsgs(&nested)}
+			},
+			math.ZeroInt(),
+			fmt.Sprintf(authz.ErrDisabledMsgType, sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})),
+		},
+		{
+			"fail - disabled msg type granted through a MsgGrant",
+			func() []interface{} {
+				grant, err := authztypes.NewMsgGrant(
+					s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1),
+					authztypes.NewGenericAuthorization(sdk.MsgTypeURL(&erc20types.MsgConvertCoin{})), nil,
+				)
+				s.Require().NoError(err)
+				return []interface{}{s.anyMsgs(grant)}
+			},
+			math.ZeroInt(),
+			fmt.Sprintf(authz.ErrDisabledMsgType, sdk.MsgTypeURL(&erc20types.MsgConvertCoin{})),
+		},
+		{
+			"pass - execute a granted message",
+			func() []interface{} {
+				s.grantMsgSend(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0))
+				return []interface{}{s.anyMsgs(sendFrom(s.keyring.GetAccAddr(1)))}
+			},
+			amount,
+			"",
+		},
+		{
+			"pass - execute a message signed by the caller without authorization",
+			func() []interface{} {
+				return []interface{}{s.anyMsgs(sendFrom(s.keyring.GetAccAddr(0)), sendFrom(s.keyring.GetAccAddr(0)))}
+			},
+			amount.MulRaw(2),
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			balance := s.network.App.GetBankKeeper().GetBalance(ctx, recipient, s.network.GetBaseDenom()).Amount
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile.Address(), 1_000_000)
+			stateDB := s.network.GetStateDB()
+
+			args := tc.malleate()
+			bz, err := s.precompile.Exec(ctx, contract, stateDB, &method, args)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Len(out[0], len(args[0].([][]byte)))
+
+			sent := s.network.App.GetBankKeeper().GetBalance(ctx, recipient, s.network.GetBaseDenom()).Amount.Sub(balance)
+			s.Require().Equal(tc.expSent, sent)
+
+			s.Require().Len(stateDB.Logs(), 1)
+			var event authz.EventExec
+			s.Require().NoError(cmn.UnpackLog(s.precompile.ABI, &event, authz.EventTypeExec, *stateDB.Logs()[0]))
+			s.Require().Equal(s.keyring.GetAddr(0), event.Grantee)
+			for _, msgTypeURL := range event.MsgTypeUrls {
+				s.Require().Equal(msgSendTypeURL, msgTypeURL)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestIsTransaction() {
+	s.SetupTest()
+
+	for name, method := range s.precompile.Methods {
+		expected := name != authz.GrantsMethod
+		s.Require().Equal(expected, s.precompile.IsTransaction(&method), name)
+	}
+}
@@ -0,0 +1,316 @@
+package bank
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/cosmos/evm/precompiles/bank"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/statedb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// unregisteredDenom is a native denom without an ERC20 token pair.
+const unregisteredDenom = "unregistered"
+
+// fundUnregisteredDenom mints the native denom without an ERC20 token pair to
+// the given address.
+func (s *PrecompileTestSuite) fundUnregisteredDenom(ctx sdk.Context, addr common.Address, amount int64) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(unregisteredDenom, amount))
+	bankKeeper := s.network.App.GetBankKeeper()
+	s.Require().NoError(bankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	s.Require().NoError(bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr.Bytes(), coins))
+}
+
+// transferEvents returns the bank Transfer events logged in the state DB.
+func (s *PrecompileTestSuite) transferEvents(stateDB *statedb.StateDB) []bank.EventTransfer {
+	events := []bank.EventTransfer{}
+	for _, log := range stateDB.Logs() {
+		s.Require().Equal(s.precompile.Address(), log.Address)
+
+		var event bank.EventTransfer
+		s.Require().NoError(cmn.UnpackLog(s.precompile.ABI, &event, bank.EventTypeTransfer, *log))
+		events = append(events, event)
+	}
+
+	return events
+}
+
+func (s *PrecompileTestSuite) TestSend() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[bank.SendMethod]
+	recipient := utiltx.GenerateAddress()
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		errContains string
+		postCheck   func(events []bank.EventTransfer)
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{recipient}
+			},
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 1),
+			nil,
+		},
+		{
+			"fail - invalid coin amount",
+			func() []interface{} {
+				return []interface{}{
+					recipient,
+					[]cmn.Coin{{Denom: unregisteredDenom, Amount: big.NewInt(-1)}},
+				}
+			},
+			"invalid amount",
+			nil,
+		},
+		{
+			"fail - zero amount",
+			func() []interface{} {
+				return []interface{}{
+					recipient,
+					[]cmn.Coin{{Denom: unregisteredDenom, Amount: big.NewInt(0)}},
+				}
+			},
+			"invalid coins",
+			nil,
+		},
+		{
+			"fail - insufficient funds",
+			func() []interface{} {
+				return []interface{}{
+					recipient,
+					[]cmn.Coin{{Denom: unregisteredDenom, Amount: big.NewInt(2_000)}},
+				}
+			},
+			"insufficient funds",
+			nil,
+		},
+		{
+			"fail - blocked recipient",
+			func() []interface{} {
+				return []interface{}{
+					common.BytesToAddress(s.network.App.GetAccountKeeper().GetModuleAddress("distribution")),
+					[]cmn.Coin{{Denom: unregisteredDenom, Amount: big.NewInt(100)}},
+				}
+			},
+			"is not allowed to receive funds",
+			nil,
+		},
+		{
+			"pass - send a denom without an ERC20 token pair and a registered denom",
+			func() []interface{} {
+				return []interface{}{
+					recipient,
+					[]cmn.Coin{
+						{Denom: xmplDenom, Amount: big.NewInt(50)},
+						{Denom: unregisteredDenom, Amount: big.NewInt(100)},
+					},
+				}
+			},
+			"",
+			func(events []bank.EventTransfer) {
+				bankKeeper := s.network.App.GetBankKeeper()
+				s.Require().Equal(int64(100), bankKeeper.GetBalance(ctx, recipient.Bytes(), unregisteredDenom).Amount.Int64())
+				s.Require().Equal(int64(900), bankKeeper.GetBalance(ctx, s.keyring.GetAccAddr(0), unregisteredDenom).Amount.Int64())
+				s.Require().Equal(int64(50), bankKeeper.GetBalance(ctx, recipient.Bytes(), xmplDenom).Amount.Int64())
+
+				sender := s.keyring.GetAddr(0)
+				s.Require().Equal([]bank.EventTransfer{
+					{From: sender, To: recipient, Denom: unregisteredDenom, Amount: big.NewInt(100)},
+					{From: sender, To: recipient, Denom: xmplDenom, Amount: big.NewInt(50)},
+				}, events)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			s.fundUnregisteredDenom(ctx, s.keyring.GetAddr(0), 1_000)
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+			stateDB := s.network.GetStateDB()
+
+			bz, err := s.precompile.Send(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(stateDB.Logs())
+				return
+			}
+
+			s.Require().NoError(err)
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Equal(true, out[0])
+			tc.postCheck(s.transferEvents(stateDB))
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestMultiSend() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[bank.MultiSendMethod]
+	recipients := []common.Address{utiltx.GenerateAddress(), utiltx.GenerateAddress()}
+
+	coins := func(amount int64) []cmn.Coin {
+		return []cmn.Coin{{Denom: unregisteredDenom, Amount: big.NewInt(amount)}}
+	}
+	outputs := []bank.Output{
+		{Addr: recipients[0], Coins: coins(100)},
+		{Addr: recipients[1], Coins: coins(200)},
+	}
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		errContains string
+		postCheck   func(events []bank.EventTransfer)
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{[]bank.Input{}}
+			},
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 1),
+			nil,
+		},
+		{
+			"fail - input not sent by the caller",
+			func() []interface{} {
+				return []interface{}{
+					[]bank.Input{{Addr: s.keyring.GetAddr(1), Coins: coins(300)}},
+					outputs,
+				}
+			},
+			"does not match the requester address",
+			nil,
+		},
+		{
+			"fail - no inputs",
+			func() []interface{} {
+				return []interface{}{[]bank.Input{}, outputs}
+			},
+			"no inputs",
+			nil,
+		},
+		{
+			"fail - multiple inputs",
+			func() []interface{} {
+				return []interface{}{
+					[]bank.Input{
+						{Addr: s.keyring.GetAddr(0), Coins: coins(100)},
+						{Addr: s.keyring.GetAddr(0), Coins: coins(200)},
+					},
+					outputs,
+				}
+			},
+			"multiple senders not allowed",
+			nil,
+		},
+		{
+			"fail - inputs do not match the outputs",
+			func() []interface{} {
+				return []interface{}{
+					[]bank.Input{{Addr: s.keyring.GetAddr(0), Coins: coins(250)}},
+					outputs,
+				}
+			},
+			"sum inputs != sum outputs",
+			nil,
+		},
+		{
+			"pass - send a denom without an ERC20 token pair to several recipients",
+			func() []interface{} {
+				return []interface{}{
+					[]bank.Input{{Addr: s.keyring.GetAddr(0), Coins: coins(300)}},
+					outputs,
+				}
+			},
+			"",
+			func(events []bank.EventTransfer) {
+				bankKeeper := s.network.App.GetBankKeeper()
+				s.Require().Equal(int64(700), bankKeeper.GetBalance(ctx, s.keyring.GetAccAddr(0), unregisteredDenom).Amount.Int64())
+				s.Require().Equal(int64(100), bankKeeper.GetBalance(ctx, recipients[0].Bytes(), unregisteredDenom).Amount.Int64())
+				s.Require().Equal(int64(200), bankKeeper.GetBalance(ctx, recipients[1].Bytes(), unregisteredDenom).Amount.Int64())
+
+				sender := s.keyring.GetAddr(0)
+				s.Require().Equal([]bank.EventTransfer{
+					{From: sender, To: recipients[0], Denom: unregisteredDenom, Amount: big.NewInt(100)},
+					{From: sender, To: recipients[1], Denom: unregisteredDenom, Amount: big.NewInt(200)},
+				}, events)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			s.fundUnregisteredDenom(ctx, s.keyring.GetAddr(0), 1_000)
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+			stateDB := s.network.GetStateDB()
+
+			bz, err := s.precompile.MultiSend(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(stateDB.Logs())
+				return
+			}
+
+			s.Require().NoError(err)
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Equal(true, out[0])
+			tc.postCheck(s.transferEvents(stateDB))
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestIsTransaction() {
+	s.SetupTest()
+
+	for name, method := range s.precompile.Methods {
+		expected := name == bank.SendMethod || name == bank.MultiSendMethod
+		s.Require().Equal(expected, s.precompile.IsTransaction(&method), name)
+	}
+}
+
+func (s *PrecompileTestSuite) TestRequiredGasSend() {
+	s.SetupTest()
+	recipient := utiltx.GenerateAddress()
+	coins := []cmn.Coin{
+		{Denom: xmplDenom, Amount: big.NewInt(1)},
+		{Denom: unregisteredDenom, Amount: big.NewInt(1)},
+	}
+
+	input, err := s.precompile.Pack(bank.SendMethod, recipient, coins)
+	s.Require().NoError(err)
+	// to, the coins offset and length, and five words for each coin
+	s.Require().Equal(uint64(bank.GasSendBase+13*bank.GasSendPerWord), s.precompile.RequiredGas(input))
+
+	input, err = s.precompile.Pack(
+		bank.MultiSendMethod,
+		[]bank.Input{{Addr: s.keyring.GetAddr(0), Coins: coins}},
+		[]bank.Output{{Addr: recipient, Coins: coins[:1]}, {Addr: recipient, Coins: coins[1:]}},
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(bank.GasSendBase+(len(input)-4)/32*bank.GasSendPerWord), s.precompile.RequiredGas(input))
+
+	// large arrays are charged by their size, without being decoded
+	input = append(input[:4:4], make([]byte, 32*1000+1)...)
+	s.Require().Equal(uint64(bank.GasSendBase+1001*bank.GasSendPerWord), s.precompile.RequiredGas(input))
+}
@@ -15,6 +15,7 @@ import (
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -23,6 +24,7 @@ import (
 func (s *PrecompileTestSuite) setupBankPrecompile() *bank.Precompile {
 	precompile, err := bank.NewPrecompile(
 		s.network.App.GetBankKeeper(),
+		bankkeeper.NewMsgServerImpl(s.network.App.GetBankKeeper()),
 		*s.network.App.GetErc20Keeper(),
 	)
 
@@ -36,6 +38,7 @@ func (s *PrecompileTestSuite) setupBankPrecompile() *bank.Precompile {
 func (is *IntegrationTestSuite) setupBankPrecompile() *bank.Precompile {
 	precompile, err := bank.NewPrecompile(
 		is.network.App.GetBankKeeper(),
+		bankkeeper.NewMsgServerImpl(is.network.App.GetBankKeeper()),
 		*is.network.App.GetErc20Keeper(),
 	)
 	Expect(err).ToNot(HaveOccurred(), "failed to create bank precompile")
@@ -251,3 +251,275 @@ func (s *PrecompileTestSuite) TestApprove() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestIncreaseAllowance() {
+	method := s.precompile.Methods[erc20.IncreaseAllowanceMethod]
+	amount := int64(100)
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expPass     bool
+		errContains string
+	}{
+		{
+			name:        "fail - empty args",
+			malleate:    func() []interface{} { return nil },
+			errContains: "invalid number of arguments",
+		},
+		{
+			name: "fail - zero added value",
+			malleate: func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(1), common.Big0,
+				}
+			},
+			errContains: erc20.ErrIncreaseNonPositiveValue.Error(),
+		},
+		{
+			name: "fail - uint256 overflow",
+			malleate: func() []interface{} {
+				s.setAllowance(
+					s.precompile.Address(),
+					s.keyring.GetPrivKey(0),
+					s.keyring.GetAddr(1),
+					abi.MaxUint256,
+				)
+
+				return []interface{}{
+					s.keyring.GetAddr(1), common.Big1,
+				}
+			},
+			errContains: "causes integer overflow",
+		},
+		{
+			name: "pass - increase without existing allowance",
+			malleate: func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(1), big.NewInt(amount),
+				}
+			},
+			expPass: true,
+			postCheck: func() {
+				s.requireAllowance(
+					s.precompile.Address(),
+					s.keyring.GetAddr(0),
+					s.keyring.GetAddr(1),
+					big.NewInt(amount),
+				)
+			},
+		},
+		{
+			name: "pass - increase existing allowance",
+			malleate: func() []interface{} {
+				s.setAllowance(
+					s.precompile.Address(),
+					s.keyring.GetPrivKey(0),
+					s.keyring.GetAddr(1),
+					big.NewInt(1),
+				)
+
+				return []interface{}{
+					s.keyring.GetAddr(1), big.NewInt(amount),
+				}
+			},
+			expPass: true,
+			postCheck: func() {
+				s.requireAllowance(
+					s.precompile.Address(),
+					s.keyring.GetAddr(0),
+					s.keyring.GetAddr(1),
+					big.NewInt(amount+1),
+				)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			ctx := s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(
+				s.T(),
+				ctx,
+				s.keyring.GetAddr(0),
+				s.precompile.Address(),
+				200_000,
+			)
+
+			var args []interface{}
+			if tc.malleate != nil {
+				args = tc.malleate()
+			}
+
+			bz, err := s.precompile.IncreaseAllowance(
+				ctx,
+				contract,
+				s.network.GetStateDB(),
+				&method,
+				args,
+			)
+
+			if tc.expPass {
+				s.Require().NoError(err, "expected no error")
+				s.Require().NotNil(bz, "expected non-nil bytes")
+			} else {
+				s.Require().Error(err, "expected error")
+				s.Require().ErrorContains(err, tc.errContains, "expected different error message")
+				s.Require().Empty(bz, "expected empty bytes")
+			}
+
+			if tc.postCheck != nil {
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestDecreaseAllowance() {
+	method := s.precompile.Methods[erc20.DecreaseAllowanceMethod]
+	amount := int64(100)
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expPass     bool
+		errContains string
+	}{
+		{
+			name:        "fail - empty args",
+			malleate:    func() []interface{} { return nil },
+			errContains: "invalid number of arguments",
+		},
+		{
+			name: "fail - zero subtracted value",
+			malleate: func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(1), common.Big0,
+				}
+			},
+			errContains: erc20.ErrDecreaseNonPositiveValue.Error(),
+		},
+		{
+			name: "fail - no existing allowance",
+			malleate: func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(1), big.NewInt(amount),
+				}
+			},
+			errContains: erc20.ErrDecreasedAllowanceBelowZero.Error(),
+		},
+		{
+			name: "fail - decrease below zero",
+			malleate: func() []interface{} {
+				s.setAllowance(
+					s.precompile.Address(),
+					s.keyring.GetPrivKey(0),
+					s.keyring.GetAddr(1),
+					big.NewInt(amount),
+				)
+
+				return []interface{}{
+					s.keyring.GetAddr(1), big.NewInt(amount + 1),
+				}
+			},
+			errContains: erc20.ErrDecreasedAllowanceBelowZero.Error(),
+		},
+		{
+			name: "pass - decrease existing allowance",
+			malleate: func() []interface{} {
+				s.setAllowance(
+					s.precompile.Address(),
+					s.keyring.GetPrivKey(0),
+					s.keyring.GetAddr(1),
+					big.NewInt(amount),
+				)
+
+				return []interface{}{
+					s.keyring.GetAddr(1), big.NewInt(1),
+				}
+			},
+			expPass: true,
+			postCheck: func() {
+				s.requireAllowance(
+					s.precompile.Address(),
+					s.keyring.GetAddr(0),
+					s.keyring.GetAddr(1),
+					big.NewInt(amount-1),
+				)
+			},
+		},
+		{
+			name: "pass - decrease existing allowance to zero",
+			malleate: func() []interface{} {
+				s.setAllowance(
+					s.precompile.Address(),
+					s.keyring.GetPrivKey(0),
+					s.keyring.GetAddr(1),
+					big.NewInt(amount),
+				)
+
+				return []interface{}{
+					s.keyring.GetAddr(1), big.NewInt(amount),
+				}
+			},
+			expPass: true,
+			postCheck: func() {
+				s.requireAllowance(
+					s.precompile.Address(),
+					s.keyring.GetAddr(0),
+					s.keyring.GetAddr(1),
+					common.Big0,
+				)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			ctx := s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(
+				s.T(),
+				ctx,
+				s.keyring.GetAddr(0),
+				s.precompile.Address(),
+				200_000,
+			)
+
+			var args []interface{}
+			if tc.malleate != nil {
+				args = tc.malleate()
+			}
+
+			bz, err := s.precompile.DecreaseAllowance(
+				ctx,
+				contract,
+				s.network.GetStateDB(),
+				&method,
+				args,
+			)
+
+			if tc.expPass {
+				s.Require().NoError(err, "expected no error")
+				s.Require().NotNil(bz, "expected non-nil bytes")
+			} else {
+				s.Require().Error(err, "expected error")
+				s.Require().ErrorContains(err, tc.errContains, "expected different error message")
+				s.Require().Empty(bz, "expected empty bytes")
+			}
+
+			if tc.postCheck != nil {
+				tc.postCheck()
+			}
+		})
+	}
+}
@@ -20,6 +20,10 @@ func (s *PrecompileTestSuite) TestIsTransaction() {
 	s.Require().False(s.precompile.IsTransaction(&method))
 	method = s.precompile.Methods[erc20.TotalSupplyMethod]
 	s.Require().False(s.precompile.IsTransaction(&method))
+	method = s.precompile.Methods[erc20.NoncesMethod]
+	s.Require().False(s.precompile.IsTransaction(&method))
+	method = s.precompile.Methods[erc20.DomainSeparatorMethod]
+	s.Require().False(s.precompile.IsTransaction(&method))
 
 	// Transactions
 	method = s.precompile.Methods[erc20.ApproveMethod]
@@ -28,6 +32,12 @@ func (s *PrecompileTestSuite) TestIsTransaction() {
 	s.Require().True(s.precompile.IsTransaction(&method))
 	method = s.precompile.Methods[erc20.TransferFromMethod]
 	s.Require().True(s.precompile.IsTransaction(&method))
+	method = s.precompile.Methods[erc20.IncreaseAllowanceMethod]
+	s.Require().True(s.precompile.IsTransaction(&method))
+	method = s.precompile.Methods[erc20.DecreaseAllowanceMethod]
+	s.Require().True(s.precompile.IsTransaction(&method))
+	method = s.precompile.Methods[erc20.PermitMethod]
+	s.Require().True(s.precompile.IsTransaction(&method))
 }
 
 func (s *PrecompileTestSuite) TestRequiredGas() {
@@ -119,6 +129,51 @@ func (s *PrecompileTestSuite) TestRequiredGas() {
 			},
 			expGas: erc20.GasAllowance,
 		},
+		{
+			name: erc20.IncreaseAllowanceMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(erc20.IncreaseAllowanceMethod, s.keyring.GetAddr(0), big.NewInt(1))
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: erc20.GasIncreaseAllowance,
+		},
+		{
+			name: erc20.DecreaseAllowanceMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(erc20.DecreaseAllowanceMethod, s.keyring.GetAddr(0), big.NewInt(1))
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: erc20.GasDecreaseAllowance,
+		},
+		{
+			name: erc20.PermitMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(erc20.PermitMethod, s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(1), big.NewInt(1), uint8(27), [32]byte{}, [32]byte{})
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: erc20.GasPermit,
+		},
+		{
+			name: erc20.NoncesMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(erc20.NoncesMethod, s.keyring.GetAddr(0))
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: erc20.GasNonces,
+		},
+		{
+			name: erc20.DomainSeparatorMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(erc20.DomainSeparatorMethod)
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: erc20.GasDomainSeparator,
+		},
 		{
 			name: "invalid method",
 			malleate: func() []byte {
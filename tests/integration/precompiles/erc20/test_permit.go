@@ -0,0 +1,200 @@
+package erc20
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/cosmos/evm/precompiles/erc20"
+	"github.com/cosmos/evm/precompiles/testutil"
+	testkeyring "github.com/cosmos/evm/testutil/keyring"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *PrecompileTestSuite) TestPermit() {
+	method := s.precompile2.Methods[erc20.PermitMethod]
+	amount := big.NewInt(100)
+
+	testcases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context) []interface{}
+		postCheck   func(ctx sdk.Context)
+		expPass     bool
+		errContains string
+	}{
+		{
+			name:        "fail - empty args",
+			malleate:    func(sdk.Context) []interface{} { return nil },
+			errContains: "invalid number of arguments",
+		},
+		{
+			name: "fail - expired deadline",
+			malleate: func(ctx sdk.Context) []interface{} {
+				deadline := big.NewInt(ctx.BlockTime().Unix() - 1)
+				return s.permitArgs(s.keyring.GetKey(0), s.keyring.GetAddr(1), amount, common.Big0, deadline)
+			},
+			errContains: erc20.ErrPermitExpired.Error(),
+		},
+		{
+			name: "fail - signed by another account",
+			malleate: func(ctx sdk.Context) []interface{} {
+				args := s.permitArgs(s.keyring.GetKey(1), s.keyring.GetAddr(1), amount, common.Big0, s.permitDeadline(ctx))
+				args[0] = s.keyring.GetAddr(0)
+				return args
+			},
+			errContains: erc20.ErrPermitInvalidSignature.Error(),
+		},
+		{
+			name: "fail - wrong nonce",
+			malleate: func(ctx sdk.Context) []interface{} {
+				return s.permitArgs(s.keyring.GetKey(0), s.keyring.GetAddr(1), amount, common.Big1, s.permitDeadline(ctx))
+			},
+			errContains: erc20.ErrPermitInvalidSignature.Error(),
+		},
+		{
+			name: "fail - invalid v",
+			malleate: func(ctx sdk.Context) []interface{} {
+				args := s.permitArgs(s.keyring.GetKey(0), s.keyring.GetAddr(1), amount, common.Big0, s.permitDeadline(ctx))
+				args[4] = uint8(1)
+				return args
+			},
+			errContains: erc20.ErrPermitInvalidSignature.Error(),
+		},
+		{
+			name: "pass - set allowance and increment nonce",
+			malleate: func(ctx sdk.Context) []interface{} {
+				return s.permitArgs(s.keyring.GetKey(0), s.keyring.GetAddr(1), amount, common.Big0, s.permitDeadline(ctx))
+			},
+			expPass: true,
+			postCheck: func(ctx sdk.Context) {
+				s.requireAllowance(s.precompile2.Address(), s.keyring.GetAddr(0), s.keyring.GetAddr(1), amount)
+
+				nonce := s.network.App.GetErc20Keeper().GetNonce(ctx, s.precompile2.Address(), s.keyring.GetAddr(0))
+				s.Require().Equal(common.Big1.String(), nonce.String())
+			},
+		},
+		{
+			name: "pass - zero value deletes existing allowance",
+			malleate: func(ctx sdk.Context) []interface{} {
+				s.setAllowance(s.precompile2.Address(), s.keyring.GetPrivKey(0), s.keyring.GetAddr(1), amount)
+				return s.permitArgs(s.keyring.GetKey(0), s.keyring.GetAddr(1), common.Big0, common.Big0, s.permitDeadline(ctx))
+			},
+			expPass: true,
+			postCheck: func(sdk.Context) {
+				s.requireAllowance(s.precompile2.Address(), s.keyring.GetAddr(0), s.keyring.GetAddr(1), common.Big0)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			ctx := s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(
+				s.T(),
+				ctx,
+				s.keyring.GetAddr(1),
+				s.precompile2.Address(),
+				200_000,
+			)
+
+			args := tc.malleate(ctx)
+
+			_, err := s.precompile2.Permit(ctx, contract, s.network.GetStateDB(), &method, args)
+
+			if tc.expPass {
+				s.Require().NoError(err, "expected no error")
+			} else {
+				s.Require().Error(err, "expected error")
+				s.Require().ErrorContains(err, tc.errContains, "expected different error message")
+			}
+
+			if tc.postCheck != nil {
+				tc.postCheck(ctx)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestPermitReplay() {
+	s.SetupTest()
+	method := s.precompile2.Methods[erc20.PermitMethod]
+
+	ctx := s.network.GetContext()
+	contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(1), s.precompile2.Address(), 200_000)
+
+	args := s.permitArgs(s.keyring.GetKey(0), s.keyring.GetAddr(1), big.NewInt(100), common.Big0, s.permitDeadline(ctx))
+
+	_, err := s.precompile2.Permit(ctx, contract, s.network.GetStateDB(), &method, args)
+	s.Require().NoError(err, "expected first permit to succeed")
+
+	_, err = s.precompile2.Permit(ctx, contract, s.network.GetStateDB(), &method, args)
+	s.Require().ErrorContains(err, erc20.ErrPermitInvalidSignature.Error(), "expected replayed permit to fail")
+}
+
+// TestPermitReplayAfterReregistration checks that the permit nonces survive
+// the deletion of the token pair, so that the permits signed for it cannot be
+// replayed once the pair is registered again with the same address.
+func (s *PrecompileTestSuite) TestPermitReplayAfterReregistration() {
+	s.SetupTest()
+	method := s.precompile2.Methods[erc20.PermitMethod]
+	erc20Keeper := s.network.App.GetErc20Keeper()
+
+	ctx := s.network.GetContext()
+	contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(1), s.precompile2.Address(), 200_000)
+
+	args := s.permitArgs(s.keyring.GetKey(0), s.keyring.GetAddr(1), big.NewInt(100), common.Big0, s.permitDeadline(ctx))
+
+	_, err := s.precompile2.Permit(ctx, contract, s.network.GetStateDB(), &method, args)
+	s.Require().NoError(err, "expected first permit to succeed")
+
+	// delete and register the token pair again
+	tokenPair, found := erc20Keeper.GetTokenPair(ctx, erc20Keeper.GetDenomMap(ctx, s.bondDenom))
+	s.Require().True(found, "expected bond denom token pair")
+	erc20Keeper.DeleteTokenPair(ctx, tokenPair)
+	s.Require().NoError(erc20Keeper.SetToken(ctx, tokenPair))
+
+	precompile, err := erc20.NewPrecompile(tokenPair, s.network.App.GetBankKeeper(), erc20Keeper, s.network.App.GetTransferKeeper())
+	s.Require().NoError(err)
+	s.Require().Equal(s.precompile2.Address(), precompile.Address())
+
+	nonce := erc20Keeper.GetNonce(ctx, precompile.Address(), s.keyring.GetAddr(0))
+	s.Require().Equal(common.Big1.String(), nonce.String(), "expected the nonce to be kept")
+
+	_, err = precompile.Permit(ctx, contract, s.network.GetStateDB(), &method, args)
+	s.Require().ErrorContains(err, erc20.ErrPermitInvalidSignature.Error(), "expected replayed permit to fail")
+	s.requireAllowance(precompile.Address(), s.keyring.GetAddr(0), s.keyring.GetAddr(1), common.Big0)
+}
+
+// permitDeadline returns a permit deadline one hour after the block time.
+func (s *PrecompileTestSuite) permitDeadline(ctx sdk.Context) *big.Int {
+	return big.NewInt(ctx.BlockTime().Unix() + 3600)
+}
+
+// permitArgs returns the arguments of a permit of precompile2 signed by the
+// given key, with the signer as owner.
+func (s *PrecompileTestSuite) permitArgs(
+	key testkeyring.Key, spender common.Address, value, nonce, deadline *big.Int,
+) []interface{} {
+	metadata, found := s.network.App.GetBankKeeper().GetDenomMetaData(s.network.GetContext(), s.bondDenom)
+	s.Require().True(found, "expected bond denom metadata")
+
+	typedData := erc20.PermitTypedData(metadata.Name, s.precompile2.Address(), key.Addr, spender, value, nonce, deadline)
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	s.Require().NoError(err, "failed to hash permit")
+
+	signature, err := key.Priv.Sign(hash)
+	s.Require().NoError(err, "failed to sign permit")
+
+	var r, sig [32]byte
+	copy(r[:], signature[:32])
+	copy(sig[:], signature[32:64])
+
+	return []interface{}{key.Addr, spender, value, deadline, signature[64] + 27, r, sig}
+}
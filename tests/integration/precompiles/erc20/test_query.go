@@ -604,3 +604,90 @@ func (s *PrecompileTestSuite) TestAllowance() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestNonces() {
+	method := s.precompile.Methods[erc20.NoncesMethod]
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+		expNonce    *big.Int
+	}{
+		{
+			name: "fail - invalid number of arguments",
+			malleate: func() []interface{} {
+				return []interface{}{}
+			},
+			errContains: "invalid number of arguments; expected 1; got: 0",
+		},
+		{
+			name: "fail - invalid owner address",
+			malleate: func() []interface{} {
+				return []interface{}{"invalid address"}
+			},
+			errContains: "invalid owner address: invalid address",
+		},
+		{
+			name: "pass - no permit used should return 0",
+			malleate: func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0)}
+			},
+			expPass:  true,
+			expNonce: common.Big0,
+		},
+		{
+			name: "pass - permit used",
+			malleate: func() []interface{} {
+				_, err := s.network.App.GetErc20Keeper().UseNonce(s.network.GetContext(), s.precompile.Address(), s.keyring.GetAddr(0))
+				s.Require().NoError(err)
+
+				return []interface{}{s.keyring.GetAddr(0)}
+			},
+			expPass:  true,
+			expNonce: common.Big1,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			bz, err := s.precompile.Nonces(
+				s.network.GetContext(),
+				nil,
+				nil,
+				&method,
+				tc.malleate(),
+			)
+
+			// NOTE: all output and error checking happens in here
+			s.requireOut(bz, err, method, tc.expPass, tc.errContains, tc.expNonce)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestDomainSeparator() {
+	s.SetupTest()
+	method := s.precompile2.Methods[erc20.DomainSeparatorMethod]
+
+	metadata, found := s.network.App.GetBankKeeper().GetDenomMetaData(s.network.GetContext(), s.bondDenom)
+	s.Require().True(found, "expected bond denom metadata")
+
+	expSeparator, err := erc20.PermitDomainSeparator(metadata.Name, s.precompile2.Address())
+	s.Require().NoError(err)
+
+	bz, err := s.precompile2.DomainSeparator(s.network.GetContext(), nil, nil, &method, nil)
+	s.requireOut(bz, err, method, true, "", [32]byte(expSeparator))
+
+	// the domain separator depends on the address of the token
+	otherSeparator, err := erc20.PermitDomainSeparator(metadata.Name, s.precompile.Address())
+	s.Require().NoError(err)
+	s.Require().NotEqual(expSeparator, otherSeparator)
+
+	// the token name is required to build the domain separator
+	method = s.precompile.Methods[erc20.DomainSeparatorMethod]
+	bz, err = s.precompile.DomainSeparator(s.network.GetContext(), nil, nil, &method, nil)
+	s.requireOut(bz, err, method, false, vm.ErrExecutionReverted.Error(), nil)
+}
@@ -0,0 +1,51 @@
+package feegrant
+
+import (
+	"math/big"
+	"time"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/feegrant"
+
+	"cosmossdk.io/math"
+	sdkfeegrant "cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *PrecompileTestSuite) TestAllowance() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	method := s.precompile.Methods[feegrant.AllowanceMethod]
+	granter, grantee := s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1)
+	args := []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+
+	_, err := s.precompile.Allowance(ctx, &method, nil, args)
+	s.Require().ErrorContains(err, "fee-grant not found")
+
+	expiration := ctx.BlockTime().Add(time.Hour).Truncate(time.Second)
+	err = s.network.App.GetFeeGrantKeeper().GrantAllowance(ctx, granter, grantee, &sdkfeegrant.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewCoin("atest", math.NewInt(1e18))),
+		Expiration: &expiration,
+	})
+	s.Require().NoError(err)
+
+	bz, err := s.precompile.Allowance(ctx, &method, nil, args)
+	s.Require().NoError(err)
+
+	var out feegrant.AllowanceOutput
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&out, feegrant.AllowanceMethod, bz))
+	s.Require().Equal(feegrant.BasicAllowance{
+		SpendLimit: []cmn.Coin{{Denom: "atest", Amount: big.NewInt(1e18)}},
+		Expiration: uint64(expiration.Unix()), //nolint:gosec // G115
+	}, out.Allowance)
+
+	// allowances other than basic ones are not supported
+	s.Require().NoError(s.network.App.GetFeeGrantKeeper().GrantAllowance(ctx, grantee, granter, &sdkfeegrant.PeriodicAllowance{
+		Period:           time.Hour,
+		PeriodSpendLimit: sdk.NewCoins(sdk.NewCoin("atest", math.NewInt(1))),
+		PeriodCanSpend:   sdk.NewCoins(sdk.NewCoin("atest", math.NewInt(1))),
+	}))
+	_, err = s.precompile.Allowance(ctx, &method, nil, []interface{}{s.keyring.GetAddr(1), s.keyring.GetAddr(0)})
+	s.Require().ErrorContains(err, "unsupported allowance type /cosmos.feegrant.v1beta1.PeriodicAllowance")
+}
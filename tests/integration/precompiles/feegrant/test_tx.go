@@ -0,0 +1,191 @@
+package feegrant
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/feegrant"
+	"github.com/cosmos/evm/precompiles/testutil"
+
+	"cosmossdk.io/math"
+	sdkfeegrant "cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// grantAllowance grants the grantee a basic allowance with the spend limit on
+// behalf of the granter.
+func (s *PrecompileTestSuite) grantAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, spendLimit sdk.Coins) {
+	err := s.network.App.GetFeeGrantKeeper().GrantAllowance(ctx, granter, grantee, &sdkfeegrant.BasicAllowance{SpendLimit: spendLimit})
+	s.Require().NoError(err)
+}
+
+func (s *PrecompileTestSuite) TestGrantAllowance() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[feegrant.GrantAllowanceMethod]
+	spendLimit := []cmn.Coin{{Denom: "atest", Amount: big.NewInt(1e18)}}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1)}
+			},
+			func() {},
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 1),
+		},
+		{
+			"fail - empty grantee",
+			func() []interface{} {
+				return []interface{}{common.Address{}, feegrant.BasicAllowance{SpendLimit: spendLimit}}
+			},
+			func() {},
+			"invalid grantee address",
+		},
+		{
+			"fail - grantee is the granter",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), feegrant.BasicAllowance{SpendLimit: spendLimit}}
+			},
+			func() {},
+			"cannot self-grant fee authorization",
+		},
+		{
+			"fail - invalid spend limit",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), feegrant.BasicAllowance{
+					SpendLimit: []cmn.Coin{{Denom: "atest", Amount: big.NewInt(0)}},
+				}}
+			},
+			func() {},
+			"invalid allowance",
+		},
+		{
+			"fail - expired allowance",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), feegrant.BasicAllowance{
+					SpendLimit: spendLimit,
+					Expiration: uint64(ctx.BlockTime().Unix() - 1), //nolint:gosec // G115
+				}}
+			},
+			func() {},
+			"expiration is before current block time",
+		},
+		{
+			"fail - allowance already exists",
+			func() []interface{} {
+				s.grantAllowance(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), nil)
+				return []interface{}{s.keyring.GetAddr(1), feegrant.BasicAllowance{SpendLimit: spendLimit}}
+			},
+			func() {},
+			"fee allowance already exists",
+		},
+		{
+			"pass - allowance with a spend limit and an expiration",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), feegrant.BasicAllowance{
+					SpendLimit: spendLimit,
+					Expiration: uint64(ctx.BlockTime().Add(time.Hour).Unix()), //nolint:gosec // G115
+				}}
+			},
+			func() {
+				allowance, err := s.network.App.GetFeeGrantKeeper().GetAllowance(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1))
+				s.Require().NoError(err)
+				basic, ok := allowance.(*sdkfeegrant.BasicAllowance)
+				s.Require().True(ok)
+				s.Require().Equal(sdk.NewCoins(sdk.NewCoin("atest", math.NewInt(1e18))), basic.SpendLimit)
+				s.Require().Equal(ctx.BlockTime().Add(time.Hour).Unix(), basic.Expiration.Unix())
+			},
+			"",
+		},
+		{
+			"pass - unlimited allowance without expiration",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), feegrant.BasicAllowance{SpendLimit: []cmn.Coin{}}}
+			},
+			func() {
+				allowance, err := s.network.App.GetFeeGrantKeeper().GetAllowance(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1))
+				s.Require().NoError(err)
+				basic, ok := allowance.(*sdkfeegrant.BasicAllowance)
+				s.Require().True(ok)
+				s.Require().Empty(basic.SpendLimit)
+				s.Require().Nil(basic.Expiration)
+			},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+			stateDB := s.network.GetStateDB()
+
+			bz, err := s.precompile.GrantAllowance(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Equal(true, out[0])
+			tc.postCheck()
+
+			s.Require().Len(stateDB.Logs(), 1)
+			var event feegrant.EventGrantAllowance
+			s.Require().NoError(cmn.UnpackLog(s.precompile.ABI, &event, feegrant.EventTypeGrantAllowance, *stateDB.Logs()[0]))
+			s.Require().Equal(s.keyring.GetAddr(0), event.Granter)
+			s.Require().Equal(s.keyring.GetAddr(1), event.Grantee)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevokeAllowance() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	method := s.precompile.Methods[feegrant.RevokeAllowanceMethod]
+
+	var contract *vm.Contract
+	contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+	_, err := s.precompile.RevokeAllowance(ctx, contract, s.network.GetStateDB(), &method, []interface{}{s.keyring.GetAddr(1)})
+	s.Require().ErrorContains(err, "fee-grant not found")
+
+	s.grantAllowance(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), nil)
+
+	stateDB := s.network.GetStateDB()
+	_, err = s.precompile.RevokeAllowance(ctx, contract, stateDB, &method, []interface{}{s.keyring.GetAddr(1)})
+	s.Require().NoError(err)
+
+	_, err = s.network.App.GetFeeGrantKeeper().GetAllowance(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1))
+	s.Require().ErrorContains(err, "fee-grant not found")
+
+	s.Require().Len(stateDB.Logs(), 1)
+	var event feegrant.EventRevokeAllowance
+	s.Require().NoError(cmn.UnpackLog(s.precompile.ABI, &event, feegrant.EventTypeRevokeAllowance, *stateDB.Logs()[0]))
+	s.Require().Equal(feegrant.EventRevokeAllowance{Granter: s.keyring.GetAddr(0), Grantee: s.keyring.GetAddr(1)}, event)
+}
+
+func (s *PrecompileTestSuite) TestIsTransaction() {
+	s.SetupTest()
+
+	for name, method := range s.precompile.Methods {
+		expected := name != feegrant.AllowanceMethod
+		s.Require().Equal(expected, s.precompile.IsTransaction(&method), name)
+	}
+}
@@ -0,0 +1,32 @@
+package ica
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm"
+	"github.com/cosmos/evm/precompiles/ica"
+)
+
+func (s *PrecompileTestSuite) TestInterchainAccountAddress() {
+	s.SetupTest()
+	ctx := s.chainA.GetContext()
+	method := s.precompile.Methods[ica.InterchainAccountAddressMethod]
+	owner := common.BytesToAddress(s.chainA.SenderAccounts[0].SenderAccount.GetAddress().Bytes())
+	connectionID := "connection-0"
+
+	_, err := s.precompile.InterchainAccountAddress(ctx, &method, nil, []interface{}{"", owner})
+	s.Require().ErrorContains(err, "invalid connection id")
+
+	_, err = s.precompile.InterchainAccountAddress(ctx, &method, nil, []interface{}{connectionID, owner})
+	s.Require().ErrorContains(err, "failed to retrieve account address")
+
+	accountAddress := s.chainB.SenderAccount.GetAddress().String()
+	s.chainA.App.(evm.EvmApp).GetICAControllerKeeper().SetInterchainAccountAddress(ctx, connectionID, s.ownerPortID(), accountAddress)
+
+	bz, err := s.precompile.InterchainAccountAddress(ctx, &method, nil, []interface{}{connectionID, owner})
+	s.Require().NoError(err)
+
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal(accountAddress, out[0])
+}
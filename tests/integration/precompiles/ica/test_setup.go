@@ -0,0 +1,57 @@
+package ica
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm"
+	"github.com/cosmos/evm/precompiles/ica"
+	evmibctesting "github.com/cosmos/evm/testutil/ibc"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+	internalT   *testing.T
+	coordinator *evmibctesting.Coordinator
+
+	create     ibctesting.AppCreator
+	chainA     *evmibctesting.TestChain
+	chainB     *evmibctesting.TestChain
+	precompile *ica.Precompile
+}
+
+//nolint:thelper // NewPrecompileTestSuite is not a helper function; it's an instantiation function for the test suite.
+func NewPrecompileTestSuite(t *testing.T, create ibctesting.AppCreator) *PrecompileTestSuite {
+	return &PrecompileTestSuite{
+		internalT: t,
+		create:    create,
+	}
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	// Setup IBC
+	if s.internalT == nil {
+		s.internalT = s.T()
+	}
+	s.coordinator = evmibctesting.NewCoordinator(s.internalT, 2, 0, s.create)
+	s.chainA = s.coordinator.GetChain(evmibctesting.GetEvmChainID(1))
+	s.chainB = s.coordinator.GetChain(evmibctesting.GetEvmChainID(2))
+
+	evmAppA := s.chainA.App.(evm.EvmApp)
+	icaControllerKeeper := evmAppA.GetICAControllerKeeper()
+
+	var err error
+	s.precompile, err = ica.NewPrecompile(
+		icacontrollerkeeper.NewMsgServerImpl(icaControllerKeeper),
+		icaControllerKeeper,
+		evmAppA.GetBankKeeper(),
+		addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+	)
+	s.Require().NoError(err)
+}
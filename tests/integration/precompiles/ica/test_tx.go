@@ -0,0 +1,234 @@
+package ica
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/cosmos/evm"
+	"github.com/cosmos/evm/precompiles/ica"
+	evmibctesting "github.com/cosmos/evm/testutil/ibc"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (s *PrecompileTestSuite) TestRegisterInterchainAccount() {
+	var path *evmibctesting.Path
+
+	testCases := []struct {
+		name         string
+		connectionID func() string
+		errContains  string
+	}{
+		{
+			"fail - empty connection id",
+			func() string { return "" },
+			"invalid connection id",
+		},
+		{
+			"fail - connection not found",
+			func() string { return "connection-9" },
+			"connection not found",
+		},
+		{
+			"pass",
+			func() string { return path.EndpointA.ConnectionID },
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			path = evmibctesting.NewPath(s.chainA, s.chainB)
+			path.SetupConnections()
+
+			data, err := s.precompile.Pack(ica.RegisterInterchainAccountMethod, tc.connectionID(), "")
+			s.Require().NoError(err)
+
+			_, _, res, err := s.chainA.SendEvmTx(s.chainA.SenderAccounts[0], 0, s.precompile.Address(), big.NewInt(0), data, 0)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, vm.ErrExecutionReverted.Error())
+				s.Require().Contains(evmtypes.NewExecErrorWithReason(res.Ret).Error(), tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			out, err := s.precompile.Unpack(ica.RegisterInterchainAccountMethod, res.Ret)
+			s.Require().NoError(err)
+			channelID, ok := out[0].(string)
+			s.Require().True(ok)
+
+			portID := s.ownerPortID()
+			channel, found := s.chainA.App.(evm.EvmApp).GetIBCKeeper().ChannelKeeper.GetChannel(s.chainA.GetContext(), portID, channelID)
+			s.Require().True(found)
+			s.Require().Equal(channeltypes.INIT, channel.State)
+			s.Require().Equal(channeltypes.UNORDERED, channel.Ordering)
+
+			s.Require().Len(res.Logs, 1)
+			s.Require().Equal(s.precompile.Events[ica.EventTypeRegisterInterchainAccount].ID.Hex(), res.Logs[0].Topics[0])
+
+			var event ica.EventRegisterInterchainAccount
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&event, ica.EventTypeRegisterInterchainAccount, res.Logs[0].Data))
+			s.Require().Equal(path.EndpointA.ConnectionID, event.ConnectionID)
+			s.Require().Equal(channelID, event.ChannelID)
+			s.Require().Equal(portID, event.PortID)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestSendTx() {
+	var (
+		msgs        [][]byte
+		timeout     uint64
+		openChannel bool
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		errContains string
+	}{
+		{
+			"fail - empty messages",
+			func() {
+				msgs = [][]byte{}
+			},
+			"messages cannot be empty",
+		},
+		{
+			"fail - invalid message",
+			func() {
+				msgs = [][]byte{{0xff}}
+			},
+			"invalid message at index 0",
+		},
+		{
+			"fail - zero timeout",
+			func() {
+				timeout = 0
+			},
+			"relative timeout cannot be zero",
+		},
+		{
+			"fail - channel not open",
+			func() {
+				openChannel = false
+			},
+			"failed to retrieve active channel",
+		},
+		{
+			"pass",
+			func() {},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			path := evmibctesting.NewPath(s.chainA, s.chainB)
+			path.SetupConnections()
+
+			msgAny, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{
+				FromAddress: s.chainB.SenderAccount.GetAddress().String(),
+				ToAddress:   s.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1))),
+			})
+			s.Require().NoError(err)
+			msgBz, err := msgAny.Marshal()
+			s.Require().NoError(err)
+
+			msgs = [][]byte{msgBz}
+			timeout = uint64(time.Hour.Nanoseconds())
+			openChannel = true
+			tc.malleate()
+
+			channelID := s.registerInterchainAccount(path.EndpointA.ConnectionID)
+			if openChannel {
+				s.openChannel(path.EndpointA.ConnectionID, channelID)
+			}
+
+			data, err := s.precompile.Pack(ica.SendTxMethod, path.EndpointA.ConnectionID, msgs, timeout)
+			s.Require().NoError(err)
+
+			_, _, res, err := s.chainA.SendEvmTx(s.chainA.SenderAccounts[0], 0, s.precompile.Address(), big.NewInt(0), data, 0)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, vm.ErrExecutionReverted.Error())
+				s.Require().Contains(evmtypes.NewExecErrorWithReason(res.Ret).Error(), tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			out, err := s.precompile.Unpack(ica.SendTxMethod, res.Ret)
+			s.Require().NoError(err)
+			s.Require().Equal(uint64(1), out[0])
+
+			commitment := s.chainA.App.(evm.EvmApp).GetIBCKeeper().ChannelKeeper.GetPacketCommitment(s.chainA.GetContext(), s.ownerPortID(), channelID, 1)
+			s.Require().NotEmpty(commitment)
+
+			s.Require().Len(res.Logs, 1)
+			var event ica.EventSendTx
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&event, ica.EventTypeSendTx, res.Logs[0].Data))
+			s.Require().Equal(path.EndpointA.ConnectionID, event.ConnectionID)
+			s.Require().Equal(uint64(1), event.Sequence)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestIsTransaction() {
+	s.SetupTest()
+
+	for name, method := range s.precompile.Methods {
+		expected := name != ica.InterchainAccountAddressMethod
+		s.Require().Equal(expected, s.precompile.IsTransaction(&method), name)
+	}
+}
+
+// ownerPortID returns the controller port of the first sender account of chain A.
+func (s *PrecompileTestSuite) ownerPortID() string {
+	portID, err := icatypes.NewControllerPortID(s.chainA.SenderAccounts[0].SenderAccount.GetAddress().String())
+	s.Require().NoError(err)
+	return portID
+}
+
+// registerInterchainAccount registers an interchain account of the first
+// sender account of chain A through the precompile and returns its channel.
+func (s *PrecompileTestSuite) registerInterchainAccount(connectionID string) string {
+	data, err := s.precompile.Pack(ica.RegisterInterchainAccountMethod, connectionID, "")
+	s.Require().NoError(err)
+
+	_, _, res, err := s.chainA.SendEvmTx(s.chainA.SenderAccounts[0], 0, s.precompile.Address(), big.NewInt(0), data, 0)
+	s.Require().NoError(err)
+
+	out, err := s.precompile.Unpack(ica.RegisterInterchainAccountMethod, res.Ret)
+	s.Require().NoError(err)
+	channelID, ok := out[0].(string)
+	s.Require().True(ok)
+	return channelID
+}
+
+// openChannel opens the interchain account channel on chain A as if the
+// handshake completed with a host chain.
+func (s *PrecompileTestSuite) openChannel(connectionID, channelID string) {
+	evmApp := s.chainA.App.(evm.EvmApp)
+	ctx := s.chainA.GetContext()
+	portID := s.ownerPortID()
+
+	channel, found := evmApp.GetIBCKeeper().ChannelKeeper.GetChannel(ctx, portID, channelID)
+	s.Require().True(found)
+	channel.State = channeltypes.OPEN
+	channel.Counterparty.ChannelId = "channel-0"
+	evmApp.GetIBCKeeper().ChannelKeeper.SetChannel(ctx, portID, channelID, channel)
+
+	evmApp.GetICAControllerKeeper().SetActiveChannelID(ctx, connectionID, portID, channelID)
+	s.coordinator.CommitBlock(s.chainA)
+}
@@ -66,3 +66,160 @@ func (s *PrecompileTestSuite) TestEmitMintEvent() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestEmitMinterAddedEvent() {
+	s.SetupTest()
+	stateDB := s.network.GetStateDB()
+
+	minter := utiltx.GenerateAddress()
+	allowance := big.NewInt(1000)
+
+	err := s.precompile.EmitMinterAddedEvent(s.network.GetContext(), stateDB, minter, "uusdc", allowance)
+	s.Require().NoError(err, "expected minter added event to be emitted successfully")
+
+	log := stateDB.Logs()[0]
+	s.Require().Equal(log.Address, s.precompile.Address())
+
+	event := s.precompile.Events[mint.EventTypeMinterAdded]
+	s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+
+	var minterAddedEvent mint.EventMinterAdded
+	err = cmn.UnpackLog(s.precompile.ABI, &minterAddedEvent, mint.EventTypeMinterAdded, *log)
+	s.Require().NoError(err, "unable to unpack log into minter added event")
+
+	s.Require().Equal(minter, minterAddedEvent.Minter, "expected different minter")
+	s.Require().Equal("uusdc", minterAddedEvent.Denom, "expected different denom")
+	s.Require().Equal(allowance, minterAddedEvent.Allowance, "expected different allowance")
+}
+
+func (s *PrecompileTestSuite) TestEmitMinterRemovedEvent() {
+	s.SetupTest()
+	stateDB := s.network.GetStateDB()
+
+	minter := utiltx.GenerateAddress()
+
+	err := s.precompile.EmitMinterRemovedEvent(s.network.GetContext(), stateDB, minter, "uusdc")
+	s.Require().NoError(err, "expected minter removed event to be emitted successfully")
+
+	log := stateDB.Logs()[0]
+	s.Require().Equal(log.Address, s.precompile.Address())
+
+	event := s.precompile.Events[mint.EventTypeMinterRemoved]
+	s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+
+	var minterRemovedEvent mint.EventMinterRemoved
+	err = cmn.UnpackLog(s.precompile.ABI, &minterRemovedEvent, mint.EventTypeMinterRemoved, *log)
+	s.Require().NoError(err, "unable to unpack log into minter removed event")
+
+	s.Require().Equal(minter, minterRemovedEvent.Minter, "expected different minter")
+	s.Require().Equal("uusdc", minterRemovedEvent.Denom, "expected different denom")
+}
+
+func (s *PrecompileTestSuite) TestEmitBurnEvent() {
+	s.SetupTest()
+	stateDB := s.network.GetStateDB()
+
+	from := utiltx.GenerateAddress()
+	value := big.NewInt(1000)
+
+	err := s.precompile.EmitBurnEvent(s.network.GetContext(), stateDB, from, "umint", value)
+	s.Require().NoError(err, "expected burn event to be emitted successfully")
+
+	log := stateDB.Logs()[0]
+	s.Require().Equal(log.Address, s.precompile.Address())
+
+	event := s.precompile.Events[mint.EventTypeBurn]
+	s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+
+	var burnEvent mint.EventBurn
+	err = cmn.UnpackLog(s.precompile.ABI, &burnEvent, mint.EventTypeBurn, *log)
+	s.Require().NoError(err, "unable to unpack log into burn event")
+
+	s.Require().Equal(from, burnEvent.From, "expected different from address")
+	s.Require().Equal("umint", burnEvent.Token, "expected different token")
+	s.Require().Equal(value, burnEvent.Value, "expected different value")
+}
+
+func (s *PrecompileTestSuite) TestEmitDenomMetadataSetEvent() {
+	s.SetupTest()
+	stateDB := s.network.GetStateDB()
+
+	err := s.precompile.EmitDenomMetadataSetEvent(s.network.GetContext(), stateDB, "uusdc", "USD Coin", "USDC", 6)
+	s.Require().NoError(err, "expected denom metadata set event to be emitted successfully")
+
+	log := stateDB.Logs()[0]
+	s.Require().Equal(log.Address, s.precompile.Address())
+
+	event := s.precompile.Events[mint.EventTypeDenomMetadataSet]
+	s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+
+	var metadataSetEvent mint.EventDenomMetadataSet
+	err = cmn.UnpackLog(s.precompile.ABI, &metadataSetEvent, mint.EventTypeDenomMetadataSet, *log)
+	s.Require().NoError(err, "unable to unpack log into denom metadata set event")
+
+	s.Require().Equal("uusdc", metadataSetEvent.Denom, "expected different denom")
+	s.Require().Equal("USD Coin", metadataSetEvent.Name, "expected different name")
+	s.Require().Equal("USDC", metadataSetEvent.Symbol, "expected different symbol")
+	s.Require().Equal(uint8(6), metadataSetEvent.Decimals, "expected different decimals")
+}
+
+func (s *PrecompileTestSuite) TestEmitMintedTokenRegisteredEvent() {
+	s.SetupTest()
+	stateDB := s.network.GetStateDB()
+	erc20 := utiltx.GenerateAddress()
+
+	err := s.precompile.EmitMintedTokenRegisteredEvent(s.network.GetContext(), stateDB, "umint", erc20)
+	s.Require().NoError(err, "expected minted token registered event to be emitted successfully")
+
+	log := stateDB.Logs()[0]
+	s.Require().Equal(log.Address, s.precompile.Address())
+
+	event := s.precompile.Events[mint.EventTypeMintedTokenRegistered]
+	s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+
+	var registeredEvent mint.EventMintedTokenRegistered
+	err = cmn.UnpackLog(s.precompile.ABI, &registeredEvent, mint.EventTypeMintedTokenRegistered, *log)
+	s.Require().NoError(err, "unable to unpack log into minted token registered event")
+
+	s.Require().Equal("umint", registeredEvent.Denom, "expected different denom")
+	s.Require().Equal(erc20, registeredEvent.Erc20, "expected different erc20 address")
+}
+
+func (s *PrecompileTestSuite) TestEmitPauseEvents() {
+	s.SetupTest()
+	stateDB := s.network.GetStateDB()
+
+	guardian := utiltx.GenerateAddress()
+
+	err := s.precompile.EmitPausedEvent(s.network.GetContext(), stateDB, guardian)
+	s.Require().NoError(err, "expected paused event to be emitted successfully")
+	err = s.precompile.EmitUnpausedEvent(s.network.GetContext(), stateDB, guardian)
+	s.Require().NoError(err, "expected unpaused event to be emitted successfully")
+	err = s.precompile.EmitDenomPausedEvent(s.network.GetContext(), stateDB, guardian, "uusdc")
+	s.Require().NoError(err, "expected denom paused event to be emitted successfully")
+
+	logs := stateDB.Logs()
+	s.Require().Len(logs, 3)
+
+	for i, eventType := range []string{mint.EventTypePaused, mint.EventTypeUnpaused} {
+		log := logs[i]
+		s.Require().Equal(log.Address, s.precompile.Address())
+
+		event := s.precompile.Events[eventType]
+		s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+
+		var pausedEvent mint.EventPaused
+		err = cmn.UnpackLog(s.precompile.ABI, &pausedEvent, eventType, *log)
+		s.Require().NoError(err, "unable to unpack log into %s event", eventType)
+		s.Require().Equal(guardian, pausedEvent.Guardian, "expected different guardian")
+	}
+
+	event := s.precompile.Events[mint.EventTypeDenomPaused]
+	s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(logs[2].Topics[0].Hex()))
+
+	var denomPausedEvent mint.EventDenomPaused
+	err = cmn.UnpackLog(s.precompile.ABI, &denomPausedEvent, mint.EventTypeDenomPaused, *logs[2])
+	s.Require().NoError(err, "unable to unpack log into denom paused event")
+	s.Require().Equal(guardian, denomPausedEvent.Guardian, "expected different guardian")
+	s.Require().Equal("uusdc", denomPausedEvent.Denom, "expected different denom")
+}
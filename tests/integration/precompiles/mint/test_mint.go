@@ -3,15 +3,63 @@ package mint
 import (
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/cosmos/evm/precompiles/mint"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 )
 
 func (s *PrecompileTestSuite) TestIsTransaction() {
 	s.SetupTest()
 
-	// The mint method should be seen as a transaction
-	method := s.precompile.Methods[mint.MintMethod]
-	s.Require().True(s.precompile.IsTransaction(&method), "mint should be identified as a transaction")
+	// The mint, burn and minter registry methods should be seen as transactions
+	for _, name := range []string{
+		mint.MintMethod,
+		mint.BurnMethod,
+		mint.BurnFromMethod,
+		mint.MintBatchMethod,
+		mint.BurnBatchMethod,
+		mint.AddMinterMethod,
+		mint.RemoveMinterMethod,
+		mint.SetDenomMetadataMethod,
+		mint.PauseMethod,
+		mint.UnpauseMethod,
+		mint.PauseDenomMethod,
+		mint.ProposeMintMethod,
+		mint.ApproveMintMethod,
+		mint.ExecuteMintMethod,
+		mint.CancelMintMethod,
+		mint.MintWithSignatureMethod,
+		mint.MintVestingMethod,
+		mint.MintPeriodicVestingMethod,
+		mint.CreateDenomMethod,
+		mint.ChangeAdminMethod,
+	} {
+		method := s.precompile.Methods[name]
+		s.Require().True(s.precompile.IsTransaction(&method), "%s should be identified as a transaction", name)
+	}
+
+	// The view methods should be seen as queries
+	for _, name := range []string{
+		mint.MinterAllowanceMethod,
+		mint.RemainingSupplyMethod,
+		mint.RemainingRateLimitMethod,
+		mint.DenomMetadataMethod,
+		mint.AuthorityMethod,
+		mint.TotalMintedMethod,
+		mint.TotalBurnedMethod,
+		mint.MintedByMethod,
+		mint.IsPausedMethod,
+		mint.MintProposalMethod,
+		mint.IsVoucherNonceUsedMethod,
+		mint.DomainSeparatorMethod,
+		mint.DenomAdminMethod,
+		mint.EmissionSchedulesMethod,
+		mint.EmissionScheduleMethod,
+	} {
+		method := s.precompile.Methods[name]
+		s.Require().False(s.precompile.IsTransaction(&method), "%s should be identified as a query", name)
+	}
 }
 
 func (s *PrecompileTestSuite) TestRequiredGas() {
@@ -35,6 +83,344 @@ func (s *PrecompileTestSuite) TestRequiredGas() {
 			},
 			expGas: mint.GasMint,
 		},
+		{
+			name: mint.BurnMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.BurnMethod, "umint", big.NewInt(1000))
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasBurn,
+		},
+		{
+			name: mint.BurnFromMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.BurnFromMethod, s.keyring.GetAddr(1), "umint", big.NewInt(1000))
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasBurnFrom,
+		},
+		{
+			name: mint.MintBatchMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(
+					mint.MintBatchMethod,
+					[]common.Address{s.keyring.GetAddr(1), s.keyring.GetAddr(2)},
+					"umint",
+					[]*big.Int{big.NewInt(1000), big.NewInt(1000)},
+				)
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			// 3 head words, 3 words per array and 2 for the denom
+			expGas: mint.GasBatchBase + 11*mint.GasBatchPerWord,
+		},
+		{
+			name: mint.BurnBatchMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(
+					mint.BurnBatchMethod,
+					[]common.Address{s.keyring.GetAddr(1), s.keyring.GetAddr(2), s.keyring.GetAddr(0)},
+					"umint",
+					[]*big.Int{big.NewInt(1000), big.NewInt(1000), big.NewInt(1000)},
+				)
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasBatchBase + 13*mint.GasBatchPerWord,
+		},
+		{
+			name: "mintBatch with malformed arguments",
+			malleate: func() []byte {
+				return s.precompile.Methods[mint.MintBatchMethod].ID
+			},
+			expGas: mint.GasBatchBase,
+		},
+		{
+			name: "mintBatch charged by argument size without decoding",
+			malleate: func() []byte {
+				return append(s.precompile.Methods[mint.MintBatchMethod].ID, make([]byte, 65)...)
+			},
+			expGas: mint.GasBatchBase + 3*mint.GasBatchPerWord,
+		},
+		{
+			name: mint.AddMinterMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.AddMinterMethod, s.keyring.GetAddr(1), "uusdc", big.NewInt(1000))
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasAddMinter,
+		},
+		{
+			name: mint.RemoveMinterMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.RemoveMinterMethod, s.keyring.GetAddr(1), "uusdc")
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasRemoveMinter,
+		},
+		{
+			name: mint.MinterAllowanceMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.MinterAllowanceMethod, s.keyring.GetAddr(1), "uusdc")
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasMinterAllowance,
+		},
+		{
+			name: mint.RemainingSupplyMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.RemainingSupplyMethod, "uusdc")
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasRemainingSupply,
+		},
+		{
+			name: mint.RemainingRateLimitMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.RemainingRateLimitMethod, "uusdc")
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasRemainingRateLimit,
+		},
+		{
+			name: mint.SetDenomMetadataMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.SetDenomMetadataMethod, "uusdc", "USD Coin", "USDC", uint8(6))
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasSetDenomMetadata,
+		},
+		{
+			name: mint.DenomMetadataMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.DenomMetadataMethod, "uusdc")
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasDenomMetadata,
+		},
+		{
+			name: mint.AuthorityMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.AuthorityMethod)
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasAuthority,
+		},
+		{
+			name: mint.TotalMintedMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.TotalMintedMethod, "uusdc")
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasTotalMinted,
+		},
+		{
+			name: mint.TotalBurnedMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.TotalBurnedMethod, "uusdc")
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasTotalBurned,
+		},
+		{
+			name: mint.MintedByMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.MintedByMethod, s.keyring.GetAddr(1), "uusdc")
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasMintedBy,
+		},
+		{
+			name: mint.PauseMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.PauseMethod)
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasPause,
+		},
+		{
+			name: mint.UnpauseMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.UnpauseMethod)
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasUnpause,
+		},
+		{
+			name: mint.PauseDenomMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.PauseDenomMethod, "uusdc")
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasPauseDenom,
+		},
+		{
+			name: mint.IsPausedMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.IsPausedMethod, "uusdc")
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasIsPaused,
+		},
+		{
+			name: mint.ProposeMintMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.ProposeMintMethod, s.keyring.GetAddr(1), "umint", big.NewInt(1000), uint64(10))
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasProposeMint,
+		},
+		{
+			name: mint.ApproveMintMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.ApproveMintMethod, big.NewInt(1))
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasApproveMint,
+		},
+		{
+			name: mint.ExecuteMintMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.ExecuteMintMethod, big.NewInt(1))
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasExecuteMint,
+		},
+		{
+			name: mint.CancelMintMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.CancelMintMethod, big.NewInt(1))
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasCancelMint,
+		},
+		{
+			name: mint.MintProposalMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.MintProposalMethod, big.NewInt(1))
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasMintProposal,
+		},
+		{
+			name: mint.MintWithSignatureMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(
+					mint.MintWithSignatureMethod,
+					s.keyring.GetAddr(1), "umint", big.NewInt(1000), big.NewInt(1), big.NewInt(1), make([]byte, 65),
+				)
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasMintWithSignature,
+		},
+		{
+			name: mint.IsVoucherNonceUsedMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.IsVoucherNonceUsedMethod, s.keyring.GetAddr(1), big.NewInt(1))
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasIsVoucherNonceUsed,
+		},
+		{
+			name: mint.DomainSeparatorMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.DomainSeparatorMethod)
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasDomainSeparator,
+		},
+		{
+			name: mint.MintVestingMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.MintVestingMethod, s.keyring.GetAddr(1), "umint", big.NewInt(1000), uint64(1), uint64(2))
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasMintVesting,
+		},
+		{
+			name: mint.MintPeriodicVestingMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(
+					mint.MintPeriodicVestingMethod,
+					s.keyring.GetAddr(1), "umint", uint64(1), []uint64{10, 20, 30}, []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3)},
+				)
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			// 5 head words, 4 words per array and 2 for the denom
+			expGas: mint.GasMintVesting + 15*mint.GasMintPeriodicVestingPerWord,
+		},
+		{
+			name: mint.CreateDenomMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.CreateDenomMethod, "team")
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasCreateDenom,
+		},
+		{
+			name: mint.ChangeAdminMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.ChangeAdminMethod, "factory/creator/team", s.keyring.GetAddr(1))
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasChangeAdmin,
+		},
+		{
+			name: mint.DenomAdminMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.DenomAdminMethod, "factory/creator/team")
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasDenomAdmin,
+		},
+		{
+			name: mint.EmissionSchedulesMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.EmissionSchedulesMethod)
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasEmissionSchedules,
+		},
+		{
+			name: mint.EmissionScheduleMethod,
+			malleate: func() []byte {
+				bz, err := s.precompile.Pack(mint.EmissionScheduleMethod, big.NewInt(1))
+				s.Require().NoError(err, "expected no error packing ABI")
+				return bz
+			},
+			expGas: mint.GasEmissionSchedule,
+		},
 		{
 			name: "invalid method",
 			malleate: func() []byte {
@@ -62,52 +448,18 @@ func (s *PrecompileTestSuite) TestRequiredGas() {
 func (s *PrecompileTestSuite) TestAddress() {
 	s.SetupTest()
 
-	expectedAddr := "0x0000000000000000000000000000000000001111"
+	expectedAddr := evmtypes.MintPrecompileAddress
 	s.Require().Equal(expectedAddr, s.precompile.Address().Hex(), "expected different precompile address")
 }
 
 func (s *PrecompileTestSuite) TestNewPrecompile() {
-	testcases := []struct {
-		name        string
-		authority   string
-		expectError bool
-		errContains string
-	}{
-		{
-			name:        "pass - valid hex authority",
-			authority:   "0x742d35Cc6635C0532925a3b8D435C617d1C3d4E3",
-			expectError: false,
-		},
-		{
-			name:        "pass - valid bech32 authority",
-			authority:   "cosmos1wskv7h9wrxdkzr8npq8g4juh4etm9khqxvcje4",
-			expectError: false,
-		},
-		{
-			name:        "pass - empty authority (for testing)",
-			authority:   "",
-			expectError: false,
-		},
-	}
-
-	for _, tc := range testcases {
-		s.Run(tc.name, func() {
-			s.SetupTest()
-
-			precompile, err := mint.NewPrecompile(
-				tc.authority,
-				s.network.App.GetBankKeeper(),
-			)
+	s.SetupTest()
 
-			if tc.expectError {
-				s.Require().Error(err, "expected error creating precompile")
-				s.Require().Contains(err.Error(), tc.errContains, "expected different error message")
-				s.Require().Nil(precompile, "expected nil precompile")
-			} else {
-				s.Require().NoError(err, "expected no error creating precompile")
-				s.Require().NotNil(precompile, "expected non-nil precompile")
-				s.Require().Equal(tc.authority, precompile.GetAuthority(), "expected different authority")
-			}
-		})
-	}
+	precompile, err := mint.NewPrecompile(
+		s.network.App.GetEVMMintKeeper(),
+		s.network.App.GetBankKeeper(),
+		s.network.App.GetErc20Keeper(),
+	)
+	s.Require().NoError(err, "expected no error creating precompile")
+	s.Require().NotNil(precompile, "expected non-nil precompile")
 }
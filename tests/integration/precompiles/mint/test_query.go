@@ -0,0 +1,404 @@
+package mint
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethmath "github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/evm/precompiles/mint"
+	precompiletestutil "github.com/cosmos/evm/precompiles/testutil"
+	minttypes "github.com/cosmos/evm/x/mint/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *PrecompileTestSuite) TestMinterAllowance() {
+	// Once setup for all test cases!
+	s.SetupTest()
+
+	method := s.precompile.Methods[mint.MinterAllowanceMethod]
+	minter := s.keyring.GetKey(1)
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expAllow    *big.Int
+		expErr      bool
+		errContains string
+	}{
+		{
+			name: "fail - invalid number of arguments",
+			malleate: func() []interface{} {
+				return []interface{}{minter.Addr}
+			},
+			expErr:      true,
+			errContains: "invalid number of arguments",
+		},
+		{
+			name: "pass - minter not registered",
+			malleate: func() []interface{} {
+				return []interface{}{minter.Addr, "uatom"}
+			},
+			expAllow: big.NewInt(0),
+		},
+		{
+			name: "pass - registered minter",
+			malleate: func() []interface{} {
+				return []interface{}{minter.Addr, "uusdc"}
+			},
+			expAllow: big.NewInt(1000),
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			ctx := s.network.GetContext()
+			err := s.network.App.GetEVMMintKeeper().SetMinterAllowance(ctx, minter.AccAddr, "uusdc", math.NewInt(1000))
+			s.Require().NoError(err)
+
+			bz, err := s.precompile.MinterAllowance(ctx, &method, tc.malleate())
+			if tc.expErr {
+				s.Require().Error(err, "expected minterAllowance query to fail")
+				s.Require().Contains(err.Error(), tc.errContains, "expected different error message")
+				return
+			}
+
+			s.Require().NoError(err, "expected minterAllowance query to succeed")
+			var allowance *big.Int
+			err = s.precompile.UnpackIntoInterface(&allowance, mint.MinterAllowanceMethod, bz)
+			s.Require().NoError(err, "failed to unpack output")
+			s.Require().Zero(tc.expAllow.Cmp(allowance), "expected different allowance")
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRemainingSupplyAndRateLimit() {
+	testcases := []struct {
+		name         string
+		malleate     func()
+		args         []interface{}
+		expSupply    *big.Int
+		expRateLimit *big.Int
+		expErr       bool
+		errContains  string
+	}{
+		{
+			name:        "fail - invalid number of arguments",
+			args:        []interface{}{},
+			expErr:      true,
+			errContains: "invalid number of arguments",
+		},
+		{
+			name:         "pass - no limit",
+			args:         []interface{}{"uusdc"},
+			expSupply:    ethmath.MaxBig256,
+			expRateLimit: ethmath.MaxBig256,
+		},
+		{
+			name: "pass - supply cap only",
+			malleate: func() {
+				err := s.network.App.GetEVMMintKeeper().UpdateDenomMintLimit(
+					s.network.GetContext(),
+					minttypes.NewDenomMintLimit("uusdc", math.NewInt(5000), math.ZeroInt(), 0),
+				)
+				s.Require().NoError(err)
+			},
+			args:         []interface{}{"uusdc"},
+			expSupply:    big.NewInt(5000),
+			expRateLimit: ethmath.MaxBig256,
+		},
+		{
+			name: "pass - headroom shrinks after a mint",
+			malleate: func() {
+				ctx := s.network.GetContext()
+				mintKeeper := s.network.App.GetEVMMintKeeper()
+				err := mintKeeper.UpdateDenomMintLimit(
+					ctx,
+					minttypes.NewDenomMintLimit("uusdc", math.NewInt(5000), math.NewInt(1000), 10),
+				)
+				s.Require().NoError(err)
+
+				err = mintKeeper.TrackMint(ctx, "uusdc", math.NewInt(400))
+				s.Require().NoError(err)
+				coins := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 400))
+				err = s.network.App.GetBankKeeper().MintCoins(ctx, minttypes.ModuleName, coins)
+				s.Require().NoError(err)
+			},
+			args:         []interface{}{"uusdc"},
+			expSupply:    big.NewInt(4600),
+			expRateLimit: big.NewInt(600),
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+
+			for _, query := range []struct {
+				name     string
+				run      func(sdk.Context, *abi.Method, []interface{}) ([]byte, error)
+				expected *big.Int
+			}{
+				{mint.RemainingSupplyMethod, s.precompile.RemainingSupply, tc.expSupply},
+				{mint.RemainingRateLimitMethod, s.precompile.RemainingRateLimit, tc.expRateLimit},
+			} {
+				method := s.precompile.Methods[query.name]
+				bz, err := query.run(ctx, &method, tc.args)
+				if tc.expErr {
+					s.Require().Error(err, "expected %s query to fail", query.name)
+					s.Require().Contains(err.Error(), tc.errContains, "expected different error message")
+					continue
+				}
+
+				s.Require().NoError(err, "expected %s query to succeed", query.name)
+				var remaining *big.Int
+				err = s.precompile.UnpackIntoInterface(&remaining, query.name, bz)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Zero(query.expected.Cmp(remaining), "expected different %s", query.name)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestDenomMetadata() {
+	s.SetupTest()
+	method := s.precompile.Methods[mint.DenomMetadataMethod]
+	s.network.App.GetBankKeeper().SetDenomMetaData(
+		s.network.GetContext(),
+		mint.NewDenomMetadata("uusdc", "USD Coin", "USDC", 6),
+	)
+
+	testcases := []struct {
+		name        string
+		args        []interface{}
+		expErr      bool
+		errContains string
+	}{
+		{
+			name:        "fail - invalid number of arguments",
+			args:        []interface{}{},
+			expErr:      true,
+			errContains: "invalid number of arguments",
+		},
+		{
+			name:        "fail - metadata not found",
+			args:        []interface{}{"uatom"},
+			expErr:      true,
+			errContains: "denom metadata not found",
+		},
+		{
+			name: "pass - metadata set",
+			args: []interface{}{"uusdc"},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			bz, err := s.precompile.DenomMetadata(s.network.GetContext(), &method, tc.args)
+			if tc.expErr {
+				s.Require().Error(err, "expected denomMetadata query to fail")
+				s.Require().Contains(err.Error(), tc.errContains, "expected different error message")
+				return
+			}
+
+			s.Require().NoError(err, "expected denomMetadata query to succeed")
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err, "failed to unpack output")
+			s.Require().Equal([]interface{}{"USD Coin", "USDC", uint8(6)}, out)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestAuthority() {
+	s.SetupTest()
+	method := s.precompile.Methods[mint.AuthorityMethod]
+	ctx := s.network.GetContext()
+	mintKeeper := s.network.App.GetEVMMintKeeper()
+
+	_, err := s.precompile.Authority(ctx, &method, []interface{}{"uusdc"})
+	s.Require().ErrorContains(err, "invalid number of arguments")
+
+	mintKeeper.SetMintAuthority(ctx, nil)
+	bz, err := s.precompile.Authority(ctx, &method, []interface{}{})
+	s.Require().NoError(err, "expected authority query to succeed")
+	var authority common.Address
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&authority, mint.AuthorityMethod, bz))
+	s.Require().Equal(common.Address{}, authority, "expected the zero address without a mint authority")
+
+	mintKeeper.SetMintAuthority(ctx, s.keyring.GetAccAddr(0))
+	bz, err = s.precompile.Authority(ctx, &method, []interface{}{})
+	s.Require().NoError(err, "expected authority query to succeed")
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&authority, mint.AuthorityMethod, bz))
+	s.Require().Equal(s.keyring.GetAddr(0), authority, "expected the mint authority")
+}
+
+func (s *PrecompileTestSuite) TestMintStats() {
+	s.SetupTest()
+	minter := s.keyring.GetKey(1)
+	mintKeeper := s.network.App.GetEVMMintKeeper()
+
+	err := mintKeeper.SetMinterAllowance(s.network.GetContext(), minter.AccAddr, "umint", math.NewInt(10_000))
+	s.Require().NoError(err)
+
+	contract, ctx := precompiletestutil.NewPrecompileContract(s.T(), s.network.GetContext(), minter.Addr, s.precompile.Address(), 0)
+	mintMethod := s.precompile.Methods[mint.MintMethod]
+	_, err = s.precompile.Mint(ctx, contract, s.network.GetStateDB(), &mintMethod, []interface{}{minter.Addr, "umint", big.NewInt(1000)})
+	s.Require().NoError(err, "expected mint to succeed")
+
+	burnMethod := s.precompile.Methods[mint.BurnMethod]
+	_, err = s.precompile.Burn(ctx, contract, s.network.GetStateDB(), &burnMethod, []interface{}{"umint", big.NewInt(400)})
+	s.Require().NoError(err, "expected burn to succeed")
+
+	testcases := []struct {
+		name     string
+		method   string
+		args     []interface{}
+		expected *big.Int
+	}{
+		{
+			name:     "totalMinted",
+			method:   mint.TotalMintedMethod,
+			args:     []interface{}{"umint"},
+			expected: big.NewInt(1000),
+		},
+		{
+			name:     "totalBurned",
+			method:   mint.TotalBurnedMethod,
+			args:     []interface{}{"umint"},
+			expected: big.NewInt(400),
+		},
+		{
+			name:     "mintedBy - minter",
+			method:   mint.MintedByMethod,
+			args:     []interface{}{minter.Addr, "umint"},
+			expected: big.NewInt(1000),
+		},
+		{
+			name:     "mintedBy - other account",
+			method:   mint.MintedByMethod,
+			args:     []interface{}{s.keyring.GetAddr(2), "umint"},
+			expected: big.NewInt(0),
+		},
+		{
+			name:     "totalMinted - denom never minted",
+			method:   mint.TotalMintedMethod,
+			args:     []interface{}{"uatom"},
+			expected: big.NewInt(0),
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			method := s.precompile.Methods[tc.method]
+
+			var (
+				bz  []byte
+				err error
+			)
+			switch tc.method {
+			case mint.TotalMintedMethod:
+				bz, err = s.precompile.TotalMinted(ctx, &method, tc.args)
+			case mint.TotalBurnedMethod:
+				bz, err = s.precompile.TotalBurned(ctx, &method, tc.args)
+			case mint.MintedByMethod:
+				bz, err = s.precompile.MintedBy(ctx, &method, tc.args)
+			}
+			s.Require().NoError(err, "expected %s query to succeed", tc.method)
+
+			var amount *big.Int
+			err = s.precompile.UnpackIntoInterface(&amount, tc.method, bz)
+			s.Require().NoError(err, "failed to unpack output")
+			s.Require().Zero(tc.expected.Cmp(amount), "expected different %s", tc.method)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestVoucherQueries() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	minter := s.keyring.GetKey(1)
+
+	method := s.precompile.Methods[mint.DomainSeparatorMethod]
+	bz, err := s.precompile.DomainSeparator(&method, []interface{}{})
+	s.Require().NoError(err, "expected DOMAIN_SEPARATOR query to succeed")
+	var separator [32]byte
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&separator, mint.DomainSeparatorMethod, bz))
+
+	expSeparator := crypto.Keccak256Hash(
+		crypto.Keccak256([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)")),
+		crypto.Keccak256([]byte(mint.VoucherDomainName)),
+		crypto.Keccak256([]byte(mint.VoucherDomainVersion)),
+		common.LeftPadBytes(evmtypes.GetEthChainConfig().ChainID.Bytes(), 32),
+		common.LeftPadBytes(s.precompile.Address().Bytes(), 32),
+	)
+	s.Require().Equal(expSeparator, common.Hash(separator), "expected the EIP-712 domain separator")
+
+	method = s.precompile.Methods[mint.IsVoucherNonceUsedMethod]
+	for _, used := range []bool{false, true} {
+		if used {
+			s.Require().NoError(s.network.App.GetEVMMintKeeper().UseVoucherNonce(ctx, minter.AccAddr, math.NewInt(7)))
+		}
+
+		bz, err = s.precompile.IsVoucherNonceUsed(ctx, &method, []interface{}{minter.Addr, big.NewInt(7)})
+		s.Require().NoError(err, "expected isVoucherNonceUsed query to succeed")
+		var isUsed bool
+		s.Require().NoError(s.precompile.UnpackIntoInterface(&isUsed, mint.IsVoucherNonceUsedMethod, bz))
+		s.Require().Equal(used, isUsed)
+	}
+}
+
+func (s *PrecompileTestSuite) TestEmissionScheduleQueries() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	mintKeeper := s.network.App.GetEVMMintKeeper()
+	recipient := s.keyring.GetKey(1)
+	height := uint64(ctx.BlockHeight()) //nolint:gosec // G115
+
+	listMethod := s.precompile.Methods[mint.EmissionSchedulesMethod]
+	bz, err := s.precompile.EmissionSchedules(ctx, &listMethod, []interface{}{})
+	s.Require().NoError(err, "expected emissionSchedules query to succeed")
+	var ids []*big.Int
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&ids, mint.EmissionSchedulesMethod, bz))
+	s.Require().Empty(ids)
+
+	method := s.precompile.Methods[mint.EmissionScheduleMethod]
+	_, err = s.precompile.EmissionSchedule(ctx, &method, []interface{}{big.NewInt(1)})
+	s.Require().ErrorContains(err, "emission schedule 1 not found")
+
+	schedule, err := mintKeeper.AddEmissionSchedule(ctx, minttypes.NewEmissionSchedule(
+		0, "umint", recipient.AccAddr, minttypes.EmissionCurveHalving, math.NewInt(100), height+1, height+10, 5,
+	))
+	s.Require().NoError(err)
+	s.Require().NoError(mintKeeper.BeginBlock(ctx.WithBlockHeight(int64(height) + 2))) //nolint:gosec // G115
+
+	bz, err = s.precompile.EmissionSchedules(ctx, &listMethod, []interface{}{})
+	s.Require().NoError(err, "expected emissionSchedules query to succeed")
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&ids, mint.EmissionSchedulesMethod, bz))
+	s.Require().Equal([]*big.Int{new(big.Int).SetUint64(schedule.ID)}, ids)
+
+	bz, err = s.precompile.EmissionSchedule(ctx, &method, []interface{}{new(big.Int).SetUint64(schedule.ID)})
+	s.Require().NoError(err, "expected emissionSchedule query to succeed")
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err, "failed to unpack output")
+	s.Require().Equal([]interface{}{
+		"umint",
+		recipient.Addr,
+		uint8(minttypes.EmissionCurveHalving),
+		big.NewInt(100),
+		height + 1,
+		height + 10,
+		uint64(5),
+		big.NewInt(200),
+		big.NewInt(550),
+	}, out)
+}
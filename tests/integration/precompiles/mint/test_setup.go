@@ -2,11 +2,14 @@ package mint
 
 import (
 	"github.com/cosmos/evm/precompiles/mint"
+	testconstants "github.com/cosmos/evm/testutil/constants"
 	"github.com/cosmos/evm/testutil/integration/evm/factory"
 	"github.com/cosmos/evm/testutil/integration/evm/grpc"
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	testkeyring "github.com/cosmos/evm/testutil/keyring"
 	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // PrecompileTestSuite is the implementation of the TestSuite interface for Mint precompile unit tests.
@@ -17,7 +20,7 @@ type PrecompileTestSuite struct {
 	options   []network.ConfigOption
 	bondDenom string
 	// authority is the address of the admin who can call the mint function.
-	authority   string
+	authority   sdk.AccAddress
 	network     *network.UnitTestNetwork
 	factory     factory.TxFactory
 	grpcHandler grpc.Handler
@@ -34,8 +37,15 @@ func NewPrecompileTestSuite(create network.CreateEvmApp, options ...network.Conf
 }
 
 func (s *PrecompileTestSuite) SetupTest() {
+	s.SetupTestWithChainID(testconstants.ExampleChainID)
+}
+
+// SetupTestWithChainID sets up the suite on a chain with the EVM coin of the
+// given chain ID.
+func (s *PrecompileTestSuite) SetupTestWithChainID(chainID testconstants.ChainID) {
 	keyring := testkeyring.New(3) // we'd need 3 keys: admin, user1, and user2
 	options := []network.ConfigOption{
+		network.WithChainID(chainID),
 		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
 	}
 	options = append(options, s.options...)
@@ -56,12 +66,14 @@ func (s *PrecompileTestSuite) SetupTest() {
 	s.network = integrationNetwork
 
 	// Set the authority as the first keyring address (admin)
-	s.authority = keyring.GetKey(0).Addr.Hex()
+	s.authority = keyring.GetAccAddr(0)
+	s.network.App.GetEVMMintKeeper().SetMintAuthority(ctx, s.authority)
 
-	// Create the mint precompile instance
+	// Create the mint precompile instance with the same bank keeper as the app
 	s.precompile, err = mint.NewPrecompile(
-		s.authority,
-		s.network.App.GetBankKeeper(),
+		s.network.App.GetEVMMintKeeper(),
+		s.network.App.GetPreciseBankKeeper(),
+		s.network.App.GetErc20Keeper(),
 	)
 	s.Require().NoError(err, "failed to create mint precomile")
 }
@@ -4,12 +4,28 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/holiman/uint256"
 
+	cmn "github.com/cosmos/evm/precompiles/common"
 	"github.com/cosmos/evm/precompiles/mint"
-	"github.com/cosmos/evm/precompiles/testutil"
+	precompiletestutil "github.com/cosmos/evm/precompiles/testutil"
+	"github.com/cosmos/evm/testutil"
+	testconstants "github.com/cosmos/evm/testutil/constants"
+	testkeyring "github.com/cosmos/evm/testutil/keyring"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	minttypes "github.com/cosmos/evm/x/mint/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 var (
@@ -21,8 +37,13 @@ func (s *PrecompileTestSuite) TestMint() {
 	s.SetupTest()
 
 	method := s.precompile.Methods[mint.MintMethod]
-	admin := s.keyring.GetKey(0)    // first key is the authority/admin
-	nonAdmin := s.keyring.GetKey(1) // second key is a non-admin user
+	minter := s.keyring.GetKey(1)    // second key is a registered minter
+	nonMinter := s.keyring.GetKey(2) // third key is not allowed to mint
+
+	err := s.network.App.GetEVMMintKeeper().SetMinterAllowance(
+		s.network.GetContext(), minter.AccAddr, "umint", math.NewInt(1500),
+	)
+	s.Require().NoError(err)
 
 	testcases := []struct {
 		name        string
@@ -34,16 +55,34 @@ func (s *PrecompileTestSuite) TestMint() {
 	}{
 		{
 			name:   "fail - unauthorized caller",
-			caller: nonAdmin.Addr,
+			caller: nonMinter.Addr,
 			malleate: func() []interface{} {
 				return []interface{}{toAddr, "umint", big.NewInt(1000)}
 			},
 			expErr:      true,
 			errContains: mint.ErrUnauthorized.Error(),
 		},
+		{
+			name:   "fail - minter not registered for denom",
+			caller: minter.Addr,
+			malleate: func() []interface{} {
+				return []interface{}{toAddr, s.bondDenom, big.NewInt(1000)}
+			},
+			expErr:      true,
+			errContains: mint.ErrUnauthorized.Error(),
+		},
+		{
+			name:   "fail - amount exceeds minter allowance",
+			caller: minter.Addr,
+			malleate: func() []interface{} {
+				return []interface{}{toAddr, "umint", big.NewInt(2000)}
+			},
+			expErr:      true,
+			errContains: minttypes.ErrInsufficientMinterAllowance.Error(),
+		},
 		{
 			name:   "fail - invalid number of arguments",
-			caller: admin.Addr,
+			caller: minter.Addr,
 			malleate: func() []interface{} {
 				return []interface{}{toAddr, "umint"} // Missing amount
 			},
@@ -52,7 +91,7 @@ func (s *PrecompileTestSuite) TestMint() {
 		},
 		{
 			name:   "fail - invalid to address",
-			caller: admin.Addr,
+			caller: minter.Addr,
 			malleate: func() []interface{} {
 				return []interface{}{"invalid", "umint", big.NewInt(1000)}
 			},
@@ -61,7 +100,7 @@ func (s *PrecompileTestSuite) TestMint() {
 		},
 		{
 			name:   "fail - invalid token string",
-			caller: admin.Addr,
+			caller: minter.Addr,
 			malleate: func() []interface{} {
 				return []interface{}{toAddr, 123, big.NewInt(1000)} // Invalid token type
 			},
@@ -70,7 +109,7 @@ func (s *PrecompileTestSuite) TestMint() {
 		},
 		{
 			name:   "fail - invalid amount",
-			caller: admin.Addr,
+			caller: minter.Addr,
 			malleate: func() []interface{} {
 				return []interface{}{toAddr, "umint", "invalid"} // Invalid amount type
 			},
@@ -79,7 +118,7 @@ func (s *PrecompileTestSuite) TestMint() {
 		},
 		{
 			name:   "fail - zero amount",
-			caller: admin.Addr,
+			caller: minter.Addr,
 			malleate: func() []interface{} {
 				return []interface{}{toAddr, "umint", big.NewInt(0)}
 			},
@@ -88,7 +127,7 @@ func (s *PrecompileTestSuite) TestMint() {
 		},
 		{
 			name:   "fail - negative amount",
-			caller: admin.Addr,
+			caller: minter.Addr,
 			malleate: func() []interface{} {
 				return []interface{}{toAddr, "umint", big.NewInt(-100)}
 			},
@@ -97,7 +136,7 @@ func (s *PrecompileTestSuite) TestMint() {
 		},
 		{
 			name:   "fail - invalid denomination",
-			caller: admin.Addr,
+			caller: minter.Addr,
 			malleate: func() []interface{} {
 				return []interface{}{toAddr, "", big.NewInt(1000)} // Empty denom
 			},
@@ -106,7 +145,7 @@ func (s *PrecompileTestSuite) TestMint() {
 		},
 		{
 			name:   "pass - successful mint",
-			caller: admin.Addr,
+			caller: minter.Addr,
 			malleate: func() []interface{} {
 				return []interface{}{toAddr, "umint", big.NewInt(1000)}
 			},
@@ -118,6 +157,38 @@ func (s *PrecompileTestSuite) TestMint() {
 					"umint",
 				)
 				s.Require().Equal(big.NewInt(1000), balance.Amount.BigInt(), "expected tokens to be minted")
+
+				// Check that the minter allowance was drawn down
+				allowance, found := s.network.App.GetEVMMintKeeper().GetMinterAllowance(
+					s.network.GetContext(),
+					minter.AccAddr,
+					"umint",
+				)
+				s.Require().True(found, "expected minter to still be registered")
+				s.Require().Equal(int64(500), allowance.Int64(), "expected allowance to be drawn down")
+			},
+			expErr: false,
+		},
+		{
+			name:   "pass - mint authority mints without an allowance",
+			caller: s.keyring.GetAddr(0),
+			malleate: func() []interface{} {
+				return []interface{}{toAddr, s.bondDenom, big.NewInt(1000)}
+			},
+			postCheck: func() {
+				balance := s.network.App.GetBankKeeper().GetBalance(
+					s.network.GetContext(),
+					toAddr.Bytes(),
+					s.bondDenom,
+				)
+				s.Require().Equal(big.NewInt(1000), balance.Amount.BigInt(), "expected tokens to be minted")
+
+				_, found := s.network.App.GetEVMMintKeeper().GetMinterAllowance(
+					s.network.GetContext(),
+					s.keyring.GetAccAddr(0),
+					s.bondDenom,
+				)
+				s.Require().False(found, "expected no minter allowance for the mint authority")
 			},
 			expErr: false,
 		},
@@ -128,7 +199,7 @@ func (s *PrecompileTestSuite) TestMint() {
 			stateDB := s.network.GetStateDB()
 
 			var contract *vm.Contract
-			contract, ctx := testutil.NewPrecompileContract(
+			contract, ctx := precompiletestutil.NewPrecompileContract(
 				s.T(),
 				s.network.GetContext(),
 				tc.caller,
@@ -150,6 +221,87 @@ func (s *PrecompileTestSuite) TestMint() {
 	}
 }
 
+func (s *PrecompileTestSuite) TestMintLimits() {
+	// Once setup for all test cases, as each mint counts against the limits!
+	s.SetupTest()
+
+	method := s.precompile.Methods[mint.MintMethod]
+	minter := s.keyring.GetKey(1)
+	mintKeeper := s.network.App.GetEVMMintKeeper()
+
+	err := mintKeeper.SetMinterAllowance(s.network.GetContext(), minter.AccAddr, "umint", math.NewInt(10_000))
+	s.Require().NoError(err)
+	err = mintKeeper.UpdateDenomMintLimit(
+		s.network.GetContext(),
+		minttypes.NewDenomMintLimit("umint", math.NewInt(1500), math.NewInt(1000), 10),
+	)
+	s.Require().NoError(err)
+
+	testcases := []struct {
+		name        string
+		heightDelta int64
+		value       int64
+		expErr      bool
+		errContains string
+	}{
+		{
+			name:  "pass - within the supply cap and rate limit",
+			value: 800,
+		},
+		{
+			name:        "fail - rate limit exceeded in the same window",
+			heightDelta: 5,
+			value:       300,
+			expErr:      true,
+			errContains: minttypes.ErrRateLimitExceeded.Error(),
+		},
+		{
+			name:        "pass - first mint left the window",
+			heightDelta: 10,
+			value:       600,
+		},
+		{
+			name:        "fail - max supply exceeded",
+			heightDelta: 20,
+			value:       200,
+			expErr:      true,
+			errContains: minttypes.ErrMaxSupplyExceeded.Error(),
+		},
+		{
+			name:        "pass - mint up to the max supply",
+			heightDelta: 20,
+			value:       100,
+		},
+	}
+
+	startHeight := s.network.GetContext().BlockHeight()
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := precompiletestutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext().WithBlockHeight(startHeight+tc.heightDelta),
+				minter.Addr,
+				s.precompile.Address(),
+				0,
+			)
+
+			supplyBefore := s.network.App.GetBankKeeper().GetSupply(ctx, "umint").Amount
+
+			_, err := s.precompile.Mint(ctx, contract, stateDB, &method, []interface{}{toAddr, "umint", big.NewInt(tc.value)})
+			if tc.expErr {
+				s.Require().Error(err, "expected mint transaction to fail")
+				s.Require().Contains(err.Error(), tc.errContains, "expected mint transaction to fail with specific error")
+			} else {
+				s.Require().NoError(err, "expected mint transaction to succeed")
+				supply := s.network.App.GetBankKeeper().GetSupply(ctx, "umint").Amount
+				s.Require().Equal(supplyBefore.AddRaw(tc.value), supply, "expected supply to grow by the minted amount")
+			}
+		})
+	}
+}
+
 func (s *PrecompileTestSuite) TestIsAuthorized() {
 	s.SetupTest()
 	ctx := s.network.GetContext()
@@ -160,80 +312,1945 @@ func (s *PrecompileTestSuite) TestIsAuthorized() {
 	testcases := []struct {
 		name      string
 		caller    common.Address
-		authority string
+		authority sdk.AccAddress
 		expected  bool
 	}{
 		{
-			name:      "pass - admin hex address",
-			caller:    admin.Addr,
-			authority: admin.Addr.Hex(),
-			expected:  true,
-		},
-		{
-			name:      "pass - admin bech32 address",
+			name:      "pass - admin",
 			caller:    admin.Addr,
-			authority: sdk.AccAddress(admin.Addr.Bytes()).String(),
+			authority: admin.AccAddr,
 			expected:  true,
 		},
 		{
 			name:      "fail - non-admin",
 			caller:    nonAdmin.Addr,
-			authority: admin.Addr.Hex(),
+			authority: admin.AccAddr,
 			expected:  false,
 		},
 		{
 			name:      "fail - zero address",
 			caller:    common.Address{},
-			authority: admin.Addr.Hex(),
+			authority: admin.AccAddr,
+			expected:  false,
+		},
+		{
+			name:      "fail - mint authority not set",
+			caller:    admin.Addr,
+			authority: nil,
 			expected:  false,
 		},
 	}
 
 	for _, tc := range testcases {
 		s.Run(tc.name, func() {
-			// Create precompile with specific authority
-			precompile, err := mint.NewPrecompile(
-				tc.authority,
-				s.network.App.GetBankKeeper(),
-			)
-			s.Require().NoError(err)
+			s.network.App.GetEVMMintKeeper().SetMintAuthority(ctx, tc.authority)
 
-			result := precompile.IsAuthorized(ctx, tc.caller)
+			result := s.precompile.IsAuthorized(ctx, tc.caller)
 			s.Require().Equal(tc.expected, result, "unexpected authorization result")
 		})
 	}
 }
 
-func (s *PrecompileTestSuite) TestIsValidRecipient() {
+func (s *PrecompileTestSuite) TestIsAuthorizedWithMintAuthority() {
 	s.SetupTest()
 	ctx := s.network.GetContext()
 
+	admin := s.keyring.GetKey(0)
+	fixedAuthority := s.keyring.GetKey(1)
+
+	// the x/mint store holds another authority, which the fixed one replaces
+	s.network.App.GetEVMMintKeeper().SetMintAuthority(ctx, admin.AccAddr)
+	mintKeeper := s.network.App.GetEVMMintKeeper().WithMintAuthority(fixedAuthority.AccAddr)
+
+	precompile, err := mint.NewPrecompile(
+		mintKeeper,
+		s.network.App.GetPreciseBankKeeper(),
+		s.network.App.GetErc20Keeper(),
+	)
+	s.Require().NoError(err)
+
+	s.Require().True(precompile.IsAuthorized(ctx, fixedAuthority.Addr), "expected the fixed authority to be authorized")
+	s.Require().False(precompile.IsAuthorized(ctx, admin.Addr), "expected the x/mint authority not to be authorized")
+
+	method := precompile.Methods[mint.AuthorityMethod]
+	bz, err := precompile.Authority(ctx, &method, []interface{}{})
+	s.Require().NoError(err)
+	var authority common.Address
+	s.Require().NoError(precompile.UnpackIntoInterface(&authority, mint.AuthorityMethod, bz))
+	s.Require().Equal(fixedAuthority.Addr, authority, "expected the fixed authority")
+
+	// the x/mint module reports the same authority and governance cannot update it
+	res, err := mintKeeper.MintAuthority(ctx, &minttypes.QueryMintAuthorityRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(fixedAuthority.AccAddr.String(), res.MintAuthority, "expected the x/mint query to return the fixed authority")
+
+	_, err = mintKeeper.UpdateMintAuthority(ctx, &minttypes.MsgUpdateMintAuthority{
+		Authority:     authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		MintAuthority: admin.AccAddr.String(),
+	})
+	s.Require().ErrorIs(err, minttypes.ErrMintAuthorityFixed)
+	s.Require().Equal(fixedAuthority.AccAddr, mintKeeper.GetMintAuthority(ctx), "expected the fixed authority to remain")
+}
+
+func (s *PrecompileTestSuite) TestMintBatch() {
+	method := s.precompile.Methods[mint.MintBatchMethod]
+	recipients := []common.Address{utiltx.GenerateAddress(), utiltx.GenerateAddress()}
+
 	testcases := []struct {
-		name     string
-		to       common.Address
-		expected bool
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expErr      bool
+		errContains string
+	}{
+		{
+			name: "fail - empty batch",
+			malleate: func() []interface{} {
+				return []interface{}{[]common.Address{}, "umint", []*big.Int{}}
+			},
+			expErr:      true,
+			errContains: mint.ErrEmptyBatch.Error(),
+		},
+		{
+			name: "fail - mismatched lengths",
+			malleate: func() []interface{} {
+				return []interface{}{recipients, "umint", []*big.Int{big.NewInt(100)}}
+			},
+			expErr:      true,
+			errContains: "mismatched batch lengths",
+		},
+		{
+			name: "fail - invalid entry",
+			malleate: func() []interface{} {
+				return []interface{}{recipients, "umint", []*big.Int{big.NewInt(100), big.NewInt(0)}}
+			},
+			expErr:      true,
+			errContains: "batch entry 1 failed: " + mint.ErrZeroAmount.Error(),
+		},
+		{
+			name: "fail - batch total exceeds minter allowance",
+			malleate: func() []interface{} {
+				return []interface{}{recipients, "umint", []*big.Int{big.NewInt(600), big.NewInt(600)}}
+			},
+			expErr:      true,
+			errContains: minttypes.ErrInsufficientMinterAllowance.Error(),
+		},
+		{
+			name: "pass - mint to every recipient",
+			malleate: func() []interface{} {
+				return []interface{}{recipients, "umint", []*big.Int{big.NewInt(100), big.NewInt(200)}}
+			},
+			postCheck: func() {
+				ctx := s.network.GetContext()
+				for i, expected := range []int64{100, 200} {
+					balance := s.network.App.GetBankKeeper().GetBalance(ctx, recipients[i].Bytes(), "umint")
+					s.Require().Equal(expected, balance.Amount.Int64(), "expected tokens to be minted")
+				}
+
+				allowance, _ := s.network.App.GetEVMMintKeeper().GetMinterAllowance(ctx, s.keyring.GetAccAddr(1), "umint")
+				s.Require().Equal(int64(700), allowance.Int64(), "expected allowance to be drawn down by the batch total")
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			err := s.network.App.GetEVMMintKeeper().SetMinterAllowance(
+				s.network.GetContext(), s.keyring.GetAccAddr(1), "umint", math.NewInt(1000),
+			)
+			s.Require().NoError(err)
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := precompiletestutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				s.keyring.GetAddr(1),
+				s.precompile.Address(),
+				0,
+			)
+
+			_, err = s.precompile.MintBatch(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expErr {
+				s.Require().Error(err, "expected mintBatch transaction to fail")
+				s.Require().Contains(err.Error(), tc.errContains, "expected mintBatch transaction to fail with specific error")
+			} else {
+				s.Require().NoError(err, "expected mintBatch transaction to succeed")
+				s.Require().Len(stateDB.Logs(), len(recipients), "expected one Mint event per recipient")
+				if tc.postCheck != nil {
+					tc.postCheck()
+				}
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestBurnBatch() {
+	method := s.precompile.Methods[mint.BurnBatchMethod]
+
+	testcases := []struct {
+		name        string
+		caller      func() common.Address
+		malleate    func() []interface{}
+		postCheck   func()
+		expErr      bool
+		errContains string
 	}{
 		{
-			name:     "pass - valid address",
-			to:       toAddr,
-			expected: true,
+			name:   "fail - caller is not the mint authority",
+			caller: func() common.Address { return s.keyring.GetAddr(1) },
+			malleate: func() []interface{} {
+				return []interface{}{
+					[]common.Address{s.keyring.GetAddr(1), s.keyring.GetAddr(2)},
+					"umint",
+					[]*big.Int{big.NewInt(100), big.NewInt(100)},
+				}
+			},
+			expErr:      true,
+			errContains: mint.ErrNotMintAuthority.Error(),
+		},
+		{
+			name:   "fail - mismatched lengths",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			malleate: func() []interface{} {
+				return []interface{}{
+					[]common.Address{s.keyring.GetAddr(1)},
+					"umint",
+					[]*big.Int{big.NewInt(100), big.NewInt(100)},
+				}
+			},
+			expErr:      true,
+			errContains: "mismatched batch lengths",
 		},
 		{
-			name:     "pass - random address",
-			to:       common.HexToAddress("0x1234567890123456789012345678901234567890"),
-			expected: true,
+			name:   "fail - insufficient balance for an entry",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			malleate: func() []interface{} {
+				return []interface{}{
+					[]common.Address{s.keyring.GetAddr(1), s.keyring.GetAddr(2)},
+					"umint",
+					[]*big.Int{big.NewInt(100), big.NewInt(2000)},
+				}
+			},
+			expErr:      true,
+			errContains: "batch entry 1 failed",
 		},
 		{
-			name:     "fail - zero address",
-			to:       common.Address{},
-			expected: false,
+			name:   "pass - burn from every account",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			malleate: func() []interface{} {
+				return []interface{}{
+					[]common.Address{s.keyring.GetAddr(1), s.keyring.GetAddr(2)},
+					"umint",
+					[]*big.Int{big.NewInt(100), big.NewInt(1000)},
+				}
+			},
+			postCheck: func() {
+				ctx := s.network.GetContext()
+				balance := s.network.App.GetBankKeeper().GetBalance(ctx, s.keyring.GetAccAddr(1), "umint")
+				s.Require().Equal(int64(900), balance.Amount.Int64(), "expected tokens to be burned")
+				balance = s.network.App.GetBankKeeper().GetBalance(ctx, s.keyring.GetAccAddr(2), "umint")
+				s.Require().True(balance.IsZero(), "expected tokens to be burned")
+
+				supply := s.network.App.GetBankKeeper().GetSupply(ctx, "umint")
+				s.Require().Equal(int64(900), supply.Amount.Int64(), "expected supply to decrease")
+			},
 		},
 	}
 
 	for _, tc := range testcases {
 		s.Run(tc.name, func() {
-			result := s.precompile.IsValidRecipient(ctx, tc.to)
-			s.Require().Equal(tc.expected, result, "unexpected recipient validation result")
+			s.SetupTest()
+			for _, i := range []int{1, 2} {
+				err := testutil.FundAccount(
+					s.network.GetContext(),
+					s.network.App.GetBankKeeper(),
+					s.keyring.GetAccAddr(i),
+					sdk.NewCoins(sdk.NewInt64Coin("umint", 1000)),
+				)
+				s.Require().NoError(err)
+			}
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := precompiletestutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				tc.caller(),
+				s.precompile.Address(),
+				0,
+			)
+
+			_, err := s.precompile.BurnBatch(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expErr {
+				s.Require().Error(err, "expected burnBatch transaction to fail")
+				s.Require().Contains(err.Error(), tc.errContains, "expected burnBatch transaction to fail with specific error")
+			} else {
+				s.Require().NoError(err, "expected burnBatch transaction to succeed")
+				s.Require().Len(stateDB.Logs(), 2, "expected one Burn event per account")
+				if tc.postCheck != nil {
+					tc.postCheck()
+				}
+			}
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestBurn() {
+	method := s.precompile.Methods[mint.BurnMethod]
+	holder := s.keyring.GetKey(1)
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expErr      bool
+		errContains string
+	}{
+		{
+			name: "fail - invalid number of arguments",
+			malleate: func() []interface{} {
+				return []interface{}{"umint"}
+			},
+			expErr:      true,
+			errContains: "invalid number of arguments",
+		},
+		{
+			name: "fail - invalid denomination",
+			malleate: func() []interface{} {
+				return []interface{}{"", big.NewInt(100)}
+			},
+			expErr:      true,
+			errContains: "invalid token denomination",
+		},
+		{
+			name: "fail - zero amount",
+			malleate: func() []interface{} {
+				return []interface{}{"umint", big.NewInt(0)}
+			},
+			expErr:      true,
+			errContains: mint.ErrZeroBurnAmount.Error(),
+		},
+		{
+			name: "fail - negative amount",
+			malleate: func() []interface{} {
+				return []interface{}{"umint", big.NewInt(-100)}
+			},
+			expErr:      true,
+			errContains: mint.ErrNegativeBurnAmount.Error(),
+		},
+		{
+			name: "fail - insufficient balance",
+			malleate: func() []interface{} {
+				return []interface{}{"umint", big.NewInt(2000)}
+			},
+			expErr:      true,
+			errContains: "insufficient funds",
+		},
+		{
+			name: "pass - burn from caller",
+			malleate: func() []interface{} {
+				return []interface{}{"umint", big.NewInt(400)}
+			},
+			postCheck: func() {
+				ctx := s.network.GetContext()
+				balance := s.network.App.GetBankKeeper().GetBalance(ctx, holder.AccAddr, "umint")
+				s.Require().Equal(int64(600), balance.Amount.Int64(), "expected tokens to be burned")
+
+				supply := s.network.App.GetBankKeeper().GetSupply(ctx, "umint")
+				s.Require().Equal(int64(600), supply.Amount.Int64(), "expected supply to decrease")
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			err := testutil.FundAccount(
+				s.network.GetContext(),
+				s.network.App.GetBankKeeper(),
+				holder.AccAddr,
+				sdk.NewCoins(sdk.NewInt64Coin("umint", 1000)),
+			)
+			s.Require().NoError(err)
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := precompiletestutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				holder.Addr,
+				s.precompile.Address(),
+				0,
+			)
+
+			_, err = s.precompile.Burn(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expErr {
+				s.Require().Error(err, "expected burn transaction to fail")
+				s.Require().Contains(err.Error(), tc.errContains, "expected burn transaction to fail with specific error")
+			} else {
+				s.Require().NoError(err, "expected burn transaction to succeed")
+				if tc.postCheck != nil {
+					tc.postCheck()
+				}
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestBurnFrom() {
+	method := s.precompile.Methods[mint.BurnFromMethod]
+
+	testcases := []struct {
+		name        string
+		caller      func() common.Address
+		malleate    func() []interface{}
+		postCheck   func()
+		expErr      bool
+		errContains string
+	}{
+		{
+			name:   "fail - invalid number of arguments",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			malleate: func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), "umint"}
+			},
+			expErr:      true,
+			errContains: "invalid number of arguments",
+		},
+		{
+			name:   "fail - caller is not the mint authority",
+			caller: func() common.Address { return s.keyring.GetAddr(1) },
+			malleate: func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), "umint", big.NewInt(100)}
+			},
+			expErr:      true,
+			errContains: mint.ErrNotMintAuthority.Error(),
+		},
+		{
+			name:   "fail - insufficient balance",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			malleate: func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), "umint", big.NewInt(2000)}
+			},
+			expErr:      true,
+			errContains: "insufficient funds",
+		},
+		{
+			name:   "pass - burn from account",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			malleate: func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), "umint", big.NewInt(1000)}
+			},
+			postCheck: func() {
+				ctx := s.network.GetContext()
+				balance := s.network.App.GetBankKeeper().GetBalance(ctx, s.keyring.GetAccAddr(1), "umint")
+				s.Require().True(balance.IsZero(), "expected tokens to be burned")
+
+				supply := s.network.App.GetBankKeeper().GetSupply(ctx, "umint")
+				s.Require().True(supply.IsZero(), "expected supply to decrease")
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			err := testutil.FundAccount(
+				s.network.GetContext(),
+				s.network.App.GetBankKeeper(),
+				s.keyring.GetAccAddr(1),
+				sdk.NewCoins(sdk.NewInt64Coin("umint", 1000)),
+			)
+			s.Require().NoError(err)
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := precompiletestutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				tc.caller(),
+				s.precompile.Address(),
+				0,
+			)
+
+			_, err = s.precompile.BurnFrom(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expErr {
+				s.Require().Error(err, "expected burnFrom transaction to fail")
+				s.Require().Contains(err.Error(), tc.errContains, "expected burnFrom transaction to fail with specific error")
+			} else {
+				s.Require().NoError(err, "expected burnFrom transaction to succeed")
+				if tc.postCheck != nil {
+					tc.postCheck()
+				}
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestAddMinter() {
+	// Once setup for all test cases!
+	s.SetupTest()
+
+	method := s.precompile.Methods[mint.AddMinterMethod]
+	admin := s.keyring.GetKey(0)
+	minter := s.keyring.GetKey(1)
+
+	testcases := []struct {
+		name        string
+		caller      common.Address
+		malleate    func() []interface{}
+		postCheck   func()
+		expErr      bool
+		errContains string
+	}{
+		{
+			name:   "fail - invalid number of arguments",
+			caller: admin.Addr,
+			malleate: func() []interface{} {
+				return []interface{}{minter.Addr, "uusdc"}
+			},
+			expErr:      true,
+			errContains: "invalid number of arguments",
+		},
+		{
+			name:   "fail - zero minter address",
+			caller: admin.Addr,
+			malleate: func() []interface{} {
+				return []interface{}{common.Address{}, "uusdc", big.NewInt(1000)}
+			},
+			expErr:      true,
+			errContains: "invalid minter address",
+		},
+		{
+			name:   "fail - invalid denom",
+			caller: admin.Addr,
+			malleate: func() []interface{} {
+				return []interface{}{minter.Addr, "", big.NewInt(1000)}
+			},
+			expErr:      true,
+			errContains: "invalid token denomination",
+		},
+		{
+			name:   "fail - zero allowance",
+			caller: admin.Addr,
+			malleate: func() []interface{} {
+				return []interface{}{minter.Addr, "uusdc", big.NewInt(0)}
+			},
+			expErr:      true,
+			errContains: mint.ErrZeroAllowance.Error(),
+		},
+		{
+			name:   "fail - caller is not the mint authority",
+			caller: minter.Addr,
+			malleate: func() []interface{} {
+				return []interface{}{minter.Addr, "uusdc", big.NewInt(1000)}
+			},
+			expErr:      true,
+			errContains: mint.ErrNotMintAuthority.Error(),
+		},
+		{
+			name:   "pass - add minter",
+			caller: admin.Addr,
+			malleate: func() []interface{} {
+				return []interface{}{minter.Addr, "uusdc", big.NewInt(1000)}
+			},
+			postCheck: func() {
+				allowance, found := s.network.App.GetEVMMintKeeper().GetMinterAllowance(
+					s.network.GetContext(), minter.AccAddr, "uusdc",
+				)
+				s.Require().True(found, "expected minter to be registered")
+				s.Require().Equal(int64(1000), allowance.Int64(), "expected different allowance")
+
+				_, found = s.network.App.GetEVMMintKeeper().GetMinterAllowance(
+					s.network.GetContext(), minter.AccAddr, s.bondDenom,
+				)
+				s.Require().False(found, "expected minter not to be registered for the bond denom")
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := precompiletestutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				tc.caller,
+				s.precompile.Address(),
+				0,
+			)
+
+			_, err := s.precompile.AddMinter(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expErr {
+				s.Require().Error(err, "expected addMinter transaction to fail")
+				s.Require().Contains(err.Error(), tc.errContains, "expected addMinter transaction to fail with specific error")
+			} else {
+				s.Require().NoError(err, "expected addMinter transaction to succeed")
+				if tc.postCheck != nil {
+					tc.postCheck()
+				}
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRemoveMinter() {
+	// Once setup for all test cases!
+	s.SetupTest()
+
+	method := s.precompile.Methods[mint.RemoveMinterMethod]
+	admin := s.keyring.GetKey(0)
+	minter := s.keyring.GetKey(1)
+
+	testcases := []struct {
+		name        string
+		caller      common.Address
+		malleate    func() []interface{}
+		postCheck   func()
+		expErr      bool
+		errContains string
+	}{
+		{
+			name:   "fail - invalid number of arguments",
+			caller: admin.Addr,
+			malleate: func() []interface{} {
+				return []interface{}{minter.Addr}
+			},
+			expErr:      true,
+			errContains: "invalid number of arguments",
+		},
+		{
+			name:   "fail - caller is not the mint authority",
+			caller: minter.Addr,
+			malleate: func() []interface{} {
+				return []interface{}{minter.Addr, "uusdc"}
+			},
+			expErr:      true,
+			errContains: mint.ErrNotMintAuthority.Error(),
+		},
+		{
+			name:   "fail - minter not registered for denom",
+			caller: admin.Addr,
+			malleate: func() []interface{} {
+				return []interface{}{minter.Addr, "uatom"}
+			},
+			expErr:      true,
+			errContains: minttypes.ErrMinterNotFound.Error(),
+		},
+		{
+			name:   "pass - remove minter",
+			caller: admin.Addr,
+			malleate: func() []interface{} {
+				return []interface{}{minter.Addr, "uusdc"}
+			},
+			postCheck: func() {
+				_, found := s.network.App.GetEVMMintKeeper().GetMinterAllowance(
+					s.network.GetContext(), minter.AccAddr, "uusdc",
+				)
+				s.Require().False(found, "expected minter to be removed")
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			err := s.network.App.GetEVMMintKeeper().SetMinterAllowance(
+				s.network.GetContext(), minter.AccAddr, "uusdc", math.NewInt(1000),
+			)
+			s.Require().NoError(err)
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := precompiletestutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				tc.caller,
+				s.precompile.Address(),
+				0,
+			)
+
+			_, err = s.precompile.RemoveMinter(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expErr {
+				s.Require().Error(err, "expected removeMinter transaction to fail")
+				s.Require().Contains(err.Error(), tc.errContains, "expected removeMinter transaction to fail with specific error")
+			} else {
+				s.Require().NoError(err, "expected removeMinter transaction to succeed")
+				if tc.postCheck != nil {
+					tc.postCheck()
+				}
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestMintRecipientValidation() {
+	method := s.precompile.Methods[mint.MintMethod]
+
+	testcases := []struct {
+		name     string
+		malleate func()
+		to       func() common.Address
+		expErr   bool
+	}{
+		{
+			name:   "pass - valid address",
+			to:     func() common.Address { return toAddr },
+			expErr: false,
+		},
+		{
+			name:   "fail - zero address",
+			to:     func() common.Address { return common.Address{} },
+			expErr: true,
+		},
+		{
+			name: "fail - module account",
+			to: func() common.Address {
+				return common.BytesToAddress(authtypes.NewModuleAddress(govtypes.ModuleName))
+			},
+			expErr: true,
+		},
+		{
+			name:   "fail - static precompile",
+			to:     func() common.Address { return common.HexToAddress(evmtypes.BankPrecompileAddress) },
+			expErr: true,
+		},
+		{
+			name: "fail - blocked recipient",
+			malleate: func() {
+				params := minttypes.NewParams([]string{sdk.AccAddress(toAddr.Bytes()).String()}, nil, false, 0)
+				err := s.network.App.GetEVMMintKeeper().SetParams(s.network.GetContext(), params)
+				s.Require().NoError(err)
+			},
+			to:     func() common.Address { return toAddr },
+			expErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			minter := s.keyring.GetKey(1)
+			err := s.network.App.GetEVMMintKeeper().SetMinterAllowance(
+				s.network.GetContext(), minter.AccAddr, "umint", math.NewInt(1000),
+			)
+			s.Require().NoError(err)
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+
+			contract, ctx := precompiletestutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				minter.Addr,
+				s.precompile.Address(),
+				0,
+			)
+
+			_, err = s.precompile.Mint(ctx, contract, s.network.GetStateDB(), &method, []interface{}{tc.to(), "umint", big.NewInt(100)})
+			if tc.expErr {
+				s.Require().ErrorIs(err, minttypes.ErrInvalidRecipient, "expected mint to an invalid recipient to fail")
+				return
+			}
+			s.Require().NoError(err, "expected mint to a valid recipient to succeed")
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestSetDenomMetadata() {
+	method := s.precompile.Methods[mint.SetDenomMetadataMethod]
+
+	testcases := []struct {
+		name        string
+		caller      func() common.Address
+		args        []interface{}
+		postCheck   func()
+		expErr      bool
+		errContains string
+	}{
+		{
+			name:        "fail - not the mint authority",
+			caller:      func() common.Address { return s.keyring.GetAddr(1) },
+			args:        []interface{}{"uusdc", "USD Coin", "USDC", uint8(6)},
+			expErr:      true,
+			errContains: mint.ErrNotMintAuthority.Error(),
+		},
+		{
+			name:        "fail - invalid denom",
+			caller:      func() common.Address { return s.keyring.GetAddr(0) },
+			args:        []interface{}{"", "USD Coin", "USDC", uint8(6)},
+			expErr:      true,
+			errContains: "invalid token denomination",
+		},
+		{
+			name:        "fail - EVM denom",
+			caller:      func() common.Address { return s.keyring.GetAddr(0) },
+			args:        []interface{}{evmtypes.GetEVMCoinDenom(), "Token", "TKN", uint8(18)},
+			expErr:      true,
+			errContains: "cannot set the metadata of the EVM denom",
+		},
+		{
+			name:        "fail - blank symbol",
+			caller:      func() common.Address { return s.keyring.GetAddr(0) },
+			args:        []interface{}{"uusdc", "USD Coin", "", uint8(6)},
+			expErr:      true,
+			errContains: "invalid denom metadata",
+		},
+		{
+			name:   "pass - token with decimals",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			args:   []interface{}{"uusdc", "USD Coin", "USDC", uint8(6)},
+			postCheck: func() {
+				metadata, found := s.network.App.GetBankKeeper().GetDenomMetaData(s.network.GetContext(), "uusdc")
+				s.Require().True(found, "expected metadata to be set")
+				s.Require().Equal("USD Coin", metadata.Name)
+				s.Require().Equal("USDC", metadata.Symbol)
+				s.Require().Equal("usdc", metadata.Display)
+				s.Require().Len(metadata.DenomUnits, 2)
+				s.Require().Equal(uint32(6), metadata.DenomUnits[1].Exponent)
+			},
+		},
+		{
+			name:   "pass - token without decimals",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			args:   []interface{}{"ticket", "Ticket", "TIX", uint8(0)},
+			postCheck: func() {
+				metadata, found := s.network.App.GetBankKeeper().GetDenomMetaData(s.network.GetContext(), "ticket")
+				s.Require().True(found, "expected metadata to be set")
+				s.Require().Equal("ticket", metadata.Display)
+				s.Require().Len(metadata.DenomUnits, 1)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := precompiletestutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				tc.caller(),
+				s.precompile.Address(),
+				0,
+			)
+
+			_, err := s.precompile.SetDenomMetadata(ctx, contract, stateDB, &method, tc.args)
+			if tc.expErr {
+				s.Require().Error(err, "expected setDenomMetadata transaction to fail")
+				s.Require().Contains(err.Error(), tc.errContains, "expected setDenomMetadata transaction to fail with specific error")
+				return
+			}
+
+			s.Require().NoError(err, "expected setDenomMetadata transaction to succeed")
+			s.Require().Len(stateDB.Logs(), 1, "expected a DenomMetadataSet event")
+			tc.postCheck()
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestMintAutoRegisterERC20() {
+	method := s.precompile.Methods[mint.MintMethod]
+
+	testcases := []struct {
+		name         string
+		autoRegister bool
+		malleate     func()
+		expRegister  bool
+	}{
+		{
+			name:         "pass - auto registration disabled",
+			autoRegister: false,
+		},
+		{
+			name:         "pass - first mint registers the denom",
+			autoRegister: true,
+			expRegister:  true,
+		},
+		{
+			name:         "pass - denom already registered",
+			autoRegister: true,
+			malleate: func() {
+				_, err := s.network.App.GetErc20Keeper().RegisterERC20Extension(s.network.GetContext(), "umint")
+				s.Require().NoError(err)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			minter := s.keyring.GetKey(1)
+			mintKeeper := s.network.App.GetEVMMintKeeper()
+
+			err := mintKeeper.SetMinterAllowance(s.network.GetContext(), minter.AccAddr, "umint", math.NewInt(1000))
+			s.Require().NoError(err)
+			err = mintKeeper.SetParams(s.network.GetContext(), minttypes.NewParams(nil, nil, tc.autoRegister, 0))
+			s.Require().NoError(err)
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+
+			stateDB := s.network.GetStateDB()
+			contract, ctx := precompiletestutil.NewPrecompileContract(
+				s.T(),
+				s.network.GetContext(),
+				minter.Addr,
+				s.precompile.Address(),
+				0,
+			)
+
+			_, err = s.precompile.Mint(ctx, contract, stateDB, &method, []interface{}{toAddr, "umint", big.NewInt(100)})
+			s.Require().NoError(err, "expected mint transaction to succeed")
+
+			erc20Keeper := s.network.App.GetErc20Keeper()
+			s.Require().Equal(tc.autoRegister, erc20Keeper.IsDenomRegistered(ctx, "umint"))
+
+			if !tc.expRegister {
+				s.Require().Len(stateDB.Logs(), 1, "expected only a Mint event")
+				return
+			}
+
+			s.Require().Len(stateDB.Logs(), 2, "expected a Mint and a MintedTokenRegistered event")
+			erc20, err := erc20Keeper.GetCoinAddress(ctx, "umint")
+			s.Require().NoError(err)
+
+			log := stateDB.Logs()[1]
+			s.Require().Equal(s.precompile.Events[mint.EventTypeMintedTokenRegistered].ID, log.Topics[0])
+			s.Require().Equal(common.BytesToHash(erc20.Bytes()), log.Topics[1])
+			s.Require().True(erc20Keeper.IsDynamicPrecompileAvailable(ctx, erc20), "expected the ERC20 precompile to be enabled")
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestPause() {
+	const (
+		guardianIdx = 2
+		otherIdx    = 1
+	)
+
+	testcases := []struct {
+		name        string
+		method      string
+		callerIdx   int
+		args        []interface{}
+		postCheck   func(ctx sdk.Context)
+		expErr      bool
+		errContains string
+	}{
+		{
+			name:        "fail - pause with arguments",
+			method:      mint.PauseMethod,
+			callerIdx:   guardianIdx,
+			args:        []interface{}{"uusdc"},
+			expErr:      true,
+			errContains: "invalid number of arguments",
+		},
+		{
+			name:        "fail - pause by non guardian",
+			method:      mint.PauseMethod,
+			callerIdx:   otherIdx,
+			args:        []interface{}{},
+			expErr:      true,
+			errContains: mint.ErrNotGuardian.Error(),
+		},
+		{
+			name:      "pass - pause",
+			method:    mint.PauseMethod,
+			callerIdx: guardianIdx,
+			args:      []interface{}{},
+			postCheck: func(ctx sdk.Context) {
+				s.Require().True(s.network.App.GetEVMMintKeeper().IsPaused(ctx), "expected minting to be paused")
+			},
+		},
+		{
+			name:        "fail - unpause by non guardian",
+			method:      mint.UnpauseMethod,
+			callerIdx:   otherIdx,
+			args:        []interface{}{},
+			expErr:      true,
+			errContains: mint.ErrNotGuardian.Error(),
+		},
+		{
+			name:      "pass - unpause lifts every pause",
+			method:    mint.UnpauseMethod,
+			callerIdx: guardianIdx,
+			args:      []interface{}{},
+			postCheck: func(ctx sdk.Context) {
+				mintKeeper := s.network.App.GetEVMMintKeeper()
+				s.Require().False(mintKeeper.IsPaused(ctx), "expected the global pause to be lifted")
+				s.Require().Empty(mintKeeper.GetPausedDenoms(ctx), "expected the denom pauses to be lifted")
+			},
+		},
+		{
+			name:        "fail - pause invalid denom",
+			method:      mint.PauseDenomMethod,
+			callerIdx:   guardianIdx,
+			args:        []interface{}{""},
+			expErr:      true,
+			errContains: "invalid token denomination",
+		},
+		{
+			name:        "fail - pause denom by non guardian",
+			method:      mint.PauseDenomMethod,
+			callerIdx:   otherIdx,
+			args:        []interface{}{"uusdc"},
+			expErr:      true,
+			errContains: mint.ErrNotGuardian.Error(),
+		},
+		{
+			name:      "pass - pause denom",
+			method:    mint.PauseDenomMethod,
+			callerIdx: guardianIdx,
+			args:      []interface{}{"uusdc"},
+			postCheck: func(ctx sdk.Context) {
+				mintKeeper := s.network.App.GetEVMMintKeeper()
+				s.Require().False(mintKeeper.IsPaused(ctx), "expected the other denoms to stay unpaused")
+				s.Require().True(mintKeeper.IsDenomPaused(ctx, "uusdc"), "expected the denom to be paused")
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			mintKeeper := s.network.App.GetEVMMintKeeper()
+			mintKeeper.SetGuardian(s.network.GetContext(), s.keyring.GetAccAddr(guardianIdx))
+			s.Require().NoError(mintKeeper.SetDenomPaused(s.network.GetContext(), "uatom", true))
+
+			method := s.precompile.Methods[tc.method]
+			stateDB := s.network.GetStateDB()
+			contract, ctx := precompiletestutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(tc.callerIdx), s.precompile.Address(), 0)
+
+			var err error
+			switch tc.method {
+			case mint.PauseMethod:
+				_, err = s.precompile.Pause(ctx, contract, stateDB, &method, tc.args)
+			case mint.UnpauseMethod:
+				_, err = s.precompile.Unpause(ctx, contract, stateDB, &method, tc.args)
+			case mint.PauseDenomMethod:
+				_, err = s.precompile.PauseDenom(ctx, contract, stateDB, &method, tc.args)
+			}
+
+			if tc.expErr {
+				s.Require().Error(err, "expected %s transaction to fail", tc.method)
+				s.Require().Contains(err.Error(), tc.errContains, "expected %s transaction to fail with specific error", tc.method)
+			} else {
+				s.Require().NoError(err, "expected %s transaction to succeed", tc.method)
+				if tc.postCheck != nil {
+					tc.postCheck(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestMintAndBurnWhilePaused() {
+	testcases := []struct {
+		name     string
+		malleate func(ctx sdk.Context)
+		denom    string
+		expPause bool
+	}{
+		{
+			name:     "not paused",
+			malleate: func(sdk.Context) {},
+			denom:    "umint",
+			expPause: false,
+		},
+		{
+			name: "global pause",
+			malleate: func(ctx sdk.Context) {
+				s.network.App.GetEVMMintKeeper().SetPaused(ctx, true)
+			},
+			denom:    "umint",
+			expPause: true,
+		},
+		{
+			name: "denom pause",
+			malleate: func(ctx sdk.Context) {
+				s.Require().NoError(s.network.App.GetEVMMintKeeper().SetDenomPaused(ctx, "umint", true))
+			},
+			denom:    "umint",
+			expPause: true,
+		},
+		{
+			name: "other denom pause",
+			malleate: func(ctx sdk.Context) {
+				s.Require().NoError(s.network.App.GetEVMMintKeeper().SetDenomPaused(ctx, "uatom", true))
+			},
+			denom:    "umint",
+			expPause: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			admin := s.keyring.GetKey(0)
+			minter := s.keyring.GetKey(1)
+			mintKeeper := s.network.App.GetEVMMintKeeper()
+			err := mintKeeper.SetMinterAllowance(s.network.GetContext(), minter.AccAddr, tc.denom, math.NewInt(10_000))
+			s.Require().NoError(err)
+
+			// fund the minter before the pause so there is something to burn
+			contract, ctx := precompiletestutil.NewPrecompileContract(s.T(), s.network.GetContext(), minter.Addr, s.precompile.Address(), 0)
+			mintMethod := s.precompile.Methods[mint.MintMethod]
+			_, err = s.precompile.Mint(ctx, contract, s.network.GetStateDB(), &mintMethod, []interface{}{minter.Addr, tc.denom, big.NewInt(1000)})
+			s.Require().NoError(err, "expected mint to succeed before the pause")
+
+			tc.malleate(ctx)
+
+			isPausedMethod := s.precompile.Methods[mint.IsPausedMethod]
+			bz, err := s.precompile.IsPaused(ctx, &isPausedMethod, []interface{}{tc.denom})
+			s.Require().NoError(err, "expected isPaused query to succeed")
+			var paused bool
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&paused, mint.IsPausedMethod, bz))
+			s.Require().Equal(tc.expPause, paused, "expected different pause status")
+
+			checkErr := func(err error, method string) {
+				if tc.expPause {
+					s.Require().ErrorContains(err, minttypes.ErrPaused.Error(), "expected %s to fail while paused", method)
+				} else {
+					s.Require().NoError(err, "expected %s to succeed", method)
+				}
+			}
+
+			_, err = s.precompile.Mint(ctx, contract, s.network.GetStateDB(), &mintMethod, []interface{}{minter.Addr, tc.denom, big.NewInt(100)})
+			checkErr(err, mint.MintMethod)
+
+			mintBatchMethod := s.precompile.Methods[mint.MintBatchMethod]
+			_, err = s.precompile.MintBatch(ctx, contract, s.network.GetStateDB(), &mintBatchMethod, []interface{}{[]common.Address{minter.Addr}, tc.denom, []*big.Int{big.NewInt(100)}})
+			checkErr(err, mint.MintBatchMethod)
+
+			burnMethod := s.precompile.Methods[mint.BurnMethod]
+			_, err = s.precompile.Burn(ctx, contract, s.network.GetStateDB(), &burnMethod, []interface{}{tc.denom, big.NewInt(100)})
+			checkErr(err, mint.BurnMethod)
+
+			adminContract, adminCtx := precompiletestutil.NewPrecompileContract(s.T(), ctx, admin.Addr, s.precompile.Address(), 0)
+			burnFromMethod := s.precompile.Methods[mint.BurnFromMethod]
+			_, err = s.precompile.BurnFrom(adminCtx, adminContract, s.network.GetStateDB(), &burnFromMethod, []interface{}{minter.Addr, tc.denom, big.NewInt(100)})
+			checkErr(err, mint.BurnFromMethod)
+
+			burnBatchMethod := s.precompile.Methods[mint.BurnBatchMethod]
+			_, err = s.precompile.BurnBatch(adminCtx, adminContract, s.network.GetStateDB(), &burnBatchMethod, []interface{}{[]common.Address{minter.Addr}, tc.denom, []*big.Int{big.NewInt(100)}})
+			checkErr(err, mint.BurnBatchMethod)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestMintEVMDenomStateDB() {
+	testcases := []struct {
+		name     string
+		chainID  testconstants.ChainID
+		denom    func() string
+		value    *big.Int
+		expDelta *big.Int
+		revert   bool
+	}{
+		{
+			name:     "pass - 18 decimals EVM denom",
+			chainID:  testconstants.ExampleChainID,
+			denom:    evmtypes.GetEVMCoinDenom,
+			value:    big.NewInt(1_000),
+			expDelta: big.NewInt(1_000),
+		},
+		{
+			name:     "pass - 6 decimals EVM denom",
+			chainID:  testconstants.SixDecimalsChainID,
+			denom:    evmtypes.GetEVMCoinDenom,
+			value:    big.NewInt(3),
+			expDelta: big.NewInt(3_000_000_000_000),
+		},
+		{
+			name:     "pass - 6 decimals extended denom with fractional amount",
+			chainID:  testconstants.SixDecimalsChainID,
+			denom:    evmtypes.GetEVMCoinExtendedDenom,
+			value:    big.NewInt(1_500_000_000_123),
+			expDelta: big.NewInt(1_500_000_000_123),
+		},
+		{
+			name:     "pass - reverted mint leaves no balance",
+			chainID:  testconstants.SixDecimalsChainID,
+			denom:    evmtypes.GetEVMCoinExtendedDenom,
+			value:    big.NewInt(1_500_000_000_123),
+			expDelta: big.NewInt(1_500_000_000_123),
+			revert:   true,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTestWithChainID(tc.chainID)
+			ctx := s.network.GetContext()
+			minter := s.keyring.GetKey(1)
+			recipient := utiltx.GenerateAddress()
+			denom := tc.denom()
+
+			mintKeeper := s.network.App.GetEVMMintKeeper()
+			err := mintKeeper.SetMinterAllowance(ctx, minter.AccAddr, denom, math.NewIntFromBigInt(tc.value))
+			s.Require().NoError(err)
+			err = mintKeeper.SetParams(ctx, minttypes.NewParams(nil, nil, true, 0))
+			s.Require().NoError(err)
+
+			contract := vm.NewPrecompile(minter.Addr, s.precompile.Address(), uint256.NewInt(0), 200_000)
+			contract.Input, err = s.precompile.Pack(mint.MintMethod, recipient, denom, tc.value)
+			s.Require().NoError(err, "failed to pack input")
+
+			precompileAddr := s.precompile.Address()
+			msg, err := s.factory.GenerateGethCoreMsg(minter.Priv, evmtypes.EvmTxArgs{
+				ChainID:   evmtypes.GetEthChainConfig().ChainID,
+				To:        &precompileAddr,
+				GasLimit:  200_000,
+				GasPrice:  testutil.ExampleMinGasPrices,
+				GasFeeCap: s.network.App.GetEVMKeeper().GetBaseFee(ctx),
+				GasTipCap: big.NewInt(1),
+				Accesses:  &ethtypes.AccessList{},
+			})
+			s.Require().NoError(err)
+
+			cfg, err := s.network.App.GetEVMKeeper().EVMConfig(ctx, ctx.BlockHeader().ProposerAddress)
+			s.Require().NoError(err, "failed to instantiate EVM config")
+			stateDB := statedb.New(ctx, s.network.App.GetEVMKeeper(), statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+			evm := s.network.App.GetEVMKeeper().NewEVM(ctx, *msg, cfg, nil, stateDB)
+
+			// load the recipient into the StateDB cache, like a contract reading
+			// its balance before the mint would
+			s.Require().True(stateDB.GetBalance(recipient).IsZero())
+			snapshot := stateDB.Snapshot()
+			registered := s.network.App.GetErc20Keeper().IsDenomRegistered(ctx, denom)
+
+			_, err = s.precompile.Run(evm, contract, false)
+			s.Require().NoError(err, "expected mint to succeed")
+			s.Require().Equal(tc.expDelta, stateDB.GetBalance(recipient).ToBig(), "expected the StateDB to see the minted amount")
+
+			expBalance := tc.expDelta
+			if tc.revert {
+				stateDB.RevertToSnapshot(snapshot)
+				s.Require().True(stateDB.GetBalance(recipient).IsZero(), "expected the mint to be reverted in the StateDB")
+				expBalance = big.NewInt(0)
+			}
+
+			s.Require().NoError(stateDB.Commit())
+
+			balance := s.network.App.GetPreciseBankKeeper().GetBalance(ctx, recipient.Bytes(), evmtypes.GetEVMCoinExtendedDenom())
+			s.Require().Equal(expBalance, balance.Amount.BigInt(), "expected the committed balance to match the StateDB")
+			s.Require().Equal(registered, s.network.App.GetErc20Keeper().IsDenomRegistered(ctx, denom), "expected the mint not to register the EVM denom as an ERC20 token pair")
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestMintProposals() {
+	// Once setup for all steps, as each step builds on the previous ones!
+	s.SetupTest()
+
+	const (
+		approverIdx = 0 // also the mint authority
+		minterIdx   = 1
+		otherIdx    = 2 // the second approver
+	)
+
+	mintKeeper := s.network.App.GetEVMMintKeeper()
+	baseCtx := s.network.GetContext()
+	s.Require().NoError(mintKeeper.SetMinterAllowance(baseCtx, s.keyring.GetAccAddr(minterIdx), "umint", math.NewInt(5000)))
+	s.Require().NoError(mintKeeper.UpdateMintApprovalConfig(baseCtx, minttypes.NewMintApprovalConfig(
+		[]string{s.keyring.GetAccAddr(approverIdx).String(), s.keyring.GetAccAddr(otherIdx).String()},
+		2, 2, 10,
+		sdk.NewCoins(sdk.NewInt64Coin("umint", 1000)),
+	)))
+
+	call := func(ctx sdk.Context, caller common.Address, name string, args ...interface{}) ([]byte, error) {
+		method := s.precompile.Methods[name]
+		stateDB := s.network.GetStateDB()
+		contract, ctx := precompiletestutil.NewPrecompileContract(s.T(), ctx, caller, s.precompile.Address(), 0)
+
+		switch name {
+		case mint.MintMethod:
+			return s.precompile.Mint(ctx, contract, stateDB, &method, args)
+		case mint.MintBatchMethod:
+			return s.precompile.MintBatch(ctx, contract, stateDB, &method, args)
+		case mint.ProposeMintMethod:
+			return s.precompile.ProposeMint(ctx, contract, stateDB, &method, args)
+		case mint.ApproveMintMethod:
+			return s.precompile.ApproveMint(ctx, contract, stateDB, &method, args)
+		case mint.ExecuteMintMethod:
+			return s.precompile.ExecuteMint(ctx, contract, stateDB, &method, args)
+		case mint.CancelMintMethod:
+			return s.precompile.CancelMint(ctx, contract, stateDB, &method, args)
+		case mint.MintProposalMethod:
+			return s.precompile.MintProposal(ctx, &method, args)
+		}
+		return nil, nil
+	}
+
+	minter := s.keyring.GetAddr(minterIdx)
+	approver := s.keyring.GetAddr(approverIdx)
+	other := s.keyring.GetAddr(otherIdx)
+
+	// mints above the large mint threshold require a proposal, also in batches
+	_, err := call(baseCtx, minter, mint.MintMethod, toAddr, "umint", big.NewInt(2000))
+	s.Require().ErrorContains(err, minttypes.ErrMintRequiresProposal.Error())
+	_, err = call(baseCtx, minter, mint.MintBatchMethod, []common.Address{toAddr, toAddr}, "umint", []*big.Int{big.NewInt(600), big.NewInt(600)})
+	s.Require().ErrorContains(err, minttypes.ErrMintRequiresProposal.Error())
+	_, err = call(baseCtx, minter, mint.MintMethod, toAddr, "umint", big.NewInt(1000))
+	s.Require().NoError(err, "expected mints up to the threshold to succeed")
+
+	// the threshold applies to the mints of the whole block, so that a large
+	// mint cannot be split into several calls
+	_, err = call(baseCtx, minter, mint.MintMethod, toAddr, "umint", big.NewInt(1))
+	s.Require().ErrorContains(err, minttypes.ErrMintRequiresProposal.Error())
+	_, err = call(baseCtx, minter, mint.MintBatchMethod, []common.Address{toAddr}, "umint", []*big.Int{big.NewInt(1)})
+	s.Require().ErrorContains(err, minttypes.ErrMintRequiresProposal.Error())
+
+	// batch totals above 256 bits are rejected
+	maxUint256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	_, err = call(baseCtx, minter, mint.MintBatchMethod, []common.Address{toAddr, toAddr}, "umint", []*big.Int{maxUint256, maxUint256})
+	s.Require().ErrorContains(err, "overflows 256 bits")
+
+	// only minters can propose, with at least the minimum delay
+	_, err = call(baseCtx, other, mint.ProposeMintMethod, toAddr, "umint", big.NewInt(2000), uint64(2))
+	s.Require().ErrorContains(err, mint.ErrUnauthorized.Error())
+	_, err = call(baseCtx, minter, mint.ProposeMintMethod, toAddr, "umint", big.NewInt(2000), uint64(1))
+	s.Require().ErrorContains(err, minttypes.ErrInvalidMintProposal.Error())
+
+	bz, err := call(baseCtx, minter, mint.ProposeMintMethod, toAddr, "umint", big.NewInt(2000), uint64(2))
+	s.Require().NoError(err, "expected proposeMint to succeed")
+	out, err := s.precompile.Methods[mint.ProposeMintMethod].Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	id := out[0].(*big.Int)
+	s.Require().Equal(int64(1), id.Int64())
+
+	// only approvers can approve
+	_, err = call(baseCtx, minter, mint.ApproveMintMethod, id)
+	s.Require().ErrorContains(err, minttypes.ErrNotMintApprover.Error())
+	_, err = call(baseCtx, approver, mint.ApproveMintMethod, id)
+	s.Require().NoError(err, "expected approveMint to succeed")
+
+	executableCtx := baseCtx.WithBlockHeight(baseCtx.BlockHeight() + 2)
+	_, err = call(executableCtx, minter, mint.ExecuteMintMethod, id)
+	s.Require().ErrorContains(err, minttypes.ErrMintProposalNotExecutable.Error(), "expected the threshold to be enforced")
+
+	_, err = call(baseCtx, other, mint.ApproveMintMethod, id)
+	s.Require().NoError(err, "expected approveMint to succeed")
+
+	bz, err = call(baseCtx, toAddr, mint.MintProposalMethod, id)
+	s.Require().NoError(err)
+	out, err = s.precompile.Methods[mint.MintProposalMethod].Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal(minter, out[0])
+	s.Require().Equal(toAddr, out[1])
+	s.Require().Equal("umint", out[2])
+	s.Require().Equal(big.NewInt(2000), out[3])
+	s.Require().Equal(big.NewInt(2), out[6])
+
+	_, err = call(baseCtx, minter, mint.ExecuteMintMethod, id)
+	s.Require().ErrorContains(err, minttypes.ErrMintProposalNotExecutable.Error(), "expected the delay to be enforced")
+	_, err = call(executableCtx, toAddr, mint.ExecuteMintMethod, id)
+	s.Require().ErrorContains(err, mint.ErrNotProposalParty.Error())
+
+	_, err = call(executableCtx, other, mint.ExecuteMintMethod, id)
+	s.Require().NoError(err, "expected executeMint to succeed")
+
+	balance := s.network.App.GetBankKeeper().GetBalance(baseCtx, toAddr.Bytes(), "umint")
+	s.Require().Equal(int64(3000), balance.Amount.Int64(), "expected the proposal to be minted")
+	allowance, _ := mintKeeper.GetMinterAllowance(baseCtx, s.keyring.GetAccAddr(minterIdx), "umint")
+	s.Require().Equal(int64(2000), allowance.Int64(), "expected the proposer's allowance to be drawn down")
+	_, found := mintKeeper.GetMintProposal(baseCtx, id.Uint64())
+	s.Require().False(found, "expected the executed proposal to be removed")
+
+	_, err = call(executableCtx, other, mint.ExecuteMintMethod, id)
+	s.Require().ErrorContains(err, "not found", "expected a proposal to be executed once")
+
+	// only the proposer and the mint authority can cancel
+	bz, err = call(baseCtx, minter, mint.ProposeMintMethod, toAddr, "umint", big.NewInt(2000), uint64(2))
+	s.Require().NoError(err)
+	out, err = s.precompile.Methods[mint.ProposeMintMethod].Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	id = out[0].(*big.Int)
+
+	_, err = call(baseCtx, other, mint.CancelMintMethod, id)
+	s.Require().ErrorContains(err, mint.ErrCannotCancel.Error())
+	_, err = call(baseCtx, approver, mint.CancelMintMethod, id)
+	s.Require().NoError(err, "expected the mint authority to cancel")
+	_, found = mintKeeper.GetMintProposal(baseCtx, id.Uint64())
+	s.Require().False(found, "expected the cancelled proposal to be removed")
+
+	// the mints tracked against the threshold are reset in the next block
+	nextCtx := baseCtx.WithBlockHeight(baseCtx.BlockHeight() + 1)
+	_, err = call(nextCtx, minter, mint.MintMethod, toAddr, "umint", big.NewInt(1000))
+	s.Require().NoError(err, "expected mints up to the threshold to succeed in the next block")
+}
+
+// signVoucher returns the signature of the given mint voucher by the given key.
+func (s *PrecompileTestSuite) signVoucher(key testkeyring.Key, to common.Address, denom string, value, nonce, deadline *big.Int) []byte {
+	hash, _, err := apitypes.TypedDataAndHash(mint.VoucherTypedData(s.precompile.Address(), to, denom, value, nonce, deadline))
+	s.Require().NoError(err, "failed to hash voucher")
+
+	signature, err := key.Priv.Sign(hash)
+	s.Require().NoError(err, "failed to sign voucher")
+
+	return signature
+}
+
+func (s *PrecompileTestSuite) TestMintWithSignature() {
+	minterIdx, otherIdx := 1, 2
+
+	testcases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context) []interface{}
+		postCheck   func(ctx sdk.Context)
+		expErr      bool
+		errContains string
+	}{
+		{
+			name: "pass - redeem voucher",
+			malleate: func(ctx sdk.Context) []interface{} {
+				deadline := big.NewInt(ctx.BlockTime().Unix())
+				sig := s.signVoucher(s.keyring.GetKey(minterIdx), toAddr, "umint", big.NewInt(1000), big.NewInt(1), deadline)
+				return []interface{}{toAddr, "umint", big.NewInt(1000), big.NewInt(1), deadline, sig}
+			},
+			postCheck: func(ctx sdk.Context) {
+				mintKeeper := s.network.App.GetEVMMintKeeper()
+				balance := s.network.App.GetBankKeeper().GetBalance(ctx, toAddr.Bytes(), "umint")
+				s.Require().Equal(int64(1000), balance.Amount.Int64(), "expected tokens to be minted")
+				allowance, _ := mintKeeper.GetMinterAllowance(ctx, s.keyring.GetAccAddr(minterIdx), "umint")
+				s.Require().Equal(int64(1000), allowance.Int64(), "expected the signer's allowance to be drawn down")
+				s.Require().True(mintKeeper.IsVoucherNonceUsed(ctx, s.keyring.GetAccAddr(minterIdx), math.OneInt()), "expected the nonce to be used")
+			},
+		},
+		{
+			name: "pass - recovery id of 27 or 28",
+			malleate: func(ctx sdk.Context) []interface{} {
+				deadline := big.NewInt(ctx.BlockTime().Unix() + 60)
+				sig := s.signVoucher(s.keyring.GetKey(minterIdx), toAddr, "umint", big.NewInt(1000), big.NewInt(1), deadline)
+				sig[64] += 27
+				return []interface{}{toAddr, "umint", big.NewInt(1000), big.NewInt(1), deadline, sig}
+			},
+		},
+		{
+			name: "fail - nonce already used",
+			malleate: func(ctx sdk.Context) []interface{} {
+				err := s.network.App.GetEVMMintKeeper().UseVoucherNonce(ctx, s.keyring.GetAccAddr(minterIdx), math.OneInt())
+				s.Require().NoError(err)
+
+				deadline := big.NewInt(ctx.BlockTime().Unix() + 60)
+				sig := s.signVoucher(s.keyring.GetKey(minterIdx), toAddr, "umint", big.NewInt(1000), big.NewInt(1), deadline)
+				return []interface{}{toAddr, "umint", big.NewInt(1000), big.NewInt(1), deadline, sig}
+			},
+			expErr:      true,
+			errContains: minttypes.ErrVoucherNonceUsed.Error(),
+		},
+		{
+			name: "fail - deadline passed",
+			malleate: func(ctx sdk.Context) []interface{} {
+				deadline := big.NewInt(ctx.BlockTime().Unix() - 1)
+				sig := s.signVoucher(s.keyring.GetKey(minterIdx), toAddr, "umint", big.NewInt(1000), big.NewInt(1), deadline)
+				return []interface{}{toAddr, "umint", big.NewInt(1000), big.NewInt(1), deadline, sig}
+			},
+			expErr:      true,
+			errContains: "mint voucher expired",
+		},
+		{
+			name: "fail - signer is not a minter",
+			malleate: func(ctx sdk.Context) []interface{} {
+				deadline := big.NewInt(ctx.BlockTime().Unix() + 60)
+				sig := s.signVoucher(s.keyring.GetKey(otherIdx), toAddr, "umint", big.NewInt(1000), big.NewInt(1), deadline)
+				return []interface{}{toAddr, "umint", big.NewInt(1000), big.NewInt(1), deadline, sig}
+			},
+			expErr:      true,
+			errContains: mint.ErrUnauthorized.Error(),
+		},
+		{
+			name: "fail - voucher value changed after signing",
+			malleate: func(ctx sdk.Context) []interface{} {
+				deadline := big.NewInt(ctx.BlockTime().Unix() + 60)
+				sig := s.signVoucher(s.keyring.GetKey(minterIdx), toAddr, "umint", big.NewInt(1000), big.NewInt(1), deadline)
+				return []interface{}{toAddr, "umint", big.NewInt(2000), big.NewInt(1), deadline, sig}
+			},
+			expErr:      true,
+			errContains: mint.ErrUnauthorized.Error(),
+		},
+		{
+			name: "fail - invalid signature length",
+			malleate: func(ctx sdk.Context) []interface{} {
+				deadline := big.NewInt(ctx.BlockTime().Unix() + 60)
+				return []interface{}{toAddr, "umint", big.NewInt(1000), big.NewInt(1), deadline, []byte{1, 2, 3}}
+			},
+			expErr:      true,
+			errContains: "invalid mint voucher signature",
+		},
+		{
+			name: "fail - above the large mint threshold",
+			malleate: func(ctx sdk.Context) []interface{} {
+				err := s.network.App.GetEVMMintKeeper().UpdateMintApprovalConfig(ctx, minttypes.NewMintApprovalConfig(
+					[]string{s.keyring.GetAccAddr(0).String()}, 1, 0, 10,
+					sdk.NewCoins(sdk.NewInt64Coin("umint", 500)),
+				))
+				s.Require().NoError(err)
+
+				deadline := big.NewInt(ctx.BlockTime().Unix() + 60)
+				sig := s.signVoucher(s.keyring.GetKey(minterIdx), toAddr, "umint", big.NewInt(1000), big.NewInt(1), deadline)
+				return []interface{}{toAddr, "umint", big.NewInt(1000), big.NewInt(1), deadline, sig}
+			},
+			expErr:      true,
+			errContains: minttypes.ErrMintRequiresProposal.Error(),
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			err := s.network.App.GetEVMMintKeeper().SetMinterAllowance(ctx, s.keyring.GetAccAddr(minterIdx), "umint", math.NewInt(2000))
+			s.Require().NoError(err)
+
+			method := s.precompile.Methods[mint.MintWithSignatureMethod]
+			_, err = s.precompile.MintWithSignature(ctx, s.network.GetStateDB(), &method, tc.malleate(ctx))
+			if tc.expErr {
+				s.Require().Error(err, "expected mintWithSignature transaction to fail")
+				s.Require().Contains(err.Error(), tc.errContains, "expected mintWithSignature transaction to fail with specific error")
+			} else {
+				s.Require().NoError(err, "expected mintWithSignature transaction to succeed")
+				if tc.postCheck != nil {
+					tc.postCheck(ctx)
+				}
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestMintVesting() {
+	minterIdx := 1
+
+	testcases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context, start int64) (string, []interface{})
+		postCheck   func(ctx sdk.Context, start int64)
+		expErr      bool
+		errContains string
+	}{
+		{
+			name: "pass - continuous vesting account",
+			malleate: func(_ sdk.Context, start int64) (string, []interface{}) {
+				return mint.MintVestingMethod, []interface{}{toAddr, "umint", big.NewInt(1000), uint64(start), uint64(start + 100)}
+			},
+			postCheck: func(ctx sdk.Context, start int64) {
+				acc, ok := s.network.App.GetAccountKeeper().GetAccount(ctx, toAddr.Bytes()).(*vestingtypes.ContinuousVestingAccount)
+				s.Require().True(ok, "expected a continuous vesting account")
+				s.Require().Equal(start, acc.StartTime)
+				s.Require().Equal(start+100, acc.EndTime)
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("umint", 1000)), acc.OriginalVesting)
+
+				balance := s.network.App.GetBankKeeper().GetBalance(ctx, toAddr.Bytes(), "umint")
+				s.Require().Equal(int64(1000), balance.Amount.Int64(), "expected tokens to be minted")
+				spendable := s.network.App.GetBankKeeper().SpendableCoin(ctx, toAddr.Bytes(), "umint")
+				s.Require().True(spendable.IsZero(), "expected the minted tokens to be locked")
+			},
+		},
+		{
+			name: "pass - extend continuous vesting account with the same schedule",
+			malleate: func(ctx sdk.Context, start int64) (string, []interface{}) {
+				s.mintVesting(ctx, minterIdx, []interface{}{toAddr, "umint", big.NewInt(400), uint64(start), uint64(start + 100)})
+				return mint.MintVestingMethod, []interface{}{toAddr, "umint", big.NewInt(600), uint64(start), uint64(start + 100)}
+			},
+			postCheck: func(ctx sdk.Context, _ int64) {
+				acc, ok := s.network.App.GetAccountKeeper().GetAccount(ctx, toAddr.Bytes()).(*vestingtypes.ContinuousVestingAccount)
+				s.Require().True(ok, "expected a continuous vesting account")
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("umint", 1000)), acc.OriginalVesting)
+			},
+		},
+		{
+			name: "pass - periodic vesting account",
+			malleate: func(_ sdk.Context, start int64) (string, []interface{}) {
+				return mint.MintPeriodicVestingMethod, []interface{}{
+					toAddr, "umint", uint64(start), []uint64{10, 20}, []*big.Int{big.NewInt(300), big.NewInt(700)},
+				}
+			},
+			postCheck: func(ctx sdk.Context, start int64) {
+				acc, ok := s.network.App.GetAccountKeeper().GetAccount(ctx, toAddr.Bytes()).(*vestingtypes.PeriodicVestingAccount)
+				s.Require().True(ok, "expected a periodic vesting account")
+				s.Require().Equal(start, acc.StartTime)
+				s.Require().Equal(start+30, acc.EndTime)
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("umint", 1000)), acc.OriginalVesting)
+				s.Require().Len(acc.VestingPeriods, 2)
+
+				allowance, _ := s.network.App.GetEVMMintKeeper().GetMinterAllowance(ctx, s.keyring.GetAccAddr(minterIdx), "umint")
+				s.Require().Equal(int64(1000), allowance.Int64(), "expected the allowance to be drawn down by the sum of the periods")
+			},
+		},
+		{
+			name: "pass - merge periodic vesting schedules",
+			malleate: func(ctx sdk.Context, start int64) (string, []interface{}) {
+				s.mintVesting(ctx, minterIdx, []interface{}{
+					toAddr, "umint", uint64(start), []uint64{10, 20}, []*big.Int{big.NewInt(300), big.NewInt(700)},
+				})
+				return mint.MintPeriodicVestingMethod, []interface{}{
+					toAddr, "umint", uint64(start + 10), []uint64{40}, []*big.Int{big.NewInt(500)},
+				}
+			},
+			postCheck: func(ctx sdk.Context, start int64) {
+				acc, ok := s.network.App.GetAccountKeeper().GetAccount(ctx, toAddr.Bytes()).(*vestingtypes.PeriodicVestingAccount)
+				s.Require().True(ok, "expected a periodic vesting account")
+				s.Require().Equal(start, acc.StartTime)
+				s.Require().Equal(start+50, acc.EndTime)
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("umint", 1500)), acc.OriginalVesting)
+				s.Require().Equal(vestingtypes.Periods{
+					{Length: 10, Amount: sdk.NewCoins(sdk.NewInt64Coin("umint", 300))},
+					{Length: 20, Amount: sdk.NewCoins(sdk.NewInt64Coin("umint", 700))},
+					{Length: 20, Amount: sdk.NewCoins(sdk.NewInt64Coin("umint", 500))},
+				}, vestingtypes.Periods(acc.VestingPeriods))
+			},
+		},
+		{
+			name: "fail - continuous vesting account with a different schedule",
+			malleate: func(ctx sdk.Context, start int64) (string, []interface{}) {
+				s.mintVesting(ctx, minterIdx, []interface{}{toAddr, "umint", big.NewInt(400), uint64(start), uint64(start + 100)})
+				return mint.MintVestingMethod, []interface{}{toAddr, "umint", big.NewInt(600), uint64(start), uint64(start + 200)}
+			},
+			expErr:      true,
+			errContains: minttypes.ErrInvalidVestingSchedule.Error(),
+		},
+		{
+			name: "fail - continuous vesting on a periodic vesting account",
+			malleate: func(ctx sdk.Context, start int64) (string, []interface{}) {
+				s.mintVesting(ctx, minterIdx, []interface{}{
+					toAddr, "umint", uint64(start), []uint64{10}, []*big.Int{big.NewInt(300)},
+				})
+				return mint.MintVestingMethod, []interface{}{toAddr, "umint", big.NewInt(600), uint64(start), uint64(start + 100)}
+			},
+			expErr:      true,
+			errContains: minttypes.ErrInvalidVestingSchedule.Error(),
+		},
+		{
+			name: "fail - start not before end",
+			malleate: func(_ sdk.Context, start int64) (string, []interface{}) {
+				return mint.MintVestingMethod, []interface{}{toAddr, "umint", big.NewInt(1000), uint64(start), uint64(start)}
+			},
+			expErr:      true,
+			errContains: minttypes.ErrInvalidVestingSchedule.Error(),
+		},
+		{
+			name: "fail - vesting time above int64",
+			malleate: func(_ sdk.Context, start int64) (string, []interface{}) {
+				return mint.MintVestingMethod, []interface{}{toAddr, "umint", big.NewInt(1000), uint64(start), uint64(1) << 63}
+			},
+			expErr:      true,
+			errContains: "invalid vesting time",
+		},
+		{
+			name: "fail - zero length period",
+			malleate: func(_ sdk.Context, start int64) (string, []interface{}) {
+				return mint.MintPeriodicVestingMethod, []interface{}{
+					toAddr, "umint", uint64(start), []uint64{10, 0}, []*big.Int{big.NewInt(300), big.NewInt(700)},
+				}
+			},
+			expErr:      true,
+			errContains: mint.ErrZeroVestingPeriod.Error(),
+		},
+		{
+			name: "fail - mismatched periods",
+			malleate: func(_ sdk.Context, start int64) (string, []interface{}) {
+				return mint.MintPeriodicVestingMethod, []interface{}{
+					toAddr, "umint", uint64(start), []uint64{10, 20}, []*big.Int{big.NewInt(300)},
+				}
+			},
+			expErr:      true,
+			errContains: "mismatched vesting periods",
+		},
+		{
+			name: "fail - above the minter allowance",
+			malleate: func(_ sdk.Context, start int64) (string, []interface{}) {
+				return mint.MintVestingMethod, []interface{}{toAddr, "umint", big.NewInt(3000), uint64(start), uint64(start + 100)}
+			},
+			expErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			err := s.network.App.GetEVMMintKeeper().SetMinterAllowance(ctx, s.keyring.GetAccAddr(minterIdx), "umint", math.NewInt(2000))
+			s.Require().NoError(err)
+
+			start := ctx.BlockTime().Unix() + 10
+			name, args := tc.malleate(ctx, start)
+			_, err = s.callMintVesting(ctx, minterIdx, name, args)
+			if tc.expErr {
+				s.Require().Error(err, "expected %s transaction to fail", name)
+				s.Require().Contains(err.Error(), tc.errContains, "expected %s transaction to fail with specific error", name)
+			} else {
+				s.Require().NoError(err, "expected %s transaction to succeed", name)
+				if tc.postCheck != nil {
+					tc.postCheck(ctx, start)
+				}
+			}
+		})
+	}
+}
+
+// mintVesting calls mintVesting, or mintPeriodicVesting when given its
+// arguments, and requires it to succeed.
+func (s *PrecompileTestSuite) mintVesting(ctx sdk.Context, minterIdx int, args []interface{}) {
+	name := mint.MintVestingMethod
+	if _, ok := args[3].([]uint64); ok {
+		name = mint.MintPeriodicVestingMethod
+	}
+
+	_, err := s.callMintVesting(ctx, minterIdx, name, args)
+	s.Require().NoError(err)
+}
+
+func (s *PrecompileTestSuite) callMintVesting(ctx sdk.Context, minterIdx int, name string, args []interface{}) ([]byte, error) {
+	method := s.precompile.Methods[name]
+	contract, ctx := precompiletestutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(minterIdx), s.precompile.Address(), 0)
+	if name == mint.MintPeriodicVestingMethod {
+		return s.precompile.MintPeriodicVesting(ctx, contract, s.network.GetStateDB(), &method, args)
+	}
+	return s.precompile.MintVesting(ctx, contract, s.network.GetStateDB(), &method, args)
+}
+
+func (s *PrecompileTestSuite) TestMintVestingEVMDenomStateDB() {
+	testcases := []struct {
+		name         string
+		chainID      testconstants.ChainID
+		denom        func() string
+		value        *big.Int
+		elapsed      int64
+		expSpendable *big.Int
+		expRevert    bool
+	}{
+		{
+			name:         "pass - 18 decimals EVM denom, fully locked",
+			chainID:      testconstants.ExampleChainID,
+			denom:        evmtypes.GetEVMCoinDenom,
+			value:        big.NewInt(1_000),
+			expSpendable: big.NewInt(0),
+		},
+		{
+			name:         "pass - 18 decimals EVM denom, half vested",
+			chainID:      testconstants.ExampleChainID,
+			denom:        evmtypes.GetEVMCoinDenom,
+			value:        big.NewInt(1_000),
+			elapsed:      50,
+			expSpendable: big.NewInt(500),
+		},
+		{
+			name:         "pass - 6 decimals EVM denom, half vested",
+			chainID:      testconstants.SixDecimalsChainID,
+			denom:        evmtypes.GetEVMCoinDenom,
+			value:        big.NewInt(4),
+			elapsed:      50,
+			expSpendable: big.NewInt(2_000_000_000_000),
+		},
+		{
+			name:      "fail - 6 decimals extended denom",
+			chainID:   testconstants.SixDecimalsChainID,
+			denom:     evmtypes.GetEVMCoinExtendedDenom,
+			value:     big.NewInt(4_000_000_000_000),
+			expRevert: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTestWithChainID(tc.chainID)
+			ctx := s.network.GetContext()
+			minter := s.keyring.GetKey(1)
+			recipient := utiltx.GenerateAddress()
+			denom := tc.denom()
+
+			err := s.network.App.GetEVMMintKeeper().SetMinterAllowance(ctx, minter.AccAddr, denom, math.NewIntFromBigInt(tc.value))
+			s.Require().NoError(err)
+
+			start := ctx.BlockTime().Unix() - tc.elapsed
+			contract := vm.NewPrecompile(minter.Addr, s.precompile.Address(), uint256.NewInt(0), 200_000)
+			contract.Input, err = s.precompile.Pack(mint.MintVestingMethod, recipient, denom, tc.value, uint64(start), uint64(start+100))
+			s.Require().NoError(err, "failed to pack input")
+
+			precompileAddr := s.precompile.Address()
+			msg, err := s.factory.GenerateGethCoreMsg(minter.Priv, evmtypes.EvmTxArgs{
+				ChainID:   evmtypes.GetEthChainConfig().ChainID,
+				To:        &precompileAddr,
+				GasLimit:  200_000,
+				GasPrice:  testutil.ExampleMinGasPrices,
+				GasFeeCap: s.network.App.GetEVMKeeper().GetBaseFee(ctx),
+				GasTipCap: big.NewInt(1),
+				Accesses:  &ethtypes.AccessList{},
+			})
+			s.Require().NoError(err)
+
+			cfg, err := s.network.App.GetEVMKeeper().EVMConfig(ctx, ctx.BlockHeader().ProposerAddress)
+			s.Require().NoError(err, "failed to instantiate EVM config")
+			stateDB := statedb.New(ctx, s.network.App.GetEVMKeeper(), statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+			evm := s.network.App.GetEVMKeeper().NewEVM(ctx, *msg, cfg, nil, stateDB)
+
+			// load the recipient into the StateDB cache before the mint
+			s.Require().True(stateDB.GetBalance(recipient).IsZero())
+
+			_, err = s.precompile.Run(evm, contract, false)
+			if tc.expRevert {
+				s.Require().ErrorIs(err, vm.ErrExecutionReverted, "expected mintVesting to revert")
+				s.Require().True(stateDB.GetBalance(recipient).IsZero())
+				return
+			}
+			s.Require().NoError(err, "expected mintVesting to succeed")
+			s.Require().Equal(tc.expSpendable.String(), stateDB.GetBalance(recipient).String(), "expected the StateDB to see the spendable amount only")
+
+			s.Require().NoError(stateDB.Commit())
+
+			_, ok := s.network.App.GetAccountKeeper().GetAccount(ctx, recipient.Bytes()).(*vestingtypes.ContinuousVestingAccount)
+			s.Require().True(ok, "expected a continuous vesting account")
+
+			bankKeeper := s.network.App.GetPreciseBankKeeper()
+			balance := bankKeeper.GetBalance(ctx, recipient.Bytes(), evmtypes.GetEVMCoinExtendedDenom())
+			s.Require().Equal(evmtypes.ConvertAmountTo18DecimalsBigInt(tc.value), balance.Amount.BigInt(), "expected the locked tokens to be minted")
+			spendable := bankKeeper.SpendableCoin(ctx, recipient.Bytes(), evmtypes.GetEVMCoinExtendedDenom())
+			s.Require().Equal(tc.expSpendable.String(), spendable.Amount.String(), "expected the committed spendable balance to match the StateDB")
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestCreateDenom() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	creator := s.keyring.GetKey(1)
+	method := s.precompile.Methods[mint.CreateDenomMethod]
+
+	testcases := []struct {
+		name        string
+		args        []interface{}
+		expDenom    string
+		expErr      bool
+		errContains string
+	}{
+		{
+			name:        "fail - invalid number of arguments",
+			args:        []interface{}{"team", "token"},
+			expErr:      true,
+			errContains: "invalid number of arguments",
+		},
+		{
+			name:        "fail - empty subdenom",
+			args:        []interface{}{""},
+			expErr:      true,
+			errContains: minttypes.ErrInvalidFactoryDenom.Error(),
+		},
+		{
+			name:        "fail - invalid subdenom",
+			args:        []interface{}{"team token"},
+			expErr:      true,
+			errContains: minttypes.ErrInvalidFactoryDenom.Error(),
+		},
+		{
+			name:     "pass - create denom",
+			args:     []interface{}{"team"},
+			expDenom: "factory/" + creator.AccAddr.String() + "/team",
+		},
+		{
+			name:        "fail - denom already created",
+			args:        []interface{}{"team"},
+			expErr:      true,
+			errContains: minttypes.ErrDenomExists.Error(),
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			stateDB := s.network.GetStateDB()
+			contract, ctx := precompiletestutil.NewPrecompileContract(s.T(), ctx, creator.Addr, s.precompile.Address(), 0)
+
+			bz, err := s.precompile.CreateDenom(ctx, contract, stateDB, &method, tc.args)
+			if tc.expErr {
+				s.Require().Error(err, "expected createDenom transaction to fail")
+				s.Require().Contains(err.Error(), tc.errContains, "expected createDenom transaction to fail with specific error")
+				return
+			}
+			s.Require().NoError(err, "expected createDenom transaction to succeed")
+
+			var denom string
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&denom, mint.CreateDenomMethod, bz))
+			s.Require().Equal(tc.expDenom, denom)
+			s.Require().True(s.network.App.GetEVMMintKeeper().IsDenomAdmin(ctx, denom, creator.AccAddr), "expected the creator to be the admin")
+
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 1)
+			var event mint.EventDenomCreated
+			s.Require().NoError(cmn.UnpackLog(s.precompile.ABI, &event, mint.EventTypeDenomCreated, *logs[0]))
+			s.Require().Equal(creator.Addr, event.Creator)
+			s.Require().Equal(denom, event.Denom)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestFactoryDenomAdmin() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	stateDB := s.network.GetStateDB()
+	authority, admin, minter := s.keyring.GetKey(0), s.keyring.GetKey(1), s.keyring.GetKey(2)
+
+	call := func(caller common.Address, name string, args ...interface{}) ([]byte, error) {
+		method := s.precompile.Methods[name]
+		contract, ctx := precompiletestutil.NewPrecompileContract(s.T(), ctx, caller, s.precompile.Address(), 0)
+		return s.precompile.HandleMethod(ctx, contract, stateDB, &method, args)
+	}
+	denomAdmin := func(denom string) common.Address {
+		bz, err := call(admin.Addr, mint.DenomAdminMethod, denom)
+		s.Require().NoError(err, "expected denomAdmin query to succeed")
+		var addr common.Address
+		s.Require().NoError(s.precompile.UnpackIntoInterface(&addr, mint.DenomAdminMethod, bz))
+		return addr
+	}
+
+	bz, err := call(admin.Addr, mint.CreateDenomMethod, "team")
+	s.Require().NoError(err)
+	var denom string
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&denom, mint.CreateDenomMethod, bz))
+	s.Require().Equal(admin.Addr, denomAdmin(denom))
+	s.Require().Equal(common.Address{}, denomAdmin("uusdc"), "expected no admin for a denom that was not created")
+
+	// the admin mints without an allowance, others cannot
+	_, err = call(admin.Addr, mint.MintMethod, toAddr, denom, big.NewInt(1000))
+	s.Require().NoError(err, "expected the admin to mint the denom")
+	_, err = call(minter.Addr, mint.MintMethod, toAddr, denom, big.NewInt(1000))
+	s.Require().ErrorIs(err, mint.ErrUnauthorized)
+	balance := s.network.App.GetBankKeeper().GetBalance(ctx, toAddr.Bytes(), denom)
+	s.Require().Equal(int64(1000), balance.Amount.Int64())
+
+	// the admin manages the minters and metadata of the denom, instead of the
+	// mint authority
+	_, err = call(authority.Addr, mint.AddMinterMethod, minter.Addr, denom, big.NewInt(500))
+	s.Require().ErrorIs(err, mint.ErrNotDenomAdmin)
+	_, err = call(authority.Addr, mint.SetDenomMetadataMethod, denom, "Team Token", "TEAM", uint8(6))
+	s.Require().ErrorIs(err, mint.ErrNotDenomAdmin)
+	_, err = call(admin.Addr, mint.AddMinterMethod, minter.Addr, denom, big.NewInt(500))
+	s.Require().NoError(err, "expected the admin to add a minter")
+	_, err = call(admin.Addr, mint.SetDenomMetadataMethod, denom, "Team Token", "TEAM", uint8(6))
+	s.Require().NoError(err, "expected the admin to set the metadata")
+	_, err = call(minter.Addr, mint.MintMethod, toAddr, denom, big.NewInt(500))
+	s.Require().NoError(err, "expected the minter to mint within its allowance")
+	_, err = call(minter.Addr, mint.MintMethod, toAddr, denom, big.NewInt(1))
+	s.Require().ErrorIs(err, minttypes.ErrInsufficientMinterAllowance)
+
+	// only the admin hands the admin over
+	_, err = call(minter.Addr, mint.ChangeAdminMethod, denom, minter.Addr)
+	s.Require().ErrorIs(err, mint.ErrNotDenomAdmin)
+	_, err = call(admin.Addr, mint.ChangeAdminMethod, denom, minter.Addr)
+	s.Require().NoError(err, "expected the admin to change the admin")
+	s.Require().Equal(minter.Addr, denomAdmin(denom))
+
+	logs := stateDB.Logs()
+	var event mint.EventDenomAdminChanged
+	s.Require().NoError(cmn.UnpackLog(s.precompile.ABI, &event, mint.EventTypeDenomAdminChanged, *logs[len(logs)-1]))
+	s.Require().Equal(mint.EventDenomAdminChanged{Denom: denom, PreviousAdmin: admin.Addr, NewAdmin: minter.Addr}, event)
+
+	_, err = call(admin.Addr, mint.MintMethod, toAddr, denom, big.NewInt(1))
+	s.Require().ErrorIs(err, mint.ErrUnauthorized)
+	_, err = call(admin.Addr, mint.RemoveMinterMethod, minter.Addr, denom)
+	s.Require().ErrorIs(err, mint.ErrNotDenomAdmin)
+
+	// renouncing the admin leaves the denom without an admin
+	_, err = call(minter.Addr, mint.ChangeAdminMethod, denom, common.Address{})
+	s.Require().NoError(err, "expected the admin to renounce")
+	s.Require().Equal(common.Address{}, denomAdmin(denom))
+	_, err = call(minter.Addr, mint.ChangeAdminMethod, denom, minter.Addr)
+	s.Require().ErrorIs(err, mint.ErrNotDenomAdmin)
+}
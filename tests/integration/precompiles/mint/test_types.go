@@ -3,6 +3,8 @@ package mint
 import (
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/cosmos/evm/precompiles/mint"
 	utiltx "github.com/cosmos/evm/testutil/tx"
 )
@@ -116,3 +118,325 @@ func (s *PrecompileTestSuite) TestParseMintArgs() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestParseAddMinterArgs() {
+	minter := utiltx.GenerateAddress()
+	denom := "uusdc"
+	allowance := big.NewInt(1000)
+
+	testcases := []struct {
+		name        string
+		args        []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			name:    "pass - correct arguments",
+			args:    []interface{}{minter, denom, allowance},
+			expPass: true,
+		},
+		{
+			name:        "fail - invalid number of arguments",
+			args:        []interface{}{minter, denom},
+			errContains: "invalid number of arguments; expected 3; got: 2",
+		},
+		{
+			name:        "fail - invalid minter address",
+			args:        []interface{}{"invalid address", denom, allowance},
+			errContains: "invalid minter address",
+		},
+		{
+			name:        "fail - invalid denom",
+			args:        []interface{}{minter, 123, allowance},
+			errContains: "invalid denom",
+		},
+		{
+			name:        "fail - invalid allowance",
+			args:        []interface{}{minter, denom, "invalid amount"},
+			errContains: "invalid allowance",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			parsedMinter, parsedDenom, parsedAllowance, err := mint.ParseAddMinterArgs(tc.args)
+			if tc.expPass {
+				s.Require().NoError(err, "unexpected error while parsing addMinter arguments")
+				s.Require().Equal(minter, parsedMinter, "expected different minter address")
+				s.Require().Equal(denom, parsedDenom, "expected different denom")
+				s.Require().Equal(allowance, parsedAllowance, "expected different allowance")
+			} else {
+				s.Require().Error(err, "expected an error parsing the addMinter arguments")
+				s.Require().ErrorContains(err, tc.errContains, "expected different error message")
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestParseMinterArgs() {
+	minter := utiltx.GenerateAddress()
+	denom := "uusdc"
+
+	testcases := []struct {
+		name        string
+		args        []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			name:    "pass - correct arguments",
+			args:    []interface{}{minter, denom},
+			expPass: true,
+		},
+		{
+			name:        "fail - invalid number of arguments",
+			args:        []interface{}{minter},
+			errContains: "invalid number of arguments; expected 2; got: 1",
+		},
+		{
+			name:        "fail - invalid minter address",
+			args:        []interface{}{"invalid address", denom},
+			errContains: "invalid minter address",
+		},
+		{
+			name:        "fail - invalid denom",
+			args:        []interface{}{minter, nil},
+			errContains: "invalid denom",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			parsedMinter, parsedDenom, err := mint.ParseMinterArgs(tc.args)
+			if tc.expPass {
+				s.Require().NoError(err, "unexpected error while parsing minter arguments")
+				s.Require().Equal(minter, parsedMinter, "expected different minter address")
+				s.Require().Equal(denom, parsedDenom, "expected different denom")
+			} else {
+				s.Require().Error(err, "expected an error parsing the minter arguments")
+				s.Require().ErrorContains(err, tc.errContains, "expected different error message")
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestParseBurnArgs() {
+	token := "umint"
+	value := big.NewInt(1000)
+
+	testcases := []struct {
+		name        string
+		args        []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			name:    "pass - correct arguments",
+			args:    []interface{}{token, value},
+			expPass: true,
+		},
+		{
+			name:        "fail - invalid number of arguments",
+			args:        []interface{}{token},
+			errContains: "invalid number of arguments; expected 2; got: 1",
+		},
+		{
+			name:        "fail - invalid token",
+			args:        []interface{}{123, value},
+			errContains: "invalid token",
+		},
+		{
+			name:        "fail - invalid amount",
+			args:        []interface{}{token, "invalid amount"},
+			errContains: "invalid amount",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			parsedToken, parsedValue, err := mint.ParseBurnArgs(tc.args)
+			if tc.expPass {
+				s.Require().NoError(err, "unexpected error while parsing burn arguments")
+				s.Require().Equal(token, parsedToken, "expected different token")
+				s.Require().Equal(value, parsedValue, "expected different value")
+			} else {
+				s.Require().Error(err, "expected an error parsing the burn arguments")
+				s.Require().ErrorContains(err, tc.errContains, "expected different error message")
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestParseBurnFromArgs() {
+	from := utiltx.GenerateAddress()
+	token := "umint"
+	value := big.NewInt(1000)
+
+	testcases := []struct {
+		name        string
+		args        []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			name:    "pass - correct arguments",
+			args:    []interface{}{from, token, value},
+			expPass: true,
+		},
+		{
+			name:        "fail - invalid number of arguments",
+			args:        []interface{}{from, token},
+			errContains: "invalid number of arguments; expected 3; got: 2",
+		},
+		{
+			name:        "fail - invalid from address",
+			args:        []interface{}{"invalid address", token, value},
+			errContains: "invalid from address",
+		},
+		{
+			name:        "fail - invalid token",
+			args:        []interface{}{from, nil, value},
+			errContains: "invalid token",
+		},
+		{
+			name:        "fail - invalid amount",
+			args:        []interface{}{from, token, nil},
+			errContains: "invalid amount",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			parsedFrom, parsedToken, parsedValue, err := mint.ParseBurnFromArgs(tc.args)
+			if tc.expPass {
+				s.Require().NoError(err, "unexpected error while parsing burnFrom arguments")
+				s.Require().Equal(from, parsedFrom, "expected different from address")
+				s.Require().Equal(token, parsedToken, "expected different token")
+				s.Require().Equal(value, parsedValue, "expected different value")
+			} else {
+				s.Require().Error(err, "expected an error parsing the burnFrom arguments")
+				s.Require().ErrorContains(err, tc.errContains, "expected different error message")
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestParseBatchArgs() {
+	accounts := []common.Address{utiltx.GenerateAddress(), utiltx.GenerateAddress()}
+	token := "umint"
+	values := []*big.Int{big.NewInt(100), big.NewInt(200)}
+
+	testcases := []struct {
+		name        string
+		args        []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			name:    "pass - correct arguments",
+			args:    []interface{}{accounts, token, values},
+			expPass: true,
+		},
+		{
+			name:        "fail - invalid number of arguments",
+			args:        []interface{}{accounts, token},
+			errContains: "invalid number of arguments; expected 3; got: 2",
+		},
+		{
+			name:        "fail - invalid addresses",
+			args:        []interface{}{accounts[0], token, values},
+			errContains: "invalid addresses",
+		},
+		{
+			name:        "fail - invalid token",
+			args:        []interface{}{accounts, 123, values},
+			errContains: "invalid token",
+		},
+		{
+			name:        "fail - invalid values",
+			args:        []interface{}{accounts, token, values[0]},
+			errContains: "invalid values",
+		},
+		{
+			name:        "fail - empty batch",
+			args:        []interface{}{[]common.Address{}, token, []*big.Int{}},
+			errContains: mint.ErrEmptyBatch.Error(),
+		},
+		{
+			name:        "fail - mismatched lengths",
+			args:        []interface{}{accounts, token, values[:1]},
+			errContains: "mismatched batch lengths: 2 addresses and 1 values",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			parsedAccounts, parsedToken, parsedValues, err := mint.ParseBatchArgs(tc.args)
+			if tc.expPass {
+				s.Require().NoError(err, "unexpected error while parsing batch arguments")
+				s.Require().Equal(accounts, parsedAccounts, "expected different accounts")
+				s.Require().Equal(token, parsedToken, "expected different token")
+				s.Require().Equal(values, parsedValues, "expected different values")
+			} else {
+				s.Require().Error(err, "expected an error parsing the batch arguments")
+				s.Require().ErrorContains(err, tc.errContains, "expected different error message")
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestParseSetDenomMetadataArgs() {
+	testcases := []struct {
+		name        string
+		args        []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			name:    "pass - correct arguments",
+			args:    []interface{}{"uusdc", "USD Coin", "USDC", uint8(6)},
+			expPass: true,
+		},
+		{
+			name:        "fail - invalid number of arguments",
+			args:        []interface{}{"uusdc", "USD Coin", "USDC"},
+			errContains: "invalid number of arguments; expected 4; got: 3",
+		},
+		{
+			name:        "fail - invalid denom",
+			args:        []interface{}{1, "USD Coin", "USDC", uint8(6)},
+			errContains: "invalid denom",
+		},
+		{
+			name:        "fail - invalid name",
+			args:        []interface{}{"uusdc", nil, "USDC", uint8(6)},
+			errContains: "invalid name",
+		},
+		{
+			name:        "fail - invalid symbol",
+			args:        []interface{}{"uusdc", "USD Coin", nil, uint8(6)},
+			errContains: "invalid symbol",
+		},
+		{
+			name:        "fail - invalid decimals",
+			args:        []interface{}{"uusdc", "USD Coin", "USDC", 6},
+			errContains: "invalid decimals",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			denom, name, symbol, decimals, err := mint.ParseSetDenomMetadataArgs(tc.args)
+			if tc.expPass {
+				s.Require().NoError(err, "unexpected error while parsing denom metadata arguments")
+				s.Require().Equal("uusdc", denom, "expected different denom")
+				s.Require().Equal("USD Coin", name, "expected different name")
+				s.Require().Equal("USDC", symbol, "expected different symbol")
+				s.Require().Equal(uint8(6), decimals, "expected different decimals")
+			} else {
+				s.Require().Error(err, "expected an error parsing the denom metadata arguments")
+				s.Require().ErrorContains(err, tc.errContains, "expected different error message")
+			}
+		})
+	}
+}
@@ -77,6 +77,7 @@ func (s *PrecompileUnitTestSuite) SetupTest(chainID testconstants.ChainID) {
 		s.network.App.GetBankKeeper(),
 		s.network.App.GetErc20Keeper(),
 		s.network.App.GetTransferKeeper(),
+		s.network.App.GetEVMMintKeeper(),
 	)
 	s.Require().NoError(err, "failed to instantiate the werc20 precompile")
 	s.Require().NotNil(precompile)
@@ -24,6 +24,7 @@ import (
 	testutiltypes "github.com/cosmos/evm/testutil/types"
 	erc20types "github.com/cosmos/evm/x/erc20/types"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	evmminttypes "github.com/cosmos/evm/x/mint/types"
 	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
@@ -88,6 +89,10 @@ func TestPrecompileIntegrationTestSuite(t *testing.T, create network.CreateEvmAp
 		withdrawAmount := depositAmount
 		transferAmount := big.NewInt(10) // Start with 10 integer units
 
+		// Mint whole integer units, so that no fractional balance is involved
+		mintUnits := big.NewInt(10)
+		mintAmount := new(big.Int).Mul(mintUnits, conversionFactor)
+
 		// Helper function to get account balance info by type
 		balanceOf := func(accountType AccountType) *AccountBalanceInfo {
 			return GetAccountBalance(accountBalances, accountType)
@@ -114,6 +119,13 @@ func TestPrecompileIntegrationTestSuite(t *testing.T, create network.CreateEvmAp
 				WithEVMCoinInfo(testconstants.ExampleChainCoinInfo[chainId]).
 				Configure()).To(BeNil(), "expected no error setting the evm configurator")
 
+			// Register the sender as a minter of the native coin.
+			mintGenesis := evmminttypes.DefaultGenesisState()
+			mintGenesis.MinterAllowances = []evmminttypes.MinterAllowance{
+				evmminttypes.NewMinterAllowance(txSender.AccAddr, evmtypes.GetEVMCoinDenom(), math.NewIntFromBigInt(mintUnits)),
+			}
+			customGenesis[evmminttypes.ModuleName] = mintGenesis
+
 			opts := []network.ConfigOption{
 				network.WithChainID(chainId),
 				network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
@@ -165,6 +177,7 @@ func TestPrecompileIntegrationTestSuite(t *testing.T, create network.CreateEvmAp
 				is.network.App.GetBankKeeper(),
 				is.network.App.GetErc20Keeper(),
 				is.network.App.GetTransferKeeper(),
+				is.network.App.GetEVMMintKeeper(),
 			)
 			Expect(err).ToNot(HaveOccurred(), "failed to instantiate the werc20 precompile")
 			is.precompile = precompile
@@ -454,6 +467,48 @@ func TestPrecompileIntegrationTestSuite(t *testing.T, create network.CreateEvmAp
 				Entry("it should not move funds and dont emit the event reverting after changing state", false, true),
 			)
 		})
+		Context("calling the mint methods", func() {
+			When("the sender is a minter of the native denom", func() {
+				It("it should mint the native coin to the receiver and emit a transfer event", func() {
+					balanceOf(Receiver).IntegerDelta = mintUnits
+
+					txArgs, callArgs := callsData.getTxAndCallArgs(directCall, werc20.MintMethod, user.Addr, mintAmount)
+					_, _, err := is.factory.CallContractAndCheckLogs(txSender.Priv, txArgs, callArgs, transferCheck)
+					Expect(err).ToNot(HaveOccurred(), "unexpected result calling contract")
+					Expect(is.network.NextBlock()).ToNot(HaveOccurred(), "error on NextBlock")
+				})
+				It("it should fail to mint above the minter allowance", func() {
+					txArgs, callArgs := callsData.getTxAndCallArgs(directCall, werc20.MintMethod, user.Addr, new(big.Int).Add(mintAmount, conversionFactor))
+					_, _, err := is.factory.CallContractAndCheckLogs(txSender.Priv, txArgs, callArgs, failCheck.WithErrContains("allowance"))
+					Expect(err).ToNot(HaveOccurred(), "unexpected result calling contract")
+					Expect(is.network.NextBlock()).ToNot(HaveOccurred(), "error on NextBlock")
+				})
+			})
+			When("the sender is not a minter", func() {
+				It("it should fail to mint", func() {
+					txArgs, callArgs := callsData.getTxAndCallArgs(directCall, werc20.MintMethod, txSender.Addr, mintAmount)
+					_, _, err := is.factory.CallContractAndCheckLogs(user.Priv, txArgs, callArgs, failCheck.WithErrContains(werc20.ErrNotMinter.Error()))
+					Expect(err).ToNot(HaveOccurred(), "unexpected result calling contract")
+					Expect(is.network.NextBlock()).ToNot(HaveOccurred(), "error on NextBlock")
+				})
+			})
+			When("burning tokens", func() {
+				It("it should burn the native coin of the sender and emit a transfer event", func() {
+					balanceOf(Sender).IntegerDelta = new(big.Int).Neg(mintUnits)
+
+					txArgs, callArgs := callsData.getTxAndCallArgs(directCall, werc20.BurnMethod, txSender.Addr, mintAmount)
+					_, _, err := is.factory.CallContractAndCheckLogs(txSender.Priv, txArgs, callArgs, transferCheck)
+					Expect(err).ToNot(HaveOccurred(), "unexpected result calling contract")
+					Expect(is.network.NextBlock()).ToNot(HaveOccurred(), "error on NextBlock")
+				})
+				It("it should fail to burn the tokens of another account", func() {
+					txArgs, callArgs := callsData.getTxAndCallArgs(directCall, werc20.BurnMethod, user.Addr, mintAmount)
+					_, _, err := is.factory.CallContractAndCheckLogs(txSender.Priv, txArgs, callArgs, failCheck.WithErrContains(werc20.ErrNotOwnerOrMintAuthority.Error()))
+					Expect(err).ToNot(HaveOccurred(), "unexpected result calling contract")
+					Expect(is.network.NextBlock()).ToNot(HaveOccurred(), "error on NextBlock")
+				})
+			})
+		})
 		Context("calling an erc20 method", func() {
 			When("transferring tokens", func() {
 				It("it should transfer tokens to a receiver using `transfer`", func() {
@@ -0,0 +1,351 @@
+package werc20
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/erc20"
+	precompiletestutil "github.com/cosmos/evm/precompiles/testutil"
+	"github.com/cosmos/evm/precompiles/werc20"
+	testconstants "github.com/cosmos/evm/testutil/constants"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	minttypes "github.com/cosmos/evm/x/mint/types"
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type TransferEvent struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+}
+
+// mintChainIDs are the chains the mint and burn methods are tested on, to
+// cover both the 18 decimals EVM denom and the extended denom of precisebank.
+var mintChainIDs = []testconstants.ChainID{
+	testconstants.ExampleChainID,
+	testconstants.SixDecimalsChainID,
+}
+
+// wiredPrecompile returns the WEVMOS precompile as instantiated by the erc20
+// keeper, with the x/precisebank and x/mint keepers of the app.
+func (s *PrecompileUnitTestSuite) wiredPrecompile() *werc20.Precompile {
+	contract, err := s.network.App.GetErc20Keeper().InstantiateERC20Precompile(
+		s.network.GetContext(),
+		common.HexToAddress(s.precompileAddrHex),
+		true,
+	)
+	s.Require().NoError(err)
+	precompile, ok := contract.(*werc20.Precompile)
+	s.Require().True(ok, "expected a werc20 precompile, got %T", contract)
+	return precompile
+}
+
+// totalSupply returns the total supply reported by the WERC20 totalSupply view.
+func (s *PrecompileUnitTestSuite) totalSupply(precompile *werc20.Precompile) *big.Int {
+	method := precompile.Methods[erc20.TotalSupplyMethod]
+	bz, err := precompile.TotalSupply(s.network.GetContext(), nil, nil, &method, nil)
+	s.Require().NoError(err)
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	return out[0].(*big.Int)
+}
+
+func (s *PrecompileUnitTestSuite) TestMint() {
+	// 2 integer units of the 6 decimals denom, so the bank supply changes on
+	// both chains
+	amount := big.NewInt(2_000_000_000_000)
+
+	testCases := []struct {
+		name        string
+		malleate    func(minter common.Address)
+		args        func(recipient common.Address) []interface{}
+		expErr      bool
+		errContains string
+	}{
+		{
+			name:     "pass - mints the native coin to the recipient",
+			malleate: func(common.Address) {},
+			args:     func(recipient common.Address) []interface{} { return []interface{}{recipient, amount} },
+		},
+		{
+			name: "fail - caller is not a minter",
+			malleate: func(minter common.Address) {
+				s.Require().NoError(s.network.App.GetEVMMintKeeper().DeleteMinterAllowance(
+					s.network.GetContext(), minter.Bytes(), evmtypes.GetEVMCoinDenom(),
+				))
+			},
+			args:        func(recipient common.Address) []interface{} { return []interface{}{recipient, amount} },
+			expErr:      true,
+			errContains: werc20.ErrNotMinter.Error(),
+		},
+		{
+			name:     "fail - amount above the minter allowance",
+			malleate: func(common.Address) {},
+			args: func(recipient common.Address) []interface{} {
+				return []interface{}{recipient, new(big.Int).Mul(amount, big.NewInt(1_000))}
+			},
+			expErr:      true,
+			errContains: "allowance",
+		},
+		{
+			name: "fail - amount above the large mint threshold of the EVM denom",
+			malleate: func(common.Address) {
+				s.Require().NoError(s.network.App.GetEVMMintKeeper().UpdateMintApprovalConfig(
+					s.network.GetContext(),
+					minttypes.NewMintApprovalConfig(
+						[]string{s.keyring.GetAccAddr(1).String()}, 1, 0, 10,
+						sdk.NewCoins(sdk.NewInt64Coin(evmtypes.GetEVMCoinDenom(), 1)),
+					),
+				))
+			},
+			args:        func(recipient common.Address) []interface{} { return []interface{}{recipient, amount} },
+			expErr:      true,
+			errContains: minttypes.ErrMintRequiresProposal.Error(),
+		},
+		{
+			name:        "fail - zero amount",
+			malleate:    func(common.Address) {},
+			args:        func(recipient common.Address) []interface{} { return []interface{}{recipient, big.NewInt(0)} },
+			expErr:      true,
+			errContains: werc20.ErrNonPositiveAmount.Error(),
+		},
+		{
+			name:        "fail - zero address recipient",
+			malleate:    func(common.Address) {},
+			args:        func(common.Address) []interface{} { return []interface{}{common.Address{}, amount} },
+			expErr:      true,
+			errContains: "invalid account address",
+		},
+		{
+			name:        "fail - invalid number of arguments",
+			malleate:    func(common.Address) {},
+			args:        func(recipient common.Address) []interface{} { return []interface{}{recipient} },
+			expErr:      true,
+			errContains: "invalid number of arguments",
+		},
+	}
+
+	for _, chainID := range mintChainIDs {
+		for _, tc := range testCases {
+			s.Run(chainID.ChainID+" "+tc.name, func() {
+				s.SetupTest(chainID)
+				minter := s.keyring.GetKey(0)
+				recipient := utiltx.GenerateAddress()
+				denom := evmtypes.GetEVMCoinDenom()
+				extendedDenom := evmtypes.GetEVMCoinExtendedDenom()
+				mintKeeper := s.network.App.GetEVMMintKeeper()
+				bankKeeper := s.network.App.GetPreciseBankKeeper()
+				// the x/mint allowances and stats are in units of the EVM denom
+				baseAmount := new(big.Int).Quo(amount, precisebanktypes.ConversionFactor().BigInt())
+
+				ctx := s.network.GetContext()
+				s.Require().NoError(mintKeeper.SetMinterAllowance(ctx, minter.AccAddr, denom, math.NewIntFromBigInt(new(big.Int).Mul(baseAmount, big.NewInt(10)))))
+				tc.malleate(minter.Addr)
+
+				precompile := s.wiredPrecompile()
+				supplyBefore := s.totalSupply(precompile)
+				stateDB := s.network.GetStateDB()
+
+				contract, ctx := precompiletestutil.NewPrecompileContract(s.T(), ctx, minter.Addr, precompile.Address(), 0)
+				_, err := precompile.Mint(ctx, contract, stateDB, tc.args(recipient))
+				if tc.expErr {
+					s.Require().ErrorContains(err, tc.errContains)
+					return
+				}
+				s.Require().NoError(err)
+
+				s.Require().Equal(amount.String(), bankKeeper.GetBalance(ctx, recipient.Bytes(), extendedDenom).Amount.String())
+
+				// the WERC20 view follows the bank supply of the native coin
+				expSupply := new(big.Int).Add(supplyBefore, baseAmount)
+				s.Require().Equal(expSupply.String(), s.totalSupply(precompile).String())
+				s.Require().Equal(expSupply.String(), bankKeeper.GetSupply(ctx, denom).Amount.String())
+
+				allowance, found := mintKeeper.GetMinterAllowance(ctx, minter.AccAddr, denom)
+				s.Require().True(found)
+				s.Require().Equal(new(big.Int).Mul(baseAmount, big.NewInt(9)).String(), allowance.String())
+				s.Require().Equal(baseAmount.String(), mintKeeper.GetDenomMintStats(ctx, denom).TotalMinted.String())
+
+				// a Transfer from the zero address is emitted
+				var transferEvent TransferEvent
+				s.Require().Len(stateDB.Logs(), 1)
+				s.Require().NoError(cmn.UnpackLog(precompile.ABI, &transferEvent, erc20.EventTypeTransfer, *stateDB.Logs()[0]))
+				s.Require().Equal(common.Address{}, transferEvent.From)
+				s.Require().Equal(recipient, transferEvent.To)
+				s.Require().Equal(amount, transferEvent.Value)
+			})
+		}
+	}
+}
+
+func (s *PrecompileUnitTestSuite) TestMintFractionalAmount() {
+	s.SetupTest(testconstants.SixDecimalsChainID)
+	minter := s.keyring.GetKey(0)
+	mintKeeper := s.network.App.GetEVMMintKeeper()
+
+	ctx := s.network.GetContext()
+	s.Require().NoError(mintKeeper.SetMinterAllowance(ctx, minter.AccAddr, evmtypes.GetEVMCoinDenom(), math.NewInt(10)))
+
+	precompile := s.wiredPrecompile()
+	contract, ctx := precompiletestutil.NewPrecompileContract(s.T(), ctx, minter.Addr, precompile.Address(), 0)
+
+	// half a unit of the 6 decimals denom cannot be drawn from the allowance
+	amount := new(big.Int).Quo(precisebanktypes.ConversionFactor().BigInt(), big.NewInt(2))
+	_, err := precompile.Mint(ctx, contract, s.network.GetStateDB(), []interface{}{utiltx.GenerateAddress(), amount})
+	s.Require().ErrorIs(err, werc20.ErrFractionalMintAmount)
+
+	allowance, found := mintKeeper.GetMinterAllowance(ctx, minter.AccAddr, evmtypes.GetEVMCoinDenom())
+	s.Require().True(found)
+	s.Require().Equal(int64(10), allowance.Int64(), "expected the allowance to be left untouched")
+}
+
+func (s *PrecompileUnitTestSuite) TestBurn() {
+	amount := big.NewInt(1_000_000_000_000)
+
+	testCases := []struct {
+		name        string
+		caller      func() common.Address
+		amount      *big.Int
+		expErr      bool
+		errContains string
+	}{
+		{
+			name:   "pass - the owner burns its tokens",
+			caller: func() common.Address { return s.keyring.GetAddr(0) },
+			amount: amount,
+		},
+		{
+			name:   "pass - the mint authority burns the tokens of the owner",
+			caller: func() common.Address { return s.keyring.GetAddr(1) },
+			amount: amount,
+		},
+		{
+			name:        "fail - caller is neither the owner nor the mint authority",
+			caller:      utiltx.GenerateAddress,
+			amount:      amount,
+			expErr:      true,
+			errContains: werc20.ErrNotOwnerOrMintAuthority.Error(),
+		},
+		{
+			name:        "fail - amount above the balance",
+			caller:      func() common.Address { return s.keyring.GetAddr(0) },
+			amount:      new(big.Int).Exp(big.NewInt(10), big.NewInt(40), nil),
+			expErr:      true,
+			errContains: erc20.ErrTransferAmountExceedsBalance.Error(),
+		},
+	}
+
+	for _, chainID := range mintChainIDs {
+		for _, tc := range testCases {
+			s.Run(chainID.ChainID+" "+tc.name, func() {
+				s.SetupTest(chainID)
+				owner := s.keyring.GetKey(0)
+				denom := evmtypes.GetEVMCoinExtendedDenom()
+				bankKeeper := s.network.App.GetPreciseBankKeeper()
+
+				ctx := s.network.GetContext()
+				s.network.App.GetEVMMintKeeper().SetMintAuthority(ctx, s.keyring.GetAccAddr(1))
+
+				precompile := s.wiredPrecompile()
+				supplyBefore := s.totalSupply(precompile)
+				balanceBefore := bankKeeper.GetBalance(ctx, owner.AccAddr, denom).Amount
+				stateDB := s.network.GetStateDB()
+
+				contract, ctx := precompiletestutil.NewPrecompileContract(s.T(), ctx, tc.caller(), precompile.Address(), 0)
+				_, err := precompile.Burn(ctx, contract, stateDB, []interface{}{owner.Addr, tc.amount})
+				if tc.expErr {
+					s.Require().ErrorContains(err, tc.errContains)
+					return
+				}
+				s.Require().NoError(err)
+
+				s.Require().Equal(balanceBefore.Sub(math.NewIntFromBigInt(tc.amount)), bankKeeper.GetBalance(ctx, owner.AccAddr, denom).Amount)
+
+				expSupply := new(big.Int).Sub(supplyBefore, new(big.Int).Quo(tc.amount, precisebanktypes.ConversionFactor().BigInt()))
+				s.Require().Equal(expSupply.String(), s.totalSupply(precompile).String())
+				s.Require().Equal(tc.amount.String(), s.network.App.GetEVMMintKeeper().GetDenomMintStats(ctx, denom).TotalBurned.String())
+
+				var transferEvent TransferEvent
+				s.Require().Len(stateDB.Logs(), 1)
+				s.Require().NoError(cmn.UnpackLog(precompile.ABI, &transferEvent, erc20.EventTypeTransfer, *stateDB.Logs()[0]))
+				s.Require().Equal(owner.Addr, transferEvent.From)
+				s.Require().Equal(common.Address{}, transferEvent.To)
+			})
+		}
+	}
+}
+
+func (s *PrecompileUnitTestSuite) TestMintDisabledWithoutMintKeeper() {
+	s.SetupTest(testconstants.ExampleChainID)
+	ctx := s.network.GetContext()
+
+	tokenPairID := s.network.App.GetErc20Keeper().GetTokenPairID(ctx, evmtypes.GetEVMCoinDenom())
+	tokenPair, found := s.network.App.GetErc20Keeper().GetTokenPair(ctx, tokenPairID)
+	s.Require().True(found)
+
+	precompile, err := werc20.NewPrecompile(
+		tokenPair,
+		s.network.App.GetPreciseBankKeeper(),
+		s.network.App.GetErc20Keeper(),
+		s.network.App.GetTransferKeeper(),
+		nil,
+	)
+	s.Require().NoError(err)
+
+	contract, ctx := precompiletestutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), precompile.Address(), 0)
+	_, err = precompile.Mint(ctx, contract, s.network.GetStateDB(), []interface{}{s.keyring.GetAddr(1), big.NewInt(1)})
+	s.Require().ErrorIs(err, werc20.ErrMintingDisabled)
+	_, err = precompile.Burn(ctx, contract, s.network.GetStateDB(), []interface{}{s.keyring.GetAddr(0), big.NewInt(1)})
+	s.Require().ErrorIs(err, werc20.ErrMintingDisabled)
+}
+
+func (s *PrecompileUnitTestSuite) TestMintAndBurnWithFixedMintAuthority() {
+	s.SetupTest(testconstants.ExampleChainID)
+	ctx := s.network.GetContext()
+
+	owner, fixedAuthority := s.keyring.GetKey(0), s.keyring.GetKey(1)
+	storedAuthority := utiltx.GenerateAddress()
+	amount := big.NewInt(1_000_000_000_000)
+
+	// the x/mint store holds another authority, which the fixed one replaces
+	s.network.App.GetEVMMintKeeper().SetMintAuthority(ctx, storedAuthority.Bytes())
+	mintKeeper := s.network.App.GetEVMMintKeeper().WithMintAuthority(fixedAuthority.AccAddr)
+
+	tokenPairID := s.network.App.GetErc20Keeper().GetTokenPairID(ctx, evmtypes.GetEVMCoinDenom())
+	tokenPair, found := s.network.App.GetErc20Keeper().GetTokenPair(ctx, tokenPairID)
+	s.Require().True(found)
+
+	precompile, err := werc20.NewPrecompile(
+		tokenPair,
+		s.network.App.GetPreciseBankKeeper(),
+		s.network.App.GetErc20Keeper(),
+		s.network.App.GetTransferKeeper(),
+		mintKeeper,
+	)
+	s.Require().NoError(err)
+
+	call := func(caller common.Address, method string, account common.Address) error {
+		contract, ctx := precompiletestutil.NewPrecompileContract(s.T(), ctx, caller, precompile.Address(), 0)
+		if method == werc20.MintMethod {
+			_, err := precompile.Mint(ctx, contract, s.network.GetStateDB(), []interface{}{account, amount})
+			return err
+		}
+		_, err := precompile.Burn(ctx, contract, s.network.GetStateDB(), []interface{}{account, amount})
+		return err
+	}
+
+	// the mint precompile and the WERC20 precompile resolve the same authority
+	s.Require().ErrorIs(call(storedAuthority, werc20.BurnMethod, owner.Addr), werc20.ErrNotOwnerOrMintAuthority)
+	s.Require().NoError(call(fixedAuthority.Addr, werc20.BurnMethod, owner.Addr), "expected the fixed authority to burn")
+
+	// the mint authority mints without an allowance, like on the mint precompile
+	s.Require().ErrorIs(call(storedAuthority, werc20.MintMethod, owner.Addr), werc20.ErrNotMinter)
+	s.Require().NoError(call(fixedAuthority.Addr, werc20.MintMethod, owner.Addr), "expected the fixed authority to mint")
+}
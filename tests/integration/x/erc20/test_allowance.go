@@ -522,3 +522,177 @@ func (s *KeeperTestSuite) TestGetAllowances() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUseNonce() {
+	var (
+		ctx       sdk.Context
+		erc20Addr common.Address
+		owner     common.Address
+
+		initArgs = func() {
+			erc20Addr = utiltx.GenerateAddress()
+			owner = utiltx.GenerateAddress()
+		}
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expNonce    *big.Int
+		expectPass  bool
+		errContains string
+	}{
+		{
+			"fail - no token pair exists",
+			func() {},
+			nil,
+			false,
+			types.ErrTokenPairNotFound.Error(),
+		},
+		{
+			"fail - token pair is disabled",
+			func() {
+				pair := types.NewTokenPair(erc20Addr, "coin", types.OWNER_MODULE)
+				pair.Enabled = false
+				err := s.network.App.GetErc20Keeper().SetToken(ctx, pair)
+				s.Require().NoError(err)
+			},
+			nil,
+			false,
+			types.ErrERC20TokenPairDisabled.Error(),
+		},
+		{
+			"fail - zero owner address",
+			func() {
+				pair := types.NewTokenPair(erc20Addr, "coin", types.OWNER_MODULE)
+				err := s.network.App.GetErc20Keeper().SetToken(ctx, pair)
+				s.Require().NoError(err)
+				owner = common.HexToAddress("0x0")
+			},
+			nil,
+			false,
+			errortypes.ErrInvalidAddress.Error(),
+		},
+		{
+			"pass - first nonce",
+			func() {
+				pair := types.NewTokenPair(erc20Addr, "coin", types.OWNER_MODULE)
+				err := s.network.App.GetErc20Keeper().SetToken(ctx, pair)
+				s.Require().NoError(err)
+			},
+			common.Big0,
+			true,
+			"",
+		},
+		{
+			"pass - used nonce",
+			func() {
+				pair := types.NewTokenPair(erc20Addr, "coin", types.OWNER_MODULE)
+				err := s.network.App.GetErc20Keeper().SetToken(ctx, pair)
+				s.Require().NoError(err)
+
+				_, err = s.network.App.GetErc20Keeper().UseNonce(ctx, erc20Addr, owner)
+				s.Require().NoError(err)
+			},
+			common.Big1,
+			true,
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			initArgs()
+			tc.malleate()
+
+			// Use Nonce
+			nonce, err := s.network.App.GetErc20Keeper().UseNonce(ctx, erc20Addr, owner)
+			if tc.expectPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expNonce.String(), nonce.String())
+
+				next := s.network.App.GetErc20Keeper().GetNonce(ctx, erc20Addr, owner)
+				s.Require().Equal(new(big.Int).Add(tc.expNonce, common.Big1).String(), next.String())
+			} else {
+				s.Require().Error(err)
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGetNonces() {
+	var (
+		ctx       sdk.Context
+		expRes    []types.Nonce
+		erc20Addr = utiltx.GenerateAddress()
+		owner     = utiltx.GenerateAddress()
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"pass - nonces are kept when the token pair is deleted",
+			func() {
+				pair := types.NewTokenPair(erc20Addr, "coin", types.OWNER_MODULE)
+				err := s.network.App.GetErc20Keeper().SetToken(ctx, pair)
+				s.Require().NoError(err)
+
+				_, err = s.network.App.GetErc20Keeper().UseNonce(ctx, erc20Addr, owner)
+				s.Require().NoError(err)
+
+				// Delete TokenPair
+				s.network.App.GetErc20Keeper().DeleteTokenPair(ctx, pair)
+
+				expRes = []types.Nonce{
+					{
+						Erc20Address: erc20Addr.Hex(),
+						Owner:        owner.Hex(),
+						Value:        math.NewInt(1),
+					},
+				}
+			},
+		},
+		{
+			"pass - no nonces",
+			func() {
+				expRes = []types.Nonce{}
+			},
+		},
+		{
+			"pass",
+			func() {
+				pair := types.NewTokenPair(erc20Addr, "coin", types.OWNER_MODULE)
+				err := s.network.App.GetErc20Keeper().SetToken(ctx, pair)
+				s.Require().NoError(err)
+
+				_, err = s.network.App.GetErc20Keeper().UseNonce(ctx, erc20Addr, owner)
+				s.Require().NoError(err)
+
+				expRes = []types.Nonce{
+					{
+						Erc20Address: erc20Addr.Hex(),
+						Owner:        owner.Hex(),
+						Value:        math.NewInt(1),
+					},
+				}
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			tc.malleate()
+
+			// Get Nonces
+			res := s.network.App.GetErc20Keeper().GetNonces(ctx)
+			s.Require().Equal(expRes, res)
+		})
+	}
+}
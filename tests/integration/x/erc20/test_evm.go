@@ -120,7 +120,7 @@ func (s *KeeperTestSuite) TestBalanceOf() {
 			authtypes.NewModuleAddress(govtypes.ModuleName),
 			s.network.App.GetAccountKeeper(), s.network.App.GetBankKeeper(),
 			mockEVMKeeper, s.network.App.GetStakingKeeper(),
-			&transferKeeper)
+			&transferKeeper, s.network.App.GetEVMMintKeeper())
 		s.network.App.SetErc20Keeper(erc20Keeper)
 
 		tc.malleate()
@@ -220,7 +220,7 @@ func (s *KeeperTestSuite) TestQueryERC20ForceFail() {
 			authtypes.NewModuleAddress(govtypes.ModuleName),
 			s.network.App.GetAccountKeeper(), s.network.App.GetBankKeeper(),
 			mockEVMKeeper, s.network.App.GetStakingKeeper(),
-			&transferKeeper)
+			&transferKeeper, s.network.App.GetEVMMintKeeper())
 		s.network.App.SetErc20Keeper(erc20Keeper)
 
 		tc.malleate()
@@ -400,6 +400,7 @@ func (s *KeeperTestSuite) TestQueryERC20Bytes32Fallback() {
 				s.network.App.GetAccountKeeper(), s.network.App.GetBankKeeper(),
 				mockEVMKeeper, s.network.App.GetStakingKeeper(),
 				&transferKeeper,
+				s.network.App.GetEVMMintKeeper(),
 			))
 
 			tc.malleate()
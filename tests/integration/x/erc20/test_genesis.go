@@ -107,6 +107,26 @@ func (s *GenesisTestSuite) TestERC20InitGenesis() {
 					},
 				},
 			),
+		}, {
+			name: "custom genesis with nonces",
+			genesisState: types.GenesisState{
+				Params: types.DefaultParams(),
+				TokenPairs: []types.TokenPair{
+					{
+						Erc20Address:  osmoERC20ContractAddr,
+						Denom:         osmoDenom.IBCDenom(),
+						Enabled:       true,
+						ContractOwner: types.OWNER_MODULE,
+					},
+				},
+				Nonces: []types.Nonce{
+					{
+						Erc20Address: osmoERC20ContractAddr,
+						Owner:        utiltx.GenerateAddress().String(),
+						Value:        math.NewInt(3),
+					},
+				},
+			},
 		},
 	}
 
@@ -136,6 +156,13 @@ func (s *GenesisTestSuite) TestERC20InitGenesis() {
 		} else {
 			s.Require().Len(tc.genesisState.Allowances, 0, tc.name)
 		}
+
+		nonces := nw.App.GetErc20Keeper().GetNonces(nw.GetContext())
+		if len(nonces) > 0 {
+			s.Require().Equal(tc.genesisState.Nonces, nonces, tc.name)
+		} else {
+			s.Require().Len(tc.genesisState.Nonces, 0, tc.name)
+		}
 	}
 }
 
@@ -302,6 +302,7 @@ func (s *KeeperTestSuite) TestOnRecvPacketRegistered() {
 				s.network.App.GetEVMKeeper(),
 				s.network.App.GetStakingKeeper(),
 				&tranasferKeeper,
+				s.network.App.GetEVMMintKeeper(),
 			)
 			s.network.App.SetErc20Keeper(erc20Keeper)
 
@@ -134,6 +134,7 @@ func (s *KeeperTestSuite) TestConvertERC20NativeERC20() {
 					authtypes.NewModuleAddress(govtypes.ModuleName), s.network.App.GetAccountKeeper(),
 					s.network.App.GetBankKeeper(), mockEVMKeeper, s.network.App.GetStakingKeeper(),
 					&transferKeeper,
+					s.network.App.GetEVMMintKeeper(),
 				)
 				s.network.App.SetErc20Keeper(erc20Keeper)
 
@@ -163,6 +164,7 @@ func (s *KeeperTestSuite) TestConvertERC20NativeERC20() {
 					authtypes.NewModuleAddress(govtypes.ModuleName), s.network.App.GetAccountKeeper(),
 					s.network.App.GetBankKeeper(), mockEVMKeeper, s.network.App.GetStakingKeeper(),
 					&transferKeeper,
+					s.network.App.GetEVMMintKeeper(),
 				)
 				s.network.App.SetErc20Keeper(erc20Keeper)
 
@@ -191,6 +193,7 @@ func (s *KeeperTestSuite) TestConvertERC20NativeERC20() {
 					authtypes.NewModuleAddress(govtypes.ModuleName), s.network.App.GetAccountKeeper(),
 					s.network.App.GetBankKeeper(), mockEVMKeeper, s.network.App.GetStakingKeeper(),
 					&transferKeeper,
+					s.network.App.GetEVMMintKeeper(),
 				)
 				s.network.App.SetErc20Keeper(erc20Keeper)
 
@@ -220,6 +223,7 @@ func (s *KeeperTestSuite) TestConvertERC20NativeERC20() {
 					authtypes.NewModuleAddress(govtypes.ModuleName), s.network.App.GetAccountKeeper(),
 					s.network.App.GetBankKeeper(), mockEVMKeeper, s.network.App.GetStakingKeeper(),
 					&transferKeeper,
+					s.network.App.GetEVMMintKeeper(),
 				)
 				s.network.App.SetErc20Keeper(erc20Keeper)
 
@@ -249,7 +253,7 @@ func (s *KeeperTestSuite) TestConvertERC20NativeERC20() {
 					s.network.App.GetKey("erc20"), s.network.App.AppCodec(),
 					authtypes.NewModuleAddress(govtypes.ModuleName), s.network.App.GetAccountKeeper(),
 					mockBankKeeper, s.network.App.GetEVMKeeper(), s.network.App.GetStakingKeeper(),
-					&transferKeeper)
+					&transferKeeper, s.network.App.GetEVMMintKeeper())
 				s.network.App.SetErc20Keeper(erc20Keeper)
 
 				mockBankKeeper.EXPECT().MintCoins(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("failed to mint")).AnyTimes()
@@ -274,7 +278,7 @@ func (s *KeeperTestSuite) TestConvertERC20NativeERC20() {
 					s.network.App.GetKey("erc20"), s.network.App.AppCodec(),
 					authtypes.NewModuleAddress(govtypes.ModuleName), s.network.App.GetAccountKeeper(),
 					mockBankKeeper, s.network.App.GetEVMKeeper(), s.network.App.GetStakingKeeper(),
-					&transferKeeper)
+					&transferKeeper, s.network.App.GetEVMMintKeeper())
 				s.network.App.SetErc20Keeper(erc20Keeper)
 
 				mockBankKeeper.EXPECT().MintCoins(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
@@ -299,7 +303,7 @@ func (s *KeeperTestSuite) TestConvertERC20NativeERC20() {
 					s.network.App.GetKey("erc20"), s.network.App.AppCodec(),
 					authtypes.NewModuleAddress(govtypes.ModuleName), s.network.App.GetAccountKeeper(),
 					mockBankKeeper, s.network.App.GetEVMKeeper(), s.network.App.GetStakingKeeper(),
-					&transferKeeper)
+					&transferKeeper, s.network.App.GetEVMMintKeeper())
 				s.network.App.SetErc20Keeper(erc20Keeper)
 
 				mockBankKeeper.EXPECT().MintCoins(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
@@ -433,6 +437,7 @@ func (s *KeeperTestSuite) TestConvertNativeERC20ToEVMERC20() {
 					authtypes.NewModuleAddress(govtypes.ModuleName), s.network.App.GetAccountKeeper(),
 					s.network.App.GetBankKeeper(), mockEVMKeeper, s.network.App.GetStakingKeeper(),
 					&transferKeeper,
+					s.network.App.GetEVMMintKeeper(),
 				)
 				s.network.App.SetErc20Keeper(erc20Keeper)
 
@@ -462,6 +467,7 @@ func (s *KeeperTestSuite) TestConvertNativeERC20ToEVMERC20() {
 					authtypes.NewModuleAddress(govtypes.ModuleName), s.network.App.GetAccountKeeper(),
 					s.network.App.GetBankKeeper(), mockEVMKeeper, s.network.App.GetStakingKeeper(),
 					&transferKeeper,
+					s.network.App.GetEVMMintKeeper(),
 				)
 				s.network.App.SetErc20Keeper(erc20Keeper)
 
@@ -490,6 +496,7 @@ func (s *KeeperTestSuite) TestConvertNativeERC20ToEVMERC20() {
 					authtypes.NewModuleAddress(govtypes.ModuleName), s.network.App.GetAccountKeeper(),
 					s.network.App.GetBankKeeper(), mockEVMKeeper, s.network.App.GetStakingKeeper(),
 					&transferKeeper,
+					s.network.App.GetEVMMintKeeper(),
 				)
 				s.network.App.SetErc20Keeper(erc20Keeper)
 
@@ -519,6 +526,7 @@ func (s *KeeperTestSuite) TestConvertNativeERC20ToEVMERC20() {
 					authtypes.NewModuleAddress(govtypes.ModuleName), s.network.App.GetAccountKeeper(),
 					s.network.App.GetBankKeeper(), mockEVMKeeper, s.network.App.GetStakingKeeper(),
 					&transferKeeper,
+					s.network.App.GetEVMMintKeeper(),
 				)
 				s.network.App.SetErc20Keeper(erc20Keeper)
 
@@ -549,6 +557,7 @@ func (s *KeeperTestSuite) TestConvertNativeERC20ToEVMERC20() {
 					authtypes.NewModuleAddress(govtypes.ModuleName), s.network.App.GetAccountKeeper(),
 					mockBankKeeper, s.network.App.GetEVMKeeper(), s.network.App.GetStakingKeeper(),
 					&transferKeeper,
+					s.network.App.GetEVMMintKeeper(),
 				)
 				s.network.App.SetErc20Keeper(erc20Keeper)
 
@@ -575,6 +584,7 @@ func (s *KeeperTestSuite) TestConvertNativeERC20ToEVMERC20() {
 					authtypes.NewModuleAddress(govtypes.ModuleName), s.network.App.GetAccountKeeper(),
 					mockBankKeeper, s.network.App.GetEVMKeeper(), s.network.App.GetStakingKeeper(),
 					&transferKeeper,
+					s.network.App.GetEVMMintKeeper(),
 				)
 				s.network.App.SetErc20Keeper(erc20Keeper)
 
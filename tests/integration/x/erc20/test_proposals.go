@@ -164,6 +164,7 @@ func (s *KeeperTestSuite) TestRegisterERC20() {
 					authtypes.NewModuleAddress(govtypes.ModuleName), s.network.App.GetAccountKeeper(),
 					s.network.App.GetBankKeeper(), mockEVMKeeper, s.network.App.GetStakingKeeper(),
 					&transferKeeper,
+					s.network.App.GetEVMMintKeeper(),
 				)
 				s.network.App.SetErc20Keeper(erc20Keeper)
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/evm/testutil/keyring"
 	"github.com/cosmos/evm/x/ibc/callbacks/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	cbtypes "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
@@ -148,6 +149,19 @@ func (s *KeeperTestSuite) TestOnAcknowledgementPacket() {
 			},
 			types.ErrInvalidCalldata,
 		},
+		{
+			"packet data is interchain accounts but custom calldata is set",
+			func() {
+				icaData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: []byte("data"),
+					Memo: fmt.Sprintf(`{"src_callback": {"address": "%s", "calldata": "%x"}}`, contract.Hex(), []byte("calldata")),
+				}
+				packet.SourcePort = icatypes.ControllerPortPrefix + senderKey.AccAddr.String()
+				packet.Data = icaData.GetBytes()
+			},
+			types.ErrInvalidCalldata,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -235,6 +249,19 @@ func (s *KeeperTestSuite) TestOnTimeoutPacket() {
 			},
 			types.ErrInvalidCalldata,
 		},
+		{
+			"packet data is interchain accounts but custom calldata is set",
+			func() {
+				icaData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: []byte("data"),
+					Memo: fmt.Sprintf(`{"src_callback": {"address": "%s", "calldata": "%x"}}`, contract.Hex(), []byte("calldata")),
+				}
+				packet.SourcePort = icatypes.ControllerPortPrefix + senderKey.AccAddr.String()
+				packet.Data = icaData.GetBytes()
+			},
+			types.ErrInvalidCalldata,
+		},
 	}
 
 	for _, tc := range testCases {
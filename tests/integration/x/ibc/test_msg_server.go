@@ -348,6 +348,7 @@ func (suite *KeeperTestSuite) TestTransfer() {
 				suite.network.App.GetAccountKeeper(),
 				suite.network.App.GetBankKeeper(),
 				suite.network.App.GetErc20Keeper(), // Add ERC20 Keeper for ERC20 transfers
+				suite.network.App.GetEVMMintKeeper(),
 				authAddr,
 			))
 			msg := tc.malleate()
@@ -501,6 +502,7 @@ func (suite *KeeperTestSuite) TestPrefixTrimming() {
 				suite.network.App.GetAccountKeeper(),
 				suite.network.App.GetBankKeeper(),
 				suite.network.App.GetErc20Keeper(), // Add ERC20 Keeper for ERC20 transfers
+				suite.network.App.GetEVMMintKeeper(),
 				authAddr,
 			))
 			msg := tc.malleate()
@@ -0,0 +1,78 @@
+package mint
+
+import (
+	"github.com/cosmos/evm/x/mint/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestCreateDenom() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetEVMMintKeeper()
+	creator, other := s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1)
+
+	denom, err := k.CreateDenom(ctx, creator, "team")
+	s.Require().NoError(err)
+	s.Require().Equal("factory/"+creator.String()+"/team", denom)
+
+	admin, found := k.GetDenomAdmin(ctx, denom)
+	s.Require().True(found)
+	s.Require().Equal(creator, admin)
+	s.Require().True(k.IsDenomAdmin(ctx, denom, creator))
+	s.Require().False(k.IsDenomAdmin(ctx, denom, other))
+
+	// a denom is created once
+	_, err = k.CreateDenom(ctx, creator, "team")
+	s.Require().ErrorIs(err, types.ErrDenomExists)
+
+	// other creators get their own namespace
+	otherDenom, err := k.CreateDenom(ctx, other, "team")
+	s.Require().NoError(err)
+	s.Require().NotEqual(denom, otherDenom)
+
+	// denoms with a supply cannot be taken over
+	coins := sdk.NewCoins(sdk.NewCoin("factory/"+creator.String()+"/minted", math.NewInt(100)))
+	s.Require().NoError(s.network.App.GetBankKeeper().MintCoins(ctx, types.ModuleName, coins))
+	_, err = k.CreateDenom(ctx, creator, "minted")
+	s.Require().ErrorIs(err, types.ErrDenomExists)
+
+	_, err = k.CreateDenom(ctx, creator, "")
+	s.Require().ErrorIs(err, types.ErrInvalidFactoryDenom)
+
+	s.Require().ElementsMatch([]types.DenomAdmin{
+		types.NewDenomAdmin(denom, creator),
+		types.NewDenomAdmin(otherDenom, other),
+	}, k.GetDenomAdmins(ctx))
+}
+
+func (s *KeeperTestSuite) TestChangeDenomAdmin() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetEVMMintKeeper()
+	creator, newAdmin := s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1)
+
+	denom, err := k.CreateDenom(ctx, creator, "team")
+	s.Require().NoError(err)
+
+	s.Require().NoError(k.ChangeDenomAdmin(ctx, denom, newAdmin))
+	s.Require().False(k.IsDenomAdmin(ctx, denom, creator))
+	s.Require().True(k.IsDenomAdmin(ctx, denom, newAdmin))
+
+	// renouncing keeps the denom created, without an admin
+	s.Require().NoError(k.ChangeDenomAdmin(ctx, denom, nil))
+	admin, found := k.GetDenomAdmin(ctx, denom)
+	s.Require().True(found)
+	s.Require().True(admin.Empty())
+	s.Require().False(k.IsDenomAdmin(ctx, denom, newAdmin))
+
+	_, err = k.CreateDenom(ctx, creator, "team")
+	s.Require().ErrorIs(err, types.ErrDenomExists)
+
+	missing, err := types.BuildFactoryDenom(creator, "missing")
+	s.Require().NoError(err)
+	err = k.ChangeDenomAdmin(ctx, missing, newAdmin)
+	s.Require().ErrorIs(err, types.ErrDenomNotFound)
+}
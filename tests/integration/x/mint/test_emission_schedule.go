@@ -0,0 +1,282 @@
+package mint
+
+import (
+	"github.com/cosmos/evm/x/mint/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func (s *KeeperTestSuite) TestProcessEmissionSchedules() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetEVMMintKeeper()
+	bankKeeper := s.network.App.GetBankKeeper()
+	recipient := s.keyring.GetAccAddr(1)
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	start := uint64(ctx.BlockHeight()) + 1 //nolint:gosec // G115
+
+	schedule, err := k.AddEmissionSchedule(ctx, types.NewEmissionSchedule(
+		0, "uusdc", recipient, types.EmissionCurveLinear, math.NewInt(1000), start, start+2, 0,
+	))
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), schedule.ID)
+	s.Require().Equal(uint64(2), k.GetNextEmissionScheduleID(ctx))
+
+	// nothing is due before the start height
+	s.Require().NoError(k.BeginBlock(ctx.WithBlockHeight(int64(start) - 1))) //nolint:gosec // G115
+	s.Require().True(bankKeeper.GetBalance(ctx, recipient, "uusdc").IsZero())
+
+	for i, expected := range []int64{333, 666} {
+		s.Require().NoError(k.BeginBlock(ctx.WithBlockHeight(int64(start) + int64(i)))) //nolint:gosec // G115
+		s.Require().Equal(math.NewInt(expected), bankKeeper.GetBalance(ctx, recipient, "uusdc").Amount)
+
+		stored, found := k.GetEmissionSchedule(ctx, schedule.ID)
+		s.Require().True(found)
+		s.Require().Equal(math.NewInt(expected), stored.Emitted)
+		s.Require().Equal(math.NewInt(1000-expected), stored.Remaining())
+	}
+
+	// the last block emits the rest and removes the completed schedule
+	endCtx := ctx.WithBlockHeight(int64(start) + 2).WithEventManager(sdk.NewEventManager()) //nolint:gosec // G115
+	s.Require().NoError(k.BeginBlock(endCtx))
+	s.Require().Equal(math.NewInt(1000), bankKeeper.GetBalance(ctx, recipient, "uusdc").Amount)
+	_, found := k.GetEmissionSchedule(ctx, schedule.ID)
+	s.Require().False(found)
+	s.Require().Empty(k.GetEmissionSchedules(ctx))
+
+	var completed bool
+	for _, event := range endCtx.EventManager().Events() {
+		if event.Type == types.EventTypeCompleteEmissionSchedule {
+			completed = true
+		}
+	}
+	s.Require().True(completed, "expected a complete emission schedule event")
+
+	// emissions run outside of any Ethereum transaction, so the typed SDK mint
+	// event is their only event, in place of the EVM log of the mint precompile
+	var mintEvents []*types.EventMint
+	for _, event := range endCtx.EventManager().ABCIEvents() {
+		if event.Type != proto.MessageName(&types.EventMint{}) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(event)
+		s.Require().NoError(err)
+		mintEvents = append(mintEvents, msg.(*types.EventMint))
+	}
+	s.Require().Equal([]*types.EventMint{{
+		Minter:    moduleAddr.String(),
+		Recipient: recipient.String(),
+		Denom:     "uusdc",
+		Amount:    "334",
+	}}, mintEvents)
+
+	// the mints go through the mint audit trail, with the module as minter
+	s.Require().Equal(math.NewInt(1000), k.GetDenomMintStats(ctx, "uusdc").TotalMinted)
+	s.Require().Equal(math.NewInt(1000), k.GetMinterMintStats(ctx, moduleAddr, "uusdc").Minted)
+	history := k.GetMintHistory(ctx)
+	s.Require().Len(history, 3)
+	s.Require().Equal(moduleAddr.String(), history[0].Minter)
+	s.Require().Equal(recipient.String(), history[0].Recipient)
+}
+
+func (s *KeeperTestSuite) TestProcessEmissionSchedulesHalving() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetEVMMintKeeper()
+	bankKeeper := s.network.App.GetBankKeeper()
+	recipient := s.keyring.GetAccAddr(1)
+	start := uint64(ctx.BlockHeight()) + 1 //nolint:gosec // G115
+
+	_, err := k.AddEmissionSchedule(ctx, types.NewEmissionSchedule(
+		0, "uusdc", recipient, types.EmissionCurveHalving, math.NewInt(100), start, start+5, 2,
+	))
+	s.Require().NoError(err)
+
+	for i, expected := range []int64{100, 200, 250, 300, 325, 350} {
+		s.Require().NoError(k.BeginBlock(ctx.WithBlockHeight(int64(start) + int64(i)))) //nolint:gosec // G115
+		s.Require().Equal(math.NewInt(expected), bankKeeper.GetBalance(ctx, recipient, "uusdc").Amount)
+	}
+
+	s.Require().Empty(k.GetEmissionSchedules(ctx))
+}
+
+func (s *KeeperTestSuite) TestProcessEmissionSchedulesCatchUp() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetEVMMintKeeper()
+	bankKeeper := s.network.App.GetBankKeeper()
+	recipient := s.keyring.GetAccAddr(1)
+	start := int64(ctx.BlockHeight()) + 1
+
+	schedule, err := k.AddEmissionSchedule(ctx, types.NewEmissionSchedule(
+		0, "uusdc", recipient, types.EmissionCurveLinear, math.NewInt(1000), uint64(start), uint64(start)+3, 0, //nolint:gosec // G115
+	))
+	s.Require().NoError(err)
+
+	// a paused denom is not emitted, and the schedule keeps its due amount
+	s.Require().NoError(k.SetDenomPaused(ctx, "uusdc", true))
+	s.Require().NoError(k.BeginBlock(ctx.WithBlockHeight(start)))
+	s.Require().True(bankKeeper.GetBalance(ctx, recipient, "uusdc").IsZero())
+	stored, _ := k.GetEmissionSchedule(ctx, schedule.ID)
+	s.Require().True(stored.Emitted.IsZero())
+	s.Require().Zero(stored.Failures, "a pause is not counted as a failure")
+
+	// once the pause is lifted the next block catches up
+	s.Require().NoError(k.LiftPause(ctx, "uusdc"))
+	s.Require().NoError(k.BeginBlock(ctx.WithBlockHeight(start + 1)))
+	s.Require().Equal(math.NewInt(500), bankKeeper.GetBalance(ctx, recipient, "uusdc").Amount)
+
+	// the supply cap applies to emissions as to any other mint
+	s.Require().NoError(k.UpdateDenomMintLimit(ctx, types.NewDenomMintLimit("uusdc", math.NewInt(600), math.ZeroInt(), 0)))
+	s.Require().NoError(k.BeginBlock(ctx.WithBlockHeight(start + 2)))
+	s.Require().Equal(math.NewInt(500), bankKeeper.GetBalance(ctx, recipient, "uusdc").Amount)
+
+	// a schedule past its end height is kept until it emitted its total
+	s.Require().NoError(k.BeginBlock(ctx.WithBlockHeight(start + 10)))
+	stored, found := k.GetEmissionSchedule(ctx, schedule.ID)
+	s.Require().True(found)
+	s.Require().Equal(math.NewInt(500), stored.Remaining())
+	s.Require().Equal(uint32(2), stored.Failures)
+
+	s.Require().NoError(k.UpdateDenomMintLimit(ctx, types.NewDenomMintLimit("uusdc", math.ZeroInt(), math.ZeroInt(), 0)))
+	s.Require().NoError(k.BeginBlock(ctx.WithBlockHeight(start + 11)))
+	s.Require().Equal(math.NewInt(1000), bankKeeper.GetBalance(ctx, recipient, "uusdc").Amount)
+	s.Require().Empty(k.GetEmissionSchedules(ctx))
+}
+
+func (s *KeeperTestSuite) TestProcessEmissionSchedulesRemovedAfterFailures() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetEVMMintKeeper()
+	bankKeeper := s.network.App.GetBankKeeper()
+	recipient := s.keyring.GetAccAddr(1)
+	start := int64(ctx.BlockHeight()) + 1
+
+	schedule, err := k.AddEmissionSchedule(ctx, types.NewEmissionSchedule(
+		0, "uusdc", recipient, types.EmissionCurveLinear, math.NewInt(1_000_000), uint64(start), uint64(start)+1000, 0, //nolint:gosec // G115
+	))
+	s.Require().NoError(err)
+	s.Require().NoError(k.UpdateDenomMintLimit(ctx, types.NewDenomMintLimit("uusdc", math.NewInt(1), math.ZeroInt(), 0)))
+
+	// every failure before the last one keeps the schedule
+	maxFailures := int64(types.MaxEmissionScheduleFailures)
+	for i := range maxFailures - 1 {
+		s.Require().NoError(k.BeginBlock(ctx.WithBlockHeight(start + i)))
+	}
+	stored, found := k.GetEmissionSchedule(ctx, schedule.ID)
+	s.Require().True(found)
+	s.Require().Equal(types.MaxEmissionScheduleFailures-1, stored.Failures)
+
+	// the last failure removes the schedule and emits an event
+	lastCtx := ctx.WithBlockHeight(start + maxFailures - 1).WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(k.BeginBlock(lastCtx))
+	_, found = k.GetEmissionSchedule(ctx, schedule.ID)
+	s.Require().False(found)
+	s.Require().True(bankKeeper.GetBalance(ctx, recipient, "uusdc").IsZero())
+
+	var removed bool
+	for _, event := range lastCtx.EventManager().Events() {
+		if event.Type == types.EventTypeRemoveEmissionSchedule {
+			removed = true
+		}
+	}
+	s.Require().True(removed, "expected a remove emission schedule event")
+}
+
+func (s *KeeperTestSuite) TestCreateEmissionSchedule() {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name      string
+		schedule  func(height uint64) types.EmissionSchedule
+		expectErr bool
+	}{
+		{
+			name: "fail - schedule ended before the current height",
+			schedule: func(height uint64) types.EmissionSchedule {
+				return types.NewEmissionSchedule(0, "uusdc", s.keyring.GetAccAddr(1), types.EmissionCurveLinear, math.NewInt(1000), 1, height-1, 0)
+			},
+			expectErr: true,
+		},
+		{
+			name: "fail - invalid schedule",
+			schedule: func(height uint64) types.EmissionSchedule {
+				return types.NewEmissionSchedule(0, "uusdc", s.keyring.GetAccAddr(1), types.EmissionCurveHalving, math.NewInt(1000), height, height+10, 0)
+			},
+			expectErr: true,
+		},
+		{
+			name: "pass - create schedule",
+			schedule: func(height uint64) types.EmissionSchedule {
+				return types.NewEmissionSchedule(0, "uusdc", s.keyring.GetAccAddr(1), types.EmissionCurveLinear, math.NewInt(1000), height+1, height+10, 0)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			k := s.network.App.GetEVMMintKeeper()
+			schedule := tc.schedule(uint64(ctx.BlockHeight())) //nolint:gosec // G115
+
+			_, err := k.CreateEmissionSchedule(ctx, &types.MsgCreateEmissionSchedule{
+				Authority: s.keyring.GetAccAddr(0).String(),
+				Schedule:  schedule,
+			})
+			s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+			res, err := k.CreateEmissionSchedule(ctx, &types.MsgCreateEmissionSchedule{
+				Authority: govAddr,
+				Schedule:  schedule,
+			})
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(uint64(1), res.Id)
+
+			schedule.ID = res.Id
+			stored, found := k.GetEmissionSchedule(ctx, res.Id)
+			s.Require().True(found)
+			s.Require().Equal(schedule, stored)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCancelEmissionSchedule() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetEVMMintKeeper()
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	height := uint64(ctx.BlockHeight()) //nolint:gosec // G115
+
+	schedule, err := k.AddEmissionSchedule(ctx, types.NewEmissionSchedule(
+		0, "uusdc", s.keyring.GetAccAddr(1), types.EmissionCurveLinear, math.NewInt(1000), height+1, height+10, 0,
+	))
+	s.Require().NoError(err)
+
+	_, err = k.CancelEmissionSchedule(ctx, &types.MsgCancelEmissionSchedule{Authority: s.keyring.GetAccAddr(0).String(), Id: schedule.ID})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = k.CancelEmissionSchedule(ctx, &types.MsgCancelEmissionSchedule{Authority: govAddr, Id: schedule.ID + 1})
+	s.Require().ErrorIs(err, types.ErrEmissionScheduleNotFound)
+
+	_, err = k.CancelEmissionSchedule(ctx, &types.MsgCancelEmissionSchedule{Authority: govAddr, Id: schedule.ID})
+	s.Require().NoError(err)
+	s.Require().Empty(k.GetEmissionSchedules(ctx))
+
+	// identifiers are not reused
+	schedule, err = k.AddEmissionSchedule(ctx, types.NewEmissionSchedule(
+		0, "uusdc", s.keyring.GetAccAddr(1), types.EmissionCurveLinear, math.NewInt(1000), height+1, height+10, 0,
+	))
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), schedule.ID)
+}
@@ -0,0 +1,101 @@
+package mint
+
+import (
+	evmmint "github.com/cosmos/evm/x/mint"
+	"github.com/cosmos/evm/x/mint/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestInitExportGenesis() {
+	testCases := []struct {
+		name     string
+		genState func() *types.GenesisState
+	}{
+		{
+			name:     "default genesis",
+			genState: types.DefaultGenesisState,
+		},
+		{
+			name: "custom mint authority, minter allowances, mint limits, params, mint stats, guardian, pauses, IBC mint routes, mint proposals, used voucher nonces, mint history and denom admins",
+			genState: func() *types.GenesisState {
+				proposal := types.NewMintProposal(
+					1,
+					s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(1),
+					"uusdc", math.NewInt(1000),
+					20, 120,
+				)
+				proposal.Approvals = []string{s.keyring.GetAccAddr(0).String()}
+
+				denom, err := types.BuildFactoryDenom(s.keyring.GetAccAddr(1), "team")
+				s.Require().NoError(err)
+
+				schedule := types.NewEmissionSchedule(
+					3, "uusdc", s.keyring.GetAccAddr(1),
+					types.EmissionCurveHalving, math.NewInt(100),
+					10, 1000, 50,
+				)
+				schedule.Emitted = math.NewInt(400)
+
+				return types.NewGenesisState(
+					s.keyring.GetAccAddr(0).String(),
+					[]types.MinterAllowance{
+						types.NewMinterAllowance(s.keyring.GetAccAddr(1), "uusdc", math.NewInt(1000)),
+					},
+					[]types.DenomMintLimit{
+						types.NewDenomMintLimit("uusdc", math.NewInt(1_000_000), math.NewInt(1000), 100),
+					},
+					types.NewParams(
+						[]string{s.keyring.GetAccAddr(1).String()},
+						[]string{"uusdc"},
+						true,
+						100,
+					),
+					[]types.DenomMintStats{
+						types.NewDenomMintStats("uusdc", math.NewInt(5000), math.NewInt(1200)),
+					},
+					[]types.MinterMintStats{
+						types.NewMinterMintStats(s.keyring.GetAccAddr(1), "uusdc", math.NewInt(5000)),
+					},
+					s.keyring.GetAccAddr(0).String(),
+					true,
+					[]string{"uusdc"},
+					[]types.IBCMintRoute{
+						types.NewIBCMintRoute("channel-0", "uusdc", "usdc"),
+					},
+					types.NewMintApprovalConfig(
+						[]string{s.keyring.GetAccAddr(0).String()},
+						1, 10, 100,
+						sdk.NewCoins(sdk.NewInt64Coin("uusdc", 500)),
+					),
+					[]types.MintProposal{proposal},
+					2,
+					[]types.UsedVoucherNonce{types.NewUsedVoucherNonce(s.keyring.GetAccAddr(1), math.NewInt(7))},
+					[]types.MintRecord{
+						types.NewMintRecord(1, 5, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), "uusdc", math.NewInt(1000)),
+					},
+					2,
+					[]types.DenomAdmin{types.NewDenomAdmin(denom, s.keyring.GetAccAddr(0))},
+					[]types.EmissionSchedule{schedule},
+					4,
+				)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			k := *s.network.App.GetEVMMintKeeper()
+			genState := tc.genState()
+
+			s.Require().NotPanics(func() {
+				evmmint.InitGenesis(ctx, k, *genState)
+			})
+			s.Require().Equal(genState, evmmint.ExportGenesis(ctx, k))
+		})
+	}
+}
@@ -0,0 +1,310 @@
+package mint
+
+import (
+	"github.com/cosmos/evm/x/mint/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestQueryMintAuthority() {
+	testCases := []struct {
+		name     string
+		malleate func()
+		expected func() string
+	}{
+		{
+			name:     "pass - mint authority not set",
+			malleate: func() {},
+			expected: func() string { return "" },
+		},
+		{
+			name: "pass - mint authority set",
+			malleate: func() {
+				s.network.App.GetEVMMintKeeper().SetMintAuthority(s.network.GetContext(), s.keyring.GetAccAddr(0))
+			},
+			expected: func() string { return s.keyring.GetAccAddr(0).String() },
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			tc.malleate()
+
+			res, err := s.network.App.GetEVMMintKeeper().MintAuthority(s.network.GetContext(), &types.QueryMintAuthorityRequest{})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected(), res.MintAuthority)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryDenomMintLimit() {
+	testCases := []struct {
+		name         string
+		malleate     func()
+		denom        string
+		expectErr    bool
+		expSupply    *math.Int
+		expRateLimit *math.Int
+	}{
+		{
+			name:      "fail - invalid denom",
+			malleate:  func() {},
+			denom:     "",
+			expectErr: true,
+		},
+		{
+			name:      "fail - no limit",
+			malleate:  func() {},
+			denom:     "uusdc",
+			expectErr: true,
+		},
+		{
+			name: "pass - supply cap only",
+			malleate: func() {
+				err := s.network.App.GetEVMMintKeeper().UpdateDenomMintLimit(
+					s.network.GetContext(),
+					types.NewDenomMintLimit("uusdc", math.NewInt(1000), math.ZeroInt(), 0),
+				)
+				s.Require().NoError(err)
+				s.mint(s.network.GetContext(), 400)
+			},
+			denom:     "uusdc",
+			expSupply: func() *math.Int { i := math.NewInt(600); return &i }(),
+		},
+		{
+			name: "pass - supply cap and rate limit",
+			malleate: func() {
+				ctx := s.network.GetContext()
+				k := s.network.App.GetEVMMintKeeper()
+				err := k.UpdateDenomMintLimit(ctx, types.NewDenomMintLimit("uusdc", math.NewInt(1000), math.NewInt(500), 10))
+				s.Require().NoError(err)
+				s.Require().NoError(k.TrackMint(ctx, "uusdc", math.NewInt(400)))
+				s.mint(ctx, 400)
+			},
+			denom:        "uusdc",
+			expSupply:    func() *math.Int { i := math.NewInt(600); return &i }(),
+			expRateLimit: func() *math.Int { i := math.NewInt(100); return &i }(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			tc.malleate()
+
+			res, err := s.network.App.GetEVMMintKeeper().DenomMintLimit(
+				s.network.GetContext(),
+				&types.QueryDenomMintLimitRequest{Denom: tc.denom},
+			)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expSupply, res.RemainingSupply)
+			s.Require().Equal(tc.expRateLimit, res.RemainingRateLimit)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryDenomMintStats() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetEVMMintKeeper()
+
+	_, err := k.DenomMintStats(ctx, &types.QueryDenomMintStatsRequest{Denom: ""})
+	s.Require().Error(err, "expected invalid denom to fail")
+
+	res, err := k.DenomMintStats(ctx, &types.QueryDenomMintStatsRequest{Denom: "uusdc"})
+	s.Require().NoError(err)
+	s.Require().Equal(types.NewDenomMintStats("uusdc", math.ZeroInt(), math.ZeroInt()), res.Stats)
+
+	s.Require().NoError(k.RecordMint(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), "uusdc", math.NewInt(1000)))
+	s.Require().NoError(k.RecordBurn(ctx, "uusdc", math.NewInt(400)))
+
+	res, err = k.DenomMintStats(ctx, &types.QueryDenomMintStatsRequest{Denom: "uusdc"})
+	s.Require().NoError(err)
+	s.Require().Equal(types.NewDenomMintStats("uusdc", math.NewInt(1000), math.NewInt(400)), res.Stats)
+}
+
+func (s *KeeperTestSuite) TestQueryMinterMintStats() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetEVMMintKeeper()
+	minter := s.keyring.GetAccAddr(0)
+
+	_, err := k.MinterMintStats(ctx, &types.QueryMinterMintStatsRequest{Minter: "invalid", Denom: "uusdc"})
+	s.Require().Error(err, "expected invalid minter to fail")
+
+	_, err = k.MinterMintStats(ctx, &types.QueryMinterMintStatsRequest{Minter: minter.String(), Denom: ""})
+	s.Require().Error(err, "expected invalid denom to fail")
+
+	s.Require().NoError(k.RecordMint(ctx, minter, s.keyring.GetAccAddr(1), "uusdc", math.NewInt(1000)))
+
+	res, err := k.MinterMintStats(ctx, &types.QueryMinterMintStatsRequest{Minter: minter.String(), Denom: "uusdc"})
+	s.Require().NoError(err)
+	s.Require().Equal(types.NewMinterMintStats(minter, "uusdc", math.NewInt(1000)), res.Stats)
+}
+
+func (s *KeeperTestSuite) TestQueryPauseStatus() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetEVMMintKeeper()
+
+	_, err := k.PauseStatus(ctx, &types.QueryPauseStatusRequest{Denom: ""})
+	s.Require().Error(err, "expected invalid denom to fail")
+
+	res, err := k.PauseStatus(ctx, &types.QueryPauseStatusRequest{Denom: "uusdc"})
+	s.Require().NoError(err)
+	s.Require().Equal(&types.QueryPauseStatusResponse{}, res)
+
+	s.Require().NoError(k.SetDenomPaused(ctx, "uusdc", true))
+	res, err = k.PauseStatus(ctx, &types.QueryPauseStatusRequest{Denom: "uusdc"})
+	s.Require().NoError(err)
+	s.Require().Equal(&types.QueryPauseStatusResponse{Paused: false, DenomPaused: true}, res)
+
+	k.SetPaused(ctx, true)
+	res, err = k.PauseStatus(ctx, &types.QueryPauseStatusRequest{Denom: "uatom"})
+	s.Require().NoError(err)
+	s.Require().Equal(&types.QueryPauseStatusResponse{Paused: true, DenomPaused: true}, res)
+}
+
+func (s *KeeperTestSuite) TestQueryIBCMintRoutes() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetEVMMintKeeper()
+
+	res, err := k.IBCMintRoutes(ctx, &types.QueryIBCMintRoutesRequest{})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Routes)
+
+	routes := []types.IBCMintRoute{
+		types.NewIBCMintRoute("channel-0", "uusdc", "usdc"),
+		types.NewIBCMintRoute("channel-1", "uusdc", "usdc"),
+	}
+	for _, route := range routes {
+		s.Require().NoError(k.UpdateIBCMintRoute(ctx, route))
+	}
+
+	res, err = k.IBCMintRoutes(ctx, &types.QueryIBCMintRoutesRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(routes, res.Routes)
+}
+
+func (s *KeeperTestSuite) TestQueryMintProposals() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetEVMMintKeeper()
+
+	configRes, err := k.MintApprovalConfig(ctx, &types.QueryMintApprovalConfigRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultMintApprovalConfig(), configRes.Config)
+
+	res, err := k.MintProposals(ctx, &types.QueryMintProposalsRequest{})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Proposals)
+
+	_, err = k.MintProposal(ctx, &types.QueryMintProposalRequest{Id: 1})
+	s.Require().Error(err)
+
+	config := types.NewMintApprovalConfig(
+		[]string{s.keyring.GetAccAddr(0).String()},
+		1, 0, 100,
+		sdk.NewCoins(sdk.NewInt64Coin("uusdc", 500)),
+	)
+	s.Require().NoError(k.UpdateMintApprovalConfig(ctx, config))
+
+	proposal, err := k.SubmitMintProposal(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(1), "uusdc", math.NewInt(1000), 5)
+	s.Require().NoError(err)
+
+	configRes, err = k.MintApprovalConfig(ctx, &types.QueryMintApprovalConfigRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(config, configRes.Config)
+
+	proposalRes, err := k.MintProposal(ctx, &types.QueryMintProposalRequest{Id: proposal.ID})
+	s.Require().NoError(err)
+	s.Require().Equal(proposal.Recipient, proposalRes.Proposal.Recipient)
+	s.Require().Equal(proposal.Amount, proposalRes.Proposal.Amount)
+
+	res, err = k.MintProposals(ctx, &types.QueryMintProposalsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Proposals, 1)
+}
+
+func (s *KeeperTestSuite) TestQueryDenomAdmin() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetEVMMintKeeper()
+	creator := s.keyring.GetAccAddr(0)
+
+	_, err := k.DenomAdmin(ctx, &types.QueryDenomAdminRequest{Denom: "uusdc"})
+	s.Require().Error(err, "expected denom outside the factory namespace to fail")
+
+	denom, err := types.BuildFactoryDenom(creator, "team")
+	s.Require().NoError(err)
+
+	_, err = k.DenomAdmin(ctx, &types.QueryDenomAdminRequest{Denom: denom})
+	s.Require().Error(err, "expected denom that was not created to fail")
+
+	_, err = k.CreateDenom(ctx, creator, "team")
+	s.Require().NoError(err)
+
+	res, err := k.DenomAdmin(ctx, &types.QueryDenomAdminRequest{Denom: denom})
+	s.Require().NoError(err)
+	s.Require().Equal(creator.String(), res.Admin)
+
+	s.Require().NoError(k.ChangeDenomAdmin(ctx, denom, nil))
+	res, err = k.DenomAdmin(ctx, &types.QueryDenomAdminRequest{Denom: denom})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Admin)
+}
+
+func (s *KeeperTestSuite) TestQueryEmissionSchedules() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetEVMMintKeeper()
+	height := uint64(ctx.BlockHeight()) //nolint:gosec // G115
+
+	res, err := k.EmissionSchedules(ctx, &types.QueryEmissionSchedulesRequest{})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Schedules)
+
+	_, err = k.EmissionSchedule(ctx, &types.QueryEmissionScheduleRequest{Id: 1})
+	s.Require().Error(err)
+
+	schedule, err := k.AddEmissionSchedule(ctx, types.NewEmissionSchedule(
+		0, "uusdc", s.keyring.GetAccAddr(1), types.EmissionCurveLinear, math.NewInt(1000), height+1, height+4, 0,
+	))
+	s.Require().NoError(err)
+	s.Require().NoError(k.BeginBlock(ctx.WithBlockHeight(int64(height) + 1))) //nolint:gosec // G115
+
+	scheduleRes, err := k.EmissionSchedule(ctx, &types.QueryEmissionScheduleRequest{Id: schedule.ID})
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(250), scheduleRes.Schedule.Emitted)
+	s.Require().Equal(math.NewInt(750), scheduleRes.Remaining)
+
+	res, err = k.EmissionSchedules(ctx, &types.QueryEmissionSchedulesRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.EmissionSchedule{scheduleRes.Schedule}, res.Schedules)
+}
+
+func (s *KeeperTestSuite) TestQueryParams() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetEVMMintKeeper()
+
+	res, err := k.Params(ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultParams(), res.Params)
+
+	params := types.NewParams([]string{s.keyring.GetAccAddr(1).String()}, []string{"uusdc"}, false, 0)
+	s.Require().NoError(k.SetParams(ctx, params))
+
+	res, err = k.Params(ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, res.Params)
+}
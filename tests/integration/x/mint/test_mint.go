@@ -0,0 +1,127 @@
+package mint
+
+import (
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/mint/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestMint() {
+	testCases := []struct {
+		name      string
+		malleate  func(ctx sdk.Context)
+		minter    func() sdk.AccAddress
+		amount    math.Int
+		expErr    error
+		expRemain math.Int
+	}{
+		{
+			name:     "fail - minting is paused",
+			malleate: func(ctx sdk.Context) { s.network.App.GetEVMMintKeeper().SetPaused(ctx, true) },
+			minter:   func() sdk.AccAddress { return s.keyring.GetAccAddr(0) },
+			amount:   math.NewInt(100),
+			expErr:   types.ErrPaused,
+		},
+		{
+			name:     "fail - minter not registered",
+			malleate: func(sdk.Context) {},
+			minter:   func() sdk.AccAddress { return s.keyring.GetAccAddr(0) },
+			amount:   math.NewInt(100),
+			expErr:   types.ErrMinterNotFound,
+		},
+		{
+			name: "fail - insufficient allowance",
+			malleate: func(ctx sdk.Context) {
+				s.Require().NoError(s.network.App.GetEVMMintKeeper().SetMinterAllowance(ctx, s.keyring.GetAccAddr(0), "uusdc", math.NewInt(50)))
+			},
+			minter: func() sdk.AccAddress { return s.keyring.GetAccAddr(0) },
+			amount: math.NewInt(100),
+			expErr: types.ErrInsufficientMinterAllowance,
+		},
+		{
+			name: "fail - max supply exceeded",
+			malleate: func(ctx sdk.Context) {
+				k := s.network.App.GetEVMMintKeeper()
+				s.Require().NoError(k.SetMinterAllowance(ctx, s.keyring.GetAccAddr(0), "uusdc", math.NewInt(150)))
+				s.Require().NoError(k.UpdateDenomMintLimit(ctx, types.NewDenomMintLimit("uusdc", math.NewInt(50), math.ZeroInt(), 0)))
+			},
+			minter: func() sdk.AccAddress { return s.keyring.GetAccAddr(0) },
+			amount: math.NewInt(100),
+			expErr: types.ErrMaxSupplyExceeded,
+		},
+		{
+			name: "pass - minter spends its allowance",
+			malleate: func(ctx sdk.Context) {
+				s.Require().NoError(s.network.App.GetEVMMintKeeper().SetMinterAllowance(ctx, s.keyring.GetAccAddr(0), "uusdc", math.NewInt(150)))
+			},
+			minter:    func() sdk.AccAddress { return s.keyring.GetAccAddr(0) },
+			amount:    math.NewInt(100),
+			expRemain: math.NewInt(50),
+		},
+		{
+			name: "pass - mint authority mints without an allowance",
+			malleate: func(ctx sdk.Context) {
+				s.network.App.GetEVMMintKeeper().SetMintAuthority(ctx, s.keyring.GetAccAddr(1))
+			},
+			minter: func() sdk.AccAddress { return s.keyring.GetAccAddr(1) },
+			amount: math.NewInt(100),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			k := s.network.App.GetEVMMintKeeper()
+			recipient := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+			tc.malleate(ctx)
+			minter := tc.minter()
+
+			err := k.Mint(ctx, minter, recipient, sdk.NewCoin("uusdc", tc.amount))
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			balance := s.network.App.GetBankKeeper().GetBalance(ctx, recipient, "uusdc")
+			s.Require().Equal(tc.amount, balance.Amount)
+
+			// the mint is recorded in the stats and history
+			s.Require().Equal(tc.amount, k.GetDenomMintStats(ctx, "uusdc").TotalMinted)
+			s.Require().Equal(tc.amount, k.GetMinterMintStats(ctx, minter, "uusdc").Minted)
+			s.Require().Len(k.GetMintHistory(ctx), 1)
+
+			allowance, found := k.GetMinterAllowance(ctx, minter, "uusdc")
+			s.Require().Equal(!tc.expRemain.IsNil(), found)
+			if found {
+				s.Require().Equal(tc.expRemain, allowance)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestBurn() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetEVMMintKeeper()
+	account := s.keyring.GetAccAddr(0)
+
+	s.Require().NoError(k.SetMinterAllowance(ctx, account, "uusdc", math.NewInt(100)))
+	s.Require().NoError(k.Mint(ctx, account, account, sdk.NewInt64Coin("uusdc", 100)))
+
+	s.Require().NoError(k.SetDenomPaused(ctx, "uusdc", true))
+	s.Require().ErrorIs(k.Burn(ctx, account, sdk.NewInt64Coin("uusdc", 40)), types.ErrPaused)
+	s.Require().NoError(k.LiftPause(ctx, "uusdc"))
+
+	s.Require().NoError(k.Burn(ctx, account, sdk.NewInt64Coin("uusdc", 40)))
+	s.Require().Equal(int64(60), s.network.App.GetBankKeeper().GetBalance(ctx, account, "uusdc").Amount.Int64())
+	s.Require().Equal(int64(60), s.network.App.GetBankKeeper().GetSupply(ctx, "uusdc").Amount.Int64())
+	s.Require().Equal(math.NewInt(40), k.GetDenomMintStats(ctx, "uusdc").TotalBurned)
+
+	s.Require().Error(k.Burn(ctx, account, sdk.NewInt64Coin("uusdc", 100)), "expected burning more than the balance to fail")
+}
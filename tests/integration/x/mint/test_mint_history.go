@@ -0,0 +1,147 @@
+package mint
+
+import (
+	"github.com/cosmos/evm/x/mint/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (s *KeeperTestSuite) TestRecordMintHistory() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetEVMMintKeeper()
+	minter, recipient := s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	s.Require().NoError(k.RecordMint(ctx.WithBlockHeight(10), minter, recipient, "uusdc", math.NewInt(1000)))
+	s.Require().NoError(k.RecordMint(ctx.WithBlockHeight(11), minter, recipient, "uatom", math.NewInt(500)))
+
+	s.Require().Equal([]types.MintRecord{
+		types.NewMintRecord(1, 10, minter, recipient, "uusdc", math.NewInt(1000)),
+		types.NewMintRecord(2, 11, minter, recipient, "uatom", math.NewInt(500)),
+	}, k.GetMintHistory(ctx))
+	s.Require().Equal(uint64(3), k.GetNextMintRecordID(ctx))
+
+	// each mint emits a typed event
+	events := ctx.EventManager().Events()
+	s.Require().Len(events, 2)
+	msg, err := sdk.ParseTypedEvent(ctx.EventManager().ABCIEvents()[0])
+	s.Require().NoError(err)
+	s.Require().Equal(&types.EventMint{
+		Minter:    minter.String(),
+		Recipient: recipient.String(),
+		Denom:     "uusdc",
+		Amount:    "1000",
+	}, msg)
+}
+
+func (s *KeeperTestSuite) TestPruneMintHistory() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetEVMMintKeeper()
+	minter, recipient := s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1)
+
+	params := k.GetParams(ctx)
+	params.MintHistoryRetention = 10
+	s.Require().NoError(k.SetParams(ctx, params))
+
+	for _, height := range []int64{5, 6, 7} {
+		s.Require().NoError(k.RecordMint(ctx.WithBlockHeight(height), minter, recipient, "uusdc", math.NewInt(1000)))
+	}
+
+	// the mints of the last 10 blocks are kept
+	s.Require().NoError(k.EndBlock(ctx.WithBlockHeight(15)))
+	s.Require().Len(k.GetMintHistory(ctx), 2)
+	s.Require().Equal(uint64(6), k.GetMintHistory(ctx)[0].Height)
+
+	s.Require().NoError(k.EndBlock(ctx.WithBlockHeight(17)))
+	s.Require().Empty(k.GetMintHistory(ctx))
+
+	// a zero retention keeps the records forever
+	params.MintHistoryRetention = 0
+	s.Require().NoError(k.SetParams(ctx, params))
+	s.Require().NoError(k.RecordMint(ctx.WithBlockHeight(20), minter, recipient, "uusdc", math.NewInt(1000)))
+	s.Require().NoError(k.EndBlock(ctx.WithBlockHeight(1_000_000)))
+	s.Require().Len(k.GetMintHistory(ctx), 1)
+	s.Require().Equal(uint64(4), k.GetMintHistory(ctx)[0].ID, "expected record ids not to be reused after pruning")
+}
+
+func (s *KeeperTestSuite) TestQueryMintHistory() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetEVMMintKeeper()
+	minter, otherMinter := s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1)
+	recipient := otherMinter
+
+	s.Require().NoError(k.RecordMint(ctx.WithBlockHeight(1), minter, recipient, "uusdc", math.NewInt(100)))
+	s.Require().NoError(k.RecordMint(ctx.WithBlockHeight(2), otherMinter, recipient, "uusdc", math.NewInt(200)))
+	s.Require().NoError(k.RecordMint(ctx.WithBlockHeight(3), minter, recipient, "uatom", math.NewInt(300)))
+	s.Require().NoError(k.RecordMint(ctx.WithBlockHeight(4), minter, recipient, "uusdc", math.NewInt(400)))
+
+	amounts := func(records []types.MintRecord) []int64 {
+		res := make([]int64, len(records))
+		for i, record := range records {
+			res[i] = record.Amount.Int64()
+		}
+		return res
+	}
+
+	testCases := []struct {
+		name       string
+		req        *types.QueryMintHistoryRequest
+		expAmounts []int64
+		expErr     bool
+	}{
+		{
+			name:       "all records, oldest first",
+			req:        &types.QueryMintHistoryRequest{},
+			expAmounts: []int64{100, 200, 300, 400},
+		},
+		{
+			name:       "by denom",
+			req:        &types.QueryMintHistoryRequest{Denom: "uusdc"},
+			expAmounts: []int64{100, 200, 400},
+		},
+		{
+			name:       "by minter",
+			req:        &types.QueryMintHistoryRequest{Minter: minter.String()},
+			expAmounts: []int64{100, 300, 400},
+		},
+		{
+			name:       "by denom and minter",
+			req:        &types.QueryMintHistoryRequest{Denom: "uusdc", Minter: minter.String()},
+			expAmounts: []int64{100, 400},
+		},
+		{
+			name:       "paginated",
+			req:        &types.QueryMintHistoryRequest{Denom: "uusdc", Pagination: &query.PageRequest{Offset: 1, Limit: 1}},
+			expAmounts: []int64{200},
+		},
+		{
+			name:   "invalid minter",
+			req:    &types.QueryMintHistoryRequest{Minter: "invalid"},
+			expErr: true,
+		},
+		{
+			name:   "invalid denom",
+			req:    &types.QueryMintHistoryRequest{Denom: "1uusdc"},
+			expErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := k.MintHistory(ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expAmounts, amounts(res.Records))
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package mint
+
+import (
+	"github.com/cosmos/evm/x/mint/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestTrackMint() {
+	testCases := []struct {
+		name        string
+		limit       types.DenomMintLimit
+		supply      int64
+		minted      int64
+		heightDelta int64
+		amount      math.Int
+		expErr      error
+	}{
+		{
+			name:   "pass - no limit",
+			limit:  types.NewDenomMintLimit("uusdc", math.ZeroInt(), math.ZeroInt(), 0),
+			amount: math.NewInt(1_000_000),
+		},
+		{
+			name:   "pass - up to the max supply",
+			limit:  types.NewDenomMintLimit("uusdc", math.NewInt(1000), math.ZeroInt(), 0),
+			supply: 400,
+			amount: math.NewInt(600),
+		},
+		{
+			name:   "fail - above the max supply",
+			limit:  types.NewDenomMintLimit("uusdc", math.NewInt(1000), math.ZeroInt(), 0),
+			supply: 400,
+			amount: math.NewInt(601),
+			expErr: types.ErrMaxSupplyExceeded,
+		},
+		{
+			name:        "fail - above the rate limit within the window",
+			limit:       types.NewDenomMintLimit("uusdc", math.ZeroInt(), math.NewInt(1000), 10),
+			minted:      400,
+			heightDelta: 9,
+			amount:      math.NewInt(601),
+			expErr:      types.ErrRateLimitExceeded,
+		},
+		{
+			name:        "pass - previous mints left the window",
+			limit:       types.NewDenomMintLimit("uusdc", math.ZeroInt(), math.NewInt(1000), 10),
+			minted:      400,
+			heightDelta: 10,
+			amount:      math.NewInt(1000),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			k := s.network.App.GetEVMMintKeeper()
+
+			s.Require().NoError(k.UpdateDenomMintLimit(ctx, tc.limit))
+			if tc.supply > 0 {
+				s.mint(ctx, tc.supply)
+			}
+			if tc.minted > 0 {
+				s.Require().NoError(k.TrackMint(ctx, "uusdc", math.NewInt(tc.minted)))
+			}
+
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + tc.heightDelta)
+			err := k.TrackMint(ctx, "uusdc", tc.amount)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRemainingRateLimit() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetEVMMintKeeper()
+
+	_, limited := k.RemainingRateLimit(ctx, "uusdc")
+	s.Require().False(limited, "expected no rate limit")
+
+	s.Require().NoError(k.UpdateDenomMintLimit(ctx, types.NewDenomMintLimit("uusdc", math.ZeroInt(), math.NewInt(1000), 3)))
+
+	start := ctx.BlockHeight()
+	for i, amount := range []int64{100, 200, 300} {
+		s.Require().NoError(k.TrackMint(ctx.WithBlockHeight(start+int64(i)), "uusdc", math.NewInt(amount)))
+	}
+
+	// the window covers the last three blocks, including the current one
+	for i, expected := range []int64{400, 500, 700, 1000} {
+		delta := int64(i) + 2
+		remaining, limited := k.RemainingRateLimit(ctx.WithBlockHeight(start+delta), "uusdc")
+		s.Require().True(limited)
+		s.Require().Equal(math.NewInt(expected), remaining, "unexpected remaining rate limit %d blocks after the first mint", delta)
+	}
+
+	// lifting the rate limit drops the tracked amounts
+	s.Require().NoError(k.UpdateDenomMintLimit(ctx, types.NewDenomMintLimit("uusdc", math.NewInt(5000), math.ZeroInt(), 0)))
+	s.Require().NoError(k.UpdateDenomMintLimit(ctx, types.NewDenomMintLimit("uusdc", math.ZeroInt(), math.NewInt(1000), 3)))
+	remaining, _ := k.RemainingRateLimit(ctx.WithBlockHeight(start+2), "uusdc")
+	s.Require().Equal(math.NewInt(1000), remaining)
+}
+
+func (s *KeeperTestSuite) TestUpdateDenomMintLimit() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetEVMMintKeeper()
+
+	limit := types.NewDenomMintLimit("uusdc", math.NewInt(1000), math.NewInt(100), 10)
+	s.Require().NoError(k.UpdateDenomMintLimit(ctx, limit))
+
+	stored, found := k.GetDenomMintLimit(ctx, "uusdc")
+	s.Require().True(found)
+	s.Require().Equal(limit, stored)
+	s.Require().Equal([]types.DenomMintLimit{limit}, k.GetDenomMintLimits(ctx))
+
+	err := k.UpdateDenomMintLimit(ctx, types.NewDenomMintLimit("uusdc", math.NewInt(-1), math.ZeroInt(), 0))
+	s.Require().ErrorIs(err, types.ErrInvalidDenomMintLimit)
+
+	// an empty limit removes it
+	s.Require().NoError(k.UpdateDenomMintLimit(ctx, types.NewDenomMintLimit("uusdc", math.ZeroInt(), math.ZeroInt(), 0)))
+	_, found = k.GetDenomMintLimit(ctx, "uusdc")
+	s.Require().False(found)
+	s.Require().Empty(k.GetDenomMintLimits(ctx))
+}
+
+// mint mints the given amount of uusdc to the module account, to raise the
+// supply of the denom.
+func (s *KeeperTestSuite) mint(ctx sdk.Context, amount int64) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uusdc", amount))
+	err := s.network.App.GetBankKeeper().MintCoins(ctx, types.ModuleName, coins)
+	s.Require().NoError(err)
+}
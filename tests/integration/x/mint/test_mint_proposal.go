@@ -0,0 +1,154 @@
+package mint
+
+import (
+	gomath "math"
+
+	"github.com/cosmos/evm/x/mint/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestApproveMintProposal() {
+	testCases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context, id uint64)
+		approver    func() sdk.AccAddress
+		expErr      error
+		expApproved bool
+	}{
+		{
+			name:     "fail - not an approver",
+			approver: func() sdk.AccAddress { return s.keyring.GetAccAddr(1) },
+			expErr:   types.ErrNotMintApprover,
+		},
+		{
+			name: "fail - already approved",
+			malleate: func(ctx sdk.Context, id uint64) {
+				_, err := s.network.App.GetEVMMintKeeper().ApproveMintProposal(ctx, id, s.keyring.GetAccAddr(0))
+				s.Require().NoError(err)
+			},
+			approver: func() sdk.AccAddress { return s.keyring.GetAccAddr(0) },
+			expErr:   types.ErrInvalidMintProposal,
+		},
+		{
+			name:        "pass - approve",
+			approver:    func() sdk.AccAddress { return s.keyring.GetAccAddr(0) },
+			expApproved: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			k := s.network.App.GetEVMMintKeeper()
+
+			config := types.NewMintApprovalConfig([]string{s.keyring.GetAccAddr(0).String()}, 1, 0, 100, nil)
+			s.Require().NoError(k.UpdateMintApprovalConfig(ctx, config))
+
+			proposal, err := k.SubmitMintProposal(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(1), "uusdc", math.NewInt(1000), 0)
+			s.Require().NoError(err)
+
+			if tc.malleate != nil {
+				tc.malleate(ctx, proposal.ID)
+			}
+
+			proposal, err = k.ApproveMintProposal(ctx, proposal.ID, tc.approver())
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expApproved, proposal.HasApproved(tc.approver()))
+			s.Require().NoError(k.CheckMintProposalExecutable(ctx, proposal))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCheckMintProposalExecutable() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetEVMMintKeeper()
+
+	config := types.NewMintApprovalConfig(
+		[]string{s.keyring.GetAccAddr(0).String(), s.keyring.GetAccAddr(1).String()},
+		2, 5, 10, nil,
+	)
+	s.Require().NoError(k.UpdateMintApprovalConfig(ctx, config))
+
+	// the delay cannot be shorter than the minimum delay
+	_, err := k.SubmitMintProposal(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(1), "uusdc", math.NewInt(1000), 4)
+	s.Require().ErrorIs(err, types.ErrInvalidMintProposal)
+
+	// the delay cannot wrap the executable height around to a past height
+	_, err = k.SubmitMintProposal(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(1), "uusdc", math.NewInt(1000), gomath.MaxUint64)
+	s.Require().ErrorIs(err, types.ErrInvalidMintProposal)
+
+	// nor the expiry height
+	maxDelay := gomath.MaxUint64 - uint64(ctx.BlockHeight()) - config.Expiry //nolint:gosec // G115
+	_, err = k.SubmitMintProposal(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(1), "uusdc", math.NewInt(1000), maxDelay+1)
+	s.Require().ErrorIs(err, types.ErrInvalidMintProposal)
+
+	proposal, err := k.SubmitMintProposal(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(1), "uusdc", math.NewInt(1000), 5)
+	s.Require().NoError(err)
+
+	for i := range 2 {
+		proposal, err = k.ApproveMintProposal(ctx, proposal.ID, s.keyring.GetAccAddr(i))
+		s.Require().NoError(err)
+	}
+
+	// the delay has not passed
+	err = k.CheckMintProposalExecutable(ctx, proposal)
+	s.Require().ErrorIs(err, types.ErrMintProposalNotExecutable)
+
+	executableCtx := ctx.WithBlockHeight(int64(proposal.ExecutableHeight)) //nolint:gosec // G115
+	s.Require().NoError(k.CheckMintProposalExecutable(executableCtx, proposal))
+
+	// approvals of removed approvers no longer count
+	config = types.NewMintApprovalConfig([]string{s.keyring.GetAccAddr(0).String()}, 1, 5, 10, nil)
+	s.Require().NoError(k.UpdateMintApprovalConfig(ctx, config))
+	s.Require().NoError(k.CheckMintProposalExecutable(executableCtx, proposal))
+
+	config = types.NewMintApprovalConfig(
+		[]string{s.keyring.GetAccAddr(0).String(), sdk.AccAddress("approver").String()},
+		2, 5, 10, nil,
+	)
+	s.Require().NoError(k.UpdateMintApprovalConfig(ctx, config))
+	err = k.CheckMintProposalExecutable(executableCtx, proposal)
+	s.Require().ErrorIs(err, types.ErrMintProposalNotExecutable)
+
+	// the proposal expired
+	expiredCtx := ctx.WithBlockHeight(int64(proposal.ExpiryHeight) + 1) //nolint:gosec // G115
+	err = k.CheckMintProposalExecutable(expiredCtx, proposal)
+	s.Require().ErrorIs(err, types.ErrMintProposalNotExecutable)
+}
+
+func (s *KeeperTestSuite) TestPruneExpiredMintProposals() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetEVMMintKeeper()
+
+	config := types.NewMintApprovalConfig([]string{s.keyring.GetAccAddr(0).String()}, 1, 0, 10, nil)
+	s.Require().NoError(k.UpdateMintApprovalConfig(ctx, config))
+
+	first, err := k.SubmitMintProposal(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(1), "uusdc", math.NewInt(1000), 0)
+	s.Require().NoError(err)
+	second, err := k.SubmitMintProposal(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(1), "uusdc", math.NewInt(1000), 5)
+	s.Require().NoError(err)
+
+	// nothing expires before the expiry height
+	s.Require().NoError(k.EndBlock(ctx.WithBlockHeight(int64(first.ExpiryHeight) - 1))) //nolint:gosec // G115
+	s.Require().Len(k.GetMintProposals(ctx), 2)
+
+	s.Require().NoError(k.EndBlock(ctx.WithBlockHeight(int64(first.ExpiryHeight)))) //nolint:gosec // G115
+	_, found := k.GetMintProposal(ctx, first.ID)
+	s.Require().False(found)
+	_, found = k.GetMintProposal(ctx, second.ID)
+	s.Require().True(found)
+
+	s.Require().NoError(k.EndBlock(ctx.WithBlockHeight(int64(second.ExpiryHeight) + 1))) //nolint:gosec // G115
+	s.Require().Empty(k.GetMintProposals(ctx))
+}
@@ -0,0 +1,37 @@
+package mint
+
+import (
+	"cosmossdk.io/math"
+)
+
+func (s *KeeperTestSuite) TestRecordMintAndBurn() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetEVMMintKeeper()
+	minter := s.keyring.GetAccAddr(0)
+	otherMinter := s.keyring.GetAccAddr(1)
+	recipient := s.keyring.GetAccAddr(0)
+
+	stats := k.GetDenomMintStats(ctx, "uusdc")
+	s.Require().True(stats.TotalMinted.IsZero(), "expected nothing minted yet")
+	s.Require().True(stats.TotalBurned.IsZero(), "expected nothing burned yet")
+	s.Require().True(k.GetMinterMintStats(ctx, minter, "uusdc").Minted.IsZero(), "expected nothing minted by the minter yet")
+
+	s.Require().NoError(k.RecordMint(ctx, minter, recipient, "uusdc", math.NewInt(1000)))
+	s.Require().NoError(k.RecordMint(ctx, otherMinter, recipient, "uusdc", math.NewInt(500)))
+	s.Require().NoError(k.RecordMint(ctx, minter, recipient, "uusdc", math.NewInt(250)))
+	s.Require().NoError(k.RecordBurn(ctx, "uusdc", math.NewInt(300)))
+
+	stats = k.GetDenomMintStats(ctx, "uusdc")
+	s.Require().Equal(math.NewInt(1750), stats.TotalMinted)
+	s.Require().Equal(math.NewInt(300), stats.TotalBurned)
+	s.Require().Equal(math.NewInt(1250), k.GetMinterMintStats(ctx, minter, "uusdc").Minted)
+	s.Require().Equal(math.NewInt(500), k.GetMinterMintStats(ctx, otherMinter, "uusdc").Minted)
+
+	// totals are tracked per denom
+	s.Require().True(k.GetDenomMintStats(ctx, "uatom").TotalMinted.IsZero())
+	s.Require().True(k.GetMinterMintStats(ctx, minter, "uatom").Minted.IsZero())
+
+	s.Require().Len(k.GetAllDenomMintStats(ctx), 1)
+	s.Require().Len(k.GetAllMinterMintStats(ctx), 2)
+}
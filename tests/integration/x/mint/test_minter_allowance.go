@@ -0,0 +1,95 @@
+package mint
+
+import (
+	"github.com/cosmos/evm/x/mint/types"
+
+	"cosmossdk.io/math"
+)
+
+func (s *KeeperTestSuite) TestSpendMinterAllowance() {
+	testCases := []struct {
+		name      string
+		malleate  func()
+		amount    math.Int
+		expErr    error
+		expRemain math.Int
+	}{
+		{
+			name:     "fail - minter not registered",
+			malleate: func() {},
+			amount:   math.NewInt(100),
+			expErr:   types.ErrMinterNotFound,
+		},
+		{
+			name: "fail - insufficient allowance",
+			malleate: func() {
+				err := s.network.App.GetEVMMintKeeper().SetMinterAllowance(s.network.GetContext(), s.keyring.GetAccAddr(0), "uusdc", math.NewInt(50))
+				s.Require().NoError(err)
+			},
+			amount: math.NewInt(100),
+			expErr: types.ErrInsufficientMinterAllowance,
+		},
+		{
+			name: "pass - spend part of the allowance",
+			malleate: func() {
+				err := s.network.App.GetEVMMintKeeper().SetMinterAllowance(s.network.GetContext(), s.keyring.GetAccAddr(0), "uusdc", math.NewInt(150))
+				s.Require().NoError(err)
+			},
+			amount:    math.NewInt(100),
+			expRemain: math.NewInt(50),
+		},
+		{
+			name: "pass - spend the whole allowance",
+			malleate: func() {
+				err := s.network.App.GetEVMMintKeeper().SetMinterAllowance(s.network.GetContext(), s.keyring.GetAccAddr(0), "uusdc", math.NewInt(100))
+				s.Require().NoError(err)
+			},
+			amount:    math.NewInt(100),
+			expRemain: math.ZeroInt(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			tc.malleate()
+
+			ctx := s.network.GetContext()
+			k := s.network.App.GetEVMMintKeeper()
+			minter := s.keyring.GetAccAddr(0)
+
+			err := k.SpendMinterAllowance(ctx, minter, "uusdc", tc.amount)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			allowance, found := k.GetMinterAllowance(ctx, minter, "uusdc")
+			s.Require().True(found)
+			s.Require().Equal(tc.expRemain, allowance)
+
+			// allowances for other denoms are untouched
+			_, found = k.GetMinterAllowance(ctx, minter, "uatom")
+			s.Require().False(found)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestDeleteMinterAllowance() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetEVMMintKeeper()
+	minter := s.keyring.GetAccAddr(0)
+
+	err := k.DeleteMinterAllowance(ctx, minter, "uusdc")
+	s.Require().ErrorIs(err, types.ErrMinterNotFound)
+
+	err = k.SetMinterAllowance(ctx, minter, "uusdc", math.NewInt(100))
+	s.Require().NoError(err)
+	s.Require().Len(k.GetMinterAllowances(ctx), 1)
+
+	err = k.DeleteMinterAllowance(ctx, minter, "uusdc")
+	s.Require().NoError(err)
+	s.Require().Empty(k.GetMinterAllowances(ctx))
+}
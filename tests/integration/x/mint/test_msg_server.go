@@ -0,0 +1,586 @@
+package mint
+
+import (
+	"github.com/cosmos/evm/x/mint/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func (s *KeeperTestSuite) TestUpdateMintAuthority() {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name      string
+		request   func() *types.MsgUpdateMintAuthority
+		expectErr bool
+		expected  func() sdk.AccAddress
+	}{
+		{
+			name: "fail - invalid signer",
+			request: func() *types.MsgUpdateMintAuthority {
+				return &types.MsgUpdateMintAuthority{
+					Authority:     s.keyring.GetAccAddr(0).String(),
+					MintAuthority: s.keyring.GetAccAddr(0).String(),
+				}
+			},
+			expectErr: true,
+		},
+		{
+			name: "fail - invalid mint authority",
+			request: func() *types.MsgUpdateMintAuthority {
+				return &types.MsgUpdateMintAuthority{
+					Authority:     govAddr,
+					MintAuthority: "foobar",
+				}
+			},
+			expectErr: true,
+		},
+		{
+			name: "pass - set mint authority",
+			request: func() *types.MsgUpdateMintAuthority {
+				return &types.MsgUpdateMintAuthority{
+					Authority:     govAddr,
+					MintAuthority: s.keyring.GetAccAddr(1).String(),
+				}
+			},
+			expected: func() sdk.AccAddress { return s.keyring.GetAccAddr(1) },
+		},
+		{
+			name: "pass - clear mint authority",
+			request: func() *types.MsgUpdateMintAuthority {
+				return &types.MsgUpdateMintAuthority{
+					Authority: govAddr,
+				}
+			},
+			expected: func() sdk.AccAddress { return nil },
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			k := s.network.App.GetEVMMintKeeper()
+
+			_, err := k.UpdateMintAuthority(ctx, tc.request())
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected(), k.GetMintAuthority(ctx))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSetDenomMintLimit() {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	limit := types.NewDenomMintLimit("uusdc", math.NewInt(1000), math.NewInt(100), 10)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		request   func() *types.MsgSetDenomMintLimit
+		expectErr bool
+		expFound  bool
+	}{
+		{
+			name: "fail - invalid signer",
+			request: func() *types.MsgSetDenomMintLimit {
+				return &types.MsgSetDenomMintLimit{
+					Authority: s.keyring.GetAccAddr(0).String(),
+					Limit:     limit,
+				}
+			},
+			expectErr: true,
+		},
+		{
+			name: "fail - invalid limit",
+			request: func() *types.MsgSetDenomMintLimit {
+				return &types.MsgSetDenomMintLimit{
+					Authority: govAddr,
+					Limit:     types.NewDenomMintLimit("uusdc", math.ZeroInt(), math.NewInt(100), 0),
+				}
+			},
+			expectErr: true,
+		},
+		{
+			name: "pass - set limit",
+			request: func() *types.MsgSetDenomMintLimit {
+				return &types.MsgSetDenomMintLimit{
+					Authority: govAddr,
+					Limit:     limit,
+				}
+			},
+			expFound: true,
+		},
+		{
+			name: "pass - remove limit",
+			malleate: func() {
+				err := s.network.App.GetEVMMintKeeper().UpdateDenomMintLimit(s.network.GetContext(), limit)
+				s.Require().NoError(err)
+			},
+			request: func() *types.MsgSetDenomMintLimit {
+				return &types.MsgSetDenomMintLimit{
+					Authority: govAddr,
+					Limit:     types.NewDenomMintLimit("uusdc", math.ZeroInt(), math.ZeroInt(), 0),
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+			ctx := s.network.GetContext()
+			k := s.network.App.GetEVMMintKeeper()
+
+			_, err := k.SetDenomMintLimit(ctx, tc.request())
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			stored, found := k.GetDenomMintLimit(ctx, "uusdc")
+			s.Require().Equal(tc.expFound, found)
+			if tc.expFound {
+				s.Require().Equal(limit, stored)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSetIBCMintRoute() {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	route := types.NewIBCMintRoute("channel-0", "uusdc", "usdc")
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		request   func() *types.MsgSetIBCMintRoute
+		expectErr bool
+		expFound  bool
+	}{
+		{
+			name: "fail - invalid signer",
+			request: func() *types.MsgSetIBCMintRoute {
+				return &types.MsgSetIBCMintRoute{
+					Authority: s.keyring.GetAccAddr(0).String(),
+					Route:     route,
+				}
+			},
+			expectErr: true,
+		},
+		{
+			name: "fail - EVM denom as canonical denom",
+			request: func() *types.MsgSetIBCMintRoute {
+				return &types.MsgSetIBCMintRoute{
+					Authority: govAddr,
+					Route:     types.NewIBCMintRoute("channel-0", "uusdc", evmtypes.GetEVMCoinDenom()),
+				}
+			},
+			expectErr: true,
+		},
+		{
+			name: "fail - canonical denom already routed on channel",
+			malleate: func() {
+				other := types.NewIBCMintRoute("channel-0", "transfer/channel-7/uusdc", "usdc")
+				err := s.network.App.GetEVMMintKeeper().UpdateIBCMintRoute(s.network.GetContext(), other)
+				s.Require().NoError(err)
+			},
+			request: func() *types.MsgSetIBCMintRoute {
+				return &types.MsgSetIBCMintRoute{
+					Authority: govAddr,
+					Route:     route,
+				}
+			},
+			expectErr: true,
+		},
+		{
+			name: "fail - factory denom as canonical denom",
+			request: func() *types.MsgSetIBCMintRoute {
+				denom, err := types.BuildFactoryDenom(s.keyring.GetAccAddr(0), "usdc")
+				s.Require().NoError(err)
+				return &types.MsgSetIBCMintRoute{
+					Authority: govAddr,
+					Route:     types.NewIBCMintRoute("channel-0", "uusdc", denom),
+				}
+			},
+			expectErr: true,
+		},
+		{
+			name: "fail - canonical denom has a supply",
+			malleate: func() {
+				ctx := s.network.GetContext()
+				k := s.network.App.GetEVMMintKeeper()
+				k.SetMintAuthority(ctx, s.keyring.GetAccAddr(0))
+				err := k.Mint(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), sdk.NewCoin("usdc", math.NewInt(1000)))
+				s.Require().NoError(err)
+			},
+			request: func() *types.MsgSetIBCMintRoute {
+				return &types.MsgSetIBCMintRoute{
+					Authority: govAddr,
+					Route:     route,
+				}
+			},
+			expectErr: true,
+		},
+		{
+			name: "fail - canonical denom has minter allowances",
+			malleate: func() {
+				err := s.network.App.GetEVMMintKeeper().SetMinterAllowance(s.network.GetContext(), s.keyring.GetAccAddr(1), "usdc", math.NewInt(1000))
+				s.Require().NoError(err)
+			},
+			request: func() *types.MsgSetIBCMintRoute {
+				return &types.MsgSetIBCMintRoute{
+					Authority: govAddr,
+					Route:     route,
+				}
+			},
+			expectErr: true,
+		},
+		{
+			name: "fail - canonical denom has emission schedules",
+			malleate: func() {
+				ctx := s.network.GetContext()
+				start := uint64(ctx.BlockHeight()) + 1 //nolint:gosec // G115
+				_, err := s.network.App.GetEVMMintKeeper().AddEmissionSchedule(ctx, types.NewEmissionSchedule(
+					0, "usdc", s.keyring.GetAccAddr(1), types.EmissionCurveLinear, math.NewInt(1000), start, start+2, 0,
+				))
+				s.Require().NoError(err)
+			},
+			request: func() *types.MsgSetIBCMintRoute {
+				return &types.MsgSetIBCMintRoute{
+					Authority: govAddr,
+					Route:     route,
+				}
+			},
+			expectErr: true,
+		},
+		{
+			name: "pass - canonical denom already routed on another channel",
+			malleate: func() {
+				other := types.NewIBCMintRoute("channel-1", "uusdc", "usdc")
+				err := s.network.App.GetEVMMintKeeper().UpdateIBCMintRoute(s.network.GetContext(), other)
+				s.Require().NoError(err)
+			},
+			request: func() *types.MsgSetIBCMintRoute {
+				return &types.MsgSetIBCMintRoute{
+					Authority: govAddr,
+					Route:     route,
+				}
+			},
+			expFound: true,
+		},
+		{
+			name: "pass - set route",
+			request: func() *types.MsgSetIBCMintRoute {
+				return &types.MsgSetIBCMintRoute{
+					Authority: govAddr,
+					Route:     route,
+				}
+			},
+			expFound: true,
+		},
+		{
+			name: "pass - remove route",
+			malleate: func() {
+				err := s.network.App.GetEVMMintKeeper().UpdateIBCMintRoute(s.network.GetContext(), route)
+				s.Require().NoError(err)
+			},
+			request: func() *types.MsgSetIBCMintRoute {
+				return &types.MsgSetIBCMintRoute{
+					Authority: govAddr,
+					Route:     types.NewIBCMintRoute("channel-0", "uusdc", ""),
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+			ctx := s.network.GetContext()
+			k := s.network.App.GetEVMMintKeeper()
+
+			_, err := k.SetIBCMintRoute(ctx, tc.request())
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			stored, found := k.GetIBCMintRoute(ctx, "channel-0", "uusdc")
+			s.Require().Equal(tc.expFound, found)
+			if tc.expFound {
+				s.Require().Equal(route, stored)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSetMintApprovalConfig() {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		config    func() types.MintApprovalConfig
+		expectErr bool
+	}{
+		{
+			name: "fail - threshold above the number of approvers",
+			config: func() types.MintApprovalConfig {
+				return types.NewMintApprovalConfig([]string{s.keyring.GetAccAddr(0).String()}, 2, 10, 100, nil)
+			},
+			expectErr: true,
+		},
+		{
+			name: "pass - set config",
+			config: func() types.MintApprovalConfig {
+				return types.NewMintApprovalConfig(
+					[]string{s.keyring.GetAccAddr(0).String(), s.keyring.GetAccAddr(1).String()},
+					2, 10, 100,
+					sdk.NewCoins(sdk.NewInt64Coin("uusdc", 500)),
+				)
+			},
+		},
+		{
+			name: "pass - disable proposals",
+			malleate: func() {
+				config := types.NewMintApprovalConfig([]string{s.keyring.GetAccAddr(0).String()}, 1, 10, 100, nil)
+				err := s.network.App.GetEVMMintKeeper().UpdateMintApprovalConfig(s.network.GetContext(), config)
+				s.Require().NoError(err)
+			},
+			config: types.DefaultMintApprovalConfig,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+			ctx := s.network.GetContext()
+			k := s.network.App.GetEVMMintKeeper()
+
+			_, err := k.SetMintApprovalConfig(ctx, &types.MsgSetMintApprovalConfig{
+				Authority: s.keyring.GetAccAddr(0).String(),
+				Config:    tc.config(),
+			})
+			s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+			_, err = k.SetMintApprovalConfig(ctx, &types.MsgSetMintApprovalConfig{
+				Authority: govAddr,
+				Config:    tc.config(),
+			})
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.config(), k.GetMintApprovalConfig(ctx))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name      string
+		request   func() *types.MsgUpdateParams
+		expectErr bool
+	}{
+		{
+			name: "fail - invalid signer",
+			request: func() *types.MsgUpdateParams {
+				return &types.MsgUpdateParams{
+					Authority: s.keyring.GetAccAddr(0).String(),
+					Params:    types.DefaultParams(),
+				}
+			},
+			expectErr: true,
+		},
+		{
+			name: "fail - invalid params",
+			request: func() *types.MsgUpdateParams {
+				return &types.MsgUpdateParams{
+					Authority: govAddr,
+					Params:    types.NewParams([]string{"invalid"}, nil, false, 0),
+				}
+			},
+			expectErr: true,
+		},
+		{
+			name: "pass - update params",
+			request: func() *types.MsgUpdateParams {
+				return &types.MsgUpdateParams{
+					Authority: govAddr,
+					Params:    types.NewParams([]string{s.keyring.GetAccAddr(1).String()}, []string{"uusdc"}, true, 0),
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			k := s.network.App.GetEVMMintKeeper()
+
+			req := tc.request()
+			_, err := k.UpdateParams(ctx, req)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(req.Params, k.GetParams(ctx))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateGuardian() {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name      string
+		request   func() *types.MsgUpdateGuardian
+		expectErr bool
+		expected  func() sdk.AccAddress
+	}{
+		{
+			name: "fail - invalid signer",
+			request: func() *types.MsgUpdateGuardian {
+				return &types.MsgUpdateGuardian{
+					Authority: s.keyring.GetAccAddr(0).String(),
+					Guardian:  s.keyring.GetAccAddr(0).String(),
+				}
+			},
+			expectErr: true,
+		},
+		{
+			name: "fail - invalid guardian",
+			request: func() *types.MsgUpdateGuardian {
+				return &types.MsgUpdateGuardian{
+					Authority: govAddr,
+					Guardian:  "foobar",
+				}
+			},
+			expectErr: true,
+		},
+		{
+			name: "pass - set guardian",
+			request: func() *types.MsgUpdateGuardian {
+				return &types.MsgUpdateGuardian{
+					Authority: govAddr,
+					Guardian:  s.keyring.GetAccAddr(1).String(),
+				}
+			},
+			expected: func() sdk.AccAddress { return s.keyring.GetAccAddr(1) },
+		},
+		{
+			name: "pass - clear guardian",
+			request: func() *types.MsgUpdateGuardian {
+				return &types.MsgUpdateGuardian{
+					Authority: govAddr,
+				}
+			},
+			expected: func() sdk.AccAddress { return nil },
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			k := s.network.App.GetEVMMintKeeper()
+
+			_, err := k.UpdateGuardian(ctx, tc.request())
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected(), k.GetGuardian(ctx))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUnpause() {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name           string
+		request        *types.MsgUnpause
+		expectErr      bool
+		expPaused      bool
+		expPausedDenom []string
+	}{
+		{
+			name: "fail - invalid signer",
+			request: &types.MsgUnpause{
+				Authority: s.keyring.GetAccAddr(0).String(),
+			},
+			expectErr: true,
+		},
+		{
+			name: "pass - unpause single denom",
+			request: &types.MsgUnpause{
+				Authority: govAddr,
+				Denom:     "uusdc",
+			},
+			expPaused:      true,
+			expPausedDenom: []string{"uatom"},
+		},
+		{
+			name: "pass - unpause everything",
+			request: &types.MsgUnpause{
+				Authority: govAddr,
+			},
+			expPaused:      false,
+			expPausedDenom: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			k := s.network.App.GetEVMMintKeeper()
+
+			k.SetPaused(ctx, true)
+			s.Require().NoError(k.SetDenomPaused(ctx, "uatom", true))
+			s.Require().NoError(k.SetDenomPaused(ctx, "uusdc", true))
+
+			_, err := k.Unpause(ctx, tc.request)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().True(k.IsPaused(ctx), "expected the pause to stay in place")
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expPaused, k.IsPaused(ctx))
+			s.Require().Equal(tc.expPausedDenom, k.GetPausedDenoms(ctx))
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package mint
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	testutiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/mint/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func (s *KeeperTestSuite) TestValidateRecipient() {
+	contract := testutiltx.GenerateAddress()
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		recipient func() sdk.AccAddress
+		denom     string
+		expErr    bool
+	}{
+		{
+			name:      "pass - externally owned account",
+			recipient: func() sdk.AccAddress { return s.keyring.GetAccAddr(1) },
+			denom:     "uusdc",
+		},
+		{
+			name:      "fail - zero address",
+			recipient: func() sdk.AccAddress { return common.Address{}.Bytes() },
+			denom:     "uusdc",
+			expErr:    true,
+		},
+		{
+			name:      "fail - mint module account",
+			recipient: func() sdk.AccAddress { return authtypes.NewModuleAddress(types.ModuleName) },
+			denom:     "uusdc",
+			expErr:    true,
+		},
+		{
+			name:      "fail - module account",
+			recipient: func() sdk.AccAddress { return authtypes.NewModuleAddress(govtypes.ModuleName) },
+			denom:     "uusdc",
+			expErr:    true,
+		},
+		{
+			name: "fail - static precompile",
+			recipient: func() sdk.AccAddress {
+				return common.HexToAddress(evmtypes.BankPrecompileAddress).Bytes()
+			},
+			denom:  "uusdc",
+			expErr: true,
+		},
+		{
+			name: "fail - blocked recipient",
+			malleate: func() {
+				params := types.NewParams([]string{s.keyring.GetAccAddr(1).String()}, nil, false, 0)
+				s.Require().NoError(s.network.App.GetEVMMintKeeper().SetParams(s.network.GetContext(), params))
+			},
+			recipient: func() sdk.AccAddress { return s.keyring.GetAccAddr(1) },
+			denom:     "uusdc",
+			expErr:    true,
+		},
+		{
+			name:      "fail - contract for a denom without contract recipients",
+			recipient: func() sdk.AccAddress { return contract.Bytes() },
+			denom:     "uusdc",
+			expErr:    true,
+		},
+		{
+			name: "pass - contract for a denom with contract recipients",
+			malleate: func() {
+				params := types.NewParams(nil, []string{"uusdc"}, false, 0)
+				s.Require().NoError(s.network.App.GetEVMMintKeeper().SetParams(s.network.GetContext(), params))
+			},
+			recipient: func() sdk.AccAddress { return contract.Bytes() },
+			denom:     "uusdc",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			s.network.App.GetEVMKeeper().SetCodeHash(ctx, contract.Bytes(), crypto.Keccak256([]byte{0x60}))
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+
+			err := s.network.App.GetEVMMintKeeper().ValidateRecipient(ctx, tc.recipient(), tc.denom)
+			if tc.expErr {
+				s.Require().ErrorIs(err, types.ErrInvalidRecipient)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package mint
+
+import (
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testkeyring "github.com/cosmos/evm/testutil/keyring"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	create  network.CreateEvmApp
+	options []network.ConfigOption
+	network *network.UnitTestNetwork
+	keyring testkeyring.Keyring
+}
+
+func NewKeeperTestSuite(create network.CreateEvmApp, options ...network.ConfigOption) *KeeperTestSuite {
+	return &KeeperTestSuite{
+		create:  create,
+		options: options,
+	}
+}
+
+// SetupTest setup test environment
+func (s *KeeperTestSuite) SetupTest() {
+	keyring := testkeyring.New(2)
+	options := []network.ConfigOption{
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	}
+	options = append(options, s.options...)
+
+	s.keyring = keyring
+	s.network = network.NewUnitTestNetwork(s.create, options...)
+}
@@ -0,0 +1,38 @@
+package mint
+
+import (
+	testutiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/mint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func (s *KeeperTestSuite) TestAddContinuousVesting() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetEVMMintKeeper()
+	addr := s.keyring.GetAccAddr(0)
+	acc := s.network.App.GetAccountKeeper().GetAccount(ctx, addr)
+	start := ctx.BlockTime().Unix()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 100))
+
+	s.Require().NoError(k.AddContinuousVesting(ctx, addr, coins, start, start+100))
+	s.Require().NoError(k.AddContinuousVesting(ctx, addr, coins, start, start+100))
+
+	// the vesting account keeps the number and sequence of the plain account
+	vestingAcc, ok := s.network.App.GetAccountKeeper().GetAccount(ctx, addr).(*vestingtypes.ContinuousVestingAccount)
+	s.Require().True(ok)
+	s.Require().Equal(acc.GetAccountNumber(), vestingAcc.GetAccountNumber())
+	s.Require().Equal(acc.GetSequence(), vestingAcc.GetSequence())
+	s.Require().Equal(coins.Add(coins...), vestingAcc.OriginalVesting)
+
+	err := k.AddContinuousVesting(ctx, addr, coins, start, start+200)
+	s.Require().ErrorIs(err, types.ErrInvalidVestingSchedule)
+
+	err = k.AddPeriodicVesting(ctx, addr, start, vestingtypes.Periods{{Length: 10, Amount: coins}})
+	s.Require().ErrorIs(err, types.ErrInvalidVestingSchedule)
+
+	err = k.AddContinuousVesting(ctx, testutiltx.GenerateAddress().Bytes(), coins, start, start+100)
+	s.Require().ErrorIs(err, types.ErrInvalidVestingSchedule, "expected accounts that do not exist to be rejected")
+}
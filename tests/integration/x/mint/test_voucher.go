@@ -0,0 +1,30 @@
+package mint
+
+import (
+	"github.com/cosmos/evm/x/mint/types"
+
+	"cosmossdk.io/math"
+)
+
+func (s *KeeperTestSuite) TestUseVoucherNonce() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetEVMMintKeeper()
+	minter, other := s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1)
+
+	s.Require().False(k.IsVoucherNonceUsed(ctx, minter, math.OneInt()))
+	s.Require().NoError(k.UseVoucherNonce(ctx, minter, math.OneInt()))
+	s.Require().True(k.IsVoucherNonceUsed(ctx, minter, math.OneInt()))
+
+	// nonces are tracked per minter
+	s.Require().False(k.IsVoucherNonceUsed(ctx, other, math.OneInt()))
+	s.Require().False(k.IsVoucherNonceUsed(ctx, minter, math.NewInt(2)))
+
+	err := k.UseVoucherNonce(ctx, minter, math.OneInt())
+	s.Require().ErrorIs(err, types.ErrVoucherNonceUsed)
+
+	err = k.UseVoucherNonce(ctx, minter, math.NewInt(-1))
+	s.Require().ErrorIs(err, types.ErrInvalidVoucherNonce)
+
+	s.Require().Equal([]types.UsedVoucherNonce{types.NewUsedVoucherNonce(minter, math.OneInt())}, k.GetUsedVoucherNonces(ctx))
+}
@@ -15,6 +15,7 @@ import (
 	cosmosevmutils "github.com/cosmos/evm/utils"
 	erc20types "github.com/cosmos/evm/x/erc20/types"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	evmminttypes "github.com/cosmos/evm/x/mint/types"
 	"github.com/cosmos/evm/x/precisebank/types"
 	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -980,6 +981,7 @@ var maccPerms = map[string][]string{
 	feemarkettypes.ModuleName:   nil,
 	erc20types.ModuleName:       {authtypes.Minter, authtypes.Burner},
 	precisebanktypes.ModuleName: {authtypes.Minter, authtypes.Burner},
+	evmminttypes.ModuleName:     {authtypes.Minter, authtypes.Burner},
 }
 
 // getMaccPerms returns a copy of the module account permissions
@@ -28,6 +28,8 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -498,6 +500,153 @@ func (s *KeeperTestSuite) TestRefundGas() {
 	}
 }
 
+func (s *KeeperTestSuite) TestRefundGrantedGas() {
+	keyring := testKeyring.New(3)
+	unitNetwork := network.NewUnitTestNetwork(
+		s.Create,
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := factory.New(unitNetwork, grpcHandler)
+
+	sender := keyring.GetKey(0)
+	recipient := keyring.GetAddr(1)
+	granter := keyring.GetKey(2)
+	denom := unitNetwork.GetBaseDenom()
+
+	coreMsg, err := txFactory.GenerateGethCoreMsg(
+		sender.Priv,
+		types.EvmTxArgs{
+			To:       &recipient,
+			Amount:   big.NewInt(100),
+			GasPrice: big.NewInt(1e9),
+		},
+	)
+	s.Require().NoError(err)
+
+	refund := coreMsg.GasLimit / 2
+	refundAmt := new(big.Int).Mul(new(big.Int).SetUint64(refund), coreMsg.GasPrice)
+	feeAmt := new(big.Int).Mul(new(big.Int).SetUint64(coreMsg.GasLimit), coreMsg.GasPrice)
+	fees := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(feeAmt)))
+	usedFees := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(new(big.Int).Sub(feeAmt, refundAmt))))
+
+	testCases := []struct {
+		name       string
+		spendLimit sdk.Coins
+		malleate   func(ctx sdk.Context)
+		// expRemaining is nil if the allowance is expected to be removed
+		expRemaining sdk.Coins
+	}{
+		{
+			name:         "allowance partially spent in the ante handler",
+			spendLimit:   fees.Add(fees...),
+			expRemaining: fees.Add(fees...).Sub(usedFees...),
+		},
+		{
+			name:       "allowance removed after being spent in the ante handler",
+			spendLimit: fees,
+		},
+		{
+			name:       "allowance revoked during the execution",
+			spendLimit: fees.Add(fees...),
+			malleate: func(ctx sdk.Context) {
+				msgServer := feegrantkeeper.NewMsgServerImpl(unitNetwork.App.GetFeeGrantKeeper())
+				_, err := msgServer.RevokeAllowance(ctx, &feegrant.MsgRevokeAllowance{
+					Granter: granter.AccAddr.String(),
+					Grantee: sender.AccAddr.String(),
+				})
+				s.Require().NoError(err)
+			},
+		},
+		{
+			name:       "allowance changed during the execution",
+			spendLimit: fees.Add(fees...),
+			malleate: func(ctx sdk.Context) {
+				allowance := &feegrant.BasicAllowance{SpendLimit: usedFees}
+				s.Require().NoError(unitNetwork.App.GetFeeGrantKeeper().UpdateAllowance(ctx, granter.AccAddr, sender.AccAddr, allowance))
+			},
+			expRemaining: usedFees,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx, _ := unitNetwork.GetContext().CacheContext()
+			evmKeeper := unitNetwork.App.GetEVMKeeper()
+			feegrantKeeper := unitNetwork.App.GetFeeGrantKeeper()
+			allowance := &feegrant.BasicAllowance{SpendLimit: tc.spendLimit}
+			s.Require().NoError(feegrantKeeper.GrantAllowance(ctx, granter.AccAddr, sender.AccAddr, allowance))
+
+			// charge the allowance and the granter for the gas limit, as the ante handler does
+			msgs := []sdk.Msg{&types.MsgEthereumTx{}}
+			s.Require().NoError(feegrantKeeper.UseGrantedFees(ctx, granter.AccAddr, sender.AccAddr, fees, msgs))
+			err = unitNetwork.App.GetBankKeeper().SendCoinsFromAccountToModule(ctx, granter.AccAddr, authtypes.FeeCollectorName, fees)
+			s.Require().NoError(err)
+
+			grant := feegrant.Grant{Granter: granter.AccAddr.String(), Grantee: sender.AccAddr.String()}
+			if charged, err := feegrantKeeper.GetAllowance(ctx, granter.AccAddr, sender.AccAddr); err == nil {
+				grant, err = feegrant.NewGrant(granter.AccAddr, sender.AccAddr, charged)
+				s.Require().NoError(err)
+			}
+
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			senderBalance := evmKeeper.GetAccount(ctx, sender.Addr).Balance.ToBig()
+			granterBalance := evmKeeper.GetAccount(ctx, granter.Addr).Balance.ToBig()
+
+			err = evmKeeper.RefundGrantedGas(ctx, *coreMsg, grant, refund, denom)
+			s.Require().NoError(err)
+
+			// the leftover gas is refunded to the granter
+			s.Require().Equal(senderBalance, evmKeeper.GetAccount(ctx, sender.Addr).Balance.ToBig())
+			s.Require().Equal(
+				new(big.Int).Add(granterBalance, refundAmt),
+				evmKeeper.GetAccount(ctx, granter.Addr).Balance.ToBig(),
+			)
+
+			// the allowance is only charged for the used gas, unless it changed
+			remaining, err := feegrantKeeper.GetAllowance(ctx, granter.AccAddr, sender.AccAddr)
+			if tc.expRemaining == nil {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expRemaining, remaining.(*feegrant.BasicAllowance).SpendLimit)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestFeeGrantTransient() {
+	s.SetupTest()
+	ctx := s.Network.GetContext()
+	evmKeeper := s.Network.App.GetEVMKeeper()
+	granter := s.Keyring.GetAccAddr(0)
+	grantee := s.Keyring.GetAccAddr(1)
+	txHash := common.BytesToHash([]byte("tx hash"))
+
+	_, found := evmKeeper.GetFeeGrantTransient(ctx, txHash)
+	s.Require().False(found)
+
+	grant, err := feegrant.NewGrant(granter, grantee, &feegrant.BasicAllowance{})
+	s.Require().NoError(err)
+	evmKeeper.SetFeeGrantTransient(ctx, txHash, grant)
+
+	// the fee grant is scoped to the transaction
+	_, found = evmKeeper.GetFeeGrantTransient(ctx, common.BytesToHash([]byte("other tx hash")))
+	s.Require().False(found)
+
+	stored, found := evmKeeper.GetFeeGrantTransient(ctx, txHash)
+	s.Require().True(found)
+	s.Require().Equal(grant.Granter, stored.Granter)
+	s.Require().Equal(grant.Grantee, stored.Grantee)
+
+	evmKeeper.DeleteFeeGrantTransient(ctx, txHash)
+	_, found = evmKeeper.GetFeeGrantTransient(ctx, txHash)
+	s.Require().False(found)
+}
+
 func (s *KeeperTestSuite) TestResetGasMeterAndConsumeGas() {
 	s.SetupTest()
 	testCases := []struct {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/holiman/uint256"
 	"github.com/pkg/errors"
@@ -189,6 +190,21 @@ func GetIBCDenomAddress(denom string) (common.Address, error) {
 	return common.BytesToAddress(bz), nil
 }
 
+// GetNativeDenomAddress returns the address of the ERC-20 representation of a
+// native (non-IBC) denomination, derived from the Keccak-256 hash of the
+// denomination. IBC vouchers are rejected, use GetIBCDenomAddress for those.
+func GetNativeDenomAddress(denom string) (common.Address, error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return common.Address{}, err
+	}
+
+	if strings.HasPrefix(denom, "ibc/") {
+		return common.Address{}, fmt.Errorf("coin %s is an IBC voucher", denom)
+	}
+
+	return common.BytesToAddress(crypto.Keccak256([]byte(denom))), nil
+}
+
 // SortSlice sorts a slice of any ordered type.
 func SortSlice[T cmp.Ordered](slice []T) {
 	sort.Slice(slice, func(i, j int) bool {
@@ -310,6 +310,47 @@ func TestGetIBCDenomAddress(t *testing.T) {
 	}
 }
 
+func TestGetNativeDenomAddress(t *testing.T) {
+	testCases := []struct {
+		name        string
+		denom       string
+		expErr      bool
+		expectedRes string
+	}{
+		{
+			"fail - invalid denom",
+			"",
+			true,
+			"invalid denom",
+		},
+		{
+			"fail - ibc voucher",
+			"ibc/DF63978F803A2E27CA5CC9B7631654CCF0BBC788B3B7F0A10200508E37C70992",
+			true,
+			"is an IBC voucher",
+		},
+		{
+			"pass - native denom",
+			"testcoin",
+			false,
+			"0x0B4c115774A1258B063dd578147781C52d8E275a",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			address, err := utils.GetNativeDenomAddress(tc.denom)
+			if tc.expErr {
+				require.Error(t, err, "expected error while get native denom address")
+				require.Contains(t, err.Error(), tc.expectedRes, "expected different error")
+			} else {
+				require.NoError(t, err, "expected no error while get native denom address")
+				require.Equal(t, tc.expectedRes, address.Hex())
+			}
+		})
+	}
+}
+
 // TestBytes32ToString tests the Bytes32ToString helper function
 func TestBytes32ToString(t *testing.T) {
 	testCases := []struct {
@@ -58,6 +58,15 @@ func InitGenesis(
 			panic(fmt.Errorf("error setting allowance %s", err))
 		}
 	}
+
+	for _, nonce := range data.Nonces {
+		erc20 := common.HexToAddress(nonce.Erc20Address)
+		owner := common.HexToAddress(nonce.Owner)
+		err := k.UnsafeSetNonce(ctx, erc20, owner, nonce.Value.BigInt())
+		if err != nil {
+			panic(fmt.Errorf("error setting nonce %s", err))
+		}
+	}
 }
 
 // ExportGenesis export module status
@@ -68,5 +77,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		Allowances:         k.GetAllowances(ctx),
 		NativePrecompiles:  k.GetNativePrecompiles(ctx),
 		DynamicPrecompiles: k.GetDynamicPrecompiles(ctx),
+		Nonces:             k.GetNonces(ctx),
 	}
 }
@@ -129,6 +129,114 @@ func (k Keeper) setAllowance(
 	return nil
 }
 
+// GetNonce returns the EIP-2612 permit nonce of the given owner
+// on the given erc20 precompile address.
+func (k Keeper) GetNonce(
+	ctx sdk.Context,
+	erc20 common.Address,
+	owner common.Address,
+) *big.Int {
+	nonceKey := types.NonceKey(erc20, owner)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNonce)
+
+	var nonce types.Nonce
+	bz := store.Get(nonceKey)
+	if bz == nil {
+		return common.Big0
+	}
+
+	k.cdc.MustUnmarshal(bz, &nonce)
+
+	return nonce.Value.BigInt()
+}
+
+// UseNonce returns the current EIP-2612 permit nonce of the given owner
+// on the given erc20 precompile address and increments it.
+func (k Keeper) UseNonce(
+	ctx sdk.Context,
+	erc20 common.Address,
+	owner common.Address,
+) (*big.Int, error) {
+	nonce := k.GetNonce(ctx, erc20, owner)
+	if err := k.setNonce(ctx, erc20, owner, new(big.Int).Add(nonce, common.Big1), false); err != nil {
+		return nil, err
+	}
+
+	return nonce, nil
+}
+
+// UnsafeSetNonce sets the permit nonce of the given owner with validation.
+// It allows setting nonces for disabled token pairs.
+// This should only be used in InitGenesis.
+func (k Keeper) UnsafeSetNonce(
+	ctx sdk.Context,
+	erc20 common.Address,
+	owner common.Address,
+	value *big.Int,
+) error {
+	return k.setNonce(ctx, erc20, owner, value, true)
+}
+
+func (k Keeper) setNonce(
+	ctx sdk.Context,
+	erc20 common.Address,
+	owner common.Address,
+	value *big.Int,
+	allowDisabledTokenPair bool,
+) error {
+	// validate existence of token pair
+	tokenPairID := k.GetERC20Map(ctx, erc20)
+	tokenPair, found := k.GetTokenPair(ctx, tokenPairID)
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token pair for address '%s' not registered", erc20,
+		)
+	}
+	if !allowDisabledTokenPair && !tokenPair.Enabled {
+		return errorsmod.Wrapf(
+			types.ErrERC20TokenPairDisabled, "token pair for address '%s' is disabled", erc20,
+		)
+	}
+
+	// validate address
+	if (owner == common.Address{}) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "owner address is empty")
+	}
+
+	// validate value
+	if value == nil || value.Sign() <= 0 {
+		return errorsmod.Wrapf(types.ErrInvalidNonce, "value '%s' is not positive", value)
+	}
+	if value.BitLen() > 256 {
+		return errorsmod.Wrapf(types.ErrInvalidNonce, "value '%s' is greater than max value of uint256", value)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNonce)
+	nonce := types.NewNonce(erc20, owner, value)
+	store.Set(types.NonceKey(erc20, owner), k.cdc.MustMarshal(&nonce))
+
+	return nil
+}
+
+// GetNonces returns all permit nonces stored on the erc20 precompile addresses.
+func (k Keeper) GetNonces(
+	ctx sdk.Context,
+) []types.Nonce {
+	nonces := []types.Nonce{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixNonce)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var nonce types.Nonce
+		k.cdc.MustUnmarshal(iterator.Value(), &nonce)
+		nonces = append(nonces, nonce)
+	}
+
+	return nonces
+}
+
 // GetAllowances returns all allowances stored on the given erc20 precompile address.
 func (k Keeper) GetAllowances(
 	ctx sdk.Context,
@@ -25,6 +25,7 @@ type Keeper struct {
 	evmKeeper      types.EVMKeeper
 	stakingKeeper  types.StakingKeeper
 	transferKeeper *transferkeeper.Keeper
+	mintKeeper     types.MintKeeper
 }
 
 // NewKeeper creates new instances of the erc20 Keeper
@@ -37,6 +38,7 @@ func NewKeeper(
 	evmKeeper types.EVMKeeper,
 	sk types.StakingKeeper,
 	transferKeeper *transferkeeper.Keeper,
+	mintKeeper types.MintKeeper,
 ) Keeper {
 	// ensure gov module account is set and is not nil
 	if err := sdk.VerifyAddressFormat(authority); err != nil {
@@ -52,6 +54,7 @@ func NewKeeper(
 		evmKeeper:      evmKeeper,
 		stakingKeeper:  sk,
 		transferKeeper: transferKeeper,
+		mintKeeper:     mintKeeper,
 	}
 }
 
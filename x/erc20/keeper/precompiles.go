@@ -62,7 +62,7 @@ func (k Keeper) InstantiateERC20Precompile(ctx sdk.Context, contractAddr common.
 	}
 
 	if hasWrappedMethods {
-		return werc20.NewPrecompile(pair, k.bankKeeper, k, *k.transferKeeper)
+		return werc20.NewPrecompile(pair, k.bankKeeper, k, *k.transferKeeper, k.mintKeeper)
 	}
 
 	return erc20.NewPrecompile(pair, k.bankKeeper, k, *k.transferKeeper)
@@ -107,6 +107,9 @@ func (k Keeper) SetTokenPair(ctx sdk.Context, tokenPair types.TokenPair) {
 }
 
 // DeleteTokenPair removes a token pair.
+// The EIP-2612 permit nonces are kept: the pair may be registered again with
+// the same address and domain separator, and permit nonces must never go back
+// so that signed permits cannot be replayed.
 func (k Keeper) DeleteTokenPair(ctx sdk.Context, tokenPair types.TokenPair) {
 	id := tokenPair.GetID()
 	k.deleteTokenPair(ctx, id)
@@ -39,3 +39,27 @@ func (a Allowance) Validate() error {
 
 	return nil
 }
+
+func NewNonce(erc20 common.Address, owner common.Address, value *big.Int) Nonce {
+	return Nonce{
+		Erc20Address: erc20.Hex(),
+		Owner:        owner.Hex(),
+		Value:        math.NewIntFromBigInt(value),
+	}
+}
+
+func (n Nonce) Validate() error {
+	if !common.IsHexAddress(n.Erc20Address) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid erc20 hex address %s", n.Erc20Address)
+	}
+
+	if !common.IsHexAddress(n.Owner) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid owner hex address %s", n.Owner)
+	}
+
+	if !n.Value.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidNonce, "invalid nonce value")
+	}
+
+	return nil
+}
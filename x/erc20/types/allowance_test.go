@@ -2,12 +2,16 @@ package types_test
 
 import (
 	"math/big"
+	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	utiltx "github.com/cosmos/evm/testutil/tx"
 	"github.com/cosmos/evm/x/erc20/types"
+
+	"cosmossdk.io/math"
 )
 
 type AllowanceTestSuite struct {
@@ -84,3 +88,47 @@ func (s *AllowanceTestSuite) TestNewAllowance() {
 		}
 	}
 }
+
+func TestNonceValidate(t *testing.T) {
+	testCases := []struct {
+		msg        string
+		nonce      types.Nonce
+		expectPass bool
+	}{
+		{
+			msg:        "invalid erc20 address",
+			nonce:      types.Nonce{Erc20Address: "bad", Owner: utiltx.GenerateAddress().Hex(), Value: math.NewInt(1)},
+			expectPass: false,
+		},
+		{
+			msg:        "invalid owner address",
+			nonce:      types.Nonce{Erc20Address: utiltx.GenerateAddress().Hex(), Owner: "bad", Value: math.NewInt(1)},
+			expectPass: false,
+		},
+		{
+			msg:        "zero value",
+			nonce:      types.NewNonce(utiltx.GenerateAddress(), utiltx.GenerateAddress(), big.NewInt(0)),
+			expectPass: false,
+		},
+		{
+			msg:        "negative value",
+			nonce:      types.NewNonce(utiltx.GenerateAddress(), utiltx.GenerateAddress(), big.NewInt(-1)),
+			expectPass: false,
+		},
+		{
+			msg:        "pass",
+			nonce:      types.NewNonce(utiltx.GenerateAddress(), utiltx.GenerateAddress(), big.NewInt(1)),
+			expectPass: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.nonce.Validate()
+
+		if tc.expectPass {
+			require.NoError(t, err, "valid test %s failed: %s", tc.msg, err)
+		} else {
+			require.Error(t, err, "invalid test %s passed: %s", tc.msg, err)
+		}
+	}
+}
@@ -20,6 +20,7 @@ func DefaultGenesisState() *GenesisState {
 		Params:     DefaultParams(),
 		TokenPairs: []TokenPair{},
 		Allowances: []Allowance{},
+		Nonces:     []Nonce{},
 	}
 }
 
@@ -73,6 +74,23 @@ func (gs GenesisState) Validate() error {
 		seenAllowance[a.Erc20Address+a.Owner+a.Spender] = true
 	}
 
+	seenNonce := make(map[string]bool)
+	for _, n := range gs.Nonces {
+		if seenNonce[n.Erc20Address+n.Owner] {
+			return fmt.Errorf("duplicated nonce on genesis: %s", n.Erc20Address+n.Owner)
+		}
+
+		if !seenErc20[n.Erc20Address] {
+			return fmt.Errorf("nonce has no corresponding token pair on genesis: %s", n.Erc20Address)
+		}
+
+		if err := n.Validate(); err != nil {
+			return fmt.Errorf("invalid nonce on genesis: %w", err)
+		}
+
+		seenNonce[n.Erc20Address+n.Owner] = true
+	}
+
 	return nil
 }
 
@@ -311,6 +311,70 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid genesis - with nonces",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: testconstants.ExampleTokenPairs,
+				Nonces: []types.Nonce{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Owner:        testconstants.ExampleEvmAddressAlice,
+						Value:        math.NewInt(1),
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated nonce",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: testconstants.ExampleTokenPairs,
+				Nonces: []types.Nonce{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Owner:        testconstants.ExampleEvmAddressAlice,
+						Value:        math.NewInt(1),
+					},
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Owner:        testconstants.ExampleEvmAddressAlice,
+						Value:        math.NewInt(2),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - nonce without token pair",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Nonces: []types.Nonce{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Owner:        testconstants.ExampleEvmAddressAlice,
+						Value:        math.NewInt(1),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid nonce value",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: testconstants.ExampleTokenPairs,
+				Nonces: []types.Nonce{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Owner:        testconstants.ExampleEvmAddressAlice,
+						Value:        math.NewInt(0),
+					},
+				},
+			},
+			expPass: false,
+		},
 		{
 			// Voting period cant be zero
 			name:     "empty genesis",
@@ -17,6 +17,7 @@ import (
 
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -75,3 +76,12 @@ type BankKeeper interface {
 	SetDenomMetaData(ctx context.Context, denomMetaData banktypes.Metadata)
 	SpendableCoin(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 }
+
+// MintKeeper defines the expected x/mint keeper interface, used by the WERC20
+// precompile to mint and burn the wrapped coin.
+type MintKeeper interface {
+	IsMintAuthority(ctx sdk.Context, addr sdk.AccAddress) bool
+	TrackLargeMint(ctx sdk.Context, denom string, amount math.Int) error
+	Mint(ctx sdk.Context, minter, recipient sdk.AccAddress, coin sdk.Coin) error
+	Burn(ctx sdk.Context, from sdk.AccAddress, coin sdk.Coin) error
+}
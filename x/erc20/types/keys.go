@@ -34,6 +34,7 @@ const (
 	prefixAllowance
 	prefixNativePrecompiles
 	prefixDynamicPrecompiles
+	prefixNonce
 )
 
 // KVStore key prefixes
@@ -45,6 +46,7 @@ var (
 	KeyPrefixAllowance          = []byte{prefixAllowance}
 	KeyPrefixNativePrecompiles  = []byte{prefixNativePrecompiles}
 	KeyPrefixDynamicPrecompiles = []byte{prefixDynamicPrecompiles}
+	KeyPrefixNonce              = []byte{prefixNonce}
 )
 
 func AllowanceKey(
@@ -54,3 +56,10 @@ func AllowanceKey(
 ) []byte {
 	return append(append(erc20.Bytes(), owner.Bytes()...), spender.Bytes()...)
 }
+
+func NonceKey(
+	erc20 common.Address,
+	owner common.Address,
+) []byte {
+	return append(erc20.Bytes(), owner.Bytes()...)
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"strings"
+
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/cometbft/cometbft/crypto/tmhash"
@@ -16,8 +18,15 @@ import (
 //
 // It derives the ERC-20 address from the hex suffix of the IBC denomination
 // (e.g. ibc/DF63978F803A2E27CA5CC9B7631654CCF0BBC788B3B7F0A10200508E37C70992).
+// Native denominations, such as the ones minted through the mint precompile,
+// get an address derived from the hash of the denomination.
 func NewTokenPairSTRv2(denom string) (TokenPair, error) {
-	address, err := utils.GetIBCDenomAddress(denom)
+	getAddress := utils.GetNativeDenomAddress
+	if strings.HasPrefix(denom, "ibc/") {
+		getAddress = utils.GetIBCDenomAddress
+	}
+
+	address, err := getAddress(denom)
 	if err != nil {
 		return TokenPair{}, err
 	}
@@ -168,10 +168,21 @@ func (suite *TokenPairTestSuite) TestNewTokenPairSTRv2() {
 		expectedPair  types.TokenPair
 	}{
 		{
-			name:          "fail to register token pair - invalid denom (not ibc)",
-			denom:         "testcoin",
+			name:       "register token pair - native denom",
+			denom:      "testcoin",
+			expectPass: true,
+			expectedPair: types.TokenPair{
+				Denom:         "testcoin",
+				Erc20Address:  "0x0B4c115774A1258B063dd578147781C52d8E275a",
+				Enabled:       true,
+				ContractOwner: types.OWNER_MODULE,
+			},
+		},
+		{
+			name:          "fail to register token pair - invalid ibc denom",
+			denom:         "ibc/",
 			expectPass:    false,
-			expectedError: "does not have 'ibc/' prefix",
+			expectedError: "is not a valid IBC voucher hash",
 		},
 		{
 			name:       "register token pair - ibc denom",
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"math/big"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -13,6 +14,7 @@ import (
 	erc20types "github.com/cosmos/evm/x/erc20/types"
 	"github.com/cosmos/evm/x/ibc/callbacks/types"
 	evmante "github.com/cosmos/evm/x/vm/ante"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	callbacktypes "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
@@ -247,7 +249,7 @@ func (k ContractKeeper) IBCReceivePacketCallback(
 // allowing contracts to react to successful or failed packet delivery.
 //
 // The function performs the following operations:
-// 1. Unmarshals and validates the IBC packet data
+// 1. Unmarshals and validates the IBC packet data (ICS-20 transfer or interchain accounts)
 // 2. Extracts callback data from the packet (source-side callback)
 // 3. Validates that no calldata is present (acknowledgement callbacks should not contain calldata)
 // 4. Verifies the target contract exists and contains code
@@ -277,7 +279,7 @@ func (k ContractKeeper) IBCOnAcknowledgementPacketCallback(
 	packetSenderAddress string,
 	version string,
 ) error {
-	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), version, "")
+	data, err := unmarshalSourcePacketData(packet, version)
 	if err != nil {
 		return err
 	}
@@ -347,7 +349,7 @@ func (k ContractKeeper) IBCOnAcknowledgementPacketCallback(
 // allowing contracts to handle timeout scenarios and perform cleanup or rollback operations.
 //
 // The function performs the following operations:
-// 1. Unmarshals and validates the IBC packet data
+// 1. Unmarshals and validates the IBC packet data (ICS-20 transfer or interchain accounts)
 // 2. Extracts callback data from the packet (source-side callback)
 // 3. Validates that no calldata is present (timeout callbacks should not contain calldata)
 // 4. Sets up a cached context with proper gas metering for EVM execution
@@ -378,7 +380,7 @@ func (k ContractKeeper) IBCOnTimeoutPacketCallback(
 	packetSenderAddress string,
 	version string,
 ) error {
-	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), version, "")
+	data, err := unmarshalSourcePacketData(packet, version)
 	if err != nil {
 		return err
 	}
@@ -439,3 +441,20 @@ func (k ContractKeeper) IBCOnTimeoutPacketCallback(
 	writeFn()
 	return nil
 }
+
+// unmarshalSourcePacketData decodes the data of a packet sent from this chain.
+// Packets sent from an interchain accounts controller port carry interchain
+// account packet data, while all other packets are expected to be ICS-20
+// transfers.
+func unmarshalSourcePacketData(packet channeltypes.Packet, version string) (any, error) {
+	if !strings.HasPrefix(packet.GetSourcePort(), icatypes.ControllerPortPrefix) {
+		return transfertypes.UnmarshalPacketData(packet.GetData(), version, "")
+	}
+
+	var data icatypes.InterchainAccountPacketData
+	if err := data.UnmarshalJSON(packet.GetData()); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -14,11 +14,13 @@ import (
 
 // Keeper defines the modified IBC transfer keeper that embeds the original one.
 // It also contains the bank keeper and the erc20 keeper to support ERC20 tokens
-// to be sent via IBC.
+// to be sent via IBC, and the mint keeper to send the canonical denoms minted
+// for IBC vouchers.
 type Keeper struct {
 	*keeper.Keeper
 	bankKeeper    types.BankKeeper
 	erc20Keeper   types.ERC20Keeper
+	mintKeeper    types.MintKeeper
 	accountKeeper types.AccountKeeper
 }
 
@@ -34,6 +36,7 @@ func NewKeeper(
 	authKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	erc20Keeper types.ERC20Keeper,
+	mintKeeper types.MintKeeper,
 	authority string,
 ) Keeper {
 	// create the original IBC transfer keeper for embedding
@@ -47,6 +50,7 @@ func NewKeeper(
 		Keeper:        &transferKeeper,
 		bankKeeper:    bankKeeper,
 		erc20Keeper:   erc20Keeper,
+		mintKeeper:    mintKeeper,
 		accountKeeper: authKeeper,
 	}
 }
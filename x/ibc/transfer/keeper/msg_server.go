@@ -24,6 +24,8 @@ var _ types.MsgServer = Keeper{}
 // registered through governance.
 // If user doesn't have enough balance of coin, it will attempt to convert
 // ERC20 tokens to the coin denomination, and continue with a regular transfer.
+// The canonical denom minted by x/mint for the vouchers of the source channel
+// is burned and sent as those vouchers.
 func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.MsgTransferResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -42,6 +44,17 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 			WithTransientKVGasConfig(transientKVGasCfg)
 	}()
 
+	// send the canonical denom of an IBC mint route as the vouchers it was minted for
+	if route, found := k.mintKeeper.GetIBCMintRouteByCanonicalDenom(ctx, msg.SourceChannel, msg.Token.Denom); found {
+		sender := sdk.MustAccAddressFromBech32(msg.Sender)
+		if err := k.mintKeeper.ConvertCanonicalToVoucher(ctx, sender, route, msg.Token.Amount); err != nil {
+			return nil, err
+		}
+
+		msg.Token.Denom = route.VoucherDenom()
+		return k.Keeper.Transfer(ctx, msg)
+	}
+
 	// use native denom or contract address
 	denom := strings.TrimPrefix(msg.Token.Denom, erc20types.Erc20NativeCoinDenomPrefix)
 
@@ -4,8 +4,11 @@ import (
 	"context"
 
 	erc20types "github.com/cosmos/evm/x/erc20/types"
+	minttypes "github.com/cosmos/evm/x/mint/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -29,3 +32,10 @@ type ERC20Keeper interface {
 	GetTokenPair(ctx sdk.Context, id []byte) (erc20types.TokenPair, bool)
 	ConvertERC20(ctx context.Context, msg *erc20types.MsgConvertERC20) (*erc20types.MsgConvertERC20Response, error)
 }
+
+// MintKeeper defines the expected mint keeper interface for sending the
+// canonical denoms minted for IBC vouchers back through their channel.
+type MintKeeper interface {
+	GetIBCMintRouteByCanonicalDenom(ctx sdk.Context, channelID, canonicalDenom string) (minttypes.IBCMintRoute, bool)
+	ConvertCanonicalToVoucher(ctx sdk.Context, account sdk.AccAddress, route minttypes.IBCMintRoute, amount math.Int) error
+}
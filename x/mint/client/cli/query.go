@@ -0,0 +1,513 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/evm/x/mint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+const (
+	flagDenom  = "denom"
+	flagMinter = "minter"
+)
+
+// GetQueryCmd returns the parent command for all x/mint CLI query commands.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the EVM mint module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetMintAuthorityCmd(),
+		GetDenomMintLimitCmd(),
+		GetDenomMintStatsCmd(),
+		GetMinterMintStatsCmd(),
+		GetGuardianCmd(),
+		GetPauseStatusCmd(),
+		GetIBCMintRoutesCmd(),
+		GetMintApprovalConfigCmd(),
+		GetMintProposalCmd(),
+		GetMintProposalsCmd(),
+		GetMintHistoryCmd(),
+		GetDenomAdminCmd(),
+		GetEmissionScheduleCmd(),
+		GetEmissionSchedulesCmd(),
+		GetParamsCmd(),
+	)
+	return cmd
+}
+
+// GetMintAuthorityCmd queries the account allowed to mint through the mint precompile
+func GetMintAuthorityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-authority",
+		Short: "Get the mint authority",
+		Long:  "Get the account allowed to mint native tokens through the mint precompile.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MintAuthority(cmd.Context(), &types.QueryMintAuthorityRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetDenomMintLimitCmd queries the supply cap and rate limit of a denom
+func GetDenomMintLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-mint-limit DENOM",
+		Short: "Get the mint limit of a denom",
+		Long:  "Get the supply cap and rate limit of a denom, together with the amount that can still be minted under each of them.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDenomMintLimitRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.DenomMintLimit(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetDenomMintStatsCmd queries the totals minted and burned of a denom
+func GetDenomMintStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-mint-stats DENOM",
+		Short: "Get the totals minted and burned of a denom",
+		Long:  "Get the total amount of a denom minted and burned through the mint precompile.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDenomMintStatsRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.DenomMintStats(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetMinterMintStatsCmd queries the total of a denom minted by a minter
+func GetMinterMintStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "minter-mint-stats MINTER DENOM",
+		Short: "Get the total of a denom minted by a minter",
+		Long:  "Get the total amount of a denom a minter minted through the mint precompile.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryMinterMintStatsRequest{
+				Minter: args[0],
+				Denom:  args[1],
+			}
+
+			res, err := queryClient.MinterMintStats(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetGuardianCmd queries the account allowed to pause minting and burning
+func GetGuardianCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "guardian",
+		Short: "Get the guardian",
+		Long:  "Get the account allowed to pause minting and burning through the mint precompile.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Guardian(cmd.Context(), &types.QueryGuardianRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetPauseStatusCmd queries if minting and burning of a denom is paused
+func GetPauseStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-status DENOM",
+		Short: "Get the pause status of a denom",
+		Long:  "Get if minting and burning of all denoms, and of the given denom, is paused.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PauseStatus(cmd.Context(), &types.QueryPauseStatusRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetIBCMintRoutesCmd queries the IBC mint routes
+func GetIBCMintRoutesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ibc-mint-routes",
+		Short: "Get the IBC mint routes",
+		Long:  "Get the (channel, base denom) pairs that are minted as a canonical native denom when received over IBC.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IBCMintRoutes(cmd.Context(), &types.QueryIBCMintRoutesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetMintApprovalConfigCmd queries the approval configuration of mint proposals
+func GetMintApprovalConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-approval-config",
+		Short: "Get the approval configuration of mint proposals",
+		Long:  "Get the approvers, threshold, delay, expiry and large mint thresholds of mint proposals.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MintApprovalConfig(cmd.Context(), &types.QueryMintApprovalConfigRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetMintProposalCmd queries a pending mint proposal
+func GetMintProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-proposal ID",
+		Short: "Get a pending mint proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid mint proposal id %s: %w", args[0], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MintProposal(cmd.Context(), &types.QueryMintProposalRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetMintProposalsCmd queries the pending mint proposals
+func GetMintProposalsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-proposals",
+		Short: "Get the pending mint proposals",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MintProposals(cmd.Context(), &types.QueryMintProposalsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetMintHistoryCmd queries the mints kept in the mint history
+func GetMintHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-history",
+		Short: "Get the mint history",
+		Long:  "Get the mints through the mint precompile kept in the mint history, oldest first, optionally filtered by denom and minter.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
+			minter, err := cmd.Flags().GetString(flagMinter)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryMintHistoryRequest{
+				Denom:      denom,
+				Minter:     minter,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.MintHistory(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagDenom, "", "only show the mints of this denom")
+	cmd.Flags().String(flagMinter, "", "only show the mints of this minter")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "mint-history")
+	return cmd
+}
+
+// GetDenomAdminCmd queries the admin of a denom created through the mint
+// precompile
+func GetDenomAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-admin DENOM",
+		Short: "Get the admin of a created denom",
+		Long:  "Get the admin of a denom created through the mint precompile, given as factory/{creator}/{subdenom}.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomAdmin(cmd.Context(), &types.QueryDenomAdminRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetEmissionScheduleCmd queries an emission schedule and the amount it still
+// has to emit
+func GetEmissionScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emission-schedule ID",
+		Short: "Get an emission schedule and the amount it still has to emit",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid emission schedule id %s: %w", args[0], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EmissionSchedule(cmd.Context(), &types.QueryEmissionScheduleRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetEmissionSchedulesCmd queries the emission schedules that have not completed
+func GetEmissionSchedulesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emission-schedules",
+		Short: "Get the emission schedules that have not completed",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EmissionSchedules(cmd.Context(), &types.QueryEmissionSchedulesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetParamsCmd queries the module parameters
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Gets mint params",
+		Long:  "Gets mint params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,444 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/evm/x/mint/types"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const flagAuthority = "authority"
+
+// NewTxCmd returns a root CLI command handler for mint transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "EVM mint subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewUpdateMintAuthorityCmd(),
+		NewSetDenomMintLimitCmd(),
+		NewUpdateGuardianCmd(),
+		NewUnpauseCmd(),
+		NewSetIBCMintRouteCmd(),
+		NewSetMintApprovalConfigCmd(),
+		NewCreateEmissionScheduleCmd(),
+		NewCancelEmissionScheduleCmd(),
+	)
+	return txCmd
+}
+
+// NewUpdateMintAuthorityCmd returns a CLI command handler for generating a
+// MsgUpdateMintAuthority. The message can only be executed by governance, so it
+// is meant to be used with --generate-only and embedded into a proposal.
+func NewUpdateMintAuthorityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-mint-authority [MINT_AUTHORITY]",
+		Short: "Generate a governance message to update the mint authority. When the mint authority [optional] is omitted, minting is disabled.",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return err
+			}
+
+			var mintAuthority string
+			if len(args) == 1 {
+				mintAuthority = args[0]
+			}
+
+			msg := &types.MsgUpdateMintAuthority{
+				Authority:     authority,
+				MintAuthority: mintAuthority,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagAuthority, authtypes.NewModuleAddress(govtypes.ModuleName).String(), "the governance module account address")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetDenomMintLimitCmd returns a CLI command handler for generating a
+// MsgSetDenomMintLimit. The message can only be executed by governance, so it
+// is meant to be used with --generate-only and embedded into a proposal.
+func NewSetDenomMintLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-mint-limit DENOM MAX_SUPPLY RATE_LIMIT RATE_LIMIT_WINDOW",
+		Short: "Generate a governance message to set the supply cap and the rate limit of a denom. A zero value disables the corresponding limit.",
+		Long:  "Generate a governance message to set the supply cap and the rate limit of a denom. The rate limit bounds the amount minted over the last RATE_LIMIT_WINDOW blocks. A zero value disables the corresponding limit.",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return err
+			}
+
+			maxSupply, ok := math.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid max supply %s", args[1])
+			}
+
+			rateLimit, ok := math.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid rate limit %s", args[2])
+			}
+
+			window, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid rate limit window %s: %w", args[3], err)
+			}
+
+			msg := &types.MsgSetDenomMintLimit{
+				Authority: authority,
+				Limit:     types.NewDenomMintLimit(args[0], maxSupply, rateLimit, window),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagAuthority, authtypes.NewModuleAddress(govtypes.ModuleName).String(), "the governance module account address")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewUpdateGuardianCmd returns a CLI command handler for generating a
+// MsgUpdateGuardian. The message can only be executed by governance, so it is
+// meant to be used with --generate-only and embedded into a proposal.
+func NewUpdateGuardianCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-guardian [GUARDIAN]",
+		Short: "Generate a governance message to update the guardian. When the guardian [optional] is omitted, nobody can pause minting.",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return err
+			}
+
+			var guardian string
+			if len(args) == 1 {
+				guardian = args[0]
+			}
+
+			msg := &types.MsgUpdateGuardian{
+				Authority: authority,
+				Guardian:  guardian,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagAuthority, authtypes.NewModuleAddress(govtypes.ModuleName).String(), "the governance module account address")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewUnpauseCmd returns a CLI command handler for generating a MsgUnpause. The
+// message can only be executed by governance, so it is meant to be used with
+// --generate-only and embedded into a proposal.
+func NewUnpauseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpause [DENOM]",
+		Short: "Generate a governance message to lift the pause of a denom. When the denom [optional] is omitted, every pause is lifted.",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return err
+			}
+
+			var denom string
+			if len(args) == 1 {
+				denom = args[0]
+			}
+
+			msg := &types.MsgUnpause{
+				Authority: authority,
+				Denom:     denom,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagAuthority, authtypes.NewModuleAddress(govtypes.ModuleName).String(), "the governance module account address")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetIBCMintRouteCmd returns a CLI command handler for generating a
+// MsgSetIBCMintRoute. The message can only be executed by governance, so it is
+// meant to be used with --generate-only and embedded into a proposal.
+func NewSetIBCMintRouteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-ibc-mint-route CHANNEL_ID BASE_DENOM [CANONICAL_DENOM]",
+		Short: "Generate a governance message to mint a canonical denom for a base denom received over a channel. When the canonical denom [optional] is omitted, the route is removed.",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return err
+			}
+
+			var canonicalDenom string
+			if len(args) == 3 {
+				canonicalDenom = args[2]
+			}
+
+			msg := &types.MsgSetIBCMintRoute{
+				Authority: authority,
+				Route:     types.NewIBCMintRoute(args[0], args[1], canonicalDenom),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagAuthority, authtypes.NewModuleAddress(govtypes.ModuleName).String(), "the governance module account address")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetMintApprovalConfigCmd returns a CLI command handler for generating a
+// MsgSetMintApprovalConfig. The message can only be executed by governance, so
+// it is meant to be used with --generate-only and embedded into a proposal.
+func NewSetMintApprovalConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-mint-approval-config APPROVERS THRESHOLD MIN_DELAY EXPIRY [LARGE_MINT_THRESHOLDS]",
+		Short: "Generate a governance message to set the approvers (comma-separated), threshold, minimum delay and expiry of mint proposals, and the amounts above which mints require a proposal [optional]. Empty approvers and zero values disable mint proposals.",
+		Args:  cobra.RangeArgs(4, 5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return err
+			}
+
+			approvers := []string{}
+			if args[0] != "" {
+				approvers = strings.Split(args[0], ",")
+			}
+
+			threshold, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid threshold %s: %w", args[1], err)
+			}
+
+			minDelay, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid minimum delay %s: %w", args[2], err)
+			}
+
+			expiry, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid expiry %s: %w", args[3], err)
+			}
+
+			largeMintThresholds := sdk.Coins{}
+			if len(args) == 5 {
+				largeMintThresholds, err = sdk.ParseCoinsNormalized(args[4])
+				if err != nil {
+					return fmt.Errorf("invalid large mint thresholds %s: %w", args[4], err)
+				}
+			}
+
+			msg := &types.MsgSetMintApprovalConfig{
+				Authority: authority,
+				Config:    types.NewMintApprovalConfig(approvers, uint32(threshold), minDelay, expiry, largeMintThresholds),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagAuthority, authtypes.NewModuleAddress(govtypes.ModuleName).String(), "the governance module account address")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCreateEmissionScheduleCmd returns a CLI command handler for generating a
+// MsgCreateEmissionSchedule. The message can only be executed by governance, so
+// it is meant to be used with --generate-only and embedded into a proposal.
+func NewCreateEmissionScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-emission-schedule DENOM RECIPIENT CURVE AMOUNT START_HEIGHT END_HEIGHT [HALVING_INTERVAL]",
+		Short: "Generate a governance message to emit a denom to a recipient each block from START_HEIGHT to END_HEIGHT, following the linear or halving CURVE",
+		Long:  "Generate a governance message to emit a denom to a recipient each block from START_HEIGHT to END_HEIGHT. The linear CURVE spreads AMOUNT evenly over the blocks. The halving CURVE emits AMOUNT each block and halves it every HALVING_INTERVAL blocks.",
+		Args:  cobra.RangeArgs(6, 7),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid recipient address %s: %w", args[1], err)
+			}
+
+			curve, err := types.ParseEmissionCurve(args[2])
+			if err != nil {
+				return err
+			}
+
+			amount, ok := math.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[3])
+			}
+
+			startHeight, err := strconv.ParseUint(args[4], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid start height %s: %w", args[4], err)
+			}
+
+			endHeight, err := strconv.ParseUint(args[5], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid end height %s: %w", args[5], err)
+			}
+
+			var halvingInterval uint64
+			if len(args) == 7 {
+				halvingInterval, err = strconv.ParseUint(args[6], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid halving interval %s: %w", args[6], err)
+				}
+			}
+
+			msg := &types.MsgCreateEmissionSchedule{
+				Authority: authority,
+				Schedule:  types.NewEmissionSchedule(0, args[0], recipient, curve, amount, startHeight, endHeight, halvingInterval),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagAuthority, authtypes.NewModuleAddress(govtypes.ModuleName).String(), "the governance module account address")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelEmissionScheduleCmd returns a CLI command handler for generating a
+// MsgCancelEmissionSchedule. The message can only be executed by governance, so
+// it is meant to be used with --generate-only and embedded into a proposal.
+func NewCancelEmissionScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-emission-schedule ID",
+		Short: "Generate a governance message to stop an emission schedule. The tokens it already emitted are kept by the recipient.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid emission schedule id %s: %w", args[0], err)
+			}
+
+			msg := &types.MsgCancelEmissionSchedule{
+				Authority: authority,
+				Id:        id,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagAuthority, authtypes.NewModuleAddress(govtypes.ModuleName).String(), "the governance module account address")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,138 @@
+package mint
+
+import (
+	"fmt"
+
+	"github.com/cosmos/evm/x/mint/keeper"
+	"github.com/cosmos/evm/x/mint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data types.GenesisState,
+) {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(fmt.Errorf("error setting params %s", err))
+	}
+
+	var mintAuthority sdk.AccAddress
+	if data.MintAuthority != "" {
+		mintAuthority = sdk.MustAccAddressFromBech32(data.MintAuthority)
+	}
+	k.SetMintAuthority(ctx, mintAuthority)
+
+	for _, allowance := range data.MinterAllowances {
+		minter := sdk.MustAccAddressFromBech32(allowance.Minter)
+		if err := k.SetMinterAllowance(ctx, minter, allowance.Denom, allowance.Value); err != nil {
+			panic(fmt.Errorf("error setting minter allowance %s", err))
+		}
+	}
+
+	for _, limit := range data.DenomMintLimits {
+		if err := k.UpdateDenomMintLimit(ctx, limit); err != nil {
+			panic(fmt.Errorf("error setting denom mint limit %s", err))
+		}
+	}
+
+	for _, stats := range data.DenomMintStats {
+		if err := k.SetDenomMintStats(ctx, stats); err != nil {
+			panic(fmt.Errorf("error setting denom mint stats %s", err))
+		}
+	}
+
+	for _, stats := range data.MinterMintStats {
+		if err := k.SetMinterMintStats(ctx, stats); err != nil {
+			panic(fmt.Errorf("error setting minter mint stats %s", err))
+		}
+	}
+
+	var guardian sdk.AccAddress
+	if data.Guardian != "" {
+		guardian = sdk.MustAccAddressFromBech32(data.Guardian)
+	}
+	k.SetGuardian(ctx, guardian)
+
+	k.SetPaused(ctx, data.Paused)
+	for _, denom := range data.PausedDenoms {
+		if err := k.SetDenomPaused(ctx, denom, true); err != nil {
+			panic(fmt.Errorf("error pausing denom %s", err))
+		}
+	}
+
+	for _, route := range data.IBCMintRoutes {
+		if err := k.UpdateIBCMintRoute(ctx, route); err != nil {
+			panic(fmt.Errorf("error setting IBC mint route %s", err))
+		}
+	}
+
+	if err := k.UpdateMintApprovalConfig(ctx, data.MintApprovalConfig); err != nil {
+		panic(fmt.Errorf("error setting mint approval config %s", err))
+	}
+
+	for _, proposal := range data.MintProposals {
+		if err := k.SetMintProposal(ctx, proposal); err != nil {
+			panic(fmt.Errorf("error setting mint proposal %s", err))
+		}
+	}
+	k.SetNextMintProposalID(ctx, data.NextMintProposalID)
+
+	for _, nonce := range data.UsedVoucherNonces {
+		if err := k.SetUsedVoucherNonce(ctx, nonce); err != nil {
+			panic(fmt.Errorf("error setting used voucher nonce %s", err))
+		}
+	}
+
+	for _, record := range data.MintHistory {
+		if err := k.SetMintRecord(ctx, record); err != nil {
+			panic(fmt.Errorf("error setting mint record %s", err))
+		}
+	}
+	k.SetNextMintRecordID(ctx, data.NextMintRecordID)
+
+	for _, denomAdmin := range data.DenomAdmins {
+		if err := k.SetDenomAdmin(ctx, denomAdmin); err != nil {
+			panic(fmt.Errorf("error setting denom admin %s", err))
+		}
+	}
+
+	for _, schedule := range data.EmissionSchedules {
+		if err := k.SetEmissionSchedule(ctx, schedule); err != nil {
+			panic(fmt.Errorf("error setting emission schedule %s", err))
+		}
+	}
+	k.SetNextEmissionScheduleID(ctx, data.NextEmissionScheduleID)
+}
+
+// ExportGenesis export module status
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesisState()
+	if mintAuthority := k.GetMintAuthority(ctx); !mintAuthority.Empty() {
+		genesis.MintAuthority = mintAuthority.String()
+	}
+	genesis.MinterAllowances = k.GetMinterAllowances(ctx)
+	genesis.DenomMintLimits = k.GetDenomMintLimits(ctx)
+	genesis.Params = k.GetParams(ctx)
+	genesis.DenomMintStats = k.GetAllDenomMintStats(ctx)
+	genesis.MinterMintStats = k.GetAllMinterMintStats(ctx)
+	if guardian := k.GetGuardian(ctx); !guardian.Empty() {
+		genesis.Guardian = guardian.String()
+	}
+	genesis.Paused = k.IsPaused(ctx)
+	genesis.PausedDenoms = k.GetPausedDenoms(ctx)
+	genesis.IBCMintRoutes = k.GetIBCMintRoutes(ctx)
+	genesis.MintApprovalConfig = k.GetMintApprovalConfig(ctx)
+	genesis.MintProposals = k.GetMintProposals(ctx)
+	genesis.NextMintProposalID = k.GetNextMintProposalID(ctx)
+	genesis.UsedVoucherNonces = k.GetUsedVoucherNonces(ctx)
+	genesis.MintHistory = k.GetMintHistory(ctx)
+	genesis.NextMintRecordID = k.GetNextMintRecordID(ctx)
+	genesis.DenomAdmins = k.GetDenomAdmins(ctx)
+	genesis.EmissionSchedules = k.GetEmissionSchedules(ctx)
+	genesis.NextEmissionScheduleID = k.GetNextEmissionScheduleID(ctx)
+
+	return genesis
+}
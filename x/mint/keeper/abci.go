@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlock mints the amounts due by the emission schedules at the current
+// height.
+func (k Keeper) BeginBlock(ctx sdk.Context) error {
+	k.ProcessEmissionSchedules(ctx)
+	return nil
+}
+
+// EndBlock prunes the mint proposals that expired without being executed and
+// the records of the mint history older than its retention.
+func (k Keeper) EndBlock(ctx sdk.Context) error {
+	k.PruneExpiredMintProposals(ctx)
+	k.PruneMintHistory(ctx)
+	return nil
+}
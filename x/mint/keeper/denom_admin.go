@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/mint/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CreateDenom creates the denom factory/{creator}/{subdenom} and makes the
+// creator its admin. It fails if the denom was already created or already has
+// a supply.
+func (k Keeper) CreateDenom(ctx sdk.Context, creator sdk.AccAddress, subdenom string) (string, error) {
+	denom, err := types.BuildFactoryDenom(creator, subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	if _, found := k.GetDenomAdmin(ctx, denom); found {
+		return "", errorsmod.Wrapf(types.ErrDenomExists, "denom %s was already created", denom)
+	}
+
+	if supply := k.bankKeeper.GetSupply(ctx, denom); !supply.IsZero() {
+		return "", errorsmod.Wrapf(types.ErrDenomExists, "denom %s already has a supply of %s", denom, supply.Amount)
+	}
+
+	if err := k.SetDenomAdmin(ctx, types.NewDenomAdmin(denom, creator)); err != nil {
+		return "", err
+	}
+
+	return denom, nil
+}
+
+// GetDenomAdmin returns the admin of a denom created through the mint
+// precompile. The returned address is empty if the admin was renounced, and
+// the boolean is false if the denom was never created.
+func (k Keeper) GetDenomAdmin(ctx sdk.Context, denom string) (sdk.AccAddress, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenomAdmin)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return nil, false
+	}
+
+	var denomAdmin types.DenomAdmin
+	k.cdc.MustUnmarshal(bz, &denomAdmin)
+
+	return denomAdmin.GetAdminAddress(), true
+}
+
+// SetDenomAdmin stores the admin of a created denom.
+func (k Keeper) SetDenomAdmin(ctx sdk.Context, denomAdmin types.DenomAdmin) error {
+	if err := denomAdmin.Validate(); err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenomAdmin)
+	store.Set([]byte(denomAdmin.Denom), k.cdc.MustMarshal(&denomAdmin))
+
+	return nil
+}
+
+// ChangeDenomAdmin hands the admin of a created denom over to a new account.
+// Passing an empty address renounces the admin, which leaves the denom without
+// anyone able to mint it or manage its minters.
+func (k Keeper) ChangeDenomAdmin(ctx sdk.Context, denom string, newAdmin sdk.AccAddress) error {
+	if _, found := k.GetDenomAdmin(ctx, denom); !found {
+		return errorsmod.Wrapf(types.ErrDenomNotFound, "denom %s was not created", denom)
+	}
+
+	return k.SetDenomAdmin(ctx, types.NewDenomAdmin(denom, newAdmin))
+}
+
+// IsDenomAdmin returns true if the given address is the admin of the given
+// created denom.
+func (k Keeper) IsDenomAdmin(ctx sdk.Context, denom string, addr sdk.AccAddress) bool {
+	admin, found := k.GetDenomAdmin(ctx, denom)
+	return found && !admin.Empty() && admin.Equals(addr)
+}
+
+// GetDenomAdmins returns the created denoms and their admins.
+func (k Keeper) GetDenomAdmins(ctx sdk.Context) []types.DenomAdmin {
+	denomAdmins := []types.DenomAdmin{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixDenomAdmin)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var denomAdmin types.DenomAdmin
+		k.cdc.MustUnmarshal(iterator.Value(), &denomAdmin)
+		denomAdmins = append(denomAdmins, denomAdmin)
+	}
+
+	return denomAdmins
+}
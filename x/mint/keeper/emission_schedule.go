@@ -0,0 +1,227 @@
+package keeper
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/cosmos/evm/x/mint/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// GetNextEmissionScheduleID returns the identifier of the next emission
+// schedule.
+func (k Keeper) GetNextEmissionScheduleID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixNextEmissionScheduleID)
+	if bz == nil {
+		return types.DefaultNextEmissionScheduleID
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextEmissionScheduleID sets the identifier of the next emission schedule.
+func (k Keeper) SetNextEmissionScheduleID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefixNextEmissionScheduleID, sdk.Uint64ToBigEndian(id))
+}
+
+// AddEmissionSchedule stores a new emission schedule under the next
+// identifier, with nothing emitted yet and no failures. The schedule cannot end before the
+// current height, nor emit the canonical denom of an IBC mint route.
+func (k Keeper) AddEmissionSchedule(ctx sdk.Context, schedule types.EmissionSchedule) (types.EmissionSchedule, error) {
+	height := uint64(ctx.BlockHeight()) //nolint:gosec // G115 // block height won't exceed uint64
+	if schedule.EndHeight < height {
+		return types.EmissionSchedule{}, errorsmod.Wrapf(
+			types.ErrInvalidEmissionSchedule,
+			"end height %d is before the current height %d", schedule.EndHeight, height,
+		)
+	}
+
+	if err := k.CheckNotIBCMintRouteDenom(ctx, schedule.Denom); err != nil {
+		return types.EmissionSchedule{}, err
+	}
+
+	schedule.ID = k.GetNextEmissionScheduleID(ctx)
+	schedule.Emitted = math.ZeroInt()
+	schedule.Failures = 0
+	if err := k.SetEmissionSchedule(ctx, schedule); err != nil {
+		return types.EmissionSchedule{}, err
+	}
+
+	k.SetNextEmissionScheduleID(ctx, schedule.ID+1)
+
+	return schedule, nil
+}
+
+// GetEmissionSchedule returns the emission schedule with the given identifier.
+func (k Keeper) GetEmissionSchedule(ctx sdk.Context, id uint64) (types.EmissionSchedule, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEmissionSchedule)
+
+	bz := store.Get(types.EmissionScheduleKey(id))
+	if bz == nil {
+		return types.EmissionSchedule{}, false
+	}
+
+	var schedule types.EmissionSchedule
+	k.cdc.MustUnmarshal(bz, &schedule)
+
+	return schedule, true
+}
+
+// SetEmissionSchedule stores an emission schedule.
+func (k Keeper) SetEmissionSchedule(ctx sdk.Context, schedule types.EmissionSchedule) error {
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+
+	if schedule.ID < types.DefaultNextEmissionScheduleID {
+		return errorsmod.Wrapf(
+			types.ErrInvalidEmissionSchedule,
+			"id must be at least %d, got %d", types.DefaultNextEmissionScheduleID, schedule.ID,
+		)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEmissionSchedule)
+	store.Set(types.EmissionScheduleKey(schedule.ID), k.cdc.MustMarshal(&schedule))
+
+	return nil
+}
+
+// DeleteEmissionSchedule removes an emission schedule, once it is completed or
+// cancelled.
+func (k Keeper) DeleteEmissionSchedule(ctx sdk.Context, id uint64) error {
+	if _, found := k.GetEmissionSchedule(ctx, id); !found {
+		return errorsmod.Wrapf(types.ErrEmissionScheduleNotFound, "id %d", id)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEmissionSchedule)
+	store.Delete(types.EmissionScheduleKey(id))
+
+	return nil
+}
+
+// GetEmissionSchedules returns all the emission schedules that have not
+// completed, ordered by identifier.
+func (k Keeper) GetEmissionSchedules(ctx sdk.Context) []types.EmissionSchedule {
+	schedules := []types.EmissionSchedule{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixEmissionSchedule)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.EmissionSchedule
+		k.cdc.MustUnmarshal(iterator.Value(), &schedule)
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules
+}
+
+// ProcessEmissionSchedules mints the amount each emission schedule is due at
+// the current height, and removes the schedules that emitted their total. It
+// is called in BeginBlock.
+//
+// The amounts go through the same pause, recipient, supply cap and rate limit
+// checks as the mints of the mint precompile. A mint that fails is left for a
+// later block, which catches up on everything due by then. While the denom is
+// paused the schedule simply waits; any other failure is counted, and the
+// schedule is removed after MaxEmissionScheduleFailures consecutive failures.
+func (k Keeper) ProcessEmissionSchedules(ctx sdk.Context) {
+	height := uint64(ctx.BlockHeight()) //nolint:gosec // G115 // block height won't exceed uint64
+
+	for _, schedule := range k.GetEmissionSchedules(ctx) {
+		if height < schedule.StartHeight {
+			continue
+		}
+
+		due := schedule.EmittedAt(height).Sub(schedule.Emitted)
+		if due.IsPositive() {
+			// a failed mint must not halt the chain, so it only delays the
+			// emission of the schedule
+			cacheCtx, writeFn := ctx.CacheContext()
+			if err := k.emit(cacheCtx, schedule, due); err != nil {
+				if !errors.Is(err, types.ErrPaused) {
+					k.recordEmissionFailure(ctx, schedule, due, err)
+				}
+				continue
+			}
+			writeFn()
+
+			schedule.Emitted = schedule.Emitted.Add(due)
+			schedule.Failures = 0
+		}
+
+		if height < schedule.EndHeight || schedule.Remaining().IsPositive() {
+			if err := k.SetEmissionSchedule(ctx, schedule); err != nil {
+				k.Logger(ctx).Error("failed to update emission schedule", "id", schedule.ID, "error", err)
+			}
+			continue
+		}
+
+		if err := k.DeleteEmissionSchedule(ctx, schedule.ID); err != nil {
+			k.Logger(ctx).Error("failed to remove completed emission schedule", "id", schedule.ID, "error", err)
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompleteEmissionSchedule,
+				sdk.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(schedule.ID, 10)),
+			),
+		)
+	}
+}
+
+// recordEmissionFailure counts a failed mint of the schedule. Once the schedule
+// reaches MaxEmissionScheduleFailures consecutive failures, it is removed so
+// that it stops retrying every block, and governance can register it again.
+func (k Keeper) recordEmissionFailure(ctx sdk.Context, schedule types.EmissionSchedule, amount math.Int, err error) {
+	schedule.Failures++
+	if schedule.Failures < types.MaxEmissionScheduleFailures {
+		k.Logger(ctx).Error("failed to process emission schedule", "id", schedule.ID, "amount", amount, "failures", schedule.Failures, "error", err)
+		if err := k.SetEmissionSchedule(ctx, schedule); err != nil {
+			k.Logger(ctx).Error("failed to update emission schedule", "id", schedule.ID, "error", err)
+		}
+		return
+	}
+
+	k.Logger(ctx).Error("removing failing emission schedule", "id", schedule.ID, "failures", schedule.Failures, "error", err)
+	if err := k.DeleteEmissionSchedule(ctx, schedule.ID); err != nil {
+		k.Logger(ctx).Error("failed to remove failing emission schedule", "id", schedule.ID, "error", err)
+		return
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveEmissionSchedule,
+			sdk.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(schedule.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+		),
+	)
+}
+
+// emit mints the given amount of the schedule to its recipient. The mint is
+// recorded with the module account as minter. It runs in BeginBlock, outside
+// of any Ethereum transaction, so it only emits the typed EventMint SDK event
+// and no EVM log.
+func (k Keeper) emit(ctx sdk.Context, schedule types.EmissionSchedule, amount math.Int) error {
+	if err := k.CheckNotPaused(ctx, schedule.Denom); err != nil {
+		return err
+	}
+
+	recipient := sdk.MustAccAddressFromBech32(schedule.Recipient)
+	if err := k.ValidateRecipient(ctx, recipient, schedule.Denom); err != nil {
+		return err
+	}
+
+	return k.issue(ctx, authtypes.NewModuleAddress(types.ModuleName), recipient, sdk.NewCoin(schedule.Denom, amount))
+}
@@ -0,0 +1,285 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/evm/x/mint/types"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// MintAuthority implements the Query/MintAuthority gRPC method
+func (k Keeper) MintAuthority(c context.Context, _ *types.QueryMintAuthorityRequest) (*types.QueryMintAuthorityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryMintAuthorityResponse{}
+	if mintAuthority := k.GetMintAuthority(ctx); !mintAuthority.Empty() {
+		res.MintAuthority = mintAuthority.String()
+	}
+
+	return res, nil
+}
+
+// DenomMintLimit implements the Query/DenomMintLimit gRPC method
+func (k Keeper) DenomMintLimit(c context.Context, req *types.QueryDenomMintLimitRequest) (*types.QueryDenomMintLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	limit, found := k.GetDenomMintLimit(ctx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no mint limit for denom %s", req.Denom)
+	}
+
+	res := &types.QueryDenomMintLimitResponse{Limit: limit}
+	if remaining, capped := k.RemainingSupply(ctx, req.Denom); capped {
+		res.RemainingSupply = &remaining
+	}
+	if remaining, limited := k.RemainingRateLimit(ctx, req.Denom); limited {
+		res.RemainingRateLimit = &remaining
+	}
+
+	return res, nil
+}
+
+// DenomMintStats implements the Query/DenomMintStats gRPC method
+func (k Keeper) DenomMintStats(c context.Context, req *types.QueryDenomMintStatsRequest) (*types.QueryDenomMintStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDenomMintStatsResponse{
+		Stats: k.GetDenomMintStats(ctx, req.Denom),
+	}, nil
+}
+
+// MinterMintStats implements the Query/MinterMintStats gRPC method
+func (k Keeper) MinterMintStats(c context.Context, req *types.QueryMinterMintStatsRequest) (*types.QueryMinterMintStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	minter, err := sdk.AccAddressFromBech32(req.Minter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryMinterMintStatsResponse{
+		Stats: k.GetMinterMintStats(ctx, minter, req.Denom),
+	}, nil
+}
+
+// Guardian implements the Query/Guardian gRPC method
+func (k Keeper) Guardian(c context.Context, _ *types.QueryGuardianRequest) (*types.QueryGuardianResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryGuardianResponse{}
+	if guardian := k.GetGuardian(ctx); !guardian.Empty() {
+		res.Guardian = guardian.String()
+	}
+
+	return res, nil
+}
+
+// PauseStatus implements the Query/PauseStatus gRPC method
+func (k Keeper) PauseStatus(c context.Context, req *types.QueryPauseStatusRequest) (*types.QueryPauseStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryPauseStatusResponse{
+		Paused:      k.IsPaused(ctx),
+		DenomPaused: k.IsDenomPaused(ctx, req.Denom),
+	}, nil
+}
+
+// IBCMintRoutes implements the Query/IBCMintRoutes gRPC method
+func (k Keeper) IBCMintRoutes(c context.Context, _ *types.QueryIBCMintRoutesRequest) (*types.QueryIBCMintRoutesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryIBCMintRoutesResponse{
+		Routes: k.GetIBCMintRoutes(ctx),
+	}, nil
+}
+
+// MintApprovalConfig implements the Query/MintApprovalConfig gRPC method
+func (k Keeper) MintApprovalConfig(c context.Context, _ *types.QueryMintApprovalConfigRequest) (*types.QueryMintApprovalConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryMintApprovalConfigResponse{
+		Config: k.GetMintApprovalConfig(ctx),
+	}, nil
+}
+
+// MintProposal implements the Query/MintProposal gRPC method
+func (k Keeper) MintProposal(c context.Context, req *types.QueryMintProposalRequest) (*types.QueryMintProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, found := k.GetMintProposal(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "mint proposal %d not found", req.Id)
+	}
+
+	return &types.QueryMintProposalResponse{
+		Proposal: proposal,
+	}, nil
+}
+
+// MintProposals implements the Query/MintProposals gRPC method
+func (k Keeper) MintProposals(c context.Context, _ *types.QueryMintProposalsRequest) (*types.QueryMintProposalsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryMintProposalsResponse{
+		Proposals: k.GetMintProposals(ctx),
+	}, nil
+}
+
+// MintHistory implements the Query/MintHistory gRPC method
+func (k Keeper) MintHistory(c context.Context, req *types.QueryMintHistoryRequest) (*types.QueryMintHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom != "" {
+		if err := sdk.ValidateDenom(req.Denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	var minter string
+	if req.Minter != "" {
+		addr, err := sdk.AccAddressFromBech32(req.Minter)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		minter = addr.String()
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	records := []types.MintRecord{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMintRecord)
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var record types.MintRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return false, err
+		}
+
+		if (req.Denom != "" && record.Denom != req.Denom) || (minter != "" && record.Minter != minter) {
+			return false, nil
+		}
+
+		if accumulate {
+			records = append(records, record)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMintHistoryResponse{
+		Records:    records,
+		Pagination: pageRes,
+	}, nil
+}
+
+// DenomAdmin implements the Query/DenomAdmin gRPC method
+func (k Keeper) DenomAdmin(c context.Context, req *types.QueryDenomAdminRequest) (*types.QueryDenomAdminResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, _, err := types.ParseFactoryDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	admin, found := k.GetDenomAdmin(ctx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "denom %s was not created", req.Denom)
+	}
+
+	res := &types.QueryDenomAdminResponse{}
+	if !admin.Empty() {
+		res.Admin = admin.String()
+	}
+
+	return res, nil
+}
+
+// EmissionSchedule implements the Query/EmissionSchedule gRPC method
+func (k Keeper) EmissionSchedule(c context.Context, req *types.QueryEmissionScheduleRequest) (*types.QueryEmissionScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	schedule, found := k.GetEmissionSchedule(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "emission schedule %d not found", req.Id)
+	}
+
+	return &types.QueryEmissionScheduleResponse{
+		Schedule:  schedule,
+		Remaining: schedule.Remaining(),
+	}, nil
+}
+
+// EmissionSchedules implements the Query/EmissionSchedules gRPC method
+func (k Keeper) EmissionSchedules(c context.Context, _ *types.QueryEmissionSchedulesRequest) (*types.QueryEmissionSchedulesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryEmissionSchedulesResponse{
+		Schedules: k.GetEmissionSchedules(ctx),
+	}, nil
+}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: params,
+	}, nil
+}
@@ -0,0 +1,257 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/evm/ibc"
+	"github.com/cosmos/evm/x/mint/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// OnSendPacket performs the ICS20 middleware send callback for sending the
+// canonical denom of an IBC mint route back through its channel. If the sender
+// holds less vouchers than the token sent, the difference is converted from the
+// canonical denom.
+//
+// CONTRACT: This middleware MUST be executed before the ICS20 OnSendPacket, so
+// that the transfer module escrows or burns the converted vouchers.
+func (k Keeper) OnSendPacket(
+	ctx sdk.Context,
+	sourceChannel string,
+	token transfertypes.Token,
+	sender sdk.AccAddress,
+) error {
+	// only the vouchers of tokens native to the counterparty chain are routed
+	if len(token.Denom.Trace) != 1 || !token.Denom.HasPrefix(transfertypes.PortID, sourceChannel) {
+		return nil
+	}
+
+	route, found := k.GetIBCMintRoute(ctx, sourceChannel, token.Denom.Base)
+	if !found {
+		return nil
+	}
+
+	amount, ok := math.NewIntFromString(token.Amount)
+	if !ok {
+		return errorsmod.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount %s", token.Amount)
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, sender, route.VoucherDenom()).Amount
+	if balance.GTE(amount) {
+		return nil
+	}
+
+	return k.ConvertCanonicalToVoucher(ctx, sender, route, amount.Sub(balance))
+}
+
+// OnRecvPacket performs the ICS20 middleware receive callback for minting the
+// canonical denom of an IBC mint route. The voucher credited by the transfer
+// module is escrowed in the module account and the receiver is credited with
+// the same amount of the canonical denom instead.
+//
+// CONTRACT: This middleware MUST be executed after the ICS20 OnRecvPacket.
+// Return acknowledgement and continue with the next layer of the IBC middleware
+// stack if:
+// - The token is not native to the counterparty chain
+// - No route is set for the channel and base denomination
+func (k Keeper) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ack exported.Acknowledgement,
+) exported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// NOTE: shouldn't happen as the packet has already
+		// been decoded on ICS20 transfer logic
+		err = errorsmod.Wrapf(errortypes.ErrInvalidType, "cannot unmarshal ICS-20 transfer packet data")
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	// only the tokens native to the counterparty chain are routed
+	denom := transfertypes.ExtractDenomFromPath(data.Denom)
+	if !denom.IsNative() {
+		return ack
+	}
+
+	route, found := k.GetIBCMintRoute(ctx, packet.DestinationChannel, denom.Base)
+	if !found {
+		return ack
+	}
+
+	coin := ibc.GetReceivedCoin(packet, transfertypes.Token{Denom: denom, Amount: data.Amount})
+	if coin.Denom != route.VoucherDenom() {
+		// the packet was received on another port than the transfer one
+		return ack
+	}
+
+	_, recipient, _, _, err := ibc.GetTransferSenderRecipient(data)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	// use a zero gas config to avoid extra costs for the relayers
+	ctx = ctx.
+		WithKVGasConfig(storetypes.GasConfig{}).
+		WithTransientKVGasConfig(storetypes.GasConfig{})
+
+	if err := k.ConvertVoucherToCanonical(ctx, recipient, route, coin.Amount); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return ack
+}
+
+// OnAcknowledgementPacket responds to the success or failure of a packet
+// acknowledgement written on the receiving chain. If the acknowledgement
+// failed, the vouchers refunded to the sender are converted back to the
+// canonical denom of their route.
+func (k Keeper) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	ack channeltypes.Acknowledgement,
+) error {
+	switch ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return k.convertRefundToCanonical(ctx, packet, data)
+	default:
+		// the acknowledgement succeeded on the receiving chain so nothing needs to
+		// be executed and no error needs to be returned
+		return nil
+	}
+}
+
+// OnTimeoutPacket converts the vouchers refunded to the sender back to the
+// canonical denom of their route, since the packet sent was never received.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) error {
+	return k.convertRefundToCanonical(ctx, packet, data)
+}
+
+// convertRefundToCanonical converts the vouchers refunded to the sender of a
+// packet to the canonical denom of their route. If the conversion fails, the
+// sender keeps the vouchers, which can be sent again through the channel.
+func (k Keeper) convertRefundToCanonical(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) error {
+	denom := transfertypes.ExtractDenomFromPath(data.Denom)
+	if len(denom.Trace) != 1 || !denom.HasPrefix(packet.SourcePort, packet.SourceChannel) {
+		return nil
+	}
+
+	route, found := k.GetIBCMintRoute(ctx, packet.SourceChannel, denom.Base)
+	if !found || packet.SourcePort != transfertypes.PortID {
+		return nil
+	}
+
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
+
+	amount, ok := math.NewIntFromString(data.Amount)
+	if !ok {
+		return errorsmod.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount %s", data.Amount)
+	}
+
+	// the refund itself must go through, so a failed conversion only leaves the
+	// vouchers with the sender
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := k.ConvertVoucherToCanonical(cacheCtx, sender, route, amount); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("failed to convert refunded %s to %s: %s", route.VoucherDenom(), route.CanonicalDenom, err.Error()))
+		return nil
+	}
+	writeFn()
+
+	return nil
+}
+
+// ConvertVoucherToCanonical escrows the given amount of the voucher of the
+// route from the account and credits it with the same amount of the canonical
+// denom. The canonical denom is minted through the same path as the other
+// mints, on behalf of the module account: the supply cap and rate limit of the
+// denom apply and the mint is recorded in the mint stats and history.
+func (k Keeper) ConvertVoucherToCanonical(ctx sdk.Context, account sdk.AccAddress, route types.IBCMintRoute, amount math.Int) error {
+	if err := k.CheckNotPaused(ctx, route.CanonicalDenom); err != nil {
+		return err
+	}
+
+	vouchers := sdk.NewCoins(sdk.NewCoin(route.VoucherDenom(), amount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, account, types.ModuleName, vouchers); err != nil {
+		return err
+	}
+
+	minter := authtypes.NewModuleAddress(types.ModuleName)
+	if err := k.issue(ctx, minter, account, sdk.NewCoin(route.CanonicalDenom, amount)); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIBCMint,
+			sdk.NewAttribute(types.AttributeKeyAccount, account.String()),
+			sdk.NewAttribute(types.AttributeKeyChannelID, route.ChannelID),
+			sdk.NewAttribute(types.AttributeKeyVoucherDenom, route.VoucherDenom()),
+			sdk.NewAttribute(types.AttributeKeyCanonicalDenom, route.CanonicalDenom),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// ConvertCanonicalToVoucher burns the given amount of the canonical denom of
+// the route from the account, records the burn in the mint stats and releases
+// the same amount of its escrowed voucher to it. It fails if fewer vouchers were escrowed through the route,
+// as the canonical denom may have been received over another channel.
+func (k Keeper) ConvertCanonicalToVoucher(ctx sdk.Context, account sdk.AccAddress, route types.IBCMintRoute, amount math.Int) error {
+	if err := k.CheckNotPaused(ctx, route.CanonicalDenom); err != nil {
+		return err
+	}
+
+	voucherDenom := route.VoucherDenom()
+	escrowed := k.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), voucherDenom).Amount
+	if escrowed.LT(amount) {
+		return errorsmod.Wrapf(
+			types.ErrInsufficientIBCEscrow,
+			"channel %s has %s%s escrowed for %s, cannot release %s",
+			route.ChannelID, escrowed, voucherDenom, route.CanonicalDenom, amount,
+		)
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(route.CanonicalDenom, amount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, account, types.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.RecordBurn(ctx, route.CanonicalDenom, amount); err != nil {
+		return err
+	}
+
+	vouchers := sdk.NewCoins(sdk.NewCoin(voucherDenom, amount))
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, account, vouchers); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIBCBurn,
+			sdk.NewAttribute(types.AttributeKeyAccount, account.String()),
+			sdk.NewAttribute(types.AttributeKeyChannelID, route.ChannelID),
+			sdk.NewAttribute(types.AttributeKeyVoucherDenom, voucherDenom),
+			sdk.NewAttribute(types.AttributeKeyCanonicalDenom, route.CanonicalDenom),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/mint/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetIBCMintRoute returns the route of the given base denom received over the
+// given channel. The boolean is false if the tokens are not routed.
+func (k Keeper) GetIBCMintRoute(ctx sdk.Context, channelID, baseDenom string) (types.IBCMintRoute, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIBCMintRoute)
+
+	bz := store.Get(types.IBCMintRouteKey(channelID, baseDenom))
+	if bz == nil {
+		return types.IBCMintRoute{}, false
+	}
+
+	var route types.IBCMintRoute
+	k.cdc.MustUnmarshal(bz, &route)
+
+	return route, true
+}
+
+// GetIBCMintRouteByCanonicalDenom returns the route of the given channel that
+// mints the given canonical denom. The boolean is false if no route of the
+// channel mints the denom.
+func (k Keeper) GetIBCMintRouteByCanonicalDenom(ctx sdk.Context, channelID, canonicalDenom string) (types.IBCMintRoute, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIBCMintRoute)
+	iterator := storetypes.KVStorePrefixIterator(store, types.IBCMintRoutePrefix(channelID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var route types.IBCMintRoute
+		k.cdc.MustUnmarshal(iterator.Value(), &route)
+
+		if route.CanonicalDenom == canonicalDenom {
+			return route, true
+		}
+	}
+
+	return types.IBCMintRoute{}, false
+}
+
+// IsIBCMintRouteDenom returns true if the denom is the canonical denom of the
+// route of any channel.
+func (k Keeper) IsIBCMintRouteDenom(ctx sdk.Context, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixIBCMintRoute)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var route types.IBCMintRoute
+		k.cdc.MustUnmarshal(iterator.Value(), &route)
+
+		if route.CanonicalDenom == denom {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CheckNotIBCMintRouteDenom returns an error if the denom is the canonical
+// denom of a route. The canonical denom is backed by the vouchers escrowed
+// through its routes, so it is only minted in exchange for them: any other
+// mint could be converted into the escrowed vouchers.
+func (k Keeper) CheckNotIBCMintRouteDenom(ctx sdk.Context, denom string) error {
+	if k.IsIBCMintRouteDenom(ctx, denom) {
+		return errorsmod.Wrapf(types.ErrIBCMintRouteDenom, "%s is only minted in exchange for IBC vouchers", denom)
+	}
+
+	return nil
+}
+
+// UpdateIBCMintRoute sets the canonical denom minted for the base denom received
+// over the channel of the route. A route without canonical denom removes it.
+// Removing a route leaves the vouchers escrowed for the canonical denom in the
+// module account until the route is set again.
+//
+// A denom that is not routed yet cannot become a canonical denom if it can be
+// minted otherwise: a denom created with createDenom, or a denom with minter
+// allowances or emission schedules.
+func (k Keeper) UpdateIBCMintRoute(ctx sdk.Context, route types.IBCMintRoute) error {
+	if err := route.Validate(); err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIBCMintRoute)
+	key := types.IBCMintRouteKey(route.ChannelID, route.BaseDenom)
+	if route.IsEmpty() {
+		store.Delete(key)
+		return nil
+	}
+
+	if route.CanonicalDenom == evmtypes.GetEVMCoinDenom() || route.CanonicalDenom == evmtypes.GetEVMCoinExtendedDenom() {
+		return errorsmod.Wrapf(types.ErrInvalidIBCMintRoute, "canonical denom cannot be the EVM denom %s", route.CanonicalDenom)
+	}
+
+	if !k.IsIBCMintRouteDenom(ctx, route.CanonicalDenom) {
+		if err := k.checkNotMintable(ctx, route.CanonicalDenom); err != nil {
+			return err
+		}
+	}
+
+	// the canonical denom is sent back as the voucher of a single base denom
+	if existing, found := k.GetIBCMintRouteByCanonicalDenom(ctx, route.ChannelID, route.CanonicalDenom); found && existing.BaseDenom != route.BaseDenom {
+		return errorsmod.Wrapf(
+			types.ErrInvalidIBCMintRoute,
+			"channel %s already mints %s for base denom %s",
+			route.ChannelID, route.CanonicalDenom, existing.BaseDenom,
+		)
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&route))
+
+	return nil
+}
+
+// checkNotMintable returns an error if the denom can be minted otherwise than
+// through an IBC mint route.
+func (k Keeper) checkNotMintable(ctx sdk.Context, denom string) error {
+	if types.IsFactoryDenom(denom) {
+		return errorsmod.Wrapf(types.ErrInvalidIBCMintRoute, "canonical denom cannot be the factory denom %s", denom)
+	}
+
+	hasMinter := false
+	k.IterateMinterAllowances(ctx, func(allowance types.MinterAllowance) (stop bool) {
+		hasMinter = allowance.Denom == denom
+		return hasMinter
+	})
+	if hasMinter {
+		return errorsmod.Wrapf(types.ErrInvalidIBCMintRoute, "canonical denom %s has minter allowances", denom)
+	}
+
+	for _, schedule := range k.GetEmissionSchedules(ctx) {
+		if schedule.Denom == denom {
+			return errorsmod.Wrapf(types.ErrInvalidIBCMintRoute, "canonical denom %s has emission schedule %d", denom, schedule.ID)
+		}
+	}
+
+	return nil
+}
+
+// GetIBCMintRoutes returns the routes of all channels.
+func (k Keeper) GetIBCMintRoutes(ctx sdk.Context) []types.IBCMintRoute {
+	routes := []types.IBCMintRoute{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixIBCMintRoute)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var route types.IBCMintRoute
+		k.cdc.MustUnmarshal(iterator.Value(), &route)
+		routes = append(routes, route)
+	}
+
+	return routes
+}
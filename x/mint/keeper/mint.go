@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/mint/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Mint mints the coin to the recipient on behalf of the minter. It is the mint
+// path shared by the mint and WERC20 precompiles: minting of the denom must not
+// be paused, the recipient must be valid, the amount is drawn down from the
+// mint rights of the minter, and the supply cap and rate limit of the denom are
+// enforced. The canonical denoms of IBC mint routes are only minted in exchange
+// for their vouchers, so they cannot be minted here. The mint is recorded in
+// the mint stats and history.
+func (k Keeper) Mint(ctx sdk.Context, minter, recipient sdk.AccAddress, coin sdk.Coin) error {
+	if err := k.CheckNotPaused(ctx, coin.Denom); err != nil {
+		return err
+	}
+
+	if err := k.CheckNotIBCMintRouteDenom(ctx, coin.Denom); err != nil {
+		return err
+	}
+
+	if err := k.ValidateRecipient(ctx, recipient, coin.Denom); err != nil {
+		return err
+	}
+
+	if err := k.SpendMintRights(ctx, minter, coin.Denom, coin.Amount); err != nil {
+		return err
+	}
+
+	return k.issue(ctx, minter, recipient, coin)
+}
+
+// SpendMintRights draws the amount down from the allowance of the minter for
+// the denom. The manager of the denom mints it without an allowance: the admin
+// for a denom created with createDenom, the mint authority for every other
+// denom. It fails with ErrMinterNotFound if the minter has no allowance for
+// the denom.
+func (k Keeper) SpendMintRights(ctx sdk.Context, minter sdk.AccAddress, denom string, amount math.Int) error {
+	if k.IsDenomManager(ctx, denom, minter) {
+		return nil
+	}
+
+	return k.SpendMinterAllowance(ctx, minter, denom, amount)
+}
+
+// IsDenomManager returns true if the given address manages the minters and
+// the metadata of the denom: the admin for a denom created with createDenom,
+// the mint authority for every other denom.
+func (k Keeper) IsDenomManager(ctx sdk.Context, denom string, addr sdk.AccAddress) bool {
+	if types.IsFactoryDenom(denom) {
+		return k.IsDenomAdmin(ctx, denom, addr)
+	}
+
+	return k.IsMintAuthority(ctx, addr)
+}
+
+// Burn burns the coin from the given account, through the module account. It
+// is the burn path shared by the mint and WERC20 precompiles: burning of the
+// denom must not be paused, and the burn is recorded in the mint stats.
+func (k Keeper) Burn(ctx sdk.Context, from sdk.AccAddress, coin sdk.Coin) error {
+	if err := k.CheckNotPaused(ctx, coin.Denom); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(coin)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return k.RecordBurn(ctx, coin.Denom, coin.Amount)
+}
+
+// issue enforces the supply cap and rate limit of the denom, mints the coin to
+// the recipient and records the mint. The callers check the pause and the
+// rights of the minter.
+func (k Keeper) issue(ctx sdk.Context, minter, recipient sdk.AccAddress, coin sdk.Coin) error {
+	if err := k.TrackMint(ctx, coin.Denom, coin.Amount); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(coin)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins); err != nil {
+		return err
+	}
+
+	return k.RecordMint(ctx, minter, recipient, coin.Denom, coin.Amount)
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/mint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WithMintAuthority fixes the mint authority to the given account, in place of
+// the one held in the store, for chains that do not want governance to manage
+// it. It must be called when the app is constructed, before the keeper is
+// handed to the modules and precompiles, so that they all resolve the same
+// authority.
+func (k Keeper) WithMintAuthority(mintAuthority sdk.AccAddress) Keeper {
+	k.fixedMintAuthority = mintAuthority
+	return k
+}
+
+// IsMintAuthorityFixed returns true if the mint authority was fixed with
+// WithMintAuthority, in which case governance cannot update it.
+func (k Keeper) IsMintAuthorityFixed() bool {
+	return !k.fixedMintAuthority.Empty()
+}
+
+// GetMintAuthority returns the account allowed to mint through the mint
+// precompile: the fixed one if any, the one held in the store otherwise. It
+// returns an empty address if no authority is set.
+func (k Keeper) GetMintAuthority(ctx sdk.Context) sdk.AccAddress {
+	if k.IsMintAuthorityFixed() {
+		return k.fixedMintAuthority
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	return sdk.AccAddress(store.Get(types.KeyPrefixMintAuthority))
+}
+
+// SetMintAuthority sets the account allowed to mint through the mint
+// precompile. Passing an empty address removes the authority.
+func (k Keeper) SetMintAuthority(ctx sdk.Context, mintAuthority sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	if mintAuthority.Empty() {
+		store.Delete(types.KeyPrefixMintAuthority)
+		return
+	}
+
+	store.Set(types.KeyPrefixMintAuthority, mintAuthority.Bytes())
+}
+
+// IsMintAuthority returns true if the given address is the current mint
+// authority.
+func (k Keeper) IsMintAuthority(ctx sdk.Context, addr sdk.AccAddress) bool {
+	mintAuthority := k.GetMintAuthority(ctx)
+	return !mintAuthority.Empty() && mintAuthority.Equals(addr)
+}
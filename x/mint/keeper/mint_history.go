@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/mint/types"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetNextMintRecordID returns the identifier of the next mint record.
+func (k Keeper) GetNextMintRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixNextMintRecordID)
+	if bz == nil {
+		return types.DefaultNextMintRecordID
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextMintRecordID sets the identifier of the next mint record.
+func (k Keeper) SetNextMintRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefixNextMintRecordID, sdk.Uint64ToBigEndian(id))
+}
+
+// AppendMintRecord adds a mint at the current height to the mint history.
+func (k Keeper) AppendMintRecord(ctx sdk.Context, minter, recipient sdk.AccAddress, denom string, amount math.Int) error {
+	id := k.GetNextMintRecordID(ctx)
+	record := types.NewMintRecord(id, uint64(ctx.BlockHeight()), minter, recipient, denom, amount) //nolint:gosec // G115 // block height won't exceed uint64
+	if err := k.SetMintRecord(ctx, record); err != nil {
+		return err
+	}
+
+	k.SetNextMintRecordID(ctx, id+1)
+
+	return nil
+}
+
+// SetMintRecord stores a record of the mint history.
+func (k Keeper) SetMintRecord(ctx sdk.Context, record types.MintRecord) error {
+	if err := record.Validate(); err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMintRecord)
+	store.Set(types.MintRecordKey(record.Height, record.ID), k.cdc.MustMarshal(&record))
+
+	return nil
+}
+
+// GetMintHistory returns all the records of the mint history, oldest first.
+func (k Keeper) GetMintHistory(ctx sdk.Context) []types.MintRecord {
+	records := []types.MintRecord{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixMintRecord)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.MintRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// PruneMintHistory deletes the mint records older than the retention set by
+// governance, keeping the mints of the last retention blocks. Nothing is pruned
+// if the retention is zero.
+func (k Keeper) PruneMintHistory(ctx sdk.Context) {
+	retention := k.GetParams(ctx).MintHistoryRetention
+	height := uint64(ctx.BlockHeight()) //nolint:gosec // G115 // block height won't exceed uint64
+	if retention == 0 || height <= retention {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMintRecord)
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(height-retention+1))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/mint/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetDenomMintLimit returns the supply cap and rate limit of the given denom.
+// The boolean is false if the denom has no limit.
+func (k Keeper) GetDenomMintLimit(ctx sdk.Context, denom string) (types.DenomMintLimit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenomMintLimit)
+
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return types.DenomMintLimit{}, false
+	}
+
+	var limit types.DenomMintLimit
+	k.cdc.MustUnmarshal(bz, &limit)
+
+	return limit, true
+}
+
+// UpdateDenomMintLimit sets the supply cap and rate limit of a denom. An empty
+// limit removes it, together with the amounts tracked for its rate limit.
+func (k Keeper) UpdateDenomMintLimit(ctx sdk.Context, limit types.DenomMintLimit) error {
+	if err := limit.Validate(); err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenomMintLimit)
+	if limit.IsEmpty() {
+		store.Delete([]byte(limit.Denom))
+		k.deleteMintedAtHeight(ctx, limit.Denom, 0)
+		return nil
+	}
+
+	if !limit.HasRateLimit() {
+		k.deleteMintedAtHeight(ctx, limit.Denom, 0)
+	}
+
+	store.Set([]byte(limit.Denom), k.cdc.MustMarshal(&limit))
+
+	return nil
+}
+
+// GetDenomMintLimits returns the mint limits of all denoms.
+func (k Keeper) GetDenomMintLimits(ctx sdk.Context) []types.DenomMintLimit {
+	limits := []types.DenomMintLimit{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixDenomMintLimit)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var limit types.DenomMintLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &limit)
+		limits = append(limits, limit)
+	}
+
+	return limits
+}
+
+// RemainingSupply returns the amount of the denom that can be minted before
+// reaching its max supply. The boolean is false if the denom has no supply cap.
+func (k Keeper) RemainingSupply(ctx sdk.Context, denom string) (math.Int, bool) {
+	limit, found := k.GetDenomMintLimit(ctx, denom)
+	if !found || !limit.HasSupplyCap() {
+		return math.ZeroInt(), false
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+	if supply.GTE(limit.MaxSupply) {
+		return math.ZeroInt(), true
+	}
+
+	return limit.MaxSupply.Sub(supply), true
+}
+
+// RemainingRateLimit returns the amount of the denom that can be minted in the
+// current window before reaching its rate limit. The boolean is false if the
+// denom has no rate limit.
+func (k Keeper) RemainingRateLimit(ctx sdk.Context, denom string) (math.Int, bool) {
+	limit, found := k.GetDenomMintLimit(ctx, denom)
+	if !found || !limit.HasRateLimit() {
+		return math.ZeroInt(), false
+	}
+
+	minted := k.mintedInWindow(ctx, denom, limit.RateLimitWindow)
+	if minted.GTE(limit.RateLimit) {
+		return math.ZeroInt(), true
+	}
+
+	return limit.RateLimit.Sub(minted), true
+}
+
+// TrackMint checks that minting the given amount of the denom stays within its
+// supply cap and rate limit, and records the amount against the rate limit.
+// It must be called before the coins are minted.
+func (k Keeper) TrackMint(ctx sdk.Context, denom string, amount math.Int) error {
+	limit, found := k.GetDenomMintLimit(ctx, denom)
+	if !found {
+		return nil
+	}
+
+	if limit.HasSupplyCap() {
+		supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+		if supply.Add(amount).GT(limit.MaxSupply) {
+			return errorsmod.Wrapf(
+				types.ErrMaxSupplyExceeded,
+				"minting %s%s would bring the supply to %s, above the max supply of %s",
+				amount, denom, supply.Add(amount), limit.MaxSupply,
+			)
+		}
+	}
+
+	if limit.HasRateLimit() {
+		// drop the amounts that fell out of the window before adding the new one
+		height := uint64(ctx.BlockHeight()) //nolint:gosec // G115 // block height won't exceed uint64
+		if height >= limit.RateLimitWindow {
+			k.deleteMintedAtHeight(ctx, denom, height-limit.RateLimitWindow+1)
+		}
+
+		minted := k.mintedInWindow(ctx, denom, limit.RateLimitWindow)
+		if minted.Add(amount).GT(limit.RateLimit) {
+			return errorsmod.Wrapf(
+				types.ErrRateLimitExceeded,
+				"%s%s already minted in the last %d blocks, limit is %s",
+				minted, denom, limit.RateLimitWindow, limit.RateLimit,
+			)
+		}
+
+		k.addMintedAtHeight(ctx, denom, height, amount)
+	}
+
+	return nil
+}
+
+// mintedInWindow returns the amount of the denom minted in the last window
+// blocks, including the current one.
+func (k Keeper) mintedInWindow(ctx sdk.Context, denom string, window uint64) math.Int {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append(types.KeyPrefixMintedAtHeight, types.MintedAtHeightPrefix(denom)...),
+	)
+
+	var start []byte
+	height := uint64(ctx.BlockHeight()) //nolint:gosec // G115 // block height won't exceed uint64
+	if height >= window {
+		start = sdk.Uint64ToBigEndian(height - window + 1)
+	}
+
+	iterator := store.Iterator(start, nil)
+	defer iterator.Close()
+
+	minted := math.ZeroInt()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount math.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		minted = minted.Add(amount)
+	}
+
+	return minted
+}
+
+// addMintedAtHeight adds the amount to the amount of the denom minted at the
+// given height.
+func (k Keeper) addMintedAtHeight(ctx sdk.Context, denom string, height uint64, amount math.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMintedAtHeight)
+	key := types.MintedAtHeightKey(denom, height)
+
+	total := amount
+	if bz := store.Get(key); bz != nil {
+		var minted math.Int
+		if err := minted.Unmarshal(bz); err != nil {
+			panic(err)
+		}
+		total = total.Add(minted)
+	}
+
+	bz, err := total.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(key, bz)
+}
+
+// deleteMintedAtHeight deletes the amounts of the denom minted before the
+// given height. A zero height deletes all of them.
+func (k Keeper) deleteMintedAtHeight(ctx sdk.Context, denom string, before uint64) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append(types.KeyPrefixMintedAtHeight, types.MintedAtHeightPrefix(denom)...),
+	)
+
+	var end []byte
+	if before > 0 {
+		end = sdk.Uint64ToBigEndian(before)
+	}
+
+	iterator := store.Iterator(nil, end)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,334 @@
+package keeper
+
+import (
+	gomath "math"
+	"strconv"
+
+	"github.com/cosmos/evm/x/mint/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetMintApprovalConfig returns the approvers of mint proposals and the mints
+// that require one.
+func (k Keeper) GetMintApprovalConfig(ctx sdk.Context) types.MintApprovalConfig {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixMintApprovalConfig)
+	if bz == nil {
+		return types.DefaultMintApprovalConfig()
+	}
+
+	var config types.MintApprovalConfig
+	k.cdc.MustUnmarshal(bz, &config)
+
+	return config
+}
+
+// UpdateMintApprovalConfig sets the approvers of mint proposals and the mints
+// that require one. A config without approvers disables mint proposals.
+// Pending proposals are kept, and are checked against the new approvers and
+// threshold when they are executed.
+func (k Keeper) UpdateMintApprovalConfig(ctx sdk.Context, config types.MintApprovalConfig) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if !config.IsEnabled() {
+		store.Delete(types.KeyPrefixMintApprovalConfig)
+		return nil
+	}
+
+	store.Set(types.KeyPrefixMintApprovalConfig, k.cdc.MustMarshal(&config))
+
+	return nil
+}
+
+// IsMintApprover returns true if the address is allowed to approve mint
+// proposals.
+func (k Keeper) IsMintApprover(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return k.GetMintApprovalConfig(ctx).IsApprover(addr)
+}
+
+// TrackLargeMint returns an error if minting the given amount of the denom,
+// added to the amounts already tracked in the current block, exceeds its large
+// mint threshold, in which case the mint must go through a proposal. Otherwise
+// it records the amount, so that a large mint cannot be split into several
+// calls of the same block. It must be called before the coins are minted.
+func (k Keeper) TrackLargeMint(ctx sdk.Context, denom string, amount math.Int) error {
+	config := k.GetMintApprovalConfig(ctx)
+	if found, _ := config.LargeMintThresholds.Find(denom); !found {
+		return nil
+	}
+
+	minted := k.largeMintedInBlock(ctx, denom)
+	if config.RequiresProposal(denom, minted.Add(amount)) {
+		return errorsmod.Wrapf(
+			types.ErrMintRequiresProposal,
+			"minting %s%s exceeds the large mint threshold, %s%s already minted in this block",
+			amount, denom, minted, denom,
+		)
+	}
+
+	k.setLargeMintedInBlock(ctx, denom, minted.Add(amount))
+
+	return nil
+}
+
+// largeMintedInBlock returns the amount of the denom tracked against its large
+// mint threshold in the current block.
+func (k Keeper) largeMintedInBlock(ctx sdk.Context, denom string) math.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixLargeMintedInBlock)
+
+	// the amount is prefixed by the height it was tracked at, and is reset
+	// at the first mint of every block
+	bz := store.Get([]byte(denom))
+	height := uint64(ctx.BlockHeight()) //nolint:gosec // G115 // block height won't exceed uint64
+	if len(bz) < 8 || sdk.BigEndianToUint64(bz[:8]) != height {
+		return math.ZeroInt()
+	}
+
+	var minted math.Int
+	if err := minted.Unmarshal(bz[8:]); err != nil {
+		panic(err)
+	}
+
+	return minted
+}
+
+// setLargeMintedInBlock sets the amount of the denom tracked against its large
+// mint threshold in the current block.
+func (k Keeper) setLargeMintedInBlock(ctx sdk.Context, denom string, minted math.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixLargeMintedInBlock)
+
+	bz, err := minted.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	height := uint64(ctx.BlockHeight()) //nolint:gosec // G115 // block height won't exceed uint64
+	store.Set([]byte(denom), append(sdk.Uint64ToBigEndian(height), bz...))
+}
+
+// GetNextMintProposalID returns the identifier of the next mint proposal.
+func (k Keeper) GetNextMintProposalID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixNextMintProposalID)
+	if bz == nil {
+		return types.DefaultNextMintProposalID
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextMintProposalID sets the identifier of the next mint proposal.
+func (k Keeper) SetNextMintProposalID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefixNextMintProposalID, sdk.Uint64ToBigEndian(id))
+}
+
+// SubmitMintProposal stores a new mint proposal, which can be executed once
+// enough approvers approved it and the given delay has passed. The delay
+// cannot be shorter than the minimum delay set by governance, nor overflow the
+// executable or expiry height.
+func (k Keeper) SubmitMintProposal(
+	ctx sdk.Context,
+	proposer, recipient sdk.AccAddress,
+	denom string,
+	amount math.Int,
+	delay uint64,
+) (types.MintProposal, error) {
+	config := k.GetMintApprovalConfig(ctx)
+	if !config.IsEnabled() {
+		return types.MintProposal{}, errorsmod.Wrap(types.ErrInvalidMintProposal, "mint proposals are disabled")
+	}
+
+	if delay < config.MinDelay {
+		return types.MintProposal{}, errorsmod.Wrapf(
+			types.ErrInvalidMintProposal,
+			"delay of %d blocks is shorter than the minimum delay of %d blocks", delay, config.MinDelay,
+		)
+	}
+
+	height := uint64(ctx.BlockHeight()) //nolint:gosec // G115 // block height won't exceed uint64
+	if delay > gomath.MaxUint64-height || height+delay > gomath.MaxUint64-config.Expiry {
+		return types.MintProposal{}, errorsmod.Wrapf(
+			types.ErrInvalidMintProposal,
+			"delay of %d blocks overflows the executable or expiry height", delay,
+		)
+	}
+
+	executableHeight := height + delay
+	proposal := types.NewMintProposal(
+		k.GetNextMintProposalID(ctx),
+		proposer, recipient,
+		denom, amount,
+		executableHeight, executableHeight+config.Expiry,
+	)
+
+	if err := k.SetMintProposal(ctx, proposal); err != nil {
+		return types.MintProposal{}, err
+	}
+
+	k.SetNextMintProposalID(ctx, proposal.ID+1)
+
+	return proposal, nil
+}
+
+// ApproveMintProposal records the approval of the given approver on a pending
+// mint proposal.
+func (k Keeper) ApproveMintProposal(ctx sdk.Context, id uint64, approver sdk.AccAddress) (types.MintProposal, error) {
+	if !k.IsMintApprover(ctx, approver) {
+		return types.MintProposal{}, errorsmod.Wrapf(types.ErrNotMintApprover, "address %s", approver)
+	}
+
+	proposal, found := k.GetMintProposal(ctx, id)
+	if !found {
+		return types.MintProposal{}, errorsmod.Wrapf(types.ErrMintProposalNotFound, "id %d", id)
+	}
+
+	if proposal.IsExpired(uint64(ctx.BlockHeight())) { //nolint:gosec // G115 // block height won't exceed uint64
+		return types.MintProposal{}, errorsmod.Wrapf(types.ErrMintProposalNotExecutable, "proposal %d expired at height %d", id, proposal.ExpiryHeight)
+	}
+
+	if proposal.HasApproved(approver) {
+		return types.MintProposal{}, errorsmod.Wrapf(types.ErrInvalidMintProposal, "proposal %d already approved by %s", id, approver)
+	}
+
+	proposal.Approvals = append(proposal.Approvals, approver.String())
+	if err := k.SetMintProposal(ctx, proposal); err != nil {
+		return types.MintProposal{}, err
+	}
+
+	return proposal, nil
+}
+
+// CheckMintProposalExecutable returns an error if the proposal cannot be
+// executed at the current height, either because its delay has not passed, it
+// expired, or it lacks approvals from the current approvers.
+func (k Keeper) CheckMintProposalExecutable(ctx sdk.Context, proposal types.MintProposal) error {
+	height := uint64(ctx.BlockHeight()) //nolint:gosec // G115 // block height won't exceed uint64
+	if height < proposal.ExecutableHeight {
+		return errorsmod.Wrapf(
+			types.ErrMintProposalNotExecutable,
+			"proposal %d cannot be executed before height %d", proposal.ID, proposal.ExecutableHeight,
+		)
+	}
+
+	if proposal.IsExpired(height) {
+		return errorsmod.Wrapf(
+			types.ErrMintProposalNotExecutable,
+			"proposal %d expired at height %d", proposal.ID, proposal.ExpiryHeight,
+		)
+	}
+
+	config := k.GetMintApprovalConfig(ctx)
+	if approvals := proposal.CountApprovals(config); !config.IsEnabled() || approvals < config.Threshold {
+		return errorsmod.Wrapf(
+			types.ErrMintProposalNotExecutable,
+			"proposal %d has %d approvals, %d required", proposal.ID, approvals, config.Threshold,
+		)
+	}
+
+	return nil
+}
+
+// GetMintProposal returns the pending mint proposal with the given identifier.
+func (k Keeper) GetMintProposal(ctx sdk.Context, id uint64) (types.MintProposal, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMintProposal)
+
+	bz := store.Get(types.MintProposalKey(id))
+	if bz == nil {
+		return types.MintProposal{}, false
+	}
+
+	var proposal types.MintProposal
+	k.cdc.MustUnmarshal(bz, &proposal)
+
+	return proposal, true
+}
+
+// SetMintProposal stores a mint proposal and queues it for pruning at its
+// expiry height.
+func (k Keeper) SetMintProposal(ctx sdk.Context, proposal types.MintProposal) error {
+	if err := proposal.Validate(); err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMintProposal)
+	store.Set(types.MintProposalKey(proposal.ID), k.cdc.MustMarshal(&proposal))
+
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMintProposalExpiry)
+	expiryStore.Set(types.MintProposalExpiryKey(proposal.ExpiryHeight, proposal.ID), []byte{1})
+
+	return nil
+}
+
+// DeleteMintProposal removes a mint proposal, once it is executed, cancelled
+// or expired.
+func (k Keeper) DeleteMintProposal(ctx sdk.Context, id uint64) error {
+	proposal, found := k.GetMintProposal(ctx, id)
+	if !found {
+		return errorsmod.Wrapf(types.ErrMintProposalNotFound, "id %d", id)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMintProposal)
+	store.Delete(types.MintProposalKey(id))
+
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMintProposalExpiry)
+	expiryStore.Delete(types.MintProposalExpiryKey(proposal.ExpiryHeight, id))
+
+	return nil
+}
+
+// GetMintProposals returns all the pending mint proposals, ordered by
+// identifier.
+func (k Keeper) GetMintProposals(ctx sdk.Context) []types.MintProposal {
+	proposals := []types.MintProposal{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixMintProposal)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal types.MintProposal
+		k.cdc.MustUnmarshal(iterator.Value(), &proposal)
+		proposals = append(proposals, proposal)
+	}
+
+	return proposals
+}
+
+// PruneExpiredMintProposals removes the mint proposals that can no longer be
+// executed after the current block. It is called in EndBlock.
+func (k Keeper) PruneExpiredMintProposals(ctx sdk.Context) {
+	height := uint64(ctx.BlockHeight()) //nolint:gosec // G115 // block height won't exceed uint64
+
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMintProposalExpiry)
+	iterator := expiryStore.Iterator(nil, sdk.Uint64ToBigEndian(height+1))
+
+	var expired []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		expired = append(expired, sdk.BigEndianToUint64(iterator.Key()[8:]))
+	}
+	iterator.Close()
+
+	for _, id := range expired {
+		if err := k.DeleteMintProposal(ctx, id); err != nil {
+			k.Logger(ctx).Error("failed to prune expired mint proposal", "id", id, "error", err)
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpireMintProposal,
+				sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(id, 10)),
+			),
+		)
+	}
+}
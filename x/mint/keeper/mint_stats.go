@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/cosmos/evm/x/mint/types"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetDenomMintStats returns the totals of the given denom minted and burned
+// through the mint precompile. Both are zero if nothing was minted or burned
+// yet.
+func (k Keeper) GetDenomMintStats(ctx sdk.Context, denom string) types.DenomMintStats {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenomMintStats)
+
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return types.NewDenomMintStats(denom, math.ZeroInt(), math.ZeroInt())
+	}
+
+	var stats types.DenomMintStats
+	k.cdc.MustUnmarshal(bz, &stats)
+
+	return stats
+}
+
+// SetDenomMintStats stores the totals minted and burned of a denom.
+func (k Keeper) SetDenomMintStats(ctx sdk.Context, stats types.DenomMintStats) error {
+	if err := stats.Validate(); err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenomMintStats)
+	store.Set([]byte(stats.Denom), k.cdc.MustMarshal(&stats))
+
+	return nil
+}
+
+// GetMinterMintStats returns the total of the given denom the minter minted
+// through the mint precompile. It is zero if the minter never minted the
+// denom.
+func (k Keeper) GetMinterMintStats(ctx sdk.Context, minter sdk.AccAddress, denom string) types.MinterMintStats {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMinterMintStats)
+
+	bz := store.Get(types.MinterMintStatsKey(minter, denom))
+	if bz == nil {
+		return types.NewMinterMintStats(minter, denom, math.ZeroInt())
+	}
+
+	var stats types.MinterMintStats
+	k.cdc.MustUnmarshal(bz, &stats)
+
+	return stats
+}
+
+// SetMinterMintStats stores the total of a denom minted by a minter.
+func (k Keeper) SetMinterMintStats(ctx sdk.Context, stats types.MinterMintStats) error {
+	if err := stats.Validate(); err != nil {
+		return err
+	}
+
+	minter := sdk.MustAccAddressFromBech32(stats.Minter)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMinterMintStats)
+	store.Set(types.MinterMintStatsKey(minter, stats.Denom), k.cdc.MustMarshal(&stats))
+
+	return nil
+}
+
+// RecordMint adds the amount to the total minted of the denom and to the total
+// the minter minted of it, and appends the mint to the mint history. It emits
+// an EventMint and updates the mint telemetry counters of the denom.
+func (k Keeper) RecordMint(ctx sdk.Context, minter, recipient sdk.AccAddress, denom string, amount math.Int) error {
+	denomStats := k.GetDenomMintStats(ctx, denom)
+	denomStats.TotalMinted = denomStats.TotalMinted.Add(amount)
+	if err := k.SetDenomMintStats(ctx, denomStats); err != nil {
+		return err
+	}
+
+	minterStats := k.GetMinterMintStats(ctx, minter, denom)
+	minterStats.Minted = minterStats.Minted.Add(amount)
+	if err := k.SetMinterMintStats(ctx, minterStats); err != nil {
+		return err
+	}
+
+	if err := k.AppendMintRecord(ctx, minter, recipient, denom, amount); err != nil {
+		return err
+	}
+
+	defer incrVolumeCounters("mint", denom, amount)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventMint{
+		Minter:    minter.String(),
+		Recipient: recipient.String(),
+		Denom:     denom,
+		Amount:    amount.String(),
+	})
+}
+
+// RecordBurn adds the amount to the total burned of the denom and updates the
+// burn telemetry counters of the denom.
+func (k Keeper) RecordBurn(ctx sdk.Context, denom string, amount math.Int) error {
+	stats := k.GetDenomMintStats(ctx, denom)
+	stats.TotalBurned = stats.TotalBurned.Add(amount)
+	if err := k.SetDenomMintStats(ctx, stats); err != nil {
+		return err
+	}
+
+	incrVolumeCounters("burn", denom, amount)
+
+	return nil
+}
+
+// incrVolumeCounters increments the telemetry counters of the number of mints
+// or burns of a denom and of the amount minted or burned.
+func incrVolumeCounters(action, denom string, amount math.Int) {
+	labels := []metrics.Label{telemetry.NewLabel("denom", denom)}
+
+	telemetry.IncrCounterWithLabels([]string{types.ModuleName, action, "total"}, 1, labels)
+
+	if amount.IsInt64() {
+		telemetry.IncrCounterWithLabels([]string{types.ModuleName, action, "amount", "total"}, float32(amount.Int64()), labels)
+	}
+}
+
+// GetAllDenomMintStats returns the totals minted and burned of all denoms.
+func (k Keeper) GetAllDenomMintStats(ctx sdk.Context) []types.DenomMintStats {
+	stats := []types.DenomMintStats{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixDenomMintStats)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var denomStats types.DenomMintStats
+		k.cdc.MustUnmarshal(iterator.Value(), &denomStats)
+		stats = append(stats, denomStats)
+	}
+
+	return stats
+}
+
+// GetAllMinterMintStats returns the totals minted by all minters.
+func (k Keeper) GetAllMinterMintStats(ctx sdk.Context) []types.MinterMintStats {
+	stats := []types.MinterMintStats{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixMinterMintStats)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var minterStats types.MinterMintStats
+		k.cdc.MustUnmarshal(iterator.Value(), &minterStats)
+		stats = append(stats, minterStats)
+	}
+
+	return stats
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/mint/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetMinterAllowance returns the remaining amount of the given denom the
+// minter is allowed to mint. The boolean is false if the minter is not
+// registered for the denom.
+func (k Keeper) GetMinterAllowance(ctx sdk.Context, minter sdk.AccAddress, denom string) (math.Int, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMinterAllowance)
+
+	bz := store.Get(types.MinterAllowanceKey(minter, denom))
+	if bz == nil {
+		return math.ZeroInt(), false
+	}
+
+	var allowance types.MinterAllowance
+	k.cdc.MustUnmarshal(bz, &allowance)
+
+	return allowance.Value, true
+}
+
+// SetMinterAllowance registers the minter for the given denom, replacing any
+// previous allowance. The canonical denoms of IBC mint routes have no minters.
+func (k Keeper) SetMinterAllowance(ctx sdk.Context, minter sdk.AccAddress, denom string, value math.Int) error {
+	allowance := types.NewMinterAllowance(minter, denom, value)
+	if err := allowance.Validate(); err != nil {
+		return err
+	}
+
+	if err := k.CheckNotIBCMintRouteDenom(ctx, denom); err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMinterAllowance)
+	store.Set(types.MinterAllowanceKey(minter, denom), k.cdc.MustMarshal(&allowance))
+
+	return nil
+}
+
+// DeleteMinterAllowance removes the minter for the given denom.
+func (k Keeper) DeleteMinterAllowance(ctx sdk.Context, minter sdk.AccAddress, denom string) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMinterAllowance)
+
+	key := types.MinterAllowanceKey(minter, denom)
+	if !store.Has(key) {
+		return errorsmod.Wrapf(types.ErrMinterNotFound, "minter %s is not registered for denom %s", minter, denom)
+	}
+
+	store.Delete(key)
+
+	return nil
+}
+
+// SpendMinterAllowance decreases the allowance of the minter for the given
+// denom by the given amount. It fails if the minter is not registered for the
+// denom or the remaining allowance is not enough.
+func (k Keeper) SpendMinterAllowance(ctx sdk.Context, minter sdk.AccAddress, denom string, amount math.Int) error {
+	allowance, found := k.GetMinterAllowance(ctx, minter, denom)
+	if !found {
+		return errorsmod.Wrapf(types.ErrMinterNotFound, "minter %s is not registered for denom %s", minter, denom)
+	}
+
+	if allowance.LT(amount) {
+		return errorsmod.Wrapf(
+			types.ErrInsufficientMinterAllowance,
+			"minter %s has %s%s left, requested %s%s", minter, allowance, denom, amount, denom,
+		)
+	}
+
+	return k.SetMinterAllowance(ctx, minter, denom, allowance.Sub(amount))
+}
+
+// GetMinterAllowances returns all the registered minter allowances.
+func (k Keeper) GetMinterAllowances(ctx sdk.Context) []types.MinterAllowance {
+	allowances := []types.MinterAllowance{}
+
+	k.IterateMinterAllowances(ctx, func(allowance types.MinterAllowance) (stop bool) {
+		allowances = append(allowances, allowance)
+		return false
+	})
+
+	return allowances
+}
+
+// IterateMinterAllowances iterates through all the registered minter
+// allowances.
+func (k Keeper) IterateMinterAllowances(
+	ctx sdk.Context,
+	cb func(allowance types.MinterAllowance) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixMinterAllowance)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var allowance types.MinterAllowance
+		k.cdc.MustUnmarshal(iterator.Value(), &allowance)
+
+		if cb(allowance) {
+			break
+		}
+	}
+}
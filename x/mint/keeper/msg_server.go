@@ -0,0 +1,269 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/evm/x/mint/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// UpdateMintAuthority implements the gRPC MsgServer interface. When an
+// UpdateMintAuthority proposal passes, it rotates the account allowed to mint
+// through the mint precompile. The update can only be performed if the
+// requested authority is the Cosmos SDK governance module account, and is
+// rejected while the mint authority is fixed by the app.
+func (k *Keeper) UpdateMintAuthority(goCtx context.Context, req *types.MsgUpdateMintAuthority) (*types.MsgUpdateMintAuthorityResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	if k.IsMintAuthorityFixed() {
+		return nil, errorsmod.Wrapf(types.ErrMintAuthorityFixed, "mint authority is fixed to %s", k.fixedMintAuthority.String())
+	}
+
+	if err := types.ValidateMintAuthority(req.MintAuthority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var mintAuthority sdk.AccAddress
+	if req.MintAuthority != "" {
+		mintAuthority = sdk.MustAccAddressFromBech32(req.MintAuthority)
+	}
+	k.SetMintAuthority(ctx, mintAuthority)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateMintAuthority,
+			sdk.NewAttribute(types.AttributeKeyMintAuthority, req.MintAuthority),
+		),
+	)
+
+	return &types.MsgUpdateMintAuthorityResponse{}, nil
+}
+
+// SetDenomMintLimit implements the gRPC MsgServer interface. When a
+// SetDenomMintLimit proposal passes, it sets the supply cap and the rate limit
+// enforced on the mints of a denom. A limit with both values set to zero removes
+// them. The update can only be performed if the requested authority is the
+// Cosmos SDK governance module account.
+func (k *Keeper) SetDenomMintLimit(goCtx context.Context, req *types.MsgSetDenomMintLimit) (*types.MsgSetDenomMintLimitResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.UpdateDenomMintLimit(ctx, req.Limit); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetDenomMintLimit,
+			sdk.NewAttribute(types.AttributeKeyDenom, req.Limit.Denom),
+			sdk.NewAttribute(types.AttributeKeyMaxSupply, req.Limit.MaxSupply.String()),
+			sdk.NewAttribute(types.AttributeKeyRateLimit, req.Limit.RateLimit.String()),
+			sdk.NewAttribute(types.AttributeKeyRateLimitWindow, strconv.FormatUint(req.Limit.RateLimitWindow, 10)),
+		),
+	)
+
+	return &types.MsgSetDenomMintLimitResponse{}, nil
+}
+
+// UpdateGuardian implements the gRPC MsgServer interface. When an
+// UpdateGuardian proposal passes, it rotates the account allowed to pause
+// minting and burning through the mint precompile. The update can only be
+// performed if the requested authority is the Cosmos SDK governance module
+// account.
+func (k *Keeper) UpdateGuardian(goCtx context.Context, req *types.MsgUpdateGuardian) (*types.MsgUpdateGuardianResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	if err := types.ValidateGuardian(req.Guardian); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var guardian sdk.AccAddress
+	if req.Guardian != "" {
+		guardian = sdk.MustAccAddressFromBech32(req.Guardian)
+	}
+	k.SetGuardian(ctx, guardian)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateGuardian,
+			sdk.NewAttribute(types.AttributeKeyGuardian, req.Guardian),
+		),
+	)
+
+	return &types.MsgUpdateGuardianResponse{}, nil
+}
+
+// Unpause implements the gRPC MsgServer interface. When an Unpause proposal
+// passes, it lifts the pause of a denom, or every pause if no denom is given.
+// The update can only be performed if the requested authority is the Cosmos
+// SDK governance module account.
+func (k *Keeper) Unpause(goCtx context.Context, req *types.MsgUnpause) (*types.MsgUnpauseResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.LiftPause(ctx, req.Denom); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnpause,
+			sdk.NewAttribute(types.AttributeKeyDenom, req.Denom),
+		),
+	)
+
+	return &types.MsgUnpauseResponse{}, nil
+}
+
+// SetIBCMintRoute implements the gRPC MsgServer interface. When a
+// SetIBCMintRoute proposal passes, it sets the canonical denom minted for the
+// tokens received over an IBC channel, or removes the route if no canonical
+// denom is given. A denom that is not routed yet must not have a supply to
+// become a canonical denom. The update can only be performed if the requested
+// authority is the Cosmos SDK governance module account.
+func (k *Keeper) SetIBCMintRoute(goCtx context.Context, req *types.MsgSetIBCMintRoute) (*types.MsgSetIBCMintRouteResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the supply of a denom that is not routed yet was minted otherwise, and
+	// could be converted into the vouchers escrowed through the route
+	if !req.Route.IsEmpty() && !k.IsIBCMintRouteDenom(ctx, req.Route.CanonicalDenom) {
+		if supply := k.bankKeeper.GetSupply(ctx, req.Route.CanonicalDenom); !supply.IsZero() {
+			return nil, errorsmod.Wrapf(
+				types.ErrInvalidIBCMintRoute,
+				"canonical denom %s already has a supply of %s", req.Route.CanonicalDenom, supply.Amount,
+			)
+		}
+	}
+
+	if err := k.UpdateIBCMintRoute(ctx, req.Route); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetIBCMintRoute,
+			sdk.NewAttribute(types.AttributeKeyChannelID, req.Route.ChannelID),
+			sdk.NewAttribute(types.AttributeKeyBaseDenom, req.Route.BaseDenom),
+			sdk.NewAttribute(types.AttributeKeyCanonicalDenom, req.Route.CanonicalDenom),
+		),
+	)
+
+	return &types.MsgSetIBCMintRouteResponse{}, nil
+}
+
+// SetMintApprovalConfig implements the gRPC MsgServer interface. After a
+// successful governance vote it sets the approvers of mint proposals and the
+// mints that require one.
+func (k *Keeper) SetMintApprovalConfig(goCtx context.Context, req *types.MsgSetMintApprovalConfig) (*types.MsgSetMintApprovalConfigResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.UpdateMintApprovalConfig(ctx, req.Config); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetMintApprovalConfig,
+			sdk.NewAttribute(types.AttributeKeyApprovers, strings.Join(req.Config.Approvers, ",")),
+			sdk.NewAttribute(types.AttributeKeyThreshold, strconv.FormatUint(uint64(req.Config.Threshold), 10)),
+		),
+	)
+
+	return &types.MsgSetMintApprovalConfigResponse{}, nil
+}
+
+// CreateEmissionSchedule implements the gRPC MsgServer interface. After a
+// successful governance vote it registers an emission schedule, which the
+// BeginBlocker mints from its start height on.
+func (k *Keeper) CreateEmissionSchedule(goCtx context.Context, req *types.MsgCreateEmissionSchedule) (*types.MsgCreateEmissionScheduleResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	schedule, err := k.AddEmissionSchedule(ctx, req.Schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateEmissionSchedule,
+			sdk.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(schedule.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyDenom, schedule.Denom),
+			sdk.NewAttribute(types.AttributeKeyRecipient, schedule.Recipient),
+			sdk.NewAttribute(types.AttributeKeyCurve, schedule.Curve.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, schedule.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyStartHeight, strconv.FormatUint(schedule.StartHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyEndHeight, strconv.FormatUint(schedule.EndHeight, 10)),
+		),
+	)
+
+	return &types.MsgCreateEmissionScheduleResponse{Id: schedule.ID}, nil
+}
+
+// CancelEmissionSchedule implements the gRPC MsgServer interface. After a
+// successful governance vote it removes an emission schedule. The tokens it
+// already emitted are kept by the recipient.
+func (k *Keeper) CancelEmissionSchedule(goCtx context.Context, req *types.MsgCancelEmissionSchedule) (*types.MsgCancelEmissionScheduleResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.DeleteEmissionSchedule(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelEmissionSchedule,
+			sdk.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(req.Id, 10)),
+		),
+	)
+
+	return &types.MsgCancelEmissionScheduleResponse{}, nil
+}
+
+// UpdateParams implements the gRPC MsgServer interface. After a successful governance vote
+// it updates the parameters in the keeper only if the requested authority
+// is the Cosmos SDK governance module account
+func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
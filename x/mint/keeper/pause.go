@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/mint/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetGuardian returns the account allowed to pause minting and burning
+// through the mint precompile. It returns an empty address if no guardian is
+// set.
+func (k Keeper) GetGuardian(ctx sdk.Context) sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.AccAddress(store.Get(types.KeyPrefixGuardian))
+}
+
+// SetGuardian sets the account allowed to pause minting and burning through
+// the mint precompile. Passing an empty address removes the guardian.
+func (k Keeper) SetGuardian(ctx sdk.Context, guardian sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	if guardian.Empty() {
+		store.Delete(types.KeyPrefixGuardian)
+		return
+	}
+
+	store.Set(types.KeyPrefixGuardian, guardian.Bytes())
+}
+
+// IsGuardian returns true if the given address is the current guardian.
+func (k Keeper) IsGuardian(ctx sdk.Context, addr sdk.AccAddress) bool {
+	guardian := k.GetGuardian(ctx)
+	return !guardian.Empty() && guardian.Equals(addr)
+}
+
+// IsPaused returns true if minting and burning of all denoms is paused.
+func (k Keeper) IsPaused(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyPrefixPaused)
+}
+
+// SetPaused pauses or resumes minting and burning of all denoms. Resuming
+// keeps the pauses of single denoms in place.
+func (k Keeper) SetPaused(ctx sdk.Context, paused bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !paused {
+		store.Delete(types.KeyPrefixPaused)
+		return
+	}
+
+	store.Set(types.KeyPrefixPaused, []byte{1})
+}
+
+// IsDenomPaused returns true if minting and burning of the given denom is
+// paused, either on its own or because everything is paused.
+func (k Keeper) IsDenomPaused(ctx sdk.Context, denom string) bool {
+	if k.IsPaused(ctx) {
+		return true
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPausedDenom)
+	return store.Has([]byte(denom))
+}
+
+// SetDenomPaused pauses or resumes minting and burning of a single denom.
+func (k Keeper) SetDenomPaused(ctx sdk.Context, denom string, paused bool) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPausedDenom)
+	if !paused {
+		store.Delete([]byte(denom))
+		return nil
+	}
+
+	store.Set([]byte(denom), []byte{1})
+
+	return nil
+}
+
+// GetPausedDenoms returns the denoms paused on their own.
+func (k Keeper) GetPausedDenoms(ctx sdk.Context) []string {
+	denoms := []string{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixPausedDenom)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()[len(types.KeyPrefixPausedDenom):]))
+	}
+
+	return denoms
+}
+
+// LiftPause lifts the pause of the given denom. An empty denom lifts the global
+// pause and the pauses of every denom.
+func (k Keeper) LiftPause(ctx sdk.Context, denom string) error {
+	if denom != "" {
+		return k.SetDenomPaused(ctx, denom, false)
+	}
+
+	k.SetPaused(ctx, false)
+	for _, pausedDenom := range k.GetPausedDenoms(ctx) {
+		if err := k.SetDenomPaused(ctx, pausedDenom, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CheckNotPaused returns an error if minting and burning of the given denom is
+// paused.
+func (k Keeper) CheckNotPaused(ctx sdk.Context, denom string) error {
+	if k.IsDenomPaused(ctx, denom) {
+		return errorsmod.Wrapf(types.ErrPaused, "denom %s", denom)
+	}
+
+	return nil
+}
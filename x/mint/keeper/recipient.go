@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/mint/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// ValidateRecipient returns an error if the address must not receive newly
+// minted coins of the given denom. Minting to any of these addresses would
+// lock the coins for good or bypass the bank module restrictions:
+//   - the zero address
+//   - the mint module account and any other module account
+//   - the addresses blocked by the bank module
+//   - the addresses in the blocked_recipients param
+//   - the active static precompiles
+//   - smart contracts, unless the denom is in the contract_recipient_denoms param
+func (k Keeper) ValidateRecipient(ctx sdk.Context, recipient sdk.AccAddress, denom string) error {
+	hexAddr := common.BytesToAddress(recipient)
+	if hexAddr == (common.Address{}) {
+		return errorsmod.Wrap(types.ErrInvalidRecipient, "cannot mint to the zero address")
+	}
+
+	if recipient.Equals(authtypes.NewModuleAddress(types.ModuleName)) {
+		return errorsmod.Wrap(types.ErrInvalidRecipient, "cannot mint to the mint module account")
+	}
+
+	if acc := k.accountKeeper.GetAccount(ctx, recipient); acc != nil {
+		if _, isModuleAccount := acc.(sdk.ModuleAccountI); isModuleAccount {
+			return errorsmod.Wrapf(types.ErrInvalidRecipient, "cannot mint to module account %s", recipient)
+		}
+	}
+
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return errorsmod.Wrapf(types.ErrInvalidRecipient, "%s is not allowed to receive funds", recipient)
+	}
+
+	params := k.GetParams(ctx)
+	if params.IsBlockedRecipient(recipient) {
+		return errorsmod.Wrapf(types.ErrInvalidRecipient, "%s is blocked from receiving minted tokens", recipient)
+	}
+
+	evmParams := k.evmKeeper.GetParams(ctx)
+	if k.evmKeeper.IsAvailableStaticPrecompile(&evmParams, hexAddr) {
+		return errorsmod.Wrapf(types.ErrInvalidRecipient, "cannot mint to precompile %s", hexAddr)
+	}
+
+	if !params.AllowsContractRecipient(denom) && k.evmKeeper.IsContract(ctx, hexAddr) {
+		return errorsmod.Wrapf(types.ErrInvalidRecipient, "%s cannot be minted to contract %s", denom, hexAddr)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/mint/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// AddContinuousVesting locks coins the account already holds in a continuous
+// vesting schedule from start to end. A plain account is converted into a
+// continuous vesting account, keeping its number, sequence and public key. A
+// continuous vesting account is extended if it has the same schedule.
+func (k Keeper) AddContinuousVesting(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins, start, end int64) error {
+	if start >= end {
+		return errorsmod.Wrapf(types.ErrInvalidVestingSchedule, "start time %d must be before end time %d", start, end)
+	}
+
+	acc, err := k.getVestingRecipient(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	var vestingAcc *vestingtypes.ContinuousVestingAccount
+	switch acc := acc.(type) {
+	case *authtypes.BaseAccount:
+		bva, err := vestingtypes.NewBaseVestingAccount(acc, coins, end)
+		if err != nil {
+			return errorsmod.Wrap(types.ErrInvalidVestingSchedule, err.Error())
+		}
+		vestingAcc = vestingtypes.NewContinuousVestingAccountRaw(bva, start)
+	case *vestingtypes.ContinuousVestingAccount:
+		if acc.StartTime != start || acc.EndTime != end {
+			return errorsmod.Wrapf(
+				types.ErrInvalidVestingSchedule,
+				"account %s vests from %d to %d, cannot extend it from %d to %d", addr, acc.StartTime, acc.EndTime, start, end,
+			)
+		}
+		acc.OriginalVesting = acc.OriginalVesting.Add(coins...)
+		vestingAcc = acc
+	default:
+		return errorsmod.Wrapf(types.ErrInvalidVestingSchedule, "account %s cannot receive a continuous vesting schedule", addr)
+	}
+
+	return k.setVestingAccount(ctx, vestingAcc)
+}
+
+// AddPeriodicVesting locks coins the account already holds in a periodic
+// vesting schedule starting at start. A plain account is converted into a
+// periodic vesting account, keeping its number, sequence and public key. The
+// schedule of a periodic vesting account is merged with the new one.
+func (k Keeper) AddPeriodicVesting(ctx sdk.Context, addr sdk.AccAddress, start int64, periods vestingtypes.Periods) error {
+	acc, err := k.getVestingRecipient(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	coins := periods.TotalAmount()
+
+	var vestingAcc *vestingtypes.PeriodicVestingAccount
+	switch acc := acc.(type) {
+	case *authtypes.BaseAccount:
+		bva, err := vestingtypes.NewBaseVestingAccount(acc, coins, start+periods.TotalLength())
+		if err != nil {
+			return errorsmod.Wrap(types.ErrInvalidVestingSchedule, err.Error())
+		}
+		vestingAcc = vestingtypes.NewPeriodicVestingAccountRaw(bva, start, periods)
+	case *vestingtypes.PeriodicVestingAccount:
+		acc.StartTime, acc.VestingPeriods = types.MergeVestingPeriods(acc.StartTime, acc.VestingPeriods, start, periods)
+		acc.EndTime = max(acc.EndTime, start+periods.TotalLength())
+		acc.OriginalVesting = acc.OriginalVesting.Add(coins...)
+		vestingAcc = acc
+	default:
+		return errorsmod.Wrapf(types.ErrInvalidVestingSchedule, "account %s cannot receive a periodic vesting schedule", addr)
+	}
+
+	return k.setVestingAccount(ctx, vestingAcc)
+}
+
+// getVestingRecipient returns the account that receives a vesting schedule.
+// Smart contracts cannot receive one.
+func (k Keeper) getVestingRecipient(ctx sdk.Context, addr sdk.AccAddress) (sdk.AccountI, error) {
+	if k.evmKeeper.IsContract(ctx, common.BytesToAddress(addr)) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidVestingSchedule, "contract %s cannot receive a vesting schedule", addr)
+	}
+
+	acc := k.accountKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidVestingSchedule, "account %s does not exist", addr)
+	}
+
+	return acc, nil
+}
+
+// setVestingAccount validates and stores a vesting account.
+func (k Keeper) setVestingAccount(ctx sdk.Context, acc sdk.AccountI) error {
+	if err := acc.(interface{ Validate() error }).Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidVestingSchedule, err.Error())
+	}
+
+	k.accountKeeper.SetAccount(ctx, acc)
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/mint/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsVoucherNonceUsed returns true if a mint voucher with the given nonce
+// signed by the given minter was already redeemed.
+func (k Keeper) IsVoucherNonceUsed(ctx sdk.Context, minter sdk.AccAddress, nonce math.Int) bool {
+	if err := types.ValidateVoucherNonce(nonce); err != nil {
+		return false
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUsedVoucherNonce)
+	return store.Has(types.UsedVoucherNonceKey(minter, nonce.BigInt()))
+}
+
+// UseVoucherNonce marks the given nonce of a mint voucher signed by the given
+// minter as used. It fails if the nonce was already used.
+func (k Keeper) UseVoucherNonce(ctx sdk.Context, minter sdk.AccAddress, nonce math.Int) error {
+	if k.IsVoucherNonceUsed(ctx, minter, nonce) {
+		return errorsmod.Wrapf(types.ErrVoucherNonceUsed, "minter %s, nonce %s", minter, nonce)
+	}
+
+	return k.SetUsedVoucherNonce(ctx, types.NewUsedVoucherNonce(minter, nonce))
+}
+
+// SetUsedVoucherNonce stores a used nonce of a mint voucher.
+func (k Keeper) SetUsedVoucherNonce(ctx sdk.Context, nonce types.UsedVoucherNonce) error {
+	if err := nonce.Validate(); err != nil {
+		return err
+	}
+
+	minter := sdk.MustAccAddressFromBech32(nonce.Minter)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUsedVoucherNonce)
+	store.Set(types.UsedVoucherNonceKey(minter, nonce.Nonce.BigInt()), k.cdc.MustMarshal(&nonce))
+
+	return nil
+}
+
+// GetUsedVoucherNonces returns the used nonces of the mint vouchers of all
+// minters.
+func (k Keeper) GetUsedVoucherNonces(ctx sdk.Context) []types.UsedVoucherNonce {
+	nonces := []types.UsedVoucherNonce{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixUsedVoucherNonce)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var nonce types.UsedVoucherNonce
+		k.cdc.MustUnmarshal(iterator.Value(), &nonce)
+		nonces = append(nonces, nonce)
+	}
+
+	return nonces
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global mint module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding.
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// AminoCdc is an amino codec created to support amino JSON compatible msgs.
+	AminoCdc = codec.NewAminoCodec(amino) //nolint:staticcheck
+)
+
+const (
+	// Amino names
+	updateMintAuthorityName = "cosmos/evm/x/mint/MsgUpdateMintAuthority"
+	setDenomMintLimitName   = "cosmos/evm/x/mint/MsgSetDenomMintLimit"
+	updateGuardianName      = "cosmos/evm/x/mint/MsgUpdateGuardian"
+	unpauseName             = "cosmos/evm/x/mint/MsgUnpause"
+	setIBCMintRouteName     = "cosmos/evm/x/mint/MsgSetIBCMintRoute"
+	setMintApprovalName     = "cosmos/evm/x/mint/MsgSetMintApprovalConfig"
+	createEmissionName      = "cosmos/evm/x/mint/MsgCreateEmissionSchedule"
+	cancelEmissionName      = "cosmos/evm/x/mint/MsgCancelEmissionSchedule"
+	updateParamsName        = "cosmos/evm/x/mint/MsgUpdateParams"
+)
+
+// NOTE: This is required for the GetSignBytes function
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
+// RegisterInterfaces registers the client interfaces to protobuf Any.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgUpdateMintAuthority{},
+		&MsgSetDenomMintLimit{},
+		&MsgUpdateGuardian{},
+		&MsgUnpause{},
+		&MsgSetIBCMintRoute{},
+		&MsgSetMintApprovalConfig{},
+		&MsgCreateEmissionSchedule{},
+		&MsgCancelEmissionSchedule{},
+		&MsgUpdateParams{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// RegisterLegacyAminoCodec required for EIP-712
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgUpdateMintAuthority{}, updateMintAuthorityName, nil)
+	cdc.RegisterConcrete(&MsgSetDenomMintLimit{}, setDenomMintLimitName, nil)
+	cdc.RegisterConcrete(&MsgUpdateGuardian{}, updateGuardianName, nil)
+	cdc.RegisterConcrete(&MsgUnpause{}, unpauseName, nil)
+	cdc.RegisterConcrete(&MsgSetIBCMintRoute{}, setIBCMintRouteName, nil)
+	cdc.RegisterConcrete(&MsgSetMintApprovalConfig{}, setMintApprovalName, nil)
+	cdc.RegisterConcrete(&MsgCreateEmissionSchedule{}, createEmissionName, nil)
+	cdc.RegisterConcrete(&MsgCancelEmissionSchedule{}, cancelEmissionName, nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+}
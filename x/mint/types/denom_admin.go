@@ -0,0 +1,110 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// FactoryDenomPrefix is the first part of the denoms created through the
+	// mint precompile, namespaced as factory/{creator}/{subdenom}.
+	FactoryDenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of the subdenom of a created
+	// denom.
+	MaxSubdenomLength = 44
+)
+
+// BuildFactoryDenom returns the denom created by the given creator with the
+// given subdenom, as factory/{creator}/{subdenom}.
+func BuildFactoryDenom(creator sdk.AccAddress, subdenom string) (string, error) {
+	if creator.Empty() {
+		return "", errorsmod.Wrap(errortypes.ErrInvalidAddress, "creator cannot be empty")
+	}
+
+	if subdenom == "" || len(subdenom) > MaxSubdenomLength {
+		return "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "subdenom length must be between 1 and %d, got %d", MaxSubdenomLength, len(subdenom))
+	}
+
+	denom := strings.Join([]string{FactoryDenomPrefix, creator.String(), subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", errorsmod.Wrap(ErrInvalidFactoryDenom, err.Error())
+	}
+
+	return denom, nil
+}
+
+// ParseFactoryDenom returns the creator and the subdenom of a denom created
+// through the mint precompile.
+func ParseFactoryDenom(denom string) (sdk.AccAddress, string, error) {
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) != 3 || parts[0] != FactoryDenomPrefix {
+		return nil, "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "denom %s is not of the form %s/{creator}/{subdenom}", denom, FactoryDenomPrefix)
+	}
+
+	creator, err := sdk.AccAddressFromBech32(parts[1])
+	if err != nil {
+		return nil, "", errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid creator address %s", parts[1])
+	}
+
+	// the creator must be in its canonical bech32 form, so that a denom has a
+	// single spelling
+	built, err := BuildFactoryDenom(creator, parts[2])
+	if err != nil {
+		return nil, "", err
+	}
+	if built != denom {
+		return nil, "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "denom %s does not match the creator address %s", denom, creator)
+	}
+
+	return creator, parts[2], nil
+}
+
+// IsFactoryDenom returns true if the denom is in the namespace of the denoms
+// created through the mint precompile. It does not check that the denom was
+// created.
+func IsFactoryDenom(denom string) bool {
+	return strings.HasPrefix(denom, FactoryDenomPrefix+"/")
+}
+
+// NewDenomAdmin returns the given admin of a created denom. An empty admin
+// means the admin was renounced.
+func NewDenomAdmin(denom string, admin sdk.AccAddress) DenomAdmin {
+	denomAdmin := DenomAdmin{Denom: denom}
+	if !admin.Empty() {
+		denomAdmin.Admin = admin.String()
+	}
+
+	return denomAdmin
+}
+
+// Validate performs a stateless validation of the denom admin.
+func (a DenomAdmin) Validate() error {
+	if _, _, err := ParseFactoryDenom(a.Denom); err != nil {
+		return err
+	}
+
+	if a.Admin == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(a.Admin); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid admin address %s", a.Admin)
+	}
+
+	return nil
+}
+
+// GetAdminAddress returns the address of the admin, or an empty address if the
+// admin was renounced.
+func (a DenomAdmin) GetAdminAddress() sdk.AccAddress {
+	if a.Admin == "" {
+		return nil
+	}
+
+	return sdk.MustAccAddressFromBech32(a.Admin)
+}
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestBuildAndParseFactoryDenom(t *testing.T) {
+	creator := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	testCases := []struct {
+		name     string
+		subdenom string
+		expPass  bool
+	}{
+		{"pass - simple subdenom", "team", true},
+		{"pass - subdenom with slashes", "team/token", true},
+		{"pass - max length subdenom", strings.Repeat("a", MaxSubdenomLength), true},
+		{"fail - empty subdenom", "", false},
+		{"fail - subdenom too long", strings.Repeat("a", MaxSubdenomLength+1), false},
+		{"fail - invalid characters", "team token", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			denom, err := BuildFactoryDenom(creator, tc.subdenom)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, FactoryDenomPrefix+"/"+creator.String()+"/"+tc.subdenom, denom)
+			require.True(t, IsFactoryDenom(denom))
+
+			parsedCreator, subdenom, err := ParseFactoryDenom(denom)
+			require.NoError(t, err)
+			require.Equal(t, creator, parsedCreator)
+			require.Equal(t, tc.subdenom, subdenom)
+		})
+	}
+
+	_, err := BuildFactoryDenom(nil, "team")
+	require.Error(t, err)
+}
+
+func TestParseFactoryDenomInvalid(t *testing.T) {
+	creator := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	for _, denom := range []string{
+		"uusdc",
+		"factory/" + creator.String(),
+		"ibc/" + creator.String() + "/team",
+		"factory/invalid/team",
+		"factory/" + strings.ToUpper(creator.String()) + "/team",
+	} {
+		_, _, err := ParseFactoryDenom(denom)
+		require.Error(t, err, denom)
+	}
+}
+
+func TestDenomAdminValidate(t *testing.T) {
+	admin := authtypes.NewModuleAddress(govtypes.ModuleName)
+	denom, err := BuildFactoryDenom(admin, "team")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		denomAdmin DenomAdmin
+		expPass    bool
+	}{
+		{
+			"pass - admin",
+			NewDenomAdmin(denom, admin),
+			true,
+		},
+		{
+			"pass - renounced admin",
+			NewDenomAdmin(denom, nil),
+			true,
+		},
+		{
+			"fail - denom outside the factory namespace",
+			NewDenomAdmin("uusdc", admin),
+			false,
+		},
+		{
+			"fail - invalid admin",
+			DenomAdmin{Denom: denom, Admin: "invalid"},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.denomAdmin.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	require.Equal(t, admin, NewDenomAdmin(denom, admin).GetAdminAddress())
+	require.True(t, NewDenomAdmin(denom, nil).GetAdminAddress().Empty())
+}
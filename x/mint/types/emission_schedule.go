@@ -0,0 +1,168 @@
+package types
+
+import (
+	"math/big"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultNextEmissionScheduleID is the identifier of the first emission
+// schedule.
+const DefaultNextEmissionScheduleID uint64 = 1
+
+// MaxEmissionScheduleFailures is the number of consecutive blocks in which the
+// mint of an emission schedule can fail before the schedule is removed.
+const MaxEmissionScheduleFailures uint32 = 100
+
+// ParseEmissionCurve returns the emission curve with the given name, either in
+// its short form, e.g. linear, or its full enum name, e.g.
+// EMISSION_CURVE_LINEAR.
+func ParseEmissionCurve(name string) (EmissionCurve, error) {
+	name = strings.ToUpper(name)
+	if !strings.HasPrefix(name, "EMISSION_CURVE_") {
+		name = "EMISSION_CURVE_" + name
+	}
+
+	curve, ok := EmissionCurve_value[name]
+	if !ok || EmissionCurve(curve) == EmissionCurveUnspecified {
+		return EmissionCurveUnspecified, errorsmod.Wrapf(ErrInvalidEmissionSchedule, "unsupported emission curve %s", name)
+	}
+
+	return EmissionCurve(curve), nil
+}
+
+// NewEmissionSchedule returns an emission schedule of the denom to the
+// recipient from the start height to the end height, that has not emitted
+// anything yet.
+func NewEmissionSchedule(
+	id uint64,
+	denom string,
+	recipient sdk.AccAddress,
+	curve EmissionCurve,
+	amount math.Int,
+	startHeight, endHeight, halvingInterval uint64,
+) EmissionSchedule {
+	return EmissionSchedule{
+		ID:              id,
+		Denom:           denom,
+		Recipient:       recipient.String(),
+		Curve:           curve,
+		Amount:          amount,
+		StartHeight:     startHeight,
+		EndHeight:       endHeight,
+		HalvingInterval: halvingInterval,
+		Emitted:         math.ZeroInt(),
+	}
+}
+
+// Validate performs a stateless validation of the emission schedule. The
+// identifier is not checked, as it is assigned when the schedule is created.
+func (s EmissionSchedule) Validate() error {
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidEmissionSchedule, err.Error())
+	}
+
+	if _, err := sdk.AccAddressFromBech32(s.Recipient); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid recipient address %s", s.Recipient)
+	}
+
+	if s.Amount.IsNil() || !s.Amount.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidEmissionSchedule, "amount must be positive, got %s", s.Amount)
+	}
+
+	if s.StartHeight == 0 {
+		return errorsmod.Wrap(ErrInvalidEmissionSchedule, "start height must be positive")
+	}
+
+	if s.EndHeight < s.StartHeight {
+		return errorsmod.Wrapf(ErrInvalidEmissionSchedule, "end height %d must not be before start height %d", s.EndHeight, s.StartHeight)
+	}
+
+	switch s.Curve {
+	case EmissionCurveLinear:
+		if s.HalvingInterval != 0 {
+			return errorsmod.Wrapf(ErrInvalidEmissionSchedule, "halving interval must be zero for the linear curve, got %d", s.HalvingInterval)
+		}
+	case EmissionCurveHalving:
+		if s.HalvingInterval == 0 {
+			return errorsmod.Wrap(ErrInvalidEmissionSchedule, "halving interval must be positive for the halving curve")
+		}
+	default:
+		return errorsmod.Wrapf(ErrInvalidEmissionSchedule, "unsupported emission curve %s", s.Curve)
+	}
+
+	total := s.emittedAt(s.EndHeight)
+	if total.BitLen() > math.MaxBitLen {
+		return errorsmod.Wrapf(ErrInvalidEmissionSchedule, "total emission %s is out of range", total)
+	}
+
+	if s.Emitted.IsNil() || s.Emitted.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidEmissionSchedule, "emitted amount must not be negative, got %s", s.Emitted)
+	}
+
+	if s.Emitted.BigInt().Cmp(total) > 0 {
+		return errorsmod.Wrapf(ErrInvalidEmissionSchedule, "emitted amount %s exceeds the total emission %s", s.Emitted, total)
+	}
+
+	return nil
+}
+
+// EmittedAt returns the total amount the schedule is due to have emitted by the
+// end of the given height. The schedule must be valid.
+func (s EmissionSchedule) EmittedAt(height uint64) math.Int {
+	return math.NewIntFromBigInt(s.emittedAt(height))
+}
+
+// Total returns the total amount the schedule emits from its start to its end
+// height. The schedule must be valid.
+func (s EmissionSchedule) Total() math.Int {
+	return s.EmittedAt(s.EndHeight)
+}
+
+// Remaining returns the amount the schedule has left to emit. The schedule must
+// be valid.
+func (s EmissionSchedule) Remaining() math.Int {
+	return s.Total().Sub(s.Emitted)
+}
+
+// emittedAt computes the cumulative emission of the schedule up to the given
+// height. The linear curve spreads the amount evenly over the blocks of the
+// schedule, rounding down, so that the full amount is emitted at the end
+// height. The halving curve emits the amount each block of the first interval
+// and halves it every interval, until the end height.
+func (s EmissionSchedule) emittedAt(height uint64) *big.Int {
+	if height < s.StartHeight {
+		return new(big.Int)
+	}
+
+	height = min(height, s.EndHeight)
+	blocks := new(big.Int).SetUint64(height - s.StartHeight + 1)
+	amount := s.Amount.BigInt()
+
+	switch s.Curve {
+	case EmissionCurveLinear:
+		total := new(big.Int).SetUint64(s.EndHeight - s.StartHeight + 1)
+		return blocks.Mul(blocks, amount).Quo(blocks, total)
+	case EmissionCurveHalving:
+		interval := new(big.Int).SetUint64(s.HalvingInterval)
+		elapsed := height - s.StartHeight
+		periods := elapsed / s.HalvingInterval
+
+		emitted := new(big.Int)
+		perBlock := amount
+		for k := uint64(0); k < periods && perBlock.Sign() > 0; k++ {
+			emitted.Add(emitted, new(big.Int).Mul(perBlock, interval))
+			perBlock = new(big.Int).Rsh(perBlock, 1)
+		}
+
+		partial := new(big.Int).SetUint64(elapsed%s.HalvingInterval + 1)
+		return emitted.Add(emitted, partial.Mul(partial, perBlock))
+	default:
+		return new(big.Int)
+	}
+}
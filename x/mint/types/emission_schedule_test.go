@@ -0,0 +1,182 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestEmissionScheduleValidate(t *testing.T) {
+	recipient := authtypes.NewModuleAddress("recipient")
+
+	emitted := func(schedule EmissionSchedule, amount math.Int) EmissionSchedule {
+		schedule.Emitted = amount
+		return schedule
+	}
+
+	testCases := []struct {
+		name     string
+		schedule EmissionSchedule
+		expError bool
+	}{
+		{
+			name:     "valid linear schedule",
+			schedule: NewEmissionSchedule(1, "uusdc", recipient, EmissionCurveLinear, math.NewInt(1000), 10, 19, 0),
+		},
+		{
+			name:     "valid halving schedule",
+			schedule: NewEmissionSchedule(1, "uusdc", recipient, EmissionCurveHalving, math.NewInt(100), 10, 1000, 50),
+		},
+		{
+			name:     "valid single block schedule",
+			schedule: NewEmissionSchedule(1, "uusdc", recipient, EmissionCurveLinear, math.NewInt(1000), 10, 10, 0),
+		},
+		{
+			name:     "fully emitted",
+			schedule: emitted(NewEmissionSchedule(1, "uusdc", recipient, EmissionCurveLinear, math.NewInt(1000), 10, 19, 0), math.NewInt(1000)),
+		},
+		{
+			name:     "invalid denom",
+			schedule: NewEmissionSchedule(1, "1uusdc", recipient, EmissionCurveLinear, math.NewInt(1000), 10, 19, 0),
+			expError: true,
+		},
+		{
+			name: "invalid recipient",
+			schedule: EmissionSchedule{
+				ID: 1, Denom: "uusdc", Recipient: "invalid", Curve: EmissionCurveLinear,
+				Amount: math.NewInt(1000), StartHeight: 10, EndHeight: 19, Emitted: math.ZeroInt(),
+			},
+			expError: true,
+		},
+		{
+			name:     "unspecified curve",
+			schedule: NewEmissionSchedule(1, "uusdc", recipient, EmissionCurveUnspecified, math.NewInt(1000), 10, 19, 0),
+			expError: true,
+		},
+		{
+			name:     "zero amount",
+			schedule: NewEmissionSchedule(1, "uusdc", recipient, EmissionCurveLinear, math.ZeroInt(), 10, 19, 0),
+			expError: true,
+		},
+		{
+			name:     "zero start height",
+			schedule: NewEmissionSchedule(1, "uusdc", recipient, EmissionCurveLinear, math.NewInt(1000), 0, 19, 0),
+			expError: true,
+		},
+		{
+			name:     "end before start",
+			schedule: NewEmissionSchedule(1, "uusdc", recipient, EmissionCurveLinear, math.NewInt(1000), 20, 19, 0),
+			expError: true,
+		},
+		{
+			name:     "linear with halving interval",
+			schedule: NewEmissionSchedule(1, "uusdc", recipient, EmissionCurveLinear, math.NewInt(1000), 10, 19, 5),
+			expError: true,
+		},
+		{
+			name:     "halving without halving interval",
+			schedule: NewEmissionSchedule(1, "uusdc", recipient, EmissionCurveHalving, math.NewInt(100), 10, 19, 0),
+			expError: true,
+		},
+		{
+			name:     "total out of range",
+			schedule: NewEmissionSchedule(1, "uusdc", recipient, EmissionCurveHalving, math.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), 250)), 1, 1000, 100),
+			expError: true,
+		},
+		{
+			name:     "nil emitted",
+			schedule: emitted(NewEmissionSchedule(1, "uusdc", recipient, EmissionCurveLinear, math.NewInt(1000), 10, 19, 0), math.Int{}),
+			expError: true,
+		},
+		{
+			name:     "negative emitted",
+			schedule: emitted(NewEmissionSchedule(1, "uusdc", recipient, EmissionCurveLinear, math.NewInt(1000), 10, 19, 0), math.NewInt(-1)),
+			expError: true,
+		},
+		{
+			name:     "emitted above total",
+			schedule: emitted(NewEmissionSchedule(1, "uusdc", recipient, EmissionCurveLinear, math.NewInt(1000), 10, 19, 0), math.NewInt(1001)),
+			expError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.schedule.Validate()
+			if tc.expError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEmissionScheduleEmittedAt(t *testing.T) {
+	recipient := authtypes.NewModuleAddress("recipient")
+
+	linear := NewEmissionSchedule(1, "uusdc", recipient, EmissionCurveLinear, math.NewInt(1000), 10, 12, 0)
+	halving := NewEmissionSchedule(2, "uusdc", recipient, EmissionCurveHalving, math.NewInt(100), 10, 29, 5)
+
+	testCases := []struct {
+		name     string
+		schedule EmissionSchedule
+		height   uint64
+		expected int64
+	}{
+		{"linear - before start", linear, 9, 0},
+		{"linear - first block rounds down", linear, 10, 333},
+		{"linear - second block", linear, 11, 666},
+		{"linear - end emits the total", linear, 12, 1000},
+		{"linear - after end", linear, 100, 1000},
+		{"halving - before start", halving, 9, 0},
+		{"halving - first block", halving, 10, 100},
+		{"halving - end of first interval", halving, 14, 500},
+		{"halving - first block of second interval", halving, 15, 550},
+		{"halving - end of second interval", halving, 19, 750},
+		{"halving - end", halving, 29, 500 + 250 + 125 + 60},
+		{"halving - after end", halving, 100, 935},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, math.NewInt(tc.expected), tc.schedule.EmittedAt(tc.height))
+		})
+	}
+
+	require.Equal(t, math.NewInt(935), halving.Total())
+
+	halving.Emitted = math.NewInt(750)
+	require.Equal(t, math.NewInt(185), halving.Remaining())
+}
+
+func TestEmissionScheduleHalvingRunsOut(t *testing.T) {
+	recipient := authtypes.NewModuleAddress("recipient")
+
+	// 4, 2 and 1 per block, then nothing for the rest of the schedule
+	schedule := NewEmissionSchedule(1, "uusdc", recipient, EmissionCurveHalving, math.NewInt(4), 1, 1_000_000_000, 1)
+	require.NoError(t, schedule.Validate())
+	require.Equal(t, math.NewInt(7), schedule.Total())
+	require.Equal(t, math.NewInt(7), schedule.EmittedAt(3))
+}
+
+func TestParseEmissionCurve(t *testing.T) {
+	curve, err := ParseEmissionCurve("linear")
+	require.NoError(t, err)
+	require.Equal(t, EmissionCurveLinear, curve)
+
+	curve, err = ParseEmissionCurve("EMISSION_CURVE_HALVING")
+	require.NoError(t, err)
+	require.Equal(t, EmissionCurveHalving, curve)
+
+	_, err = ParseEmissionCurve("unspecified")
+	require.Error(t, err)
+
+	_, err = ParseEmissionCurve("exponential")
+	require.Error(t, err)
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrInvalidMintAuthority        = errorsmod.Register(ModuleName, 2, "invalid mint authority")
+	ErrInvalidMinterAllowance      = errorsmod.Register(ModuleName, 3, "invalid minter allowance")
+	ErrMinterNotFound              = errorsmod.Register(ModuleName, 4, "minter not found")
+	ErrInsufficientMinterAllowance = errorsmod.Register(ModuleName, 5, "insufficient minter allowance")
+	ErrInvalidDenomMintLimit       = errorsmod.Register(ModuleName, 6, "invalid denom mint limit")
+	ErrMaxSupplyExceeded           = errorsmod.Register(ModuleName, 7, "max supply exceeded")
+	ErrRateLimitExceeded           = errorsmod.Register(ModuleName, 8, "mint rate limit exceeded")
+	ErrInvalidRecipient            = errorsmod.Register(ModuleName, 9, "invalid mint recipient")
+	ErrInvalidMintStats            = errorsmod.Register(ModuleName, 10, "invalid mint stats")
+	ErrInvalidGuardian             = errorsmod.Register(ModuleName, 11, "invalid guardian")
+	ErrPaused                      = errorsmod.Register(ModuleName, 12, "minting and burning are paused")
+	ErrInvalidIBCMintRoute         = errorsmod.Register(ModuleName, 13, "invalid IBC mint route")
+	ErrInsufficientIBCEscrow       = errorsmod.Register(ModuleName, 14, "insufficient IBC voucher escrow")
+	ErrInvalidMintApprovalConfig   = errorsmod.Register(ModuleName, 15, "invalid mint approval config")
+	ErrInvalidMintProposal         = errorsmod.Register(ModuleName, 16, "invalid mint proposal")
+	ErrMintProposalNotFound        = errorsmod.Register(ModuleName, 17, "mint proposal not found")
+	ErrNotMintApprover             = errorsmod.Register(ModuleName, 18, "not a mint approver")
+	ErrMintProposalNotExecutable   = errorsmod.Register(ModuleName, 19, "mint proposal not executable")
+	ErrMintRequiresProposal        = errorsmod.Register(ModuleName, 20, "mint requires an approved proposal")
+	ErrInvalidVoucherNonce         = errorsmod.Register(ModuleName, 21, "invalid mint voucher nonce")
+	ErrVoucherNonceUsed            = errorsmod.Register(ModuleName, 22, "mint voucher nonce already used")
+	ErrInvalidVestingSchedule      = errorsmod.Register(ModuleName, 23, "invalid vesting schedule")
+	ErrInvalidMintRecord           = errorsmod.Register(ModuleName, 24, "invalid mint record")
+	ErrInvalidFactoryDenom         = errorsmod.Register(ModuleName, 25, "invalid factory denom")
+	ErrDenomExists                 = errorsmod.Register(ModuleName, 26, "denom already exists")
+	ErrDenomNotFound               = errorsmod.Register(ModuleName, 27, "denom not found")
+	ErrInvalidEmissionSchedule     = errorsmod.Register(ModuleName, 28, "invalid emission schedule")
+	ErrEmissionScheduleNotFound    = errorsmod.Register(ModuleName, 29, "emission schedule not found")
+	ErrMintAuthorityFixed          = errorsmod.Register(ModuleName, 30, "mint authority is fixed")
+	ErrIBCMintRouteDenom           = errorsmod.Register(ModuleName, 31, "denom is minted by an IBC mint route")
+)
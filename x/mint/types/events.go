@@ -0,0 +1,41 @@
+package types
+
+// mint events
+const (
+	EventTypeUpdateMintAuthority   = "update_mint_authority"
+	EventTypeSetDenomMintLimit     = "set_denom_mint_limit"
+	EventTypeUpdateGuardian        = "update_guardian"
+	EventTypeUnpause               = "unpause"
+	EventTypeSetIBCMintRoute       = "set_ibc_mint_route"
+	EventTypeIBCMint               = "ibc_mint"
+	EventTypeIBCBurn               = "ibc_burn"
+	EventTypeSetMintApprovalConfig = "set_mint_approval_config"
+	EventTypeExpireMintProposal    = "expire_mint_proposal"
+
+	EventTypeCreateEmissionSchedule   = "create_emission_schedule"
+	EventTypeCancelEmissionSchedule   = "cancel_emission_schedule"
+	EventTypeCompleteEmissionSchedule = "complete_emission_schedule"
+	EventTypeRemoveEmissionSchedule   = "remove_emission_schedule"
+
+	AttributeKeyMintAuthority   = "mint_authority"
+	AttributeKeyGuardian        = "guardian"
+	AttributeKeyDenom           = "denom"
+	AttributeKeyMaxSupply       = "max_supply"
+	AttributeKeyRateLimit       = "rate_limit"
+	AttributeKeyRateLimitWindow = "rate_limit_window"
+	AttributeKeyChannelID       = "channel_id"
+	AttributeKeyBaseDenom       = "base_denom"
+	AttributeKeyCanonicalDenom  = "canonical_denom"
+	AttributeKeyVoucherDenom    = "voucher_denom"
+	AttributeKeyAccount         = "account"
+	AttributeKeyAmount          = "amount"
+	AttributeKeyApprovers       = "approvers"
+	AttributeKeyThreshold       = "threshold"
+	AttributeKeyProposalID      = "proposal_id"
+	AttributeKeyScheduleID      = "schedule_id"
+	AttributeKeyRecipient       = "recipient"
+	AttributeKeyCurve           = "curve"
+	AttributeKeyStartHeight     = "start_height"
+	AttributeKeyEndHeight       = "end_height"
+	AttributeKeyError           = "error"
+)
@@ -0,0 +1,301 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(
+	mintAuthority string,
+	minterAllowances []MinterAllowance,
+	denomMintLimits []DenomMintLimit,
+	params Params,
+	denomMintStats []DenomMintStats,
+	minterMintStats []MinterMintStats,
+	guardian string,
+	paused bool,
+	pausedDenoms []string,
+	ibcMintRoutes []IBCMintRoute,
+	mintApprovalConfig MintApprovalConfig,
+	mintProposals []MintProposal,
+	nextMintProposalID uint64,
+	usedVoucherNonces []UsedVoucherNonce,
+	mintHistory []MintRecord,
+	nextMintRecordID uint64,
+	denomAdmins []DenomAdmin,
+	emissionSchedules []EmissionSchedule,
+	nextEmissionScheduleID uint64,
+) *GenesisState {
+	return &GenesisState{
+		MintAuthority:    mintAuthority,
+		MinterAllowances: minterAllowances,
+		DenomMintLimits:  denomMintLimits,
+		Params:           params,
+		DenomMintStats:   denomMintStats,
+		MinterMintStats:  minterMintStats,
+		Guardian:         guardian,
+		Paused:           paused,
+		PausedDenoms:     pausedDenoms,
+		IBCMintRoutes:    ibcMintRoutes,
+
+		MintApprovalConfig: mintApprovalConfig,
+		MintProposals:      mintProposals,
+		NextMintProposalID: nextMintProposalID,
+		UsedVoucherNonces:  usedVoucherNonces,
+		MintHistory:        mintHistory,
+		NextMintRecordID:   nextMintRecordID,
+		DenomAdmins:        denomAdmins,
+
+		EmissionSchedules:      emissionSchedules,
+		NextEmissionScheduleID: nextEmissionScheduleID,
+	}
+}
+
+// DefaultNextMintProposalID is the identifier of the first mint proposal.
+const DefaultNextMintProposalID uint64 = 1
+
+// DefaultGenesisState sets default mint genesis state. No mint authority is
+// set by default, which disables minting until governance assigns one.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		MinterAllowances: []MinterAllowance{},
+		DenomMintLimits:  []DenomMintLimit{},
+		Params:           DefaultParams(),
+		DenomMintStats:   []DenomMintStats{},
+		MinterMintStats:  []MinterMintStats{},
+		PausedDenoms:     []string{},
+		IBCMintRoutes:    []IBCMintRoute{},
+
+		MintApprovalConfig: DefaultMintApprovalConfig(),
+		MintProposals:      []MintProposal{},
+		NextMintProposalID: DefaultNextMintProposalID,
+		UsedVoucherNonces:  []UsedVoucherNonce{},
+		MintHistory:        []MintRecord{},
+		NextMintRecordID:   DefaultNextMintRecordID,
+		DenomAdmins:        []DenomAdmin{},
+
+		EmissionSchedules:      []EmissionSchedule{},
+		NextEmissionScheduleID: DefaultNextEmissionScheduleID,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := ValidateMintAuthority(gs.MintAuthority); err != nil {
+		return err
+	}
+
+	seenAllowances := make(map[string]bool)
+	for _, allowance := range gs.MinterAllowances {
+		if err := allowance.Validate(); err != nil {
+			return err
+		}
+
+		key := allowance.Minter + "/" + allowance.Denom
+		if seenAllowances[key] {
+			return fmt.Errorf("duplicate minter allowance for minter %s and denom %s", allowance.Minter, allowance.Denom)
+		}
+		seenAllowances[key] = true
+	}
+
+	seenLimits := make(map[string]bool)
+	for _, limit := range gs.DenomMintLimits {
+		if err := limit.Validate(); err != nil {
+			return err
+		}
+
+		if seenLimits[limit.Denom] {
+			return fmt.Errorf("duplicate mint limit for denom %s", limit.Denom)
+		}
+		seenLimits[limit.Denom] = true
+	}
+
+	seenDenomStats := make(map[string]bool)
+	for _, stats := range gs.DenomMintStats {
+		if err := stats.Validate(); err != nil {
+			return err
+		}
+
+		if seenDenomStats[stats.Denom] {
+			return fmt.Errorf("duplicate mint stats for denom %s", stats.Denom)
+		}
+		seenDenomStats[stats.Denom] = true
+	}
+
+	seenMinterStats := make(map[string]bool)
+	for _, stats := range gs.MinterMintStats {
+		if err := stats.Validate(); err != nil {
+			return err
+		}
+
+		key := stats.Minter + "/" + stats.Denom
+		if seenMinterStats[key] {
+			return fmt.Errorf("duplicate mint stats for minter %s and denom %s", stats.Minter, stats.Denom)
+		}
+		seenMinterStats[key] = true
+	}
+
+	if err := ValidateGuardian(gs.Guardian); err != nil {
+		return err
+	}
+
+	seenPausedDenoms := make(map[string]bool)
+	for _, denom := range gs.PausedDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+
+		if seenPausedDenoms[denom] {
+			return fmt.Errorf("duplicate paused denom %s", denom)
+		}
+		seenPausedDenoms[denom] = true
+	}
+
+	seenRoutes := make(map[string]bool)
+	seenCanonicalDenoms := make(map[string]bool)
+	for _, route := range gs.IBCMintRoutes {
+		if err := route.Validate(); err != nil {
+			return err
+		}
+
+		if route.IsEmpty() {
+			return fmt.Errorf("IBC mint route for channel %s and base denom %s has no canonical denom", route.ChannelID, route.BaseDenom)
+		}
+
+		key := route.ChannelID + "/" + route.BaseDenom
+		if seenRoutes[key] {
+			return fmt.Errorf("duplicate IBC mint route for channel %s and base denom %s", route.ChannelID, route.BaseDenom)
+		}
+		seenRoutes[key] = true
+
+		key = route.ChannelID + "/" + route.CanonicalDenom
+		if seenCanonicalDenoms[key] {
+			return fmt.Errorf("duplicate IBC mint route for channel %s and canonical denom %s", route.ChannelID, route.CanonicalDenom)
+		}
+		seenCanonicalDenoms[key] = true
+	}
+
+	if err := gs.MintApprovalConfig.Validate(); err != nil {
+		return err
+	}
+
+	if gs.NextMintProposalID < DefaultNextMintProposalID {
+		return fmt.Errorf("next mint proposal id must be at least %d, got %d", DefaultNextMintProposalID, gs.NextMintProposalID)
+	}
+
+	seenProposals := make(map[uint64]bool)
+	for _, proposal := range gs.MintProposals {
+		if err := proposal.Validate(); err != nil {
+			return err
+		}
+
+		if proposal.ID == 0 || proposal.ID >= gs.NextMintProposalID {
+			return fmt.Errorf("mint proposal id %d must be between 1 and the next mint proposal id %d", proposal.ID, gs.NextMintProposalID)
+		}
+
+		if seenProposals[proposal.ID] {
+			return fmt.Errorf("duplicate mint proposal %d", proposal.ID)
+		}
+		seenProposals[proposal.ID] = true
+	}
+
+	seenNonces := make(map[string]bool)
+	for _, nonce := range gs.UsedVoucherNonces {
+		if err := nonce.Validate(); err != nil {
+			return err
+		}
+
+		key := nonce.Minter + "/" + nonce.Nonce.String()
+		if seenNonces[key] {
+			return fmt.Errorf("duplicate used voucher nonce %s for minter %s", nonce.Nonce, nonce.Minter)
+		}
+		seenNonces[key] = true
+	}
+
+	if gs.NextMintRecordID < DefaultNextMintRecordID {
+		return fmt.Errorf("next mint record id must be at least %d, got %d", DefaultNextMintRecordID, gs.NextMintRecordID)
+	}
+
+	seenRecords := make(map[uint64]bool)
+	for _, record := range gs.MintHistory {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+
+		if record.ID >= gs.NextMintRecordID {
+			return fmt.Errorf("mint record id %d must be below the next mint record id %d", record.ID, gs.NextMintRecordID)
+		}
+
+		if seenRecords[record.ID] {
+			return fmt.Errorf("duplicate mint record %d", record.ID)
+		}
+		seenRecords[record.ID] = true
+	}
+
+	seenDenomAdmins := make(map[string]bool)
+	for _, denomAdmin := range gs.DenomAdmins {
+		if err := denomAdmin.Validate(); err != nil {
+			return err
+		}
+
+		if seenDenomAdmins[denomAdmin.Denom] {
+			return fmt.Errorf("duplicate denom admin for denom %s", denomAdmin.Denom)
+		}
+		seenDenomAdmins[denomAdmin.Denom] = true
+	}
+
+	if gs.NextEmissionScheduleID < DefaultNextEmissionScheduleID {
+		return fmt.Errorf("next emission schedule id must be at least %d, got %d", DefaultNextEmissionScheduleID, gs.NextEmissionScheduleID)
+	}
+
+	seenSchedules := make(map[uint64]bool)
+	for _, schedule := range gs.EmissionSchedules {
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+
+		if schedule.ID == 0 || schedule.ID >= gs.NextEmissionScheduleID {
+			return fmt.Errorf("emission schedule id %d must be between 1 and the next emission schedule id %d", schedule.ID, gs.NextEmissionScheduleID)
+		}
+
+		if seenSchedules[schedule.ID] {
+			return fmt.Errorf("duplicate emission schedule %d", schedule.ID)
+		}
+		seenSchedules[schedule.ID] = true
+	}
+
+	return gs.Params.Validate()
+}
+
+// ValidateMintAuthority checks that the mint authority is either empty or a
+// valid bech32 account address.
+func ValidateMintAuthority(mintAuthority string) error {
+	if mintAuthority == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(mintAuthority); err != nil {
+		return errorsmod.Wrap(ErrInvalidMintAuthority, err.Error())
+	}
+
+	return nil
+}
+
+// ValidateGuardian checks that the guardian is either empty or a valid bech32
+// account address.
+func ValidateGuardian(guardian string) error {
+	if guardian == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(guardian); err != nil {
+		return errorsmod.Wrap(ErrInvalidGuardian, err.Error())
+	}
+
+	return nil
+}
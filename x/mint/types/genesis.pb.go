@@ -0,0 +1,1375 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/evm/mint/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the module's genesis state.
+type GenesisState struct {
+	// mint_authority is the bech32 address of the account allowed to mint
+	// native tokens through the mint precompile. An empty value disables
+	// minting.
+	MintAuthority string `protobuf:"bytes,1,opt,name=mint_authority,json=mintAuthority,proto3" json:"mint_authority,omitempty"`
+	// minter_allowances is a slice of the registered minter allowances at
+	// genesis
+	MinterAllowances []MinterAllowance `protobuf:"bytes,2,rep,name=minter_allowances,json=minterAllowances,proto3" json:"minter_allowances"`
+	// denom_mint_limits is a slice of the supply caps and rate limits of each
+	// denom at genesis
+	DenomMintLimits []DenomMintLimit `protobuf:"bytes,3,rep,name=denom_mint_limits,json=denomMintLimits,proto3" json:"denom_mint_limits"`
+	// params are the mint module parameters at genesis
+	Params Params `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	// denom_mint_stats is a slice of the totals minted and burned of each denom
+	// at genesis
+	DenomMintStats []DenomMintStats `protobuf:"bytes,5,rep,name=denom_mint_stats,json=denomMintStats,proto3" json:"denom_mint_stats"`
+	// minter_mint_stats is a slice of the totals minted by each minter at
+	// genesis
+	MinterMintStats []MinterMintStats `protobuf:"bytes,6,rep,name=minter_mint_stats,json=minterMintStats,proto3" json:"minter_mint_stats"`
+	// guardian is the bech32 address of the account allowed to pause minting
+	// and burning through the mint precompile. An empty value disables the
+	// guardian.
+	Guardian string `protobuf:"bytes,7,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// paused defines if minting and burning of all denoms is paused at genesis
+	Paused bool `protobuf:"varint,8,opt,name=paused,proto3" json:"paused,omitempty"`
+	// paused_denoms is a slice of the denoms whose minting and burning is paused
+	// at genesis
+	PausedDenoms []string `protobuf:"bytes,9,rep,name=paused_denoms,json=pausedDenoms,proto3" json:"paused_denoms,omitempty"`
+	// ibc_mint_routes is a slice of the IBC channels whose tokens are minted as
+	// a canonical native denom at genesis
+	IBCMintRoutes []IBCMintRoute `protobuf:"bytes,10,rep,name=ibc_mint_routes,json=ibcMintRoutes,proto3" json:"ibc_mint_routes"`
+	// mint_approval_config defines the approvers of mint proposals and the
+	// mints that require one at genesis
+	MintApprovalConfig MintApprovalConfig `protobuf:"bytes,11,opt,name=mint_approval_config,json=mintApprovalConfig,proto3" json:"mint_approval_config"`
+	// mint_proposals is a slice of the pending mint proposals at genesis
+	MintProposals []MintProposal `protobuf:"bytes,12,rep,name=mint_proposals,json=mintProposals,proto3" json:"mint_proposals"`
+	// next_mint_proposal_id is the identifier of the next mint proposal
+	NextMintProposalID uint64 `protobuf:"varint,13,opt,name=next_mint_proposal_id,json=nextMintProposalId,proto3" json:"next_mint_proposal_id,omitempty"`
+	// used_voucher_nonces is a slice of the nonces of the signed mint vouchers
+	// redeemed before genesis
+	UsedVoucherNonces []UsedVoucherNonce `protobuf:"bytes,14,rep,name=used_voucher_nonces,json=usedVoucherNonces,proto3" json:"used_voucher_nonces"`
+	// mint_history is a slice of the mint records kept at genesis
+	MintHistory []MintRecord `protobuf:"bytes,15,rep,name=mint_history,json=mintHistory,proto3" json:"mint_history"`
+	// next_mint_record_id is the identifier of the next mint record
+	NextMintRecordID uint64 `protobuf:"varint,16,opt,name=next_mint_record_id,json=nextMintRecordId,proto3" json:"next_mint_record_id,omitempty"`
+	// denom_admins is a slice of the denoms created through the mint precompile
+	// and their admins at genesis
+	DenomAdmins []DenomAdmin `protobuf:"bytes,17,rep,name=denom_admins,json=denomAdmins,proto3" json:"denom_admins"`
+	// emission_schedules is a slice of the emission schedules that still have
+	// tokens to emit at genesis
+	EmissionSchedules []EmissionSchedule `protobuf:"bytes,18,rep,name=emission_schedules,json=emissionSchedules,proto3" json:"emission_schedules"`
+	// next_emission_schedule_id is the identifier of the next emission schedule
+	NextEmissionScheduleID uint64 `protobuf:"varint,19,opt,name=next_emission_schedule_id,json=nextEmissionScheduleId,proto3" json:"next_emission_schedule_id,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75b95ef3f984f23d, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetMintAuthority() string {
+	if m != nil {
+		return m.MintAuthority
+	}
+	return ""
+}
+
+func (m *GenesisState) GetMinterAllowances() []MinterAllowance {
+	if m != nil {
+		return m.MinterAllowances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomMintLimits() []DenomMintLimit {
+	if m != nil {
+		return m.DenomMintLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetDenomMintStats() []DenomMintStats {
+	if m != nil {
+		return m.DenomMintStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMinterMintStats() []MinterMintStats {
+	if m != nil {
+		return m.MinterMintStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+func (m *GenesisState) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *GenesisState) GetPausedDenoms() []string {
+	if m != nil {
+		return m.PausedDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIBCMintRoutes() []IBCMintRoute {
+	if m != nil {
+		return m.IBCMintRoutes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintApprovalConfig() MintApprovalConfig {
+	if m != nil {
+		return m.MintApprovalConfig
+	}
+	return MintApprovalConfig{}
+}
+
+func (m *GenesisState) GetMintProposals() []MintProposal {
+	if m != nil {
+		return m.MintProposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextMintProposalID() uint64 {
+	if m != nil {
+		return m.NextMintProposalID
+	}
+	return 0
+}
+
+func (m *GenesisState) GetUsedVoucherNonces() []UsedVoucherNonce {
+	if m != nil {
+		return m.UsedVoucherNonces
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintHistory() []MintRecord {
+	if m != nil {
+		return m.MintHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextMintRecordID() uint64 {
+	if m != nil {
+		return m.NextMintRecordID
+	}
+	return 0
+}
+
+func (m *GenesisState) GetDenomAdmins() []DenomAdmin {
+	if m != nil {
+		return m.DenomAdmins
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEmissionSchedules() []EmissionSchedule {
+	if m != nil {
+		return m.EmissionSchedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextEmissionScheduleID() uint64 {
+	if m != nil {
+		return m.NextEmissionScheduleID
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "cosmos.evm.mint.v1.GenesisState")
+}
+
+func init() { proto.RegisterFile("cosmos/evm/mint/v1/genesis.proto", fileDescriptor_75b95ef3f984f23d) }
+
+var fileDescriptor_75b95ef3f984f23d = []byte{
+	// 756 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xc1, 0x6e, 0xeb, 0x44,
+	0x14, 0x86, 0x63, 0x5a, 0x4a, 0x33, 0x49, 0x9a, 0x64, 0x1a, 0xa2, 0x69, 0x24, 0x12, 0xab, 0x45,
+	0x28, 0x42, 0x22, 0x51, 0x0b, 0x4b, 0x10, 0x4a, 0x1a, 0x04, 0x91, 0x4a, 0x55, 0xb9, 0x2a, 0x88,
+	0x22, 0x61, 0x39, 0x9e, 0x21, 0x19, 0x29, 0xf6, 0x44, 0x3e, 0xe3, 0xd0, 0xbe, 0x05, 0x8f, 0xc1,
+	0x92, 0x05, 0x0f, 0xd1, 0x65, 0x61, 0xc5, 0x2a, 0xba, 0x4a, 0x17, 0xf7, 0x35, 0xae, 0x66, 0xc6,
+	0x6d, 0xed, 0x34, 0x51, 0xef, 0xc6, 0xb2, 0xcf, 0xff, 0xf9, 0xf7, 0xf9, 0xcf, 0x78, 0x34, 0xc8,
+	0xf6, 0x05, 0x04, 0x02, 0xba, 0x6c, 0x1e, 0x74, 0x03, 0x1e, 0xca, 0xee, 0xfc, 0xb8, 0x3b, 0x66,
+	0x21, 0x03, 0x0e, 0x9d, 0x59, 0x24, 0xa4, 0xc0, 0xd8, 0x10, 0x1d, 0x36, 0x0f, 0x3a, 0x8a, 0xe8,
+	0xcc, 0x8f, 0x1b, 0x55, 0x2f, 0xe0, 0xa1, 0xe8, 0xea, 0xab, 0xc1, 0x1a, 0x9f, 0xac, 0x31, 0xd2,
+	0xb8, 0x91, 0x0f, 0x8c, 0xec, 0xea, 0xa7, 0x6e, 0x62, 0x69, 0xa4, 0xda, 0x58, 0x8c, 0x85, 0xa9,
+	0xab, 0x3b, 0x53, 0x3d, 0xfc, 0xb7, 0x80, 0x8a, 0xdf, 0x9b, 0x46, 0x2e, 0xa5, 0x27, 0x19, 0xfe,
+	0x16, 0xed, 0x29, 0x3f, 0xd7, 0x8b, 0xe5, 0x44, 0x44, 0x5c, 0xde, 0x12, 0xcb, 0xb6, 0xda, 0xf9,
+	0x3e, 0xf9, 0xef, 0x9f, 0x2f, 0x6a, 0x89, 0x61, 0x8f, 0xd2, 0x88, 0x01, 0x5c, 0xca, 0x88, 0x87,
+	0x63, 0xa7, 0xa4, 0xf8, 0xde, 0x23, 0x8e, 0x7f, 0x45, 0x55, 0x55, 0x60, 0x91, 0xeb, 0x4d, 0xa7,
+	0xe2, 0x0f, 0x2f, 0xf4, 0x19, 0x90, 0x0f, 0xec, 0xad, 0x76, 0xe1, 0xe4, 0xa8, 0xf3, 0x32, 0x64,
+	0xe7, 0x47, 0x0d, 0xf7, 0x1e, 0xd9, 0x7e, 0xfe, 0x6e, 0xd1, 0xca, 0xfd, 0xf5, 0xf6, 0xef, 0xcf,
+	0x2d, 0xa7, 0x12, 0x64, 0x35, 0xc0, 0xbf, 0xa0, 0x2a, 0x65, 0xa1, 0x08, 0x5c, 0xdd, 0xe3, 0x94,
+	0x07, 0x5c, 0x02, 0xd9, 0xd2, 0xe6, 0x87, 0xeb, 0xcc, 0x07, 0x0a, 0x56, 0x5f, 0x38, 0x53, 0x68,
+	0xda, 0xbb, 0x4c, 0x33, 0x12, 0xe0, 0x6f, 0xd0, 0xce, 0xcc, 0x8b, 0xbc, 0x00, 0xc8, 0xb6, 0x6d,
+	0xb5, 0x0b, 0x27, 0x8d, 0x75, 0x7e, 0x17, 0x9a, 0x48, 0xfb, 0x24, 0x2f, 0xe1, 0x9f, 0x51, 0x25,
+	0xd5, 0x19, 0x48, 0x4f, 0x02, 0xf9, 0xf0, 0x3d, 0x1a, 0x53, 0x53, 0xcf, 0x18, 0xee, 0xd1, 0x8c,
+	0x84, 0xaf, 0x9f, 0xe6, 0x99, 0x72, 0xde, 0x79, 0x6d, 0x9e, 0x6b, 0xad, 0xcb, 0x41, 0x56, 0xc3,
+	0x5f, 0xa1, 0xdd, 0x71, 0xec, 0x45, 0x94, 0x7b, 0x21, 0xf9, 0xe8, 0x95, 0x65, 0x7e, 0x22, 0x71,
+	0x5d, 0x4d, 0x2a, 0x06, 0x46, 0xc9, 0xae, 0x6d, 0xb5, 0x77, 0x9d, 0xe4, 0x09, 0x1f, 0xa1, 0x92,
+	0xb9, 0x73, 0x75, 0x04, 0x20, 0x79, 0x7b, 0xab, 0x9d, 0x77, 0x8a, 0xa6, 0xa8, 0x13, 0x03, 0xa6,
+	0xa8, 0xcc, 0x47, 0xbe, 0xc9, 0x12, 0x89, 0x58, 0x32, 0x20, 0x48, 0x87, 0xb1, 0xd7, 0x85, 0x19,
+	0xf6, 0x4f, 0x55, 0xb7, 0x8e, 0x02, 0xfb, 0x0d, 0x95, 0x64, 0xb9, 0x68, 0x95, 0xd2, 0x55, 0x30,
+	0xd1, 0x4a, 0x7c, 0xe4, 0x3f, 0xd7, 0xb0, 0x8f, 0x6a, 0xe6, 0x2f, 0x9e, 0xcd, 0x22, 0x31, 0xf7,
+	0xa6, 0xae, 0x2f, 0xc2, 0xdf, 0xf9, 0x98, 0x14, 0xf4, 0xd2, 0x7e, 0xb6, 0x69, 0x6e, 0xbd, 0x04,
+	0x3f, 0xd5, 0x74, 0x7a, 0x74, 0x38, 0x78, 0x21, 0x63, 0x27, 0xd9, 0x2a, 0xb3, 0x48, 0xcc, 0x04,
+	0x78, 0x53, 0x20, 0xc5, 0xcd, 0x49, 0x94, 0xfd, 0x45, 0x02, 0xa6, 0x8d, 0x4b, 0x41, 0x4a, 0x00,
+	0x3c, 0x44, 0x1f, 0x87, 0xec, 0x46, 0xba, 0x19, 0x63, 0x97, 0x53, 0x52, 0xb2, 0xad, 0xf6, 0x76,
+	0xbf, 0xbe, 0x5c, 0xb4, 0xf0, 0x39, 0xbb, 0x91, 0x69, 0xbb, 0xe1, 0xc0, 0xc1, 0xe1, 0x6a, 0x8d,
+	0x62, 0x17, 0xed, 0xeb, 0xc5, 0x98, 0x8b, 0xd8, 0x9f, 0xb0, 0xc8, 0x0d, 0x85, 0xde, 0x8a, 0x7b,
+	0xba, 0xc7, 0x4f, 0xd7, 0xf5, 0x78, 0x05, 0x8c, 0xfe, 0x64, 0xe8, 0x73, 0xb1, 0xb2, 0x17, 0xab,
+	0xf1, 0x8a, 0x08, 0xf8, 0x0c, 0x15, 0x75, 0x9b, 0x13, 0x0e, 0x52, 0x44, 0xb7, 0xa4, 0xac, 0x9d,
+	0x9b, 0x9b, 0xd2, 0x3b, 0xcc, 0x17, 0x11, 0x4d, 0x7b, 0x16, 0x94, 0xfc, 0x83, 0x79, 0x1b, 0x9f,
+	0xa2, 0xfd, 0xe7, 0xe4, 0x91, 0x66, 0x55, 0xee, 0x8a, 0xce, 0x5d, 0x5b, 0x2e, 0x5a, 0x95, 0xc7,
+	0xdc, 0xc6, 0x68, 0x38, 0x70, 0x2a, 0x61, 0xb6, 0x42, 0x55, 0x4b, 0x66, 0x17, 0x7a, 0x34, 0xe0,
+	0x21, 0x90, 0xea, 0xe6, 0x96, 0xf4, 0xff, 0xd8, 0x53, 0x58, 0xa6, 0x25, 0xfa, 0x54, 0x06, 0xfc,
+	0x1b, 0xc2, 0x2c, 0xe0, 0x00, 0x5c, 0x84, 0x2e, 0xf8, 0x13, 0x46, 0xe3, 0x29, 0x03, 0x82, 0x37,
+	0x0f, 0xf0, 0xbb, 0x84, 0xbe, 0x4c, 0xe0, 0xcc, 0x00, 0xd9, 0x8a, 0x08, 0xf8, 0x0a, 0x1d, 0xe8,
+	0xc8, 0x2f, 0x3e, 0xa2, 0x82, 0xef, 0xeb, 0xe0, 0x8d, 0xe5, 0xa2, 0x55, 0x57, 0xc1, 0x57, 0xad,
+	0x87, 0x03, 0xa7, 0x1e, 0xae, 0xab, 0xd3, 0xfe, 0xd7, 0x77, 0xcb, 0xa6, 0x75, 0xbf, 0x6c, 0x5a,
+	0x6f, 0x96, 0x4d, 0xeb, 0xcf, 0x87, 0x66, 0xee, 0xfe, 0xa1, 0x99, 0xfb, 0xff, 0xa1, 0x99, 0xbb,
+	0x3e, 0x1c, 0x73, 0x39, 0x89, 0x47, 0x1d, 0x5f, 0x04, 0xdd, 0xd4, 0x41, 0x72, 0x63, 0x8e, 0x12,
+	0x79, 0x3b, 0x63, 0x30, 0xda, 0xd1, 0x07, 0xc3, 0x97, 0xef, 0x06, 0x00, 0x75, 0x2c, 0xea, 0x0d,
+	0xb3, 0x06, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextEmissionScheduleID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextEmissionScheduleID))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.EmissionSchedules) > 0 {
+		for iNdEx := len(m.EmissionSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EmissionSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.DenomAdmins) > 0 {
+		for iNdEx := len(m.DenomAdmins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomAdmins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.NextMintRecordID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextMintRecordID))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.MintHistory) > 0 {
+		for iNdEx := len(m.MintHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.UsedVoucherNonces) > 0 {
+		for iNdEx := len(m.UsedVoucherNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UsedVoucherNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.NextMintProposalID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextMintProposalID))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.MintProposals) > 0 {
+		for iNdEx := len(m.MintProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size, err := m.MintApprovalConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.IBCMintRoutes) > 0 {
+		for iNdEx := len(m.IBCMintRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IBCMintRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PausedDenoms) > 0 {
+		for iNdEx := len(m.PausedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PausedDenoms[iNdEx])
+			copy(dAtA[i:], m.PausedDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PausedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.MinterMintStats) > 0 {
+		for iNdEx := len(m.MinterMintStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinterMintStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DenomMintStats) > 0 {
+		for iNdEx := len(m.DenomMintStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomMintStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.DenomMintLimits) > 0 {
+		for iNdEx := len(m.DenomMintLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomMintLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MinterAllowances) > 0 {
+		for iNdEx := len(m.MinterAllowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinterAllowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MintAuthority) > 0 {
+		i -= len(m.MintAuthority)
+		copy(dAtA[i:], m.MintAuthority)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MintAuthority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MintAuthority)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.MinterAllowances) > 0 {
+		for _, e := range m.MinterAllowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomMintLimits) > 0 {
+		for _, e := range m.DenomMintLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DenomMintStats) > 0 {
+		for _, e := range m.DenomMintStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MinterMintStats) > 0 {
+		for _, e := range m.MinterMintStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	if len(m.PausedDenoms) > 0 {
+		for _, s := range m.PausedDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IBCMintRoutes) > 0 {
+		for _, e := range m.IBCMintRoutes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.MintApprovalConfig.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MintProposals) > 0 {
+		for _, e := range m.MintProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextMintProposalID != 0 {
+		n += 1 + sovGenesis(uint64(m.NextMintProposalID))
+	}
+	if len(m.UsedVoucherNonces) > 0 {
+		for _, e := range m.UsedVoucherNonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintHistory) > 0 {
+		for _, e := range m.MintHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextMintRecordID != 0 {
+		n += 2 + sovGenesis(uint64(m.NextMintRecordID))
+	}
+	if len(m.DenomAdmins) > 0 {
+		for _, e := range m.DenomAdmins {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EmissionSchedules) > 0 {
+		for _, e := range m.EmissionSchedules {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextEmissionScheduleID != 0 {
+		n += 2 + sovGenesis(uint64(m.NextEmissionScheduleID))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterAllowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterAllowances = append(m.MinterAllowances, MinterAllowance{})
+			if err := m.MinterAllowances[len(m.MinterAllowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomMintLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomMintLimits = append(m.DenomMintLimits, DenomMintLimit{})
+			if err := m.DenomMintLimits[len(m.DenomMintLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomMintStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomMintStats = append(m.DenomMintStats, DenomMintStats{})
+			if err := m.DenomMintStats[len(m.DenomMintStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterMintStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterMintStats = append(m.MinterMintStats, MinterMintStats{})
+			if err := m.MinterMintStats[len(m.MinterMintStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedDenoms = append(m.PausedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCMintRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IBCMintRoutes = append(m.IBCMintRoutes, IBCMintRoute{})
+			if err := m.IBCMintRoutes[len(m.IBCMintRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintApprovalConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintApprovalConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintProposals = append(m.MintProposals, MintProposal{})
+			if err := m.MintProposals[len(m.MintProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextMintProposalID", wireType)
+			}
+			m.NextMintProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextMintProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedVoucherNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsedVoucherNonces = append(m.UsedVoucherNonces, UsedVoucherNonce{})
+			if err := m.UsedVoucherNonces[len(m.UsedVoucherNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintHistory = append(m.MintHistory, MintRecord{})
+			if err := m.MintHistory[len(m.MintHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextMintRecordID", wireType)
+			}
+			m.NextMintRecordID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextMintRecordID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomAdmins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomAdmins = append(m.DenomAdmins, DenomAdmin{})
+			if err := m.DenomAdmins[len(m.DenomAdmins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmissionSchedules = append(m.EmissionSchedules, EmissionSchedule{})
+			if err := m.EmissionSchedules[len(m.EmissionSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEmissionScheduleID", wireType)
+			}
+			m.NextEmissionScheduleID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEmissionScheduleID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
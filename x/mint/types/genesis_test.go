@@ -0,0 +1,423 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
+
+func (suite *GenesisTestSuite) TestValidateGenesis() {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	allowance := NewMinterAllowance(govAddr, "uusdc", math.NewInt(1000))
+	limit := NewDenomMintLimit("uusdc", math.NewInt(1_000_000), math.NewInt(1000), 100)
+	denomStats := NewDenomMintStats("uusdc", math.NewInt(1000), math.NewInt(400))
+	minterStats := NewMinterMintStats(govAddr, "uusdc", math.NewInt(1000))
+	route := NewIBCMintRoute("channel-0", "uusdc", "usdc")
+	approvalConfig := NewMintApprovalConfig([]string{govAddr.String()}, 1, 10, 100, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1000)))
+	proposal := NewMintProposal(1, govAddr, govAddr, "uusdc", math.NewInt(5000), 20, 120)
+	record := NewMintRecord(1, 10, govAddr, govAddr, "uusdc", math.NewInt(1000))
+	factoryDenom, err := BuildFactoryDenom(govAddr, "team")
+	suite.Require().NoError(err)
+	denomAdmin := NewDenomAdmin(factoryDenom, govAddr)
+	schedule := NewEmissionSchedule(1, "uusdc", govAddr, EmissionCurveLinear, math.NewInt(1000), 10, 19, 0)
+
+	testCases := []struct {
+		name     string
+		genState *GenesisState
+		expPass  bool
+	}{
+		{
+			"default",
+			DefaultGenesisState(),
+			true,
+		},
+		{
+			"valid genesis",
+			NewGenesisState(govAddr.String(), []MinterAllowance{allowance}, []DenomMintLimit{limit}, DefaultParams(), nil, nil, "", false, nil, nil, DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil, nil, DefaultNextMintRecordID, nil, nil, DefaultNextEmissionScheduleID),
+			true,
+		},
+		{
+			"valid genesis with mint stats",
+			NewGenesisState(
+				govAddr.String(), nil, nil, DefaultParams(),
+				[]DenomMintStats{denomStats}, []MinterMintStats{minterStats},
+				"", false, nil, nil,
+				DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil,
+				nil,
+				DefaultNextMintRecordID,
+				nil,
+				nil, DefaultNextEmissionScheduleID,
+			),
+			true,
+		},
+		{
+			"invalid mint authority",
+			NewGenesisState("invalid", nil, nil, DefaultParams(), nil, nil, "", false, nil, nil, DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil, nil, DefaultNextMintRecordID, nil, nil, DefaultNextEmissionScheduleID),
+			false,
+		},
+		{
+			"invalid minter allowance",
+			NewGenesisState(govAddr.String(), []MinterAllowance{
+				NewMinterAllowance(govAddr, "uusdc", math.NewInt(-1)),
+			}, nil, DefaultParams(), nil, nil, "", false, nil, nil, DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil, nil, DefaultNextMintRecordID, nil, nil, DefaultNextEmissionScheduleID),
+			false,
+		},
+		{
+			"duplicate minter allowance",
+			NewGenesisState(govAddr.String(), []MinterAllowance{allowance, allowance}, nil, DefaultParams(), nil, nil, "", false, nil, nil, DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil, nil, DefaultNextMintRecordID, nil, nil, DefaultNextEmissionScheduleID),
+			false,
+		},
+		{
+			"invalid denom mint limit",
+			NewGenesisState(govAddr.String(), nil, []DenomMintLimit{
+				NewDenomMintLimit("uusdc", math.ZeroInt(), math.NewInt(1000), 0),
+			}, DefaultParams(), nil, nil, "", false, nil, nil, DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil, nil, DefaultNextMintRecordID, nil, nil, DefaultNextEmissionScheduleID),
+			false,
+		},
+		{
+			"duplicate denom mint limit",
+			NewGenesisState(govAddr.String(), nil, []DenomMintLimit{limit, limit}, DefaultParams(), nil, nil, "", false, nil, nil, DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil, nil, DefaultNextMintRecordID, nil, nil, DefaultNextEmissionScheduleID),
+			false,
+		},
+		{
+			"invalid denom mint stats",
+			NewGenesisState(govAddr.String(), nil, nil, DefaultParams(), []DenomMintStats{
+				NewDenomMintStats("uusdc", math.NewInt(-1), math.ZeroInt()),
+			}, nil, "", false, nil, nil, DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil, nil, DefaultNextMintRecordID, nil, nil, DefaultNextEmissionScheduleID),
+			false,
+		},
+		{
+			"duplicate denom mint stats",
+			NewGenesisState(govAddr.String(), nil, nil, DefaultParams(), []DenomMintStats{denomStats, denomStats}, nil, "", false, nil, nil, DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil, nil, DefaultNextMintRecordID, nil, nil, DefaultNextEmissionScheduleID),
+			false,
+		},
+		{
+			"invalid minter mint stats",
+			NewGenesisState(govAddr.String(), nil, nil, DefaultParams(), nil, []MinterMintStats{
+				{Minter: "invalid", Denom: "uusdc", Minted: math.NewInt(1000)},
+			}, "", false, nil, nil, DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil, nil, DefaultNextMintRecordID, nil, nil, DefaultNextEmissionScheduleID),
+			false,
+		},
+		{
+			"duplicate minter mint stats",
+			NewGenesisState(govAddr.String(), nil, nil, DefaultParams(), nil, []MinterMintStats{minterStats, minterStats}, "", false, nil, nil, DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil, nil, DefaultNextMintRecordID, nil, nil, DefaultNextEmissionScheduleID),
+			false,
+		},
+		{
+			"valid genesis with guardian and pauses",
+			NewGenesisState(govAddr.String(), nil, nil, DefaultParams(), nil, nil, govAddr.String(), true, []string{"uusdc"}, nil, DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil, nil, DefaultNextMintRecordID, nil, nil, DefaultNextEmissionScheduleID),
+			true,
+		},
+		{
+			"invalid guardian",
+			NewGenesisState(govAddr.String(), nil, nil, DefaultParams(), nil, nil, "invalid", false, nil, nil, DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil, nil, DefaultNextMintRecordID, nil, nil, DefaultNextEmissionScheduleID),
+			false,
+		},
+		{
+			"invalid paused denom",
+			NewGenesisState(govAddr.String(), nil, nil, DefaultParams(), nil, nil, "", false, []string{"1"}, nil, DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil, nil, DefaultNextMintRecordID, nil, nil, DefaultNextEmissionScheduleID),
+			false,
+		},
+		{
+			"duplicate paused denom",
+			NewGenesisState(govAddr.String(), nil, nil, DefaultParams(), nil, nil, "", false, []string{"uusdc", "uusdc"}, nil, DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil, nil, DefaultNextMintRecordID, nil, nil, DefaultNextEmissionScheduleID),
+			false,
+		},
+		{
+			"valid genesis with IBC mint routes",
+			NewGenesisState(govAddr.String(), nil, nil, DefaultParams(), nil, nil, "", false, nil, []IBCMintRoute{
+				route, NewIBCMintRoute("channel-1", "uusdc", "usdc"),
+			}, DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil, nil, DefaultNextMintRecordID, nil, nil, DefaultNextEmissionScheduleID),
+			true,
+		},
+		{
+			"invalid IBC mint route",
+			NewGenesisState(govAddr.String(), nil, nil, DefaultParams(), nil, nil, "", false, nil, []IBCMintRoute{
+				NewIBCMintRoute("channel-0", "uusdc", "ibc/usdc"),
+			}, DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil, nil, DefaultNextMintRecordID, nil, nil, DefaultNextEmissionScheduleID),
+			false,
+		},
+		{
+			"IBC mint route without canonical denom",
+			NewGenesisState(govAddr.String(), nil, nil, DefaultParams(), nil, nil, "", false, nil, []IBCMintRoute{
+				NewIBCMintRoute("channel-0", "uusdc", ""),
+			}, DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil, nil, DefaultNextMintRecordID, nil, nil, DefaultNextEmissionScheduleID),
+			false,
+		},
+		{
+			"duplicate IBC mint route",
+			NewGenesisState(govAddr.String(), nil, nil, DefaultParams(), nil, nil, "", false, nil, []IBCMintRoute{route, route}, DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil, nil, DefaultNextMintRecordID, nil, nil, DefaultNextEmissionScheduleID),
+			false,
+		},
+		{
+			"duplicate canonical denom on channel",
+			NewGenesisState(govAddr.String(), nil, nil, DefaultParams(), nil, nil, "", false, nil, []IBCMintRoute{
+				route, NewIBCMintRoute("channel-0", "transfer/channel-5/uusdc", "usdc"),
+			}, DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil, nil, DefaultNextMintRecordID, nil, nil, DefaultNextEmissionScheduleID),
+			false,
+		},
+		{
+			"valid genesis with mint proposals",
+			NewGenesisState(
+				govAddr.String(), nil, nil, DefaultParams(), nil, nil, "", false, nil, nil,
+				approvalConfig, []MintProposal{proposal}, 2, nil,
+				nil,
+				DefaultNextMintRecordID,
+				nil,
+				nil, DefaultNextEmissionScheduleID,
+			),
+			true,
+		},
+		{
+			"invalid mint approval config",
+			NewGenesisState(
+				govAddr.String(), nil, nil, DefaultParams(), nil, nil, "", false, nil, nil,
+				NewMintApprovalConfig([]string{govAddr.String()}, 2, 10, 100, nil), nil, DefaultNextMintProposalID, nil,
+				nil,
+				DefaultNextMintRecordID,
+				nil,
+				nil, DefaultNextEmissionScheduleID,
+			),
+			false,
+		},
+		{
+			"invalid mint proposal",
+			NewGenesisState(
+				govAddr.String(), nil, nil, DefaultParams(), nil, nil, "", false, nil, nil,
+				approvalConfig, []MintProposal{NewMintProposal(1, govAddr, govAddr, "uusdc", math.ZeroInt(), 20, 120)}, 2, nil,
+				nil,
+				DefaultNextMintRecordID,
+				nil,
+				nil, DefaultNextEmissionScheduleID,
+			),
+			false,
+		},
+		{
+			"mint proposal id not below next id",
+			NewGenesisState(
+				govAddr.String(), nil, nil, DefaultParams(), nil, nil, "", false, nil, nil,
+				approvalConfig, []MintProposal{proposal}, 1, nil,
+				nil,
+				DefaultNextMintRecordID,
+				nil,
+				nil, DefaultNextEmissionScheduleID,
+			),
+			false,
+		},
+		{
+			"duplicate mint proposal",
+			NewGenesisState(
+				govAddr.String(), nil, nil, DefaultParams(), nil, nil, "", false, nil, nil,
+				approvalConfig, []MintProposal{proposal, proposal}, 2, nil,
+				nil,
+				DefaultNextMintRecordID,
+				nil,
+				nil, DefaultNextEmissionScheduleID,
+			),
+			false,
+		},
+		{
+			"zero next mint proposal id",
+			NewGenesisState(
+				govAddr.String(), nil, nil, DefaultParams(), nil, nil, "", false, nil, nil,
+				DefaultMintApprovalConfig(), nil, 0, nil,
+				nil,
+				DefaultNextMintRecordID,
+				nil,
+				nil, DefaultNextEmissionScheduleID,
+			),
+			false,
+		},
+		{
+			"valid genesis with used voucher nonces",
+			NewGenesisState(
+				govAddr.String(), nil, nil, DefaultParams(), nil, nil, "", false, nil, nil,
+				DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID,
+				[]UsedVoucherNonce{NewUsedVoucherNonce(govAddr, math.ZeroInt()), NewUsedVoucherNonce(govAddr, math.OneInt())},
+				nil,
+				DefaultNextMintRecordID,
+				nil,
+				nil, DefaultNextEmissionScheduleID,
+			),
+			true,
+		},
+		{
+			"invalid used voucher nonce",
+			NewGenesisState(
+				govAddr.String(), nil, nil, DefaultParams(), nil, nil, "", false, nil, nil,
+				DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID,
+				[]UsedVoucherNonce{NewUsedVoucherNonce(govAddr, math.NewInt(-1))},
+				nil,
+				DefaultNextMintRecordID,
+				nil,
+				nil, DefaultNextEmissionScheduleID,
+			),
+			false,
+		},
+		{
+			"duplicate used voucher nonce",
+			NewGenesisState(
+				govAddr.String(), nil, nil, DefaultParams(), nil, nil, "", false, nil, nil,
+				DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID,
+				[]UsedVoucherNonce{NewUsedVoucherNonce(govAddr, math.OneInt()), NewUsedVoucherNonce(govAddr, math.OneInt())},
+				nil,
+				DefaultNextMintRecordID,
+				nil,
+				nil, DefaultNextEmissionScheduleID,
+			),
+			false,
+		},
+		{
+			"valid genesis with mint history",
+			NewGenesisState(
+				govAddr.String(), nil, nil, DefaultParams(), nil, nil, "", false, nil, nil,
+				DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil,
+				[]MintRecord{record},
+				2,
+				nil,
+				nil, DefaultNextEmissionScheduleID,
+			),
+			true,
+		},
+		{
+			"invalid mint record",
+			NewGenesisState(
+				govAddr.String(), nil, nil, DefaultParams(), nil, nil, "", false, nil, nil,
+				DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil,
+				[]MintRecord{NewMintRecord(1, 10, govAddr, govAddr, "uusdc", math.ZeroInt())},
+				2,
+				nil,
+				nil, DefaultNextEmissionScheduleID,
+			),
+			false,
+		},
+		{
+			"duplicate mint record",
+			NewGenesisState(
+				govAddr.String(), nil, nil, DefaultParams(), nil, nil, "", false, nil, nil,
+				DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil,
+				[]MintRecord{record, record},
+				2,
+				nil,
+				nil, DefaultNextEmissionScheduleID,
+			),
+			false,
+		},
+		{
+			"mint record id not below the next mint record id",
+			NewGenesisState(
+				govAddr.String(), nil, nil, DefaultParams(), nil, nil, "", false, nil, nil,
+				DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil,
+				[]MintRecord{record},
+				1,
+				nil,
+				nil, DefaultNextEmissionScheduleID,
+			),
+			false,
+		},
+		{
+			"zero next mint record id",
+			NewGenesisState(govAddr.String(), nil, nil, DefaultParams(), nil, nil, "", false, nil, nil, DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil, nil, 0, nil, nil, DefaultNextEmissionScheduleID),
+			false,
+		},
+		{
+			"valid genesis with denom admins",
+			NewGenesisState(
+				govAddr.String(), nil, nil, DefaultParams(), nil, nil, "", false, nil, nil,
+				DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil, nil, DefaultNextMintRecordID,
+				[]DenomAdmin{denomAdmin, NewDenomAdmin(factoryDenom+"2", nil)},
+				nil, DefaultNextEmissionScheduleID,
+			),
+			true,
+		},
+		{
+			"denom admin of a denom outside the factory namespace",
+			NewGenesisState(
+				govAddr.String(), nil, nil, DefaultParams(), nil, nil, "", false, nil, nil,
+				DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil, nil, DefaultNextMintRecordID,
+				[]DenomAdmin{NewDenomAdmin("uusdc", govAddr)},
+				nil, DefaultNextEmissionScheduleID,
+			),
+			false,
+		},
+		{
+			"duplicate denom admin",
+			NewGenesisState(
+				govAddr.String(), nil, nil, DefaultParams(), nil, nil, "", false, nil, nil,
+				DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil, nil, DefaultNextMintRecordID,
+				[]DenomAdmin{denomAdmin, denomAdmin},
+				nil, DefaultNextEmissionScheduleID,
+			),
+			false,
+		},
+		{
+			"valid genesis with emission schedules",
+			NewGenesisState(
+				govAddr.String(), nil, nil, DefaultParams(), nil, nil, "", false, nil, nil,
+				DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil, nil, DefaultNextMintRecordID, nil,
+				[]EmissionSchedule{schedule}, 2,
+			),
+			true,
+		},
+		{
+			"invalid emission schedule",
+			NewGenesisState(
+				govAddr.String(), nil, nil, DefaultParams(), nil, nil, "", false, nil, nil,
+				DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil, nil, DefaultNextMintRecordID, nil,
+				[]EmissionSchedule{NewEmissionSchedule(1, "uusdc", govAddr, EmissionCurveLinear, math.NewInt(1000), 20, 19, 0)}, 2,
+			),
+			false,
+		},
+		{
+			"emission schedule id not below the next emission schedule id",
+			NewGenesisState(
+				govAddr.String(), nil, nil, DefaultParams(), nil, nil, "", false, nil, nil,
+				DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil, nil, DefaultNextMintRecordID, nil,
+				[]EmissionSchedule{schedule}, 1,
+			),
+			false,
+		},
+		{
+			"duplicate emission schedule",
+			NewGenesisState(
+				govAddr.String(), nil, nil, DefaultParams(), nil, nil, "", false, nil, nil,
+				DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil, nil, DefaultNextMintRecordID, nil,
+				[]EmissionSchedule{schedule, schedule}, 2,
+			),
+			false,
+		},
+		{
+			"zero next emission schedule id",
+			NewGenesisState(govAddr.String(), nil, nil, DefaultParams(), nil, nil, "", false, nil, nil, DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil, nil, DefaultNextMintRecordID, nil, nil, 0),
+			false,
+		},
+		{
+			"invalid params",
+			NewGenesisState(govAddr.String(), nil, nil, NewParams([]string{"invalid"}, nil, false, 0), nil, nil, "", false, nil, nil, DefaultMintApprovalConfig(), nil, DefaultNextMintProposalID, nil, nil, DefaultNextMintRecordID, nil, nil, DefaultNextEmissionScheduleID),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
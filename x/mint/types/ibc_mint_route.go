@@ -0,0 +1,59 @@
+package types
+
+import (
+	"strings"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewIBCMintRoute returns a new route minting the given canonical denom for the
+// base denom received over the given channel.
+func NewIBCMintRoute(channelID, baseDenom, canonicalDenom string) IBCMintRoute {
+	return IBCMintRoute{
+		ChannelID:      channelID,
+		BaseDenom:      baseDenom,
+		CanonicalDenom: canonicalDenom,
+	}
+}
+
+// Validate performs a stateless validation of the route. An empty canonical
+// denom is valid, as it is used to remove a route.
+func (r IBCMintRoute) Validate() error {
+	if err := host.ChannelIdentifierValidator(r.ChannelID); err != nil {
+		return errorsmod.Wrap(ErrInvalidIBCMintRoute, err.Error())
+	}
+
+	if strings.TrimSpace(r.BaseDenom) == "" {
+		return errorsmod.Wrap(ErrInvalidIBCMintRoute, "base denom cannot be blank")
+	}
+
+	if r.IsEmpty() {
+		return nil
+	}
+
+	if err := sdk.ValidateDenom(r.CanonicalDenom); err != nil {
+		return errorsmod.Wrap(ErrInvalidIBCMintRoute, err.Error())
+	}
+
+	if strings.HasPrefix(r.CanonicalDenom, transfertypes.DenomPrefix+"/") {
+		return errorsmod.Wrapf(ErrInvalidIBCMintRoute, "canonical denom %s cannot be an IBC voucher", r.CanonicalDenom)
+	}
+
+	return nil
+}
+
+// IsEmpty returns true if the route has no canonical denom.
+func (r IBCMintRoute) IsEmpty() bool {
+	return r.CanonicalDenom == ""
+}
+
+// VoucherDenom returns the ibc/HASH denom the transfer module credits for the
+// base denom received over the channel of the route.
+func (r IBCMintRoute) VoucherDenom() string {
+	return transfertypes.NewDenom(r.BaseDenom, transfertypes.NewHop(transfertypes.PortID, r.ChannelID)).IBCDenom()
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+)
+
+func TestIBCMintRouteValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		route   IBCMintRoute
+		expPass bool
+	}{
+		{
+			"pass - valid route",
+			NewIBCMintRoute("channel-0", "uusdc", "usdc"),
+			true,
+		},
+		{
+			"pass - multi-hop base denom",
+			NewIBCMintRoute("channel-0", "transfer/channel-7/uusdc", "usdc"),
+			true,
+		},
+		{
+			"pass - empty canonical denom",
+			NewIBCMintRoute("channel-0", "uusdc", ""),
+			true,
+		},
+		{
+			"fail - invalid channel",
+			NewIBCMintRoute("chan", "uusdc", "usdc"),
+			false,
+		},
+		{
+			"fail - blank base denom",
+			NewIBCMintRoute("channel-0", " ", "usdc"),
+			false,
+		},
+		{
+			"fail - invalid canonical denom",
+			NewIBCMintRoute("channel-0", "uusdc", "1usdc"),
+			false,
+		},
+		{
+			"fail - IBC voucher canonical denom",
+			NewIBCMintRoute("channel-0", "uusdc", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.route.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestIBCMintRouteVoucherDenom(t *testing.T) {
+	route := NewIBCMintRoute("channel-0", "uusdc", "usdc")
+	expDenom := transfertypes.ExtractDenomFromPath("transfer/channel-0/uusdc").IBCDenom()
+	require.Equal(t, expDenom, route.VoucherDenom())
+}
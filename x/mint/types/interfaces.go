@@ -0,0 +1,54 @@
+package types
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountKeeper defines the expected interface needed to retrieve account info
+// and to turn accounts into vesting accounts.
+type AccountKeeper interface {
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+	SetAccount(ctx context.Context, acc sdk.AccountI)
+}
+
+// BankKeeper defines the expected interface needed to check the supply of a
+// denom against its mint limit, the addresses blocked from receiving funds,
+// and to swap IBC vouchers for their canonical denom.
+type BankKeeper interface {
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+	BlockedAddr(addr sdk.AccAddress) bool
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+}
+
+// EVMKeeper defines the expected EVM keeper interface needed to reject
+// precompile and smart contract recipients.
+type EVMKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+	IsAvailableStaticPrecompile(params *evmtypes.Params, address common.Address) bool
+	IsContract(ctx sdk.Context, addr common.Address) bool
+}
+
+// MintKeeper defines the expected mint keeper interface of the IBC middleware
+// minting the canonical denom of IBC vouchers.
+type MintKeeper interface {
+	OnSendPacket(ctx sdk.Context, sourceChannel string, token transfertypes.Token, sender sdk.AccAddress) error
+	OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, ack exported.Acknowledgement) exported.Acknowledgement
+	OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData, ack channeltypes.Acknowledgement) error
+	OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) error
+	Logger(ctx sdk.Context) log.Logger
+}
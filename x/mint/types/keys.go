@@ -0,0 +1,147 @@
+package types
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName name that will be used throughout the module. It differs from
+	// the Cosmos SDK x/mint module name, and must not share a prefix with any
+	// other store key, to avoid store key collisions.
+	ModuleName = "tokenmint"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the mint persistent store
+const (
+	prefixMintAuthority = iota + 1
+	prefixMinterAllowance
+	prefixDenomMintLimit
+	prefixMintedAtHeight
+	prefixParams
+	prefixDenomMintStats
+	prefixMinterMintStats
+	prefixGuardian
+	prefixPaused
+	prefixPausedDenom
+	prefixIBCMintRoute
+	prefixMintApprovalConfig
+	prefixMintProposal
+	prefixMintProposalExpiry
+	prefixNextMintProposalID
+	prefixUsedVoucherNonce
+	prefixMintRecord
+	prefixNextMintRecordID
+	prefixDenomAdmin
+	prefixEmissionSchedule
+	prefixNextEmissionScheduleID
+	prefixLargeMintedInBlock
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixMintAuthority   = []byte{prefixMintAuthority}
+	KeyPrefixMinterAllowance = []byte{prefixMinterAllowance}
+	KeyPrefixDenomMintLimit  = []byte{prefixDenomMintLimit}
+	KeyPrefixMintedAtHeight  = []byte{prefixMintedAtHeight}
+	KeyPrefixParams          = []byte{prefixParams}
+	KeyPrefixDenomMintStats  = []byte{prefixDenomMintStats}
+	KeyPrefixMinterMintStats = []byte{prefixMinterMintStats}
+	KeyPrefixGuardian        = []byte{prefixGuardian}
+	KeyPrefixPaused          = []byte{prefixPaused}
+	KeyPrefixPausedDenom     = []byte{prefixPausedDenom}
+	KeyPrefixIBCMintRoute    = []byte{prefixIBCMintRoute}
+
+	KeyPrefixMintApprovalConfig = []byte{prefixMintApprovalConfig}
+	KeyPrefixMintProposal       = []byte{prefixMintProposal}
+	KeyPrefixMintProposalExpiry = []byte{prefixMintProposalExpiry}
+	KeyPrefixNextMintProposalID = []byte{prefixNextMintProposalID}
+
+	KeyPrefixUsedVoucherNonce = []byte{prefixUsedVoucherNonce}
+
+	KeyPrefixMintRecord       = []byte{prefixMintRecord}
+	KeyPrefixNextMintRecordID = []byte{prefixNextMintRecordID}
+
+	KeyPrefixDenomAdmin = []byte{prefixDenomAdmin}
+
+	KeyPrefixEmissionSchedule       = []byte{prefixEmissionSchedule}
+	KeyPrefixNextEmissionScheduleID = []byte{prefixNextEmissionScheduleID}
+
+	KeyPrefixLargeMintedInBlock = []byte{prefixLargeMintedInBlock}
+)
+
+// MinterAllowanceKey returns the store key of the allowance of the given
+// minter for the given denom.
+func MinterAllowanceKey(minter []byte, denom string) []byte {
+	return append(address.MustLengthPrefix(minter), []byte(denom)...)
+}
+
+// MinterMintStatsKey returns the store key of the total the given minter
+// minted of the given denom.
+func MinterMintStatsKey(minter []byte, denom string) []byte {
+	return append(address.MustLengthPrefix(minter), []byte(denom)...)
+}
+
+// MintedAtHeightPrefix returns the store prefix of the amounts of the given
+// denom minted at each height.
+func MintedAtHeightPrefix(denom string) []byte {
+	return address.MustLengthPrefix([]byte(denom))
+}
+
+// MintedAtHeightKey returns the store key of the amount of the given denom
+// minted at the given height.
+func MintedAtHeightKey(denom string, height uint64) []byte {
+	return append(MintedAtHeightPrefix(denom), sdk.Uint64ToBigEndian(height)...)
+}
+
+// IBCMintRoutePrefix returns the store prefix of the routes of the given
+// channel.
+func IBCMintRoutePrefix(channelID string) []byte {
+	return address.MustLengthPrefix([]byte(channelID))
+}
+
+// IBCMintRouteKey returns the store key of the route of the given base denom
+// received over the given channel.
+func IBCMintRouteKey(channelID, baseDenom string) []byte {
+	return append(IBCMintRoutePrefix(channelID), []byte(baseDenom)...)
+}
+
+// MintProposalKey returns the store key of the mint proposal with the given
+// identifier.
+func MintProposalKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// MintProposalExpiryKey returns the store key of the entry of the given mint
+// proposal in the expiry queue, which is ordered by expiry height.
+func MintProposalExpiryKey(expiryHeight, id uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(expiryHeight), sdk.Uint64ToBigEndian(id)...)
+}
+
+// UsedVoucherNonceKey returns the store key of the given nonce of a mint
+// voucher signed by the given minter. The nonce is encoded as a 32 byte
+// big-endian uint256.
+func UsedVoucherNonceKey(minter []byte, nonce *big.Int) []byte {
+	return append(address.MustLengthPrefix(minter), nonce.FillBytes(make([]byte, 32))...)
+}
+
+// MintRecordKey returns the store key of the mint record with the given
+// identifier, minted at the given height. Records are ordered by height, so
+// that the oldest ones can be pruned first.
+func MintRecordKey(height, id uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(height), sdk.Uint64ToBigEndian(id)...)
+}
+
+// EmissionScheduleKey returns the store key of the emission schedule with the
+// given identifier.
+func EmissionScheduleKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
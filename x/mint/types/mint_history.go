@@ -0,0 +1,50 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultNextMintRecordID is the identifier of the first mint record.
+const DefaultNextMintRecordID uint64 = 1
+
+// NewMintRecord returns a record of a mint of the given amount of the denom by
+// the minter to the recipient at the given height.
+func NewMintRecord(id, height uint64, minter, recipient sdk.AccAddress, denom string, amount math.Int) MintRecord {
+	return MintRecord{
+		ID:        id,
+		Height:    height,
+		Minter:    minter.String(),
+		Recipient: recipient.String(),
+		Denom:     denom,
+		Amount:    amount,
+	}
+}
+
+// Validate performs a stateless validation of the mint record.
+func (r MintRecord) Validate() error {
+	if r.ID < DefaultNextMintRecordID {
+		return errorsmod.Wrapf(ErrInvalidMintRecord, "id must be at least %d, got %d", DefaultNextMintRecordID, r.ID)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.Minter); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid minter address %s", r.Minter)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.Recipient); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid recipient address %s", r.Recipient)
+	}
+
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidMintRecord, err.Error())
+	}
+
+	if r.Amount.IsNil() || !r.Amount.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidMintRecord, "amount must be positive, got %s", r.Amount)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestMintRecordValidate(t *testing.T) {
+	minter := authtypes.NewModuleAddress(govtypes.ModuleName)
+	recipient := authtypes.NewModuleAddress("recipient")
+
+	testCases := []struct {
+		name     string
+		record   MintRecord
+		expError bool
+	}{
+		{
+			name:   "valid record",
+			record: NewMintRecord(1, 10, minter, recipient, "uusdc", math.NewInt(1000)),
+		},
+		{
+			name:     "zero id",
+			record:   NewMintRecord(0, 10, minter, recipient, "uusdc", math.NewInt(1000)),
+			expError: true,
+		},
+		{
+			name: "invalid minter",
+			record: MintRecord{
+				ID: 1, Height: 10, Minter: "invalid", Recipient: recipient.String(), Denom: "uusdc", Amount: math.NewInt(1000),
+			},
+			expError: true,
+		},
+		{
+			name: "invalid recipient",
+			record: MintRecord{
+				ID: 1, Height: 10, Minter: minter.String(), Recipient: "invalid", Denom: "uusdc", Amount: math.NewInt(1000),
+			},
+			expError: true,
+		},
+		{
+			name:     "invalid denom",
+			record:   NewMintRecord(1, 10, minter, recipient, "1uusdc", math.NewInt(1000)),
+			expError: true,
+		},
+		{
+			name:     "zero amount",
+			record:   NewMintRecord(1, 10, minter, recipient, "uusdc", math.ZeroInt()),
+			expError: true,
+		},
+		{
+			name:     "nil amount",
+			record:   MintRecord{ID: 1, Height: 10, Minter: minter.String(), Recipient: recipient.String(), Denom: "uusdc"},
+			expError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.record.Validate()
+			if tc.expError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDenomMintLimit returns a new mint limit for the given denom.
+func NewDenomMintLimit(denom string, maxSupply, rateLimit math.Int, rateLimitWindow uint64) DenomMintLimit {
+	return DenomMintLimit{
+		Denom:           denom,
+		MaxSupply:       maxSupply,
+		RateLimit:       rateLimit,
+		RateLimitWindow: rateLimitWindow,
+	}
+}
+
+// Validate performs a stateless validation of the mint limit.
+func (l DenomMintLimit) Validate() error {
+	if err := sdk.ValidateDenom(l.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidDenomMintLimit, err.Error())
+	}
+
+	if l.MaxSupply.IsNil() || l.MaxSupply.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidDenomMintLimit, "max supply cannot be negative")
+	}
+
+	if l.RateLimit.IsNil() || l.RateLimit.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidDenomMintLimit, "rate limit cannot be negative")
+	}
+
+	if l.RateLimit.IsPositive() && l.RateLimitWindow == 0 {
+		return errorsmod.Wrap(ErrInvalidDenomMintLimit, "rate limit window must be set along with the rate limit")
+	}
+
+	return nil
+}
+
+// HasSupplyCap returns true if the limit caps the total supply of the denom.
+func (l DenomMintLimit) HasSupplyCap() bool {
+	return l.MaxSupply.IsPositive()
+}
+
+// HasRateLimit returns true if the limit bounds the amount minted per window.
+func (l DenomMintLimit) HasRateLimit() bool {
+	return l.RateLimit.IsPositive()
+}
+
+// IsEmpty returns true if the limit neither caps the supply nor the mint rate.
+func (l DenomMintLimit) IsEmpty() bool {
+	return !l.HasSupplyCap() && !l.HasRateLimit()
+}
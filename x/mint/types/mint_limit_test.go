@@ -0,0 +1,74 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+)
+
+func TestDenomMintLimitValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		limit   DenomMintLimit
+		expPass bool
+	}{
+		{
+			"pass - supply cap and rate limit",
+			NewDenomMintLimit("uusdc", math.NewInt(1_000_000), math.NewInt(1000), 100),
+			true,
+		},
+		{
+			"pass - supply cap only",
+			NewDenomMintLimit("uusdc", math.NewInt(1_000_000), math.ZeroInt(), 0),
+			true,
+		},
+		{
+			"pass - rate limit only",
+			NewDenomMintLimit("uusdc", math.ZeroInt(), math.NewInt(1000), 100),
+			true,
+		},
+		{
+			"pass - empty limit",
+			NewDenomMintLimit("uusdc", math.ZeroInt(), math.ZeroInt(), 0),
+			true,
+		},
+		{
+			"fail - invalid denom",
+			NewDenomMintLimit("", math.NewInt(1_000_000), math.ZeroInt(), 0),
+			false,
+		},
+		{
+			"fail - negative max supply",
+			NewDenomMintLimit("uusdc", math.NewInt(-1), math.ZeroInt(), 0),
+			false,
+		},
+		{
+			"fail - negative rate limit",
+			NewDenomMintLimit("uusdc", math.ZeroInt(), math.NewInt(-1), 100),
+			false,
+		},
+		{
+			"fail - rate limit without window",
+			NewDenomMintLimit("uusdc", math.ZeroInt(), math.NewInt(1000), 0),
+			false,
+		},
+		{
+			"fail - nil values",
+			DenomMintLimit{Denom: "uusdc"},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.limit.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMintApprovalConfig returns a new mint approval config.
+func NewMintApprovalConfig(
+	approvers []string,
+	threshold uint32,
+	minDelay, expiry uint64,
+	largeMintThresholds sdk.Coins,
+) MintApprovalConfig {
+	return MintApprovalConfig{
+		Approvers:           approvers,
+		Threshold:           threshold,
+		MinDelay:            minDelay,
+		Expiry:              expiry,
+		LargeMintThresholds: largeMintThresholds,
+	}
+}
+
+// DefaultMintApprovalConfig returns the default mint approval config. No
+// approver is set, which disables mint proposals.
+func DefaultMintApprovalConfig() MintApprovalConfig {
+	return MintApprovalConfig{
+		Approvers:           []string{},
+		LargeMintThresholds: sdk.Coins{},
+	}
+}
+
+// Validate performs a stateless validation of the config. Without approvers,
+// every other field must be unset, since no proposal could ever be approved.
+func (c MintApprovalConfig) Validate() error {
+	seenApprovers := make(map[string]bool)
+	for _, approver := range c.Approvers {
+		if _, err := sdk.AccAddressFromBech32(approver); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMintApprovalConfig, "invalid approver %s: %s", approver, err)
+		}
+
+		if seenApprovers[approver] {
+			return errorsmod.Wrapf(ErrInvalidMintApprovalConfig, "duplicate approver %s", approver)
+		}
+		seenApprovers[approver] = true
+	}
+
+	if err := c.LargeMintThresholds.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMintApprovalConfig, "invalid large mint thresholds: %s", err)
+	}
+
+	if !c.IsEnabled() {
+		if c.Threshold != 0 || c.MinDelay != 0 || c.Expiry != 0 || !c.LargeMintThresholds.Empty() {
+			return errorsmod.Wrap(ErrInvalidMintApprovalConfig, "threshold, delay, expiry and large mint thresholds require approvers")
+		}
+		return nil
+	}
+
+	if c.Threshold == 0 || int(c.Threshold) > len(c.Approvers) {
+		return errorsmod.Wrapf(
+			ErrInvalidMintApprovalConfig,
+			"threshold must be between 1 and the number of approvers %d, got %d", len(c.Approvers), c.Threshold,
+		)
+	}
+
+	if c.Expiry == 0 {
+		return errorsmod.Wrap(ErrInvalidMintApprovalConfig, "expiry must be greater than zero")
+	}
+
+	return nil
+}
+
+// IsEnabled returns true if mint proposals can be submitted, that is if at
+// least one approver is set. A valid config without approvers is empty.
+func (c MintApprovalConfig) IsEnabled() bool {
+	return len(c.Approvers) > 0
+}
+
+// IsApprover returns true if the address is allowed to approve mint proposals.
+func (c MintApprovalConfig) IsApprover(addr sdk.AccAddress) bool {
+	for _, approver := range c.Approvers {
+		if addr.Equals(sdk.MustAccAddressFromBech32(approver)) {
+			return true
+		}
+	}
+	return false
+}
+
+// RequiresProposal returns true if minting the given amount of the denom
+// exceeds its large mint threshold, and must go through a proposal.
+func (c MintApprovalConfig) RequiresProposal(denom string, amount math.Int) bool {
+	found, threshold := c.LargeMintThresholds.Find(denom)
+	return found && amount.GT(threshold.Amount)
+}
+
+// NewMintProposal returns a new mint proposal without approvals.
+func NewMintProposal(
+	id uint64,
+	proposer, recipient sdk.AccAddress,
+	denom string,
+	amount math.Int,
+	executableHeight, expiryHeight uint64,
+) MintProposal {
+	return MintProposal{
+		ID:               id,
+		Proposer:         proposer.String(),
+		Recipient:        recipient.String(),
+		Denom:            denom,
+		Amount:           amount,
+		ExecutableHeight: executableHeight,
+		ExpiryHeight:     expiryHeight,
+		Approvals:        []string{},
+	}
+}
+
+// Validate performs a stateless validation of the proposal.
+func (p MintProposal) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.Proposer); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMintProposal, "invalid proposer %s: %s", p.Proposer, err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.Recipient); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMintProposal, "invalid recipient %s: %s", p.Recipient, err)
+	}
+
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidMintProposal, err.Error())
+	}
+
+	if p.Amount.IsNil() || !p.Amount.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidMintProposal, "amount must be positive, got %s", p.Amount)
+	}
+
+	if p.ExpiryHeight < p.ExecutableHeight {
+		return errorsmod.Wrapf(
+			ErrInvalidMintProposal,
+			"expiry height %d is before executable height %d", p.ExpiryHeight, p.ExecutableHeight,
+		)
+	}
+
+	seenApprovals := make(map[string]bool)
+	for _, approver := range p.Approvals {
+		if _, err := sdk.AccAddressFromBech32(approver); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMintProposal, "invalid approval %s: %s", approver, err)
+		}
+
+		if seenApprovals[approver] {
+			return errorsmod.Wrapf(ErrInvalidMintProposal, "duplicate approval %s", approver)
+		}
+		seenApprovals[approver] = true
+	}
+
+	return nil
+}
+
+// HasApproved returns true if the address approved the proposal.
+func (p MintProposal) HasApproved(addr sdk.AccAddress) bool {
+	for _, approver := range p.Approvals {
+		if addr.Equals(sdk.MustAccAddressFromBech32(approver)) {
+			return true
+		}
+	}
+	return false
+}
+
+// CountApprovals returns the number of approvals given by addresses that are
+// still approvers in the given config. Approvals of removed approvers do not
+// count towards the threshold.
+func (p MintProposal) CountApprovals(config MintApprovalConfig) uint32 {
+	var count uint32
+	for _, approver := range p.Approvals {
+		if config.IsApprover(sdk.MustAccAddressFromBech32(approver)) {
+			count++
+		}
+	}
+	return count
+}
+
+// IsExpired returns true if the proposal can no longer be executed at the
+// given height.
+func (p MintProposal) IsExpired(height uint64) bool {
+	return height > p.ExpiryHeight
+}
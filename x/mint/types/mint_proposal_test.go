@@ -0,0 +1,173 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestMintApprovalConfigValidate(t *testing.T) {
+	approver := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	other := authtypes.NewModuleAddress("other").String()
+	thresholds := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1000))
+
+	testCases := []struct {
+		name    string
+		config  MintApprovalConfig
+		expPass bool
+	}{
+		{
+			"pass - default config",
+			DefaultMintApprovalConfig(),
+			true,
+		},
+		{
+			"pass - 2 of 2 approvers",
+			NewMintApprovalConfig([]string{approver, other}, 2, 10, 100, thresholds),
+			true,
+		},
+		{
+			"pass - no large mint thresholds",
+			NewMintApprovalConfig([]string{approver}, 1, 0, 100, nil),
+			true,
+		},
+		{
+			"fail - invalid approver",
+			NewMintApprovalConfig([]string{"invalid"}, 1, 10, 100, nil),
+			false,
+		},
+		{
+			"fail - duplicate approver",
+			NewMintApprovalConfig([]string{approver, approver}, 1, 10, 100, nil),
+			false,
+		},
+		{
+			"fail - zero threshold",
+			NewMintApprovalConfig([]string{approver}, 0, 10, 100, nil),
+			false,
+		},
+		{
+			"fail - threshold above the number of approvers",
+			NewMintApprovalConfig([]string{approver}, 2, 10, 100, nil),
+			false,
+		},
+		{
+			"fail - zero expiry",
+			NewMintApprovalConfig([]string{approver}, 1, 10, 0, nil),
+			false,
+		},
+		{
+			"fail - large mint thresholds without approvers",
+			NewMintApprovalConfig(nil, 0, 0, 0, thresholds),
+			false,
+		},
+		{
+			"fail - min delay without approvers",
+			NewMintApprovalConfig(nil, 0, 10, 0, nil),
+			false,
+		},
+		{
+			"fail - invalid large mint thresholds",
+			NewMintApprovalConfig([]string{approver}, 1, 10, 100, sdk.Coins{{Denom: "uusdc", Amount: math.ZeroInt()}}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.config.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMintApprovalConfigRequiresProposal(t *testing.T) {
+	approver := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	config := NewMintApprovalConfig([]string{approver}, 1, 10, 100, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1000)))
+
+	require.False(t, config.RequiresProposal("uusdc", math.NewInt(1000)))
+	require.True(t, config.RequiresProposal("uusdc", math.NewInt(1001)))
+	require.False(t, config.RequiresProposal("uatom", math.NewInt(1_000_000)))
+}
+
+func TestMintProposalValidate(t *testing.T) {
+	addr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	valid := NewMintProposal(1, addr, addr, "uusdc", math.NewInt(1000), 10, 110)
+
+	withApprovals := func(approvals ...string) MintProposal {
+		proposal := valid
+		proposal.Approvals = approvals
+		return proposal
+	}
+
+	testCases := []struct {
+		name     string
+		proposal MintProposal
+		expPass  bool
+	}{
+		{
+			"pass - valid proposal",
+			valid,
+			true,
+		},
+		{
+			"pass - approved proposal",
+			withApprovals(addr.String()),
+			true,
+		},
+		{
+			"fail - invalid proposer",
+			MintProposal{Proposer: "invalid", Recipient: addr.String(), Denom: "uusdc", Amount: math.NewInt(1000)},
+			false,
+		},
+		{
+			"fail - invalid denom",
+			NewMintProposal(1, addr, addr, "", math.NewInt(1000), 10, 110),
+			false,
+		},
+		{
+			"fail - zero amount",
+			NewMintProposal(1, addr, addr, "uusdc", math.ZeroInt(), 10, 110),
+			false,
+		},
+		{
+			"fail - expiry before executable height",
+			NewMintProposal(1, addr, addr, "uusdc", math.NewInt(1000), 10, 9),
+			false,
+		},
+		{
+			"fail - duplicate approval",
+			withApprovals(addr.String(), addr.String()),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.proposal.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMintProposalCountApprovals(t *testing.T) {
+	approver := authtypes.NewModuleAddress(govtypes.ModuleName)
+	removed := authtypes.NewModuleAddress("removed")
+	config := NewMintApprovalConfig([]string{approver.String()}, 1, 0, 100, nil)
+
+	proposal := NewMintProposal(1, approver, approver, "uusdc", math.NewInt(1000), 10, 110)
+	proposal.Approvals = []string{approver.String(), removed.String()}
+
+	require.True(t, proposal.HasApproved(removed))
+	require.Equal(t, uint32(1), proposal.CountApprovals(config))
+}
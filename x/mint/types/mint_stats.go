@@ -0,0 +1,62 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewDenomMintStats returns the totals minted and burned of the given denom.
+func NewDenomMintStats(denom string, totalMinted, totalBurned math.Int) DenomMintStats {
+	return DenomMintStats{
+		Denom:       denom,
+		TotalMinted: totalMinted,
+		TotalBurned: totalBurned,
+	}
+}
+
+// Validate performs a stateless validation of the denom totals.
+func (s DenomMintStats) Validate() error {
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidMintStats, err.Error())
+	}
+
+	if s.TotalMinted.IsNil() || s.TotalMinted.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidMintStats, "total minted cannot be negative")
+	}
+
+	if s.TotalBurned.IsNil() || s.TotalBurned.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidMintStats, "total burned cannot be negative")
+	}
+
+	return nil
+}
+
+// NewMinterMintStats returns the total the given minter minted of the given
+// denom.
+func NewMinterMintStats(minter sdk.AccAddress, denom string, minted math.Int) MinterMintStats {
+	return MinterMintStats{
+		Minter: minter.String(),
+		Denom:  denom,
+		Minted: minted,
+	}
+}
+
+// Validate performs a stateless validation of the minter total.
+func (s MinterMintStats) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Minter); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid minter address %s", s.Minter)
+	}
+
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidMintStats, err.Error())
+	}
+
+	if s.Minted.IsNil() || s.Minted.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidMintStats, "minted amount cannot be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestDenomMintStatsValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		stats   DenomMintStats
+		expPass bool
+	}{
+		{
+			"pass - valid totals",
+			NewDenomMintStats("uusdc", math.NewInt(1000), math.NewInt(400)),
+			true,
+		},
+		{
+			"fail - invalid denom",
+			NewDenomMintStats("", math.NewInt(1000), math.NewInt(400)),
+			false,
+		},
+		{
+			"fail - negative total minted",
+			NewDenomMintStats("uusdc", math.NewInt(-1), math.ZeroInt()),
+			false,
+		},
+		{
+			"fail - nil total burned",
+			DenomMintStats{Denom: "uusdc", TotalMinted: math.NewInt(1000)},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.stats.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMinterMintStatsValidate(t *testing.T) {
+	minter := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	testCases := []struct {
+		name    string
+		stats   MinterMintStats
+		expPass bool
+	}{
+		{
+			"pass - valid total",
+			NewMinterMintStats(minter, "uusdc", math.NewInt(1000)),
+			true,
+		},
+		{
+			"fail - invalid minter",
+			MinterMintStats{Minter: "invalid", Denom: "uusdc", Minted: math.NewInt(1000)},
+			false,
+		},
+		{
+			"fail - invalid denom",
+			NewMinterMintStats(minter, "", math.NewInt(1000)),
+			false,
+		},
+		{
+			"fail - negative total",
+			NewMinterMintStats(minter, "uusdc", math.NewInt(-1)),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.stats.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewMinterAllowance returns a new allowance of the given minter for the
+// given denom.
+func NewMinterAllowance(minter sdk.AccAddress, denom string, value math.Int) MinterAllowance {
+	return MinterAllowance{
+		Minter: minter.String(),
+		Denom:  denom,
+		Value:  value,
+	}
+}
+
+// Validate performs a stateless validation of the minter allowance.
+func (a MinterAllowance) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.Minter); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid minter address %s", a.Minter)
+	}
+
+	if err := sdk.ValidateDenom(a.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidMinterAllowance, err.Error())
+	}
+
+	if a.Value.IsNil() || a.Value.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidMinterAllowance, "allowance value cannot be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestMinterAllowanceValidate(t *testing.T) {
+	minter := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	testCases := []struct {
+		name      string
+		allowance MinterAllowance
+		expPass   bool
+	}{
+		{
+			"pass - valid allowance",
+			NewMinterAllowance(minter, "uusdc", math.NewInt(1000)),
+			true,
+		},
+		{
+			"pass - drained allowance",
+			NewMinterAllowance(minter, "uusdc", math.ZeroInt()),
+			true,
+		},
+		{
+			"fail - invalid minter",
+			MinterAllowance{Minter: "invalid", Denom: "uusdc", Value: math.NewInt(1000)},
+			false,
+		},
+		{
+			"fail - invalid denom",
+			NewMinterAllowance(minter, "", math.NewInt(1000)),
+			false,
+		},
+		{
+			"fail - negative allowance",
+			NewMinterAllowance(minter, "uusdc", math.NewInt(-1)),
+			false,
+		},
+		{
+			"fail - nil allowance",
+			MinterAllowance{Minter: minter.String(), Denom: "uusdc"},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.allowance.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg              = &MsgUpdateMintAuthority{}
+	_ sdk.Msg              = &MsgSetDenomMintLimit{}
+	_ sdk.Msg              = &MsgUpdateGuardian{}
+	_ sdk.Msg              = &MsgUnpause{}
+	_ sdk.Msg              = &MsgSetIBCMintRoute{}
+	_ sdk.Msg              = &MsgSetMintApprovalConfig{}
+	_ sdk.Msg              = &MsgCreateEmissionSchedule{}
+	_ sdk.Msg              = &MsgCancelEmissionSchedule{}
+	_ sdk.Msg              = &MsgUpdateParams{}
+	_ sdk.HasValidateBasic = &MsgUpdateMintAuthority{}
+	_ sdk.HasValidateBasic = &MsgSetDenomMintLimit{}
+	_ sdk.HasValidateBasic = &MsgUpdateGuardian{}
+	_ sdk.HasValidateBasic = &MsgUnpause{}
+	_ sdk.HasValidateBasic = &MsgSetIBCMintRoute{}
+	_ sdk.HasValidateBasic = &MsgSetMintApprovalConfig{}
+	_ sdk.HasValidateBasic = &MsgCreateEmissionSchedule{}
+	_ sdk.HasValidateBasic = &MsgCancelEmissionSchedule{}
+	_ sdk.HasValidateBasic = &MsgUpdateParams{}
+)
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateMintAuthority) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return ValidateMintAuthority(m.MintAuthority)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateMintAuthority) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetDenomMintLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Limit.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetDenomMintLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateGuardian) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return ValidateGuardian(m.Guardian)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateGuardian) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUnpause) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if m.Denom == "" {
+		return nil
+	}
+
+	return sdk.ValidateDenom(m.Denom)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUnpause) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetIBCMintRoute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Route.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetIBCMintRoute) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetMintApprovalConfig) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Config.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetMintApprovalConfig) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data. The identifier and
+// the emitted amount of the schedule are set when it is created, so they must
+// be left empty.
+func (m *MsgCreateEmissionSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if m.Schedule.ID != 0 {
+		return errorsmod.Wrapf(ErrInvalidEmissionSchedule, "id is assigned on creation, got %d", m.Schedule.ID)
+	}
+
+	schedule := m.Schedule
+	if schedule.Emitted.IsNil() {
+		schedule.Emitted = math.ZeroInt()
+	}
+	if !schedule.Emitted.IsZero() {
+		return errorsmod.Wrapf(ErrInvalidEmissionSchedule, "emitted amount must be zero on creation, got %s", schedule.Emitted)
+	}
+
+	return schedule.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCreateEmissionSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgCancelEmissionSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if m.Id < DefaultNextEmissionScheduleID {
+		return errorsmod.Wrapf(ErrInvalidEmissionSchedule, "id must be at least %d, got %d", DefaultNextEmissionScheduleID, m.Id)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCancelEmissionSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
@@ -0,0 +1,443 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type MsgsTestSuite struct {
+	suite.Suite
+}
+
+func TestMsgsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgsTestSuite))
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateMintAuthorityValidateBasic() {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		msg     *MsgUpdateMintAuthority
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&MsgUpdateMintAuthority{
+				Authority:     "invalid",
+				MintAuthority: govAddr,
+			},
+			false,
+		},
+		{
+			"fail - invalid mint authority address",
+			&MsgUpdateMintAuthority{
+				Authority:     govAddr,
+				MintAuthority: "invalid",
+			},
+			false,
+		},
+		{
+			"pass - empty mint authority",
+			&MsgUpdateMintAuthority{
+				Authority: govAddr,
+			},
+			true,
+		},
+		{
+			"pass - valid msg",
+			&MsgUpdateMintAuthority{
+				Authority:     govAddr,
+				MintAuthority: govAddr,
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgSetDenomMintLimitValidateBasic() {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		msg     *MsgSetDenomMintLimit
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&MsgSetDenomMintLimit{
+				Authority: "invalid",
+				Limit:     NewDenomMintLimit("uusdc", math.NewInt(1000), math.ZeroInt(), 0),
+			},
+			false,
+		},
+		{
+			"fail - invalid limit",
+			&MsgSetDenomMintLimit{
+				Authority: govAddr,
+				Limit:     NewDenomMintLimit("uusdc", math.NewInt(-1), math.ZeroInt(), 0),
+			},
+			false,
+		},
+		{
+			"pass - removal",
+			&MsgSetDenomMintLimit{
+				Authority: govAddr,
+				Limit:     NewDenomMintLimit("uusdc", math.ZeroInt(), math.ZeroInt(), 0),
+			},
+			true,
+		},
+		{
+			"pass - valid msg",
+			&MsgSetDenomMintLimit{
+				Authority: govAddr,
+				Limit:     NewDenomMintLimit("uusdc", math.NewInt(1000), math.NewInt(100), 10),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateGuardianValidateBasic() {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		msg     *MsgUpdateGuardian
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&MsgUpdateGuardian{
+				Authority: "invalid",
+				Guardian:  govAddr,
+			},
+			false,
+		},
+		{
+			"fail - invalid guardian address",
+			&MsgUpdateGuardian{
+				Authority: govAddr,
+				Guardian:  "invalid",
+			},
+			false,
+		},
+		{
+			"pass - empty guardian",
+			&MsgUpdateGuardian{
+				Authority: govAddr,
+			},
+			true,
+		},
+		{
+			"pass - valid msg",
+			&MsgUpdateGuardian{
+				Authority: govAddr,
+				Guardian:  govAddr,
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUnpauseValidateBasic() {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		msg     *MsgUnpause
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&MsgUnpause{
+				Authority: "invalid",
+			},
+			false,
+		},
+		{
+			"fail - invalid denom",
+			&MsgUnpause{
+				Authority: govAddr,
+				Denom:     "1",
+			},
+			false,
+		},
+		{
+			"pass - every pause",
+			&MsgUnpause{
+				Authority: govAddr,
+			},
+			true,
+		},
+		{
+			"pass - single denom",
+			&MsgUnpause{
+				Authority: govAddr,
+				Denom:     "uusdc",
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgSetIBCMintRouteValidateBasic() {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		msg     *MsgSetIBCMintRoute
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&MsgSetIBCMintRoute{
+				Authority: "invalid",
+				Route:     NewIBCMintRoute("channel-0", "uusdc", "usdc"),
+			},
+			false,
+		},
+		{
+			"fail - invalid route",
+			&MsgSetIBCMintRoute{
+				Authority: govAddr,
+				Route:     NewIBCMintRoute("invalid", "uusdc", "usdc"),
+			},
+			false,
+		},
+		{
+			"pass - remove route",
+			&MsgSetIBCMintRoute{
+				Authority: govAddr,
+				Route:     NewIBCMintRoute("channel-0", "uusdc", ""),
+			},
+			true,
+		},
+		{
+			"pass - valid msg",
+			&MsgSetIBCMintRoute{
+				Authority: govAddr,
+				Route:     NewIBCMintRoute("channel-0", "uusdc", "usdc"),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgSetMintApprovalConfigValidateBasic() {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		msg     *MsgSetMintApprovalConfig
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&MsgSetMintApprovalConfig{
+				Authority: "invalid",
+				Config:    NewMintApprovalConfig([]string{govAddr}, 1, 10, 100, nil),
+			},
+			false,
+		},
+		{
+			"fail - invalid config",
+			&MsgSetMintApprovalConfig{
+				Authority: govAddr,
+				Config:    NewMintApprovalConfig([]string{govAddr}, 2, 10, 100, nil),
+			},
+			false,
+		},
+		{
+			"pass - disable mint proposals",
+			&MsgSetMintApprovalConfig{
+				Authority: govAddr,
+				Config:    DefaultMintApprovalConfig(),
+			},
+			true,
+		},
+		{
+			"pass - valid msg",
+			&MsgSetMintApprovalConfig{
+				Authority: govAddr,
+				Config:    NewMintApprovalConfig([]string{govAddr}, 1, 10, 100, nil),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgCreateEmissionScheduleValidateBasic() {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	recipient := authtypes.NewModuleAddress("recipient")
+	schedule := NewEmissionSchedule(0, "uusdc", recipient, EmissionCurveLinear, math.NewInt(1000), 10, 19, 0)
+
+	withID := schedule
+	withID.ID = 1
+
+	withEmitted := schedule
+	withEmitted.Emitted = math.NewInt(10)
+
+	withoutEmitted := schedule
+	withoutEmitted.Emitted = math.Int{}
+
+	testCases := []struct {
+		name    string
+		msg     *MsgCreateEmissionSchedule
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&MsgCreateEmissionSchedule{Authority: "invalid", Schedule: schedule},
+			false,
+		},
+		{
+			"fail - id set",
+			&MsgCreateEmissionSchedule{Authority: govAddr, Schedule: withID},
+			false,
+		},
+		{
+			"fail - emitted amount set",
+			&MsgCreateEmissionSchedule{Authority: govAddr, Schedule: withEmitted},
+			false,
+		},
+		{
+			"fail - invalid schedule",
+			&MsgCreateEmissionSchedule{
+				Authority: govAddr,
+				Schedule:  NewEmissionSchedule(0, "uusdc", recipient, EmissionCurveHalving, math.NewInt(1000), 10, 19, 0),
+			},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&MsgCreateEmissionSchedule{Authority: govAddr, Schedule: schedule},
+			true,
+		},
+		{
+			"pass - emitted amount left empty",
+			&MsgCreateEmissionSchedule{Authority: govAddr, Schedule: withoutEmitted},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgCancelEmissionScheduleValidateBasic() {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		msg     *MsgCancelEmissionSchedule
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&MsgCancelEmissionSchedule{Authority: "invalid", Id: 1},
+			false,
+		},
+		{
+			"fail - zero id",
+			&MsgCancelEmissionSchedule{Authority: govAddr, Id: 0},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&MsgCancelEmissionSchedule{Authority: govAddr, Id: 1},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
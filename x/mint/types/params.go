@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParams creates a new Params instance
+func NewParams(
+	blockedRecipients []string,
+	contractRecipientDenoms []string,
+	autoRegisterERC20 bool,
+	mintHistoryRetention uint64,
+) Params {
+	return Params{
+		BlockedRecipients:       blockedRecipients,
+		ContractRecipientDenoms: contractRecipientDenoms,
+		AutoRegisterERC20:       autoRegisterERC20,
+		MintHistoryRetention:    mintHistoryRetention,
+	}
+}
+
+// DefaultMintHistoryRetention is the default number of blocks the mints are
+// kept in the mint history, about a week with 6 second blocks.
+const DefaultMintHistoryRetention uint64 = 100_000
+
+// DefaultParams returns the default mint module parameters. No address is
+// blocked besides the ones checked by the keeper, no denom can be minted to
+// smart contracts, minted denoms are not registered in x/erc20 and the mint
+// history keeps the mints of the last DefaultMintHistoryRetention blocks.
+func DefaultParams() Params {
+	return Params{
+		MintHistoryRetention: DefaultMintHistoryRetention,
+	}
+}
+
+// Validate performs a stateless validation of the params.
+func (p Params) Validate() error {
+	seenRecipients := make(map[string]bool)
+	for _, recipient := range p.BlockedRecipients {
+		if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+			return fmt.Errorf("invalid blocked recipient %s: %w", recipient, err)
+		}
+
+		if seenRecipients[recipient] {
+			return fmt.Errorf("duplicate blocked recipient %s", recipient)
+		}
+		seenRecipients[recipient] = true
+	}
+
+	seenDenoms := make(map[string]bool)
+	for _, denom := range p.ContractRecipientDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid contract recipient denom %s: %w", denom, err)
+		}
+
+		if seenDenoms[denom] {
+			return fmt.Errorf("duplicate contract recipient denom %s", denom)
+		}
+		seenDenoms[denom] = true
+	}
+
+	return nil
+}
+
+// IsBlockedRecipient returns true if the address is in the deny-list.
+func (p Params) IsBlockedRecipient(addr sdk.AccAddress) bool {
+	for _, recipient := range p.BlockedRecipients {
+		if addr.Equals(sdk.MustAccAddressFromBech32(recipient)) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsContractRecipient returns true if the denom can be minted to smart
+// contracts.
+func (p Params) AllowsContractRecipient(denom string) bool {
+	for _, d := range p.ContractRecipientDenoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		params  Params
+		expPass bool
+	}{
+		{
+			"pass - default params",
+			DefaultParams(),
+			true,
+		},
+		{
+			"pass - blocked recipients and contract recipient denoms",
+			NewParams([]string{govAddr}, []string{"uusdc", "uatom"}, false, 0),
+			true,
+		},
+		{
+			"fail - invalid blocked recipient",
+			NewParams([]string{"invalid"}, nil, false, 0),
+			false,
+		},
+		{
+			"fail - duplicate blocked recipient",
+			NewParams([]string{govAddr, govAddr}, nil, false, 0),
+			false,
+		},
+		{
+			"fail - invalid contract recipient denom",
+			NewParams(nil, []string{""}, false, 0),
+			false,
+		},
+		{
+			"fail - duplicate contract recipient denom",
+			NewParams(nil, []string{"uusdc", "uusdc"}, false, 0),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsRecipients(t *testing.T) {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	params := NewParams([]string{govAddr.String()}, []string{"uusdc"}, false, 0)
+
+	require.True(t, params.IsBlockedRecipient(govAddr))
+	require.False(t, params.IsBlockedRecipient(authtypes.NewModuleAddress(ModuleName)))
+	require.True(t, params.AllowsContractRecipient("uusdc"))
+	require.False(t, params.AllowsContractRecipient("uatom"))
+}
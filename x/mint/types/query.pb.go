@@ -0,0 +1,6143 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/evm/mint/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryMintAuthorityRequest is the request type for the Query/MintAuthority RPC
+// method.
+type QueryMintAuthorityRequest struct {
+}
+
+func (m *QueryMintAuthorityRequest) Reset()         { *m = QueryMintAuthorityRequest{} }
+func (m *QueryMintAuthorityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintAuthorityRequest) ProtoMessage()    {}
+func (*QueryMintAuthorityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{0}
+}
+func (m *QueryMintAuthorityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintAuthorityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintAuthorityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintAuthorityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintAuthorityRequest.Merge(m, src)
+}
+func (m *QueryMintAuthorityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintAuthorityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintAuthorityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintAuthorityRequest proto.InternalMessageInfo
+
+// QueryMintAuthorityResponse is the response type for the Query/MintAuthority
+// RPC method.
+type QueryMintAuthorityResponse struct {
+	// mint_authority is the bech32 address of the current mint authority. It is
+	// empty when minting is disabled.
+	MintAuthority string `protobuf:"bytes,1,opt,name=mint_authority,json=mintAuthority,proto3" json:"mint_authority,omitempty"`
+}
+
+func (m *QueryMintAuthorityResponse) Reset()         { *m = QueryMintAuthorityResponse{} }
+func (m *QueryMintAuthorityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintAuthorityResponse) ProtoMessage()    {}
+func (*QueryMintAuthorityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{1}
+}
+func (m *QueryMintAuthorityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintAuthorityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintAuthorityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintAuthorityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintAuthorityResponse.Merge(m, src)
+}
+func (m *QueryMintAuthorityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintAuthorityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintAuthorityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintAuthorityResponse proto.InternalMessageInfo
+
+func (m *QueryMintAuthorityResponse) GetMintAuthority() string {
+	if m != nil {
+		return m.MintAuthority
+	}
+	return ""
+}
+
+// QueryDenomMintLimitRequest is the request type for the Query/DenomMintLimit
+// RPC method.
+type QueryDenomMintLimitRequest struct {
+	// denom is the denomination to query the limit of
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryDenomMintLimitRequest) Reset()         { *m = QueryDenomMintLimitRequest{} }
+func (m *QueryDenomMintLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomMintLimitRequest) ProtoMessage()    {}
+func (*QueryDenomMintLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{2}
+}
+func (m *QueryDenomMintLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomMintLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomMintLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomMintLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomMintLimitRequest.Merge(m, src)
+}
+func (m *QueryDenomMintLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomMintLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomMintLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomMintLimitRequest proto.InternalMessageInfo
+
+func (m *QueryDenomMintLimitRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryDenomMintLimitResponse is the response type for the Query/DenomMintLimit
+// RPC method.
+type QueryDenomMintLimitResponse struct {
+	// limit is the supply cap and rate limit of the denom. It is empty if the
+	// denom has no limit.
+	Limit DenomMintLimit `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit"`
+	// remaining_supply is the amount that can be minted before reaching the max
+	// supply. It is empty if the denom has no supply cap.
+	RemainingSupply *cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=remaining_supply,json=remainingSupply,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_supply,omitempty"`
+	// remaining_rate_limit is the amount that can be minted in the current
+	// window before reaching the rate limit. It is empty if the denom has no
+	// rate limit.
+	RemainingRateLimit *cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=remaining_rate_limit,json=remainingRateLimit,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_rate_limit,omitempty"`
+}
+
+func (m *QueryDenomMintLimitResponse) Reset()         { *m = QueryDenomMintLimitResponse{} }
+func (m *QueryDenomMintLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomMintLimitResponse) ProtoMessage()    {}
+func (*QueryDenomMintLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{3}
+}
+func (m *QueryDenomMintLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomMintLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomMintLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomMintLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomMintLimitResponse.Merge(m, src)
+}
+func (m *QueryDenomMintLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomMintLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomMintLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomMintLimitResponse proto.InternalMessageInfo
+
+func (m *QueryDenomMintLimitResponse) GetLimit() DenomMintLimit {
+	if m != nil {
+		return m.Limit
+	}
+	return DenomMintLimit{}
+}
+
+// QueryDenomMintStatsRequest is the request type for the Query/DenomMintStats
+// RPC method.
+type QueryDenomMintStatsRequest struct {
+	// denom is the denomination to query the totals of
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryDenomMintStatsRequest) Reset()         { *m = QueryDenomMintStatsRequest{} }
+func (m *QueryDenomMintStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomMintStatsRequest) ProtoMessage()    {}
+func (*QueryDenomMintStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{4}
+}
+func (m *QueryDenomMintStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomMintStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomMintStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomMintStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomMintStatsRequest.Merge(m, src)
+}
+func (m *QueryDenomMintStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomMintStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomMintStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomMintStatsRequest proto.InternalMessageInfo
+
+func (m *QueryDenomMintStatsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryDenomMintStatsResponse is the response type for the Query/DenomMintStats
+// RPC method.
+type QueryDenomMintStatsResponse struct {
+	// stats are the totals minted and burned of the denom
+	Stats DenomMintStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats"`
+}
+
+func (m *QueryDenomMintStatsResponse) Reset()         { *m = QueryDenomMintStatsResponse{} }
+func (m *QueryDenomMintStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomMintStatsResponse) ProtoMessage()    {}
+func (*QueryDenomMintStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{5}
+}
+func (m *QueryDenomMintStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomMintStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomMintStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomMintStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomMintStatsResponse.Merge(m, src)
+}
+func (m *QueryDenomMintStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomMintStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomMintStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomMintStatsResponse proto.InternalMessageInfo
+
+func (m *QueryDenomMintStatsResponse) GetStats() DenomMintStats {
+	if m != nil {
+		return m.Stats
+	}
+	return DenomMintStats{}
+}
+
+// QueryMinterMintStatsRequest is the request type for the
+// Query/MinterMintStats RPC method.
+type QueryMinterMintStatsRequest struct {
+	// minter is the bech32 address of the minter to query
+	Minter string `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter,omitempty"`
+	// denom is the denomination to query the total of
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryMinterMintStatsRequest) Reset()         { *m = QueryMinterMintStatsRequest{} }
+func (m *QueryMinterMintStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMinterMintStatsRequest) ProtoMessage()    {}
+func (*QueryMinterMintStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{6}
+}
+func (m *QueryMinterMintStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinterMintStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinterMintStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinterMintStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinterMintStatsRequest.Merge(m, src)
+}
+func (m *QueryMinterMintStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinterMintStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinterMintStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMinterMintStatsRequest proto.InternalMessageInfo
+
+func (m *QueryMinterMintStatsRequest) GetMinter() string {
+	if m != nil {
+		return m.Minter
+	}
+	return ""
+}
+
+func (m *QueryMinterMintStatsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryMinterMintStatsResponse is the response type for the
+// Query/MinterMintStats RPC method.
+type QueryMinterMintStatsResponse struct {
+	// stats is the total the minter minted of the denom
+	Stats MinterMintStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats"`
+}
+
+func (m *QueryMinterMintStatsResponse) Reset()         { *m = QueryMinterMintStatsResponse{} }
+func (m *QueryMinterMintStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMinterMintStatsResponse) ProtoMessage()    {}
+func (*QueryMinterMintStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{7}
+}
+func (m *QueryMinterMintStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinterMintStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinterMintStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinterMintStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinterMintStatsResponse.Merge(m, src)
+}
+func (m *QueryMinterMintStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinterMintStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinterMintStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMinterMintStatsResponse proto.InternalMessageInfo
+
+func (m *QueryMinterMintStatsResponse) GetStats() MinterMintStats {
+	if m != nil {
+		return m.Stats
+	}
+	return MinterMintStats{}
+}
+
+// QueryGuardianRequest is the request type for the Query/Guardian RPC method.
+type QueryGuardianRequest struct {
+}
+
+func (m *QueryGuardianRequest) Reset()         { *m = QueryGuardianRequest{} }
+func (m *QueryGuardianRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGuardianRequest) ProtoMessage()    {}
+func (*QueryGuardianRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{8}
+}
+func (m *QueryGuardianRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGuardianRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGuardianRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGuardianRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGuardianRequest.Merge(m, src)
+}
+func (m *QueryGuardianRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGuardianRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGuardianRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGuardianRequest proto.InternalMessageInfo
+
+// QueryGuardianResponse is the response type for the Query/Guardian RPC
+// method.
+type QueryGuardianResponse struct {
+	// guardian is the bech32 address of the current guardian. It is empty when
+	// no guardian is set.
+	Guardian string `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian,omitempty"`
+}
+
+func (m *QueryGuardianResponse) Reset()         { *m = QueryGuardianResponse{} }
+func (m *QueryGuardianResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGuardianResponse) ProtoMessage()    {}
+func (*QueryGuardianResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{9}
+}
+func (m *QueryGuardianResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGuardianResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGuardianResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGuardianResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGuardianResponse.Merge(m, src)
+}
+func (m *QueryGuardianResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGuardianResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGuardianResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGuardianResponse proto.InternalMessageInfo
+
+func (m *QueryGuardianResponse) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+// QueryPauseStatusRequest is the request type for the Query/PauseStatus RPC
+// method.
+type QueryPauseStatusRequest struct {
+	// denom is the denomination to query the pause status of
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryPauseStatusRequest) Reset()         { *m = QueryPauseStatusRequest{} }
+func (m *QueryPauseStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseStatusRequest) ProtoMessage()    {}
+func (*QueryPauseStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{10}
+}
+func (m *QueryPauseStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPauseStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPauseStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseStatusRequest.Merge(m, src)
+}
+func (m *QueryPauseStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauseStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPauseStatusRequest proto.InternalMessageInfo
+
+func (m *QueryPauseStatusRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryPauseStatusResponse is the response type for the Query/PauseStatus RPC
+// method.
+type QueryPauseStatusResponse struct {
+	// paused defines if minting and burning of all denoms is paused
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	// denom_paused defines if minting and burning of the denom is paused
+	DenomPaused bool `protobuf:"varint,2,opt,name=denom_paused,json=denomPaused,proto3" json:"denom_paused,omitempty"`
+}
+
+func (m *QueryPauseStatusResponse) Reset()         { *m = QueryPauseStatusResponse{} }
+func (m *QueryPauseStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseStatusResponse) ProtoMessage()    {}
+func (*QueryPauseStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{11}
+}
+func (m *QueryPauseStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPauseStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPauseStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseStatusResponse.Merge(m, src)
+}
+func (m *QueryPauseStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauseStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPauseStatusResponse proto.InternalMessageInfo
+
+func (m *QueryPauseStatusResponse) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *QueryPauseStatusResponse) GetDenomPaused() bool {
+	if m != nil {
+		return m.DenomPaused
+	}
+	return false
+}
+
+// QueryIBCMintRoutesRequest is the request type for the Query/IBCMintRoutes
+// RPC method.
+type QueryIBCMintRoutesRequest struct {
+}
+
+func (m *QueryIBCMintRoutesRequest) Reset()         { *m = QueryIBCMintRoutesRequest{} }
+func (m *QueryIBCMintRoutesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCMintRoutesRequest) ProtoMessage()    {}
+func (*QueryIBCMintRoutesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{12}
+}
+func (m *QueryIBCMintRoutesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCMintRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCMintRoutesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIBCMintRoutesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCMintRoutesRequest.Merge(m, src)
+}
+func (m *QueryIBCMintRoutesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCMintRoutesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCMintRoutesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCMintRoutesRequest proto.InternalMessageInfo
+
+// QueryIBCMintRoutesResponse is the response type for the Query/IBCMintRoutes
+// RPC method.
+type QueryIBCMintRoutesResponse struct {
+	// routes are the IBC mint routes set by governance
+	Routes []IBCMintRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes"`
+}
+
+func (m *QueryIBCMintRoutesResponse) Reset()         { *m = QueryIBCMintRoutesResponse{} }
+func (m *QueryIBCMintRoutesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCMintRoutesResponse) ProtoMessage()    {}
+func (*QueryIBCMintRoutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{13}
+}
+func (m *QueryIBCMintRoutesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCMintRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCMintRoutesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIBCMintRoutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCMintRoutesResponse.Merge(m, src)
+}
+func (m *QueryIBCMintRoutesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCMintRoutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCMintRoutesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCMintRoutesResponse proto.InternalMessageInfo
+
+func (m *QueryIBCMintRoutesResponse) GetRoutes() []IBCMintRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+// QueryMintApprovalConfigRequest is the request type for the
+// Query/MintApprovalConfig RPC method.
+type QueryMintApprovalConfigRequest struct {
+}
+
+func (m *QueryMintApprovalConfigRequest) Reset()         { *m = QueryMintApprovalConfigRequest{} }
+func (m *QueryMintApprovalConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintApprovalConfigRequest) ProtoMessage()    {}
+func (*QueryMintApprovalConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{14}
+}
+func (m *QueryMintApprovalConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintApprovalConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintApprovalConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintApprovalConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintApprovalConfigRequest.Merge(m, src)
+}
+func (m *QueryMintApprovalConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintApprovalConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintApprovalConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintApprovalConfigRequest proto.InternalMessageInfo
+
+// QueryMintApprovalConfigResponse is the response type for the
+// Query/MintApprovalConfig RPC method.
+type QueryMintApprovalConfigResponse struct {
+	// config is the mint approval config set by governance
+	Config MintApprovalConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config"`
+}
+
+func (m *QueryMintApprovalConfigResponse) Reset()         { *m = QueryMintApprovalConfigResponse{} }
+func (m *QueryMintApprovalConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintApprovalConfigResponse) ProtoMessage()    {}
+func (*QueryMintApprovalConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{15}
+}
+func (m *QueryMintApprovalConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintApprovalConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintApprovalConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintApprovalConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintApprovalConfigResponse.Merge(m, src)
+}
+func (m *QueryMintApprovalConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintApprovalConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintApprovalConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintApprovalConfigResponse proto.InternalMessageInfo
+
+func (m *QueryMintApprovalConfigResponse) GetConfig() MintApprovalConfig {
+	if m != nil {
+		return m.Config
+	}
+	return MintApprovalConfig{}
+}
+
+// QueryMintProposalRequest is the request type for the Query/MintProposal RPC
+// method.
+type QueryMintProposalRequest struct {
+	// id is the identifier of the proposal to query
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryMintProposalRequest) Reset()         { *m = QueryMintProposalRequest{} }
+func (m *QueryMintProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintProposalRequest) ProtoMessage()    {}
+func (*QueryMintProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{16}
+}
+func (m *QueryMintProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintProposalRequest.Merge(m, src)
+}
+func (m *QueryMintProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintProposalRequest proto.InternalMessageInfo
+
+func (m *QueryMintProposalRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryMintProposalResponse is the response type for the Query/MintProposal
+// RPC method.
+type QueryMintProposalResponse struct {
+	// proposal is the pending mint proposal
+	Proposal MintProposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal"`
+}
+
+func (m *QueryMintProposalResponse) Reset()         { *m = QueryMintProposalResponse{} }
+func (m *QueryMintProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintProposalResponse) ProtoMessage()    {}
+func (*QueryMintProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{17}
+}
+func (m *QueryMintProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintProposalResponse.Merge(m, src)
+}
+func (m *QueryMintProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintProposalResponse proto.InternalMessageInfo
+
+func (m *QueryMintProposalResponse) GetProposal() MintProposal {
+	if m != nil {
+		return m.Proposal
+	}
+	return MintProposal{}
+}
+
+// QueryMintProposalsRequest is the request type for the Query/MintProposals
+// RPC method.
+type QueryMintProposalsRequest struct {
+}
+
+func (m *QueryMintProposalsRequest) Reset()         { *m = QueryMintProposalsRequest{} }
+func (m *QueryMintProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintProposalsRequest) ProtoMessage()    {}
+func (*QueryMintProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{18}
+}
+func (m *QueryMintProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintProposalsRequest.Merge(m, src)
+}
+func (m *QueryMintProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintProposalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintProposalsRequest proto.InternalMessageInfo
+
+// QueryMintProposalsResponse is the response type for the Query/MintProposals
+// RPC method.
+type QueryMintProposalsResponse struct {
+	// proposals are the pending mint proposals
+	Proposals []MintProposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
+}
+
+func (m *QueryMintProposalsResponse) Reset()         { *m = QueryMintProposalsResponse{} }
+func (m *QueryMintProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintProposalsResponse) ProtoMessage()    {}
+func (*QueryMintProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{19}
+}
+func (m *QueryMintProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintProposalsResponse.Merge(m, src)
+}
+func (m *QueryMintProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintProposalsResponse proto.InternalMessageInfo
+
+func (m *QueryMintProposalsResponse) GetProposals() []MintProposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+// QueryMintHistoryRequest is the request type for the Query/MintHistory RPC
+// method.
+type QueryMintHistoryRequest struct {
+	// denom filters the records by minted denom, if set
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// minter filters the records by the bech32 address of the minter, if set
+	Minter string `protobuf:"bytes,2,opt,name=minter,proto3" json:"minter,omitempty"`
+	// pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMintHistoryRequest) Reset()         { *m = QueryMintHistoryRequest{} }
+func (m *QueryMintHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintHistoryRequest) ProtoMessage()    {}
+func (*QueryMintHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{20}
+}
+func (m *QueryMintHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintHistoryRequest.Merge(m, src)
+}
+func (m *QueryMintHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryMintHistoryRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryMintHistoryRequest) GetMinter() string {
+	if m != nil {
+		return m.Minter
+	}
+	return ""
+}
+
+func (m *QueryMintHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMintHistoryResponse is the response type for the Query/MintHistory RPC
+// method.
+type QueryMintHistoryResponse struct {
+	// records are the mint records matching the filters
+	Records []MintRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMintHistoryResponse) Reset()         { *m = QueryMintHistoryResponse{} }
+func (m *QueryMintHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintHistoryResponse) ProtoMessage()    {}
+func (*QueryMintHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{21}
+}
+func (m *QueryMintHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintHistoryResponse.Merge(m, src)
+}
+func (m *QueryMintHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryMintHistoryResponse) GetRecords() []MintRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryMintHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDenomAdminRequest is the request type for the Query/DenomAdmin RPC
+// method.
+type QueryDenomAdminRequest struct {
+	// denom is the created denomination to query the admin of, as
+	// factory/{creator}/{subdenom}
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryDenomAdminRequest) Reset()         { *m = QueryDenomAdminRequest{} }
+func (m *QueryDenomAdminRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomAdminRequest) ProtoMessage()    {}
+func (*QueryDenomAdminRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{22}
+}
+func (m *QueryDenomAdminRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomAdminRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomAdminRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomAdminRequest.Merge(m, src)
+}
+func (m *QueryDenomAdminRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomAdminRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomAdminRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomAdminRequest proto.InternalMessageInfo
+
+func (m *QueryDenomAdminRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryDenomAdminResponse is the response type for the Query/DenomAdmin RPC
+// method.
+type QueryDenomAdminResponse struct {
+	// admin is the bech32 address of the admin of the denom. It is empty when
+	// the admin was renounced.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+}
+
+func (m *QueryDenomAdminResponse) Reset()         { *m = QueryDenomAdminResponse{} }
+func (m *QueryDenomAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomAdminResponse) ProtoMessage()    {}
+func (*QueryDenomAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{23}
+}
+func (m *QueryDenomAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomAdminResponse.Merge(m, src)
+}
+func (m *QueryDenomAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomAdminResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomAdminResponse proto.InternalMessageInfo
+
+func (m *QueryDenomAdminResponse) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+// QueryEmissionScheduleRequest is the request type for the
+// Query/EmissionSchedule RPC method.
+type QueryEmissionScheduleRequest struct {
+	// id is the identifier of the emission schedule
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryEmissionScheduleRequest) Reset()         { *m = QueryEmissionScheduleRequest{} }
+func (m *QueryEmissionScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionScheduleRequest) ProtoMessage()    {}
+func (*QueryEmissionScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{24}
+}
+func (m *QueryEmissionScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEmissionScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionScheduleRequest.Merge(m, src)
+}
+func (m *QueryEmissionScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEmissionScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryEmissionScheduleRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryEmissionScheduleResponse is the response type for the
+// Query/EmissionSchedule RPC method.
+type QueryEmissionScheduleResponse struct {
+	// schedule is the emission schedule
+	Schedule EmissionSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule"`
+	// remaining is the amount the schedule still has to emit
+	Remaining cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=remaining,proto3,customtype=cosmossdk.io/math.Int" json:"remaining"`
+}
+
+func (m *QueryEmissionScheduleResponse) Reset()         { *m = QueryEmissionScheduleResponse{} }
+func (m *QueryEmissionScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionScheduleResponse) ProtoMessage()    {}
+func (*QueryEmissionScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{25}
+}
+func (m *QueryEmissionScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEmissionScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionScheduleResponse.Merge(m, src)
+}
+func (m *QueryEmissionScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEmissionScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryEmissionScheduleResponse) GetSchedule() EmissionSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return EmissionSchedule{}
+}
+
+// QueryEmissionSchedulesRequest is the request type for the
+// Query/EmissionSchedules RPC method.
+type QueryEmissionSchedulesRequest struct {
+}
+
+func (m *QueryEmissionSchedulesRequest) Reset()         { *m = QueryEmissionSchedulesRequest{} }
+func (m *QueryEmissionSchedulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionSchedulesRequest) ProtoMessage()    {}
+func (*QueryEmissionSchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{26}
+}
+func (m *QueryEmissionSchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionSchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionSchedulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEmissionSchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionSchedulesRequest.Merge(m, src)
+}
+func (m *QueryEmissionSchedulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionSchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionSchedulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEmissionSchedulesRequest proto.InternalMessageInfo
+
+// QueryEmissionSchedulesResponse is the response type for the
+// Query/EmissionSchedules RPC method.
+type QueryEmissionSchedulesResponse struct {
+	// schedules are the emission schedules that still have tokens to emit
+	Schedules []EmissionSchedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules"`
+}
+
+func (m *QueryEmissionSchedulesResponse) Reset()         { *m = QueryEmissionSchedulesResponse{} }
+func (m *QueryEmissionSchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionSchedulesResponse) ProtoMessage()    {}
+func (*QueryEmissionSchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{27}
+}
+func (m *QueryEmissionSchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionSchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionSchedulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEmissionSchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionSchedulesResponse.Merge(m, src)
+}
+func (m *QueryEmissionSchedulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionSchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionSchedulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEmissionSchedulesResponse proto.InternalMessageInfo
+
+func (m *QueryEmissionSchedulesResponse) GetSchedules() []EmissionSchedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{28}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC
+// method.
+type QueryParamsResponse struct {
+	// params are the mint module parameters
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b6c624b8dd43d3, []int{29}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func init() {
+	proto.RegisterType((*QueryMintAuthorityRequest)(nil), "cosmos.evm.mint.v1.QueryMintAuthorityRequest")
+	proto.RegisterType((*QueryMintAuthorityResponse)(nil), "cosmos.evm.mint.v1.QueryMintAuthorityResponse")
+	proto.RegisterType((*QueryDenomMintLimitRequest)(nil), "cosmos.evm.mint.v1.QueryDenomMintLimitRequest")
+	proto.RegisterType((*QueryDenomMintLimitResponse)(nil), "cosmos.evm.mint.v1.QueryDenomMintLimitResponse")
+	proto.RegisterType((*QueryDenomMintStatsRequest)(nil), "cosmos.evm.mint.v1.QueryDenomMintStatsRequest")
+	proto.RegisterType((*QueryDenomMintStatsResponse)(nil), "cosmos.evm.mint.v1.QueryDenomMintStatsResponse")
+	proto.RegisterType((*QueryMinterMintStatsRequest)(nil), "cosmos.evm.mint.v1.QueryMinterMintStatsRequest")
+	proto.RegisterType((*QueryMinterMintStatsResponse)(nil), "cosmos.evm.mint.v1.QueryMinterMintStatsResponse")
+	proto.RegisterType((*QueryGuardianRequest)(nil), "cosmos.evm.mint.v1.QueryGuardianRequest")
+	proto.RegisterType((*QueryGuardianResponse)(nil), "cosmos.evm.mint.v1.QueryGuardianResponse")
+	proto.RegisterType((*QueryPauseStatusRequest)(nil), "cosmos.evm.mint.v1.QueryPauseStatusRequest")
+	proto.RegisterType((*QueryPauseStatusResponse)(nil), "cosmos.evm.mint.v1.QueryPauseStatusResponse")
+	proto.RegisterType((*QueryIBCMintRoutesRequest)(nil), "cosmos.evm.mint.v1.QueryIBCMintRoutesRequest")
+	proto.RegisterType((*QueryIBCMintRoutesResponse)(nil), "cosmos.evm.mint.v1.QueryIBCMintRoutesResponse")
+	proto.RegisterType((*QueryMintApprovalConfigRequest)(nil), "cosmos.evm.mint.v1.QueryMintApprovalConfigRequest")
+	proto.RegisterType((*QueryMintApprovalConfigResponse)(nil), "cosmos.evm.mint.v1.QueryMintApprovalConfigResponse")
+	proto.RegisterType((*QueryMintProposalRequest)(nil), "cosmos.evm.mint.v1.QueryMintProposalRequest")
+	proto.RegisterType((*QueryMintProposalResponse)(nil), "cosmos.evm.mint.v1.QueryMintProposalResponse")
+	proto.RegisterType((*QueryMintProposalsRequest)(nil), "cosmos.evm.mint.v1.QueryMintProposalsRequest")
+	proto.RegisterType((*QueryMintProposalsResponse)(nil), "cosmos.evm.mint.v1.QueryMintProposalsResponse")
+	proto.RegisterType((*QueryMintHistoryRequest)(nil), "cosmos.evm.mint.v1.QueryMintHistoryRequest")
+	proto.RegisterType((*QueryMintHistoryResponse)(nil), "cosmos.evm.mint.v1.QueryMintHistoryResponse")
+	proto.RegisterType((*QueryDenomAdminRequest)(nil), "cosmos.evm.mint.v1.QueryDenomAdminRequest")
+	proto.RegisterType((*QueryDenomAdminResponse)(nil), "cosmos.evm.mint.v1.QueryDenomAdminResponse")
+	proto.RegisterType((*QueryEmissionScheduleRequest)(nil), "cosmos.evm.mint.v1.QueryEmissionScheduleRequest")
+	proto.RegisterType((*QueryEmissionScheduleResponse)(nil), "cosmos.evm.mint.v1.QueryEmissionScheduleResponse")
+	proto.RegisterType((*QueryEmissionSchedulesRequest)(nil), "cosmos.evm.mint.v1.QueryEmissionSchedulesRequest")
+	proto.RegisterType((*QueryEmissionSchedulesResponse)(nil), "cosmos.evm.mint.v1.QueryEmissionSchedulesResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evm.mint.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evm.mint.v1.QueryParamsResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/evm/mint/v1/query.proto", fileDescriptor_17b6c624b8dd43d3) }
+
+var fileDescriptor_17b6c624b8dd43d3 = []byte{
+	// 1434 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x98, 0x5b, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0xe3, 0x2d, 0x09, 0xc9, 0x49, 0xaf, 0x43, 0xda, 0x6e, 0xdd, 0x74, 0x93, 0xba, 0xa5,
+	0x49, 0x73, 0xb1, 0x9b, 0xb4, 0x20, 0x24, 0x6e, 0xea, 0x05, 0xda, 0x08, 0x2a, 0xca, 0x06, 0x5e,
+	0x90, 0xd0, 0xca, 0xbb, 0x1e, 0x9c, 0x11, 0x6b, 0x8f, 0x6b, 0x7b, 0x57, 0x44, 0x55, 0x5e, 0x90,
+	0x78, 0x45, 0x08, 0xde, 0x80, 0x77, 0x5a, 0x84, 0x04, 0x0f, 0xfd, 0x10, 0x7d, 0xac, 0xe0, 0x05,
+	0xf1, 0x50, 0xa1, 0x14, 0x89, 0x4f, 0x81, 0x84, 0x3c, 0x73, 0x7c, 0xdb, 0xb5, 0x77, 0x9d, 0x97,
+	0xaa, 0x3b, 0x73, 0xfe, 0x67, 0x7e, 0x73, 0x66, 0x7c, 0xe6, 0xaf, 0x40, 0xa3, 0xc3, 0x03, 0x87,
+	0x07, 0x06, 0xed, 0x3b, 0x86, 0xc3, 0xdc, 0xd0, 0xe8, 0x6f, 0x18, 0xf7, 0x7b, 0xd4, 0xdf, 0xd5,
+	0x3d, 0x9f, 0x87, 0x9c, 0x10, 0x39, 0xaf, 0xd3, 0xbe, 0xa3, 0x47, 0xf3, 0x7a, 0x7f, 0x43, 0x3d,
+	0x61, 0x3a, 0xcc, 0xe5, 0x86, 0xf8, 0x57, 0x86, 0xa9, 0x2b, 0x98, 0xa6, 0x6d, 0x06, 0x54, 0xea,
+	0x8d, 0xfe, 0x46, 0x9b, 0x86, 0xe6, 0x86, 0xe1, 0x99, 0x36, 0x73, 0xcd, 0x90, 0x71, 0x17, 0x63,
+	0xcf, 0x15, 0x2c, 0x29, 0x52, 0xcb, 0xe9, 0x33, 0x72, 0xba, 0x25, 0x7e, 0x19, 0xb8, 0xbc, 0x9c,
+	0x9a, 0xb3, 0xb9, 0xcd, 0xe5, 0x78, 0xf4, 0x3f, 0x1c, 0x9d, 0xb7, 0x39, 0xb7, 0xbb, 0xd4, 0x30,
+	0x3d, 0x66, 0x98, 0xae, 0xcb, 0x43, 0xb1, 0x18, 0x6a, 0xb4, 0xb3, 0x70, 0xe6, 0xc3, 0x88, 0xe7,
+	0x2e, 0x73, 0xc3, 0xeb, 0xbd, 0x70, 0x87, 0xfb, 0x2c, 0xdc, 0x6d, 0xd2, 0xfb, 0x3d, 0x1a, 0x84,
+	0xda, 0xa7, 0xa0, 0x16, 0x4d, 0x06, 0x1e, 0x77, 0x03, 0x4a, 0xde, 0x86, 0xa3, 0x11, 0x57, 0xcb,
+	0x8c, 0x67, 0xea, 0xca, 0xa2, 0xb2, 0x3c, 0x73, 0xa3, 0xfe, 0xfb, 0xe3, 0xf5, 0x39, 0x04, 0xbb,
+	0x6e, 0x59, 0x3e, 0x0d, 0x82, 0xed, 0xd0, 0x67, 0xae, 0xdd, 0x3c, 0xe2, 0x64, 0x13, 0x69, 0x9b,
+	0x98, 0xfe, 0x16, 0x75, 0xb9, 0x13, 0xad, 0xf1, 0x3e, 0x73, 0x58, 0x88, 0x8b, 0x93, 0x39, 0x98,
+	0xb4, 0xa2, 0x09, 0x99, 0xb5, 0x29, 0x7f, 0x68, 0xff, 0x29, 0x70, 0xb6, 0x50, 0x84, 0x50, 0x37,
+	0x61, 0xb2, 0x1b, 0x0d, 0x08, 0xd5, 0xec, 0xa6, 0xa6, 0x0f, 0x1f, 0x90, 0x9e, 0x97, 0xde, 0x98,
+	0x79, 0xf2, 0x6c, 0x61, 0xe2, 0xe1, 0xbf, 0xbf, 0xad, 0x28, 0x4d, 0xa9, 0x25, 0x77, 0xe0, 0xb8,
+	0x4f, 0x1d, 0x93, 0xb9, 0xcc, 0xb5, 0x5b, 0x41, 0xcf, 0xf3, 0xba, 0xbb, 0xf5, 0x9a, 0xd8, 0xdb,
+	0xb9, 0x27, 0xcf, 0x16, 0x94, 0xbf, 0x9e, 0x2d, 0x9c, 0x94, 0x69, 0x03, 0xeb, 0x73, 0x9d, 0x71,
+	0xc3, 0x31, 0xc3, 0x1d, 0x7d, 0xcb, 0x0d, 0x9b, 0xc7, 0x12, 0xd9, 0xb6, 0x50, 0x91, 0x0f, 0x60,
+	0x2e, 0xcd, 0xe4, 0x9b, 0x21, 0x6d, 0x49, 0xba, 0x43, 0x55, 0xb2, 0x91, 0x44, 0xda, 0x34, 0x43,
+	0x2a, 0x60, 0x87, 0x6b, 0xb6, 0x1d, 0x9a, 0x61, 0x30, 0xba, 0x66, 0x6d, 0x38, 0x5b, 0xa8, 0x49,
+	0x4b, 0x16, 0x44, 0x03, 0x95, 0x4a, 0x26, 0xa4, 0xb9, 0x92, 0x09, 0xad, 0x46, 0x71, 0x8d, 0x28,
+	0x86, 0xfa, 0x43, 0x60, 0x57, 0x60, 0xca, 0x11, 0x33, 0x63, 0xef, 0x08, 0xc6, 0xa5, 0x5b, 0xa9,
+	0x65, 0xb7, 0x62, 0xc1, 0x7c, 0xf1, 0x32, 0xb8, 0x97, 0x5b, 0xf9, 0xbd, 0x5c, 0x28, 0xda, 0xcb,
+	0x80, 0xb6, 0x60, 0x33, 0xa7, 0x60, 0x4e, 0xac, 0x72, 0xbb, 0x67, 0xfa, 0x16, 0x33, 0xdd, 0xf8,
+	0x7b, 0xb8, 0x0b, 0x27, 0x07, 0xc6, 0x71, 0xd9, 0x6b, 0x30, 0x6d, 0xe3, 0xd8, 0xd8, 0x0d, 0x26,
+	0x91, 0x9a, 0x01, 0xa7, 0x45, 0xba, 0x7b, 0x66, 0x2f, 0xa0, 0x11, 0x4b, 0x6f, 0xcc, 0x41, 0x7e,
+	0x0c, 0xf5, 0x61, 0x01, 0x22, 0x9c, 0x82, 0x29, 0x2f, 0x1a, 0xb6, 0x84, 0x64, 0xba, 0x89, 0xbf,
+	0xc8, 0x79, 0x38, 0x2c, 0xc4, 0x2d, 0x9c, 0xad, 0x89, 0xd9, 0x59, 0x31, 0x26, 0xf2, 0x58, 0x49,
+	0x0f, 0xd8, 0xba, 0x71, 0x33, 0xaa, 0x4a, 0x93, 0xf7, 0x42, 0x1a, 0x93, 0x68, 0x26, 0xa8, 0x45,
+	0x93, 0xc9, 0xdd, 0x99, 0xf2, 0xc5, 0x48, 0x5d, 0x59, 0x3c, 0xb4, 0x3c, 0xbb, 0xb9, 0x58, 0x54,
+	0xf0, 0xac, 0x34, 0x5b, 0x6d, 0x94, 0x6a, 0x8b, 0xd0, 0x48, 0xdb, 0x8c, 0xe7, 0xf9, 0xbc, 0x6f,
+	0x76, 0x6f, 0x72, 0xf7, 0x33, 0x66, 0xc7, 0x10, 0x5d, 0x58, 0x28, 0x8d, 0x40, 0x92, 0x2d, 0x98,
+	0xea, 0x88, 0x11, 0x3c, 0xfa, 0x4b, 0x65, 0x47, 0x9f, 0xd7, 0xe7, 0x78, 0x64, 0x02, 0x6d, 0x05,
+	0xcb, 0x1c, 0x45, 0xdf, 0xf3, 0xb9, 0xc7, 0x03, 0xb3, 0x1b, 0x1f, 0xcc, 0x51, 0xa8, 0x31, 0x59,
+	0xe2, 0x17, 0x9a, 0x35, 0x66, 0x69, 0x16, 0x9c, 0x29, 0x88, 0x45, 0xa6, 0xdb, 0x30, 0xed, 0xe1,
+	0x18, 0x52, 0x2d, 0x96, 0x51, 0xc5, 0xda, 0x2c, 0x4f, 0x22, 0xce, 0x75, 0xe9, 0x38, 0x32, 0x39,
+	0x21, 0x1b, 0xd4, 0xa2, 0xc9, 0xa4, 0x2e, 0x33, 0x71, 0x9a, 0x91, 0x87, 0x54, 0x06, 0x91, 0xaa,
+	0xb5, 0x47, 0x0a, 0x5e, 0xd8, 0x28, 0xf6, 0x0e, 0x0b, 0x42, 0xee, 0xef, 0x8e, 0xbc, 0xb0, 0x99,
+	0xcf, 0xbe, 0x56, 0xf1, 0xb3, 0x7f, 0x17, 0x20, 0x7d, 0x11, 0xeb, 0x87, 0xf2, 0x47, 0x19, 0x3d,
+	0x9f, 0xba, 0x7c, 0x7e, 0xf1, 0xf9, 0xd4, 0xef, 0x99, 0x36, 0x45, 0x86, 0x66, 0x46, 0xa9, 0x3d,
+	0x54, 0xa0, 0x3e, 0xcc, 0x9a, 0xdc, 0xda, 0x17, 0x7d, 0xda, 0xe1, 0xbe, 0x15, 0x57, 0xa4, 0x51,
+	0x56, 0x91, 0xa6, 0x08, 0xcb, 0xd6, 0x23, 0x56, 0x92, 0xdb, 0x39, 0xd2, 0x9a, 0x20, 0x5d, 0x1a,
+	0x4b, 0x2a, 0x09, 0x72, 0xa8, 0x3a, 0x9c, 0x4a, 0xdb, 0xf3, 0x75, 0xcb, 0x61, 0xee, 0xe8, 0x2e,
+	0xb0, 0x05, 0xa7, 0x87, 0xe2, 0x71, 0x63, 0x3a, 0x4c, 0x9a, 0xd1, 0xc0, 0xd8, 0x26, 0x24, 0xc3,
+	0x34, 0x1d, 0xdb, 0xe9, 0x3b, 0x0e, 0x0b, 0x02, 0xc6, 0xdd, 0xed, 0xce, 0x0e, 0xb5, 0x7a, 0x5d,
+	0x5a, 0x76, 0xdb, 0x1f, 0x29, 0x70, 0xae, 0x44, 0x80, 0x04, 0xef, 0xc1, 0x74, 0x80, 0x63, 0x78,
+	0xe5, 0x2f, 0x16, 0xd5, 0x76, 0x50, 0x9f, 0xbb, 0xf6, 0x71, 0x02, 0xf2, 0x3a, 0xcc, 0x24, 0x4f,
+	0x60, 0xe6, 0x01, 0x9e, 0x28, 0x7f, 0x32, 0xd3, 0x78, 0x6d, 0xa1, 0x04, 0x35, 0xf9, 0x6e, 0x38,
+	0x34, 0xca, 0x02, 0x70, 0x33, 0x77, 0x61, 0x26, 0x66, 0x89, 0x6f, 0xca, 0x81, 0x77, 0x93, 0x66,
+	0xd0, 0xe6, 0x80, 0x60, 0xfb, 0xf6, 0x4d, 0x27, 0xc1, 0xf8, 0x08, 0x5e, 0xca, 0x8d, 0xe2, 0xda,
+	0x6f, 0x46, 0xfd, 0x3c, 0x1a, 0xc1, 0x32, 0xaa, 0x45, 0x0b, 0x4b, 0x4d, 0xae, 0x87, 0x49, 0xd1,
+	0xe6, 0x3e, 0x81, 0x49, 0x91, 0x96, 0x7c, 0xaf, 0xc0, 0x91, 0x9c, 0x81, 0x23, 0xeb, 0x45, 0xa9,
+	0x4a, 0x5d, 0xa0, 0xaa, 0x57, 0x0d, 0x97, 0xe4, 0xda, 0xca, 0x97, 0x7f, 0xfc, 0xf3, 0x5d, 0xed,
+	0x22, 0xd1, 0x8c, 0x12, 0x27, 0x9b, 0x3a, 0x46, 0xf2, 0x50, 0x81, 0xa3, 0x79, 0x3b, 0x46, 0xca,
+	0x97, 0x2b, 0xf4, 0x89, 0xaa, 0x51, 0x39, 0x1e, 0xf9, 0x5e, 0x11, 0x7c, 0x06, 0x59, 0x2f, 0xe2,
+	0x93, 0x6f, 0xa5, 0xa0, 0x14, 0x4e, 0x2d, 0x30, 0x1e, 0x88, 0xa1, 0x3d, 0xf2, 0x53, 0x16, 0x55,
+	0x38, 0x87, 0x2a, 0xa8, 0x59, 0x17, 0xa4, 0x1a, 0x95, 0xe3, 0x11, 0xf5, 0x9a, 0x40, 0xd5, 0xc9,
+	0xda, 0x18, 0x54, 0x61, 0x5b, 0x12, 0xd2, 0xc7, 0x0a, 0x1c, 0x1b, 0x30, 0x39, 0xc4, 0x18, 0x79,
+	0x88, 0xc3, 0x8e, 0x4d, 0xbd, 0x52, 0x5d, 0x80, 0xb0, 0x6f, 0x09, 0xd8, 0xd7, 0xc8, 0xab, 0x65,
+	0xe7, 0x4e, 0xfd, 0x1c, 0xad, 0x1c, 0xda, 0x4b, 0xb0, 0xbf, 0x52, 0x60, 0x3a, 0x76, 0x56, 0x64,
+	0xb9, 0x74, 0xf9, 0x01, 0x53, 0xa6, 0x5e, 0xae, 0x10, 0x89, 0x84, 0x17, 0x05, 0x61, 0x83, 0xcc,
+	0x17, 0x11, 0xc6, 0xb6, 0x2c, 0xfa, 0x60, 0x66, 0x33, 0x0e, 0x8b, 0xac, 0x96, 0x2e, 0x30, 0x6c,
+	0xdc, 0xd4, 0xb5, 0x6a, 0xc1, 0x08, 0x74, 0x45, 0x00, 0xad, 0x90, 0xe5, 0x22, 0x20, 0x61, 0xd8,
+	0x44, 0xb1, 0x7a, 0xe9, 0xd9, 0xfe, 0xa0, 0xc0, 0x91, 0x9c, 0x15, 0x1b, 0xf1, 0x35, 0x17, 0xf9,
+	0x39, 0x55, 0xaf, 0x1a, 0x8e, 0x88, 0xab, 0x02, 0xf1, 0x65, 0x72, 0xa1, 0x08, 0x91, 0xb5, 0x3b,
+	0xf2, 0x48, 0xa5, 0x93, 0x23, 0xbf, 0x2a, 0x40, 0x86, 0x3d, 0x16, 0xd9, 0x1c, 0xdd, 0x41, 0x8a,
+	0x2c, 0x9f, 0x7a, 0xf5, 0x40, 0x9a, 0x2a, 0xf5, 0x94, 0xad, 0x07, 0x85, 0x2d, 0xe9, 0xf5, 0xc8,
+	0x8f, 0x0a, 0x1c, 0xce, 0x5a, 0x1f, 0xb2, 0x36, 0x72, 0xdd, 0x01, 0x3b, 0xa8, 0xae, 0x57, 0x8c,
+	0x46, 0x3e, 0x43, 0xf0, 0x5d, 0x26, 0x4b, 0xa5, 0x7c, 0x89, 0xdb, 0x32, 0x1e, 0x30, 0x6b, 0x2f,
+	0x69, 0xde, 0x71, 0xa6, 0x80, 0x54, 0x5b, 0x31, 0xa8, 0xd6, 0xbc, 0x87, 0xec, 0x62, 0x85, 0xe6,
+	0x9d, 0x10, 0x92, 0x6f, 0x15, 0x98, 0xcd, 0xd8, 0xab, 0x11, 0x1f, 0xca, 0xb0, 0x61, 0x54, 0xd7,
+	0xaa, 0x05, 0x23, 0xd6, 0xb2, 0xc0, 0xd2, 0xc8, 0x62, 0x29, 0xd6, 0x0e, 0x42, 0x7c, 0xad, 0x00,
+	0xa4, 0xce, 0x88, 0xac, 0x8c, 0x6e, 0xb9, 0x59, 0xbb, 0xa5, 0xae, 0x56, 0x8a, 0x45, 0xa2, 0x25,
+	0x41, 0x74, 0x9e, 0x2c, 0x94, 0xb7, 0x66, 0xe1, 0xb1, 0xc8, 0x2f, 0x0a, 0x1c, 0x1f, 0x34, 0x08,
+	0xa4, 0xbc, 0xbb, 0x96, 0x58, 0x31, 0x75, 0xe3, 0x00, 0x0a, 0x44, 0xbc, 0x2a, 0x10, 0xd7, 0xc9,
+	0x6a, 0x11, 0x22, 0x45, 0x55, 0x2b, 0xf1, 0x27, 0xf2, 0xc6, 0xfd, 0xac, 0xc0, 0x89, 0xc1, 0x8c,
+	0x01, 0xa9, 0xbe, 0x7a, 0x72, 0xf3, 0x36, 0x0f, 0x22, 0x41, 0x62, 0x5d, 0x10, 0x2f, 0x93, 0x4b,
+	0xd5, 0x88, 0xc9, 0x1e, 0x4c, 0x49, 0x0b, 0x44, 0x2e, 0x8d, 0xe8, 0xbb, 0x19, 0xb7, 0xa5, 0x2e,
+	0x8d, 0x8d, 0x43, 0x14, 0x4d, 0xa0, 0xcc, 0x13, 0xb5, 0xb8, 0x35, 0x0b, 0xdf, 0xf5, 0xc6, 0x93,
+	0xfd, 0x86, 0xf2, 0x74, 0xbf, 0xa1, 0xfc, 0xbd, 0xdf, 0x50, 0xbe, 0x79, 0xde, 0x98, 0x78, 0xfa,
+	0xbc, 0x31, 0xf1, 0xe7, 0xf3, 0xc6, 0xc4, 0x27, 0x9a, 0xcd, 0xc2, 0x9d, 0x5e, 0x5b, 0xef, 0x70,
+	0x27, 0xab, 0xff, 0x42, 0x66, 0x08, 0x77, 0x3d, 0x1a, 0xb4, 0xa7, 0xc4, 0x5f, 0xe0, 0xae, 0xfe,
+	0x3f, 0x00, 0x6d, 0x04, 0xe6, 0x20, 0x64, 0x14, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// MintAuthority queries the account allowed to mint native tokens through
+	// the mint precompile.
+	MintAuthority(ctx context.Context, in *QueryMintAuthorityRequest, opts ...grpc.CallOption) (*QueryMintAuthorityResponse, error)
+	// DenomMintLimit queries the supply cap and rate limit of a denom, together
+	// with the amount that can still be minted under each of them.
+	DenomMintLimit(ctx context.Context, in *QueryDenomMintLimitRequest, opts ...grpc.CallOption) (*QueryDenomMintLimitResponse, error)
+	// DenomMintStats queries the total amount of a denom minted and burned
+	// through the mint precompile.
+	DenomMintStats(ctx context.Context, in *QueryDenomMintStatsRequest, opts ...grpc.CallOption) (*QueryDenomMintStatsResponse, error)
+	// MinterMintStats queries the total amount of a denom a minter minted
+	// through the mint precompile.
+	MinterMintStats(ctx context.Context, in *QueryMinterMintStatsRequest, opts ...grpc.CallOption) (*QueryMinterMintStatsResponse, error)
+	// Guardian queries the account allowed to pause minting and burning through
+	// the mint precompile.
+	Guardian(ctx context.Context, in *QueryGuardianRequest, opts ...grpc.CallOption) (*QueryGuardianResponse, error)
+	// PauseStatus queries if minting and burning of a denom is paused.
+	PauseStatus(ctx context.Context, in *QueryPauseStatusRequest, opts ...grpc.CallOption) (*QueryPauseStatusResponse, error)
+	// IBCMintRoutes queries the IBC channels whose tokens are minted as a
+	// canonical native denom.
+	IBCMintRoutes(ctx context.Context, in *QueryIBCMintRoutesRequest, opts ...grpc.CallOption) (*QueryIBCMintRoutesResponse, error)
+	// MintApprovalConfig queries the approvers of mint proposals and the mints
+	// that require one.
+	MintApprovalConfig(ctx context.Context, in *QueryMintApprovalConfigRequest, opts ...grpc.CallOption) (*QueryMintApprovalConfigResponse, error)
+	// MintProposal queries a pending mint proposal.
+	MintProposal(ctx context.Context, in *QueryMintProposalRequest, opts ...grpc.CallOption) (*QueryMintProposalResponse, error)
+	// MintProposals queries all the pending mint proposals.
+	MintProposals(ctx context.Context, in *QueryMintProposalsRequest, opts ...grpc.CallOption) (*QueryMintProposalsResponse, error)
+	// MintHistory queries the mints through the mint precompile kept in the
+	// mint history, oldest first, optionally filtered by denom and minter.
+	MintHistory(ctx context.Context, in *QueryMintHistoryRequest, opts ...grpc.CallOption) (*QueryMintHistoryResponse, error)
+	// DenomAdmin queries the admin of a denom created through the mint
+	// precompile.
+	DenomAdmin(ctx context.Context, in *QueryDenomAdminRequest, opts ...grpc.CallOption) (*QueryDenomAdminResponse, error)
+	// EmissionSchedule queries an emission schedule that still has tokens to
+	// emit.
+	EmissionSchedule(ctx context.Context, in *QueryEmissionScheduleRequest, opts ...grpc.CallOption) (*QueryEmissionScheduleResponse, error)
+	// EmissionSchedules queries all the emission schedules that still have
+	// tokens to emit.
+	EmissionSchedules(ctx context.Context, in *QueryEmissionSchedulesRequest, opts ...grpc.CallOption) (*QueryEmissionSchedulesResponse, error)
+	// Params retrieves the mint module params
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) MintAuthority(ctx context.Context, in *QueryMintAuthorityRequest, opts ...grpc.CallOption) (*QueryMintAuthorityResponse, error) {
+	out := new(QueryMintAuthorityResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.mint.v1.Query/MintAuthority", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomMintLimit(ctx context.Context, in *QueryDenomMintLimitRequest, opts ...grpc.CallOption) (*QueryDenomMintLimitResponse, error) {
+	out := new(QueryDenomMintLimitResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.mint.v1.Query/DenomMintLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomMintStats(ctx context.Context, in *QueryDenomMintStatsRequest, opts ...grpc.CallOption) (*QueryDenomMintStatsResponse, error) {
+	out := new(QueryDenomMintStatsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.mint.v1.Query/DenomMintStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MinterMintStats(ctx context.Context, in *QueryMinterMintStatsRequest, opts ...grpc.CallOption) (*QueryMinterMintStatsResponse, error) {
+	out := new(QueryMinterMintStatsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.mint.v1.Query/MinterMintStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Guardian(ctx context.Context, in *QueryGuardianRequest, opts ...grpc.CallOption) (*QueryGuardianResponse, error) {
+	out := new(QueryGuardianResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.mint.v1.Query/Guardian", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PauseStatus(ctx context.Context, in *QueryPauseStatusRequest, opts ...grpc.CallOption) (*QueryPauseStatusResponse, error) {
+	out := new(QueryPauseStatusResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.mint.v1.Query/PauseStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IBCMintRoutes(ctx context.Context, in *QueryIBCMintRoutesRequest, opts ...grpc.CallOption) (*QueryIBCMintRoutesResponse, error) {
+	out := new(QueryIBCMintRoutesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.mint.v1.Query/IBCMintRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintApprovalConfig(ctx context.Context, in *QueryMintApprovalConfigRequest, opts ...grpc.CallOption) (*QueryMintApprovalConfigResponse, error) {
+	out := new(QueryMintApprovalConfigResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.mint.v1.Query/MintApprovalConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintProposal(ctx context.Context, in *QueryMintProposalRequest, opts ...grpc.CallOption) (*QueryMintProposalResponse, error) {
+	out := new(QueryMintProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.mint.v1.Query/MintProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintProposals(ctx context.Context, in *QueryMintProposalsRequest, opts ...grpc.CallOption) (*QueryMintProposalsResponse, error) {
+	out := new(QueryMintProposalsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.mint.v1.Query/MintProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintHistory(ctx context.Context, in *QueryMintHistoryRequest, opts ...grpc.CallOption) (*QueryMintHistoryResponse, error) {
+	out := new(QueryMintHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.mint.v1.Query/MintHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomAdmin(ctx context.Context, in *QueryDenomAdminRequest, opts ...grpc.CallOption) (*QueryDenomAdminResponse, error) {
+	out := new(QueryDenomAdminResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.mint.v1.Query/DenomAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EmissionSchedule(ctx context.Context, in *QueryEmissionScheduleRequest, opts ...grpc.CallOption) (*QueryEmissionScheduleResponse, error) {
+	out := new(QueryEmissionScheduleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.mint.v1.Query/EmissionSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EmissionSchedules(ctx context.Context, in *QueryEmissionSchedulesRequest, opts ...grpc.CallOption) (*QueryEmissionSchedulesResponse, error) {
+	out := new(QueryEmissionSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.mint.v1.Query/EmissionSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.mint.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// MintAuthority queries the account allowed to mint native tokens through
+	// the mint precompile.
+	MintAuthority(context.Context, *QueryMintAuthorityRequest) (*QueryMintAuthorityResponse, error)
+	// DenomMintLimit queries the supply cap and rate limit of a denom, together
+	// with the amount that can still be minted under each of them.
+	DenomMintLimit(context.Context, *QueryDenomMintLimitRequest) (*QueryDenomMintLimitResponse, error)
+	// DenomMintStats queries the total amount of a denom minted and burned
+	// through the mint precompile.
+	DenomMintStats(context.Context, *QueryDenomMintStatsRequest) (*QueryDenomMintStatsResponse, error)
+	// MinterMintStats queries the total amount of a denom a minter minted
+	// through the mint precompile.
+	MinterMintStats(context.Context, *QueryMinterMintStatsRequest) (*QueryMinterMintStatsResponse, error)
+	// Guardian queries the account allowed to pause minting and burning through
+	// the mint precompile.
+	Guardian(context.Context, *QueryGuardianRequest) (*QueryGuardianResponse, error)
+	// PauseStatus queries if minting and burning of a denom is paused.
+	PauseStatus(context.Context, *QueryPauseStatusRequest) (*QueryPauseStatusResponse, error)
+	// IBCMintRoutes queries the IBC channels whose tokens are minted as a
+	// canonical native denom.
+	IBCMintRoutes(context.Context, *QueryIBCMintRoutesRequest) (*QueryIBCMintRoutesResponse, error)
+	// MintApprovalConfig queries the approvers of mint proposals and the mints
+	// that require one.
+	MintApprovalConfig(context.Context, *QueryMintApprovalConfigRequest) (*QueryMintApprovalConfigResponse, error)
+	// MintProposal queries a pending mint proposal.
+	MintProposal(context.Context, *QueryMintProposalRequest) (*QueryMintProposalResponse, error)
+	// MintProposals queries all the pending mint proposals.
+	MintProposals(context.Context, *QueryMintProposalsRequest) (*QueryMintProposalsResponse, error)
+	// MintHistory queries the mints through the mint precompile kept in the
+	// mint history, oldest first, optionally filtered by denom and minter.
+	MintHistory(context.Context, *QueryMintHistoryRequest) (*QueryMintHistoryResponse, error)
+	// DenomAdmin queries the admin of a denom created through the mint
+	// precompile.
+	DenomAdmin(context.Context, *QueryDenomAdminRequest) (*QueryDenomAdminResponse, error)
+	// EmissionSchedule queries an emission schedule that still has tokens to
+	// emit.
+	EmissionSchedule(context.Context, *QueryEmissionScheduleRequest) (*QueryEmissionScheduleResponse, error)
+	// EmissionSchedules queries all the emission schedules that still have
+	// tokens to emit.
+	EmissionSchedules(context.Context, *QueryEmissionSchedulesRequest) (*QueryEmissionSchedulesResponse, error)
+	// Params retrieves the mint module params
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) MintAuthority(ctx context.Context, req *QueryMintAuthorityRequest) (*QueryMintAuthorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintAuthority not implemented")
+}
+func (*UnimplementedQueryServer) DenomMintLimit(ctx context.Context, req *QueryDenomMintLimitRequest) (*QueryDenomMintLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomMintLimit not implemented")
+}
+func (*UnimplementedQueryServer) DenomMintStats(ctx context.Context, req *QueryDenomMintStatsRequest) (*QueryDenomMintStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomMintStats not implemented")
+}
+func (*UnimplementedQueryServer) MinterMintStats(ctx context.Context, req *QueryMinterMintStatsRequest) (*QueryMinterMintStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinterMintStats not implemented")
+}
+func (*UnimplementedQueryServer) Guardian(ctx context.Context, req *QueryGuardianRequest) (*QueryGuardianResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Guardian not implemented")
+}
+func (*UnimplementedQueryServer) PauseStatus(ctx context.Context, req *QueryPauseStatusRequest) (*QueryPauseStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseStatus not implemented")
+}
+func (*UnimplementedQueryServer) IBCMintRoutes(ctx context.Context, req *QueryIBCMintRoutesRequest) (*QueryIBCMintRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCMintRoutes not implemented")
+}
+func (*UnimplementedQueryServer) MintApprovalConfig(ctx context.Context, req *QueryMintApprovalConfigRequest) (*QueryMintApprovalConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintApprovalConfig not implemented")
+}
+func (*UnimplementedQueryServer) MintProposal(ctx context.Context, req *QueryMintProposalRequest) (*QueryMintProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintProposal not implemented")
+}
+func (*UnimplementedQueryServer) MintProposals(ctx context.Context, req *QueryMintProposalsRequest) (*QueryMintProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintProposals not implemented")
+}
+func (*UnimplementedQueryServer) MintHistory(ctx context.Context, req *QueryMintHistoryRequest) (*QueryMintHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintHistory not implemented")
+}
+func (*UnimplementedQueryServer) DenomAdmin(ctx context.Context, req *QueryDenomAdminRequest) (*QueryDenomAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomAdmin not implemented")
+}
+func (*UnimplementedQueryServer) EmissionSchedule(ctx context.Context, req *QueryEmissionScheduleRequest) (*QueryEmissionScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmissionSchedule not implemented")
+}
+func (*UnimplementedQueryServer) EmissionSchedules(ctx context.Context, req *QueryEmissionSchedulesRequest) (*QueryEmissionSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmissionSchedules not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_MintAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintAuthorityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintAuthority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.mint.v1.Query/MintAuthority",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintAuthority(ctx, req.(*QueryMintAuthorityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomMintLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomMintLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomMintLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.mint.v1.Query/DenomMintLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomMintLimit(ctx, req.(*QueryDenomMintLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomMintStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomMintStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomMintStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.mint.v1.Query/DenomMintStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomMintStats(ctx, req.(*QueryDenomMintStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MinterMintStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinterMintStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinterMintStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.mint.v1.Query/MinterMintStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinterMintStats(ctx, req.(*QueryMinterMintStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Guardian_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGuardianRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Guardian(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.mint.v1.Query/Guardian",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Guardian(ctx, req.(*QueryGuardianRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PauseStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPauseStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PauseStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.mint.v1.Query/PauseStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PauseStatus(ctx, req.(*QueryPauseStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IBCMintRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIBCMintRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IBCMintRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.mint.v1.Query/IBCMintRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IBCMintRoutes(ctx, req.(*QueryIBCMintRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintApprovalConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintApprovalConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintApprovalConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.mint.v1.Query/MintApprovalConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintApprovalConfig(ctx, req.(*QueryMintApprovalConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.mint.v1.Query/MintProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintProposal(ctx, req.(*QueryMintProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.mint.v1.Query/MintProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintProposals(ctx, req.(*QueryMintProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.mint.v1.Query/MintHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintHistory(ctx, req.(*QueryMintHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.mint.v1.Query/DenomAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomAdmin(ctx, req.(*QueryDenomAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EmissionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmissionScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmissionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.mint.v1.Query/EmissionSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmissionSchedule(ctx, req.(*QueryEmissionScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EmissionSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmissionSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmissionSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.mint.v1.Query/EmissionSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmissionSchedules(ctx, req.(*QueryEmissionSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.mint.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.evm.mint.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MintAuthority",
+			Handler:    _Query_MintAuthority_Handler,
+		},
+		{
+			MethodName: "DenomMintLimit",
+			Handler:    _Query_DenomMintLimit_Handler,
+		},
+		{
+			MethodName: "DenomMintStats",
+			Handler:    _Query_DenomMintStats_Handler,
+		},
+		{
+			MethodName: "MinterMintStats",
+			Handler:    _Query_MinterMintStats_Handler,
+		},
+		{
+			MethodName: "Guardian",
+			Handler:    _Query_Guardian_Handler,
+		},
+		{
+			MethodName: "PauseStatus",
+			Handler:    _Query_PauseStatus_Handler,
+		},
+		{
+			MethodName: "IBCMintRoutes",
+			Handler:    _Query_IBCMintRoutes_Handler,
+		},
+		{
+			MethodName: "MintApprovalConfig",
+			Handler:    _Query_MintApprovalConfig_Handler,
+		},
+		{
+			MethodName: "MintProposal",
+			Handler:    _Query_MintProposal_Handler,
+		},
+		{
+			MethodName: "MintProposals",
+			Handler:    _Query_MintProposals_Handler,
+		},
+		{
+			MethodName: "MintHistory",
+			Handler:    _Query_MintHistory_Handler,
+		},
+		{
+			MethodName: "DenomAdmin",
+			Handler:    _Query_DenomAdmin_Handler,
+		},
+		{
+			MethodName: "EmissionSchedule",
+			Handler:    _Query_EmissionSchedule_Handler,
+		},
+		{
+			MethodName: "EmissionSchedules",
+			Handler:    _Query_EmissionSchedules_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/evm/mint/v1/query.proto",
+}
+
+func (m *QueryMintAuthorityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintAuthorityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintAuthorityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintAuthorityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintAuthorityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MintAuthority) > 0 {
+		i -= len(m.MintAuthority)
+		copy(dAtA[i:], m.MintAuthority)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MintAuthority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomMintLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomMintLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomMintLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomMintLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomMintLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomMintLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingRateLimit != nil {
+		{
+			size := m.RemainingRateLimit.Size()
+			i -= size
+			if _, err := m.RemainingRateLimit.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RemainingSupply != nil {
+		{
+			size := m.RemainingSupply.Size()
+			i -= size
+			if _, err := m.RemainingSupply.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomMintStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomMintStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomMintStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomMintStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomMintStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomMintStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinterMintStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinterMintStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinterMintStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinterMintStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinterMintStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinterMintStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGuardianRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGuardianRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGuardianRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGuardianResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGuardianResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGuardianResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DenomPaused {
+		i--
+		if m.DenomPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCMintRoutesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCMintRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCMintRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCMintRoutesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCMintRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCMintRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintApprovalConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintApprovalConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintApprovalConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintApprovalConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintApprovalConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintApprovalConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomAdminRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomAdminRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Remaining.Size()
+		i -= size
+		if _, err := m.Remaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionSchedulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionSchedulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionSchedulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionSchedulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionSchedulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionSchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryMintAuthorityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMintAuthorityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MintAuthority)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomMintLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomMintLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.RemainingSupply != nil {
+		l = m.RemainingSupply.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RemainingRateLimit != nil {
+		l = m.RemainingRateLimit.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomMintStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomMintStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMinterMintStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMinterMintStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGuardianRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGuardianResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPauseStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPauseStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	if m.DenomPaused {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryIBCMintRoutesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryIBCMintRoutesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMintApprovalConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMintApprovalConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Config.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMintProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryMintProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proposal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMintProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMintProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMintHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomAdminRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEmissionScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryEmissionScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Schedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Remaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEmissionSchedulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEmissionSchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryMintAuthorityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintAuthorityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintAuthorityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintAuthorityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintAuthorityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintAuthorityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomMintLimitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomMintLimitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomMintLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomMintLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomMintLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomMintLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.RemainingSupply = &v
+			if err := m.RemainingSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingRateLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.RemainingRateLimit = &v
+			if err := m.RemainingRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomMintStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomMintStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomMintStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomMintStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomMintStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomMintStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinterMintStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinterMintStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinterMintStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinterMintStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinterMintStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinterMintStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGuardianRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGuardianRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGuardianRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGuardianResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGuardianResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGuardianResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPauseStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPauseStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPauseStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPauseStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPauseStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPauseStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DenomPaused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIBCMintRoutesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCMintRoutesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCMintRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIBCMintRoutesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCMintRoutesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCMintRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, IBCMintRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintApprovalConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintApprovalConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintApprovalConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintApprovalConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintApprovalConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintApprovalConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, MintProposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, MintRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomAdminRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomAdminRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomAdminResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomAdminResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionSchedulesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionSchedulesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionSchedulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionSchedulesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionSchedulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionSchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, EmissionSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
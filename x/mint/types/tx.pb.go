@@ -0,0 +1,3768 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/evm/mint/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgUpdateMintAuthority defines a Msg for updating the mint authority.
+type MsgUpdateMintAuthority struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// mint_authority is the bech32 address of the new mint authority. An empty
+	// value disables minting.
+	MintAuthority string `protobuf:"bytes,2,opt,name=mint_authority,json=mintAuthority,proto3" json:"mint_authority,omitempty"`
+}
+
+func (m *MsgUpdateMintAuthority) Reset()         { *m = MsgUpdateMintAuthority{} }
+func (m *MsgUpdateMintAuthority) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMintAuthority) ProtoMessage()    {}
+func (*MsgUpdateMintAuthority) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b98949d196cbfc84, []int{0}
+}
+func (m *MsgUpdateMintAuthority) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMintAuthority) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMintAuthority.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateMintAuthority) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMintAuthority.Merge(m, src)
+}
+func (m *MsgUpdateMintAuthority) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMintAuthority) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMintAuthority.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateMintAuthority proto.InternalMessageInfo
+
+func (m *MsgUpdateMintAuthority) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateMintAuthority) GetMintAuthority() string {
+	if m != nil {
+		return m.MintAuthority
+	}
+	return ""
+}
+
+// MsgUpdateMintAuthorityResponse defines the response structure for executing
+// a MsgUpdateMintAuthority message.
+type MsgUpdateMintAuthorityResponse struct {
+}
+
+func (m *MsgUpdateMintAuthorityResponse) Reset()         { *m = MsgUpdateMintAuthorityResponse{} }
+func (m *MsgUpdateMintAuthorityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMintAuthorityResponse) ProtoMessage()    {}
+func (*MsgUpdateMintAuthorityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b98949d196cbfc84, []int{1}
+}
+func (m *MsgUpdateMintAuthorityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMintAuthorityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMintAuthorityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateMintAuthorityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMintAuthorityResponse.Merge(m, src)
+}
+func (m *MsgUpdateMintAuthorityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMintAuthorityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMintAuthorityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateMintAuthorityResponse proto.InternalMessageInfo
+
+// MsgSetDenomMintLimit defines a Msg for setting the supply cap and rate limit
+// of a denom. Setting both the max supply and the rate limit to zero removes
+// the limit.
+type MsgSetDenomMintLimit struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// limit is the new supply cap and rate limit of the denom.
+	Limit DenomMintLimit `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit"`
+}
+
+func (m *MsgSetDenomMintLimit) Reset()         { *m = MsgSetDenomMintLimit{} }
+func (m *MsgSetDenomMintLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomMintLimit) ProtoMessage()    {}
+func (*MsgSetDenomMintLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b98949d196cbfc84, []int{2}
+}
+func (m *MsgSetDenomMintLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomMintLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomMintLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDenomMintLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomMintLimit.Merge(m, src)
+}
+func (m *MsgSetDenomMintLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomMintLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomMintLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDenomMintLimit proto.InternalMessageInfo
+
+func (m *MsgSetDenomMintLimit) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetDenomMintLimit) GetLimit() DenomMintLimit {
+	if m != nil {
+		return m.Limit
+	}
+	return DenomMintLimit{}
+}
+
+// MsgSetDenomMintLimitResponse defines the response structure for executing a
+// MsgSetDenomMintLimit message.
+type MsgSetDenomMintLimitResponse struct {
+}
+
+func (m *MsgSetDenomMintLimitResponse) Reset()         { *m = MsgSetDenomMintLimitResponse{} }
+func (m *MsgSetDenomMintLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomMintLimitResponse) ProtoMessage()    {}
+func (*MsgSetDenomMintLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b98949d196cbfc84, []int{3}
+}
+func (m *MsgSetDenomMintLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomMintLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomMintLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDenomMintLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomMintLimitResponse.Merge(m, src)
+}
+func (m *MsgSetDenomMintLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomMintLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomMintLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDenomMintLimitResponse proto.InternalMessageInfo
+
+// MsgUpdateGuardian defines a Msg for updating the guardian.
+type MsgUpdateGuardian struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// guardian is the bech32 address of the new guardian. An empty value
+	// disables the guardian.
+	Guardian string `protobuf:"bytes,2,opt,name=guardian,proto3" json:"guardian,omitempty"`
+}
+
+func (m *MsgUpdateGuardian) Reset()         { *m = MsgUpdateGuardian{} }
+func (m *MsgUpdateGuardian) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGuardian) ProtoMessage()    {}
+func (*MsgUpdateGuardian) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b98949d196cbfc84, []int{4}
+}
+func (m *MsgUpdateGuardian) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGuardian) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGuardian.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGuardian) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGuardian.Merge(m, src)
+}
+func (m *MsgUpdateGuardian) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGuardian) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGuardian.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGuardian proto.InternalMessageInfo
+
+func (m *MsgUpdateGuardian) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateGuardian) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+// MsgUpdateGuardianResponse defines the response structure for executing a
+// MsgUpdateGuardian message.
+type MsgUpdateGuardianResponse struct {
+}
+
+func (m *MsgUpdateGuardianResponse) Reset()         { *m = MsgUpdateGuardianResponse{} }
+func (m *MsgUpdateGuardianResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGuardianResponse) ProtoMessage()    {}
+func (*MsgUpdateGuardianResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b98949d196cbfc84, []int{5}
+}
+func (m *MsgUpdateGuardianResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGuardianResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGuardianResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGuardianResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGuardianResponse.Merge(m, src)
+}
+func (m *MsgUpdateGuardianResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGuardianResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGuardianResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGuardianResponse proto.InternalMessageInfo
+
+// MsgUnpause defines a Msg for lifting a pause of minting and burning.
+type MsgUnpause struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// denom is the denom to unpause. An empty value lifts the global pause and
+	// the pauses of every denom.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgUnpause) Reset()         { *m = MsgUnpause{} }
+func (m *MsgUnpause) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpause) ProtoMessage()    {}
+func (*MsgUnpause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b98949d196cbfc84, []int{6}
+}
+func (m *MsgUnpause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnpause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpause.Merge(m, src)
+}
+func (m *MsgUnpause) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpause) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpause proto.InternalMessageInfo
+
+func (m *MsgUnpause) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUnpause) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgUnpauseResponse defines the response structure for executing a
+// MsgUnpause message.
+type MsgUnpauseResponse struct {
+}
+
+func (m *MsgUnpauseResponse) Reset()         { *m = MsgUnpauseResponse{} }
+func (m *MsgUnpauseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpauseResponse) ProtoMessage()    {}
+func (*MsgUnpauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b98949d196cbfc84, []int{7}
+}
+func (m *MsgUnpauseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpauseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnpauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpauseResponse.Merge(m, src)
+}
+func (m *MsgUnpauseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpauseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpauseResponse proto.InternalMessageInfo
+
+// MsgSetIBCMintRoute defines a Msg for setting the canonical denom minted for
+// the tokens received over an IBC channel. An empty canonical denom removes the
+// route.
+type MsgSetIBCMintRoute struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// route is the new route of the channel and base denom.
+	Route IBCMintRoute `protobuf:"bytes,2,opt,name=route,proto3" json:"route"`
+}
+
+func (m *MsgSetIBCMintRoute) Reset()         { *m = MsgSetIBCMintRoute{} }
+func (m *MsgSetIBCMintRoute) String() string { return proto.CompactTextString(m) }
+func (*MsgSetIBCMintRoute) ProtoMessage()    {}
+func (*MsgSetIBCMintRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b98949d196cbfc84, []int{8}
+}
+func (m *MsgSetIBCMintRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetIBCMintRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetIBCMintRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetIBCMintRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetIBCMintRoute.Merge(m, src)
+}
+func (m *MsgSetIBCMintRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetIBCMintRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetIBCMintRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetIBCMintRoute proto.InternalMessageInfo
+
+func (m *MsgSetIBCMintRoute) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetIBCMintRoute) GetRoute() IBCMintRoute {
+	if m != nil {
+		return m.Route
+	}
+	return IBCMintRoute{}
+}
+
+// MsgSetIBCMintRouteResponse defines the response structure for executing a
+// MsgSetIBCMintRoute message.
+type MsgSetIBCMintRouteResponse struct {
+}
+
+func (m *MsgSetIBCMintRouteResponse) Reset()         { *m = MsgSetIBCMintRouteResponse{} }
+func (m *MsgSetIBCMintRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetIBCMintRouteResponse) ProtoMessage()    {}
+func (*MsgSetIBCMintRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b98949d196cbfc84, []int{9}
+}
+func (m *MsgSetIBCMintRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetIBCMintRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetIBCMintRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetIBCMintRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetIBCMintRouteResponse.Merge(m, src)
+}
+func (m *MsgSetIBCMintRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetIBCMintRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetIBCMintRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetIBCMintRouteResponse proto.InternalMessageInfo
+
+// MsgSetMintApprovalConfig defines a Msg for setting the approvers of mint
+// proposals and the mints that require one. An empty config disables mint
+// proposals.
+type MsgSetMintApprovalConfig struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// config is the new mint approval config.
+	Config MintApprovalConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config"`
+}
+
+func (m *MsgSetMintApprovalConfig) Reset()         { *m = MsgSetMintApprovalConfig{} }
+func (m *MsgSetMintApprovalConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMintApprovalConfig) ProtoMessage()    {}
+func (*MsgSetMintApprovalConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b98949d196cbfc84, []int{10}
+}
+func (m *MsgSetMintApprovalConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMintApprovalConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMintApprovalConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMintApprovalConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMintApprovalConfig.Merge(m, src)
+}
+func (m *MsgSetMintApprovalConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMintApprovalConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMintApprovalConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMintApprovalConfig proto.InternalMessageInfo
+
+func (m *MsgSetMintApprovalConfig) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetMintApprovalConfig) GetConfig() MintApprovalConfig {
+	if m != nil {
+		return m.Config
+	}
+	return MintApprovalConfig{}
+}
+
+// MsgSetMintApprovalConfigResponse defines the response structure for
+// executing a MsgSetMintApprovalConfig message.
+type MsgSetMintApprovalConfigResponse struct {
+}
+
+func (m *MsgSetMintApprovalConfigResponse) Reset()         { *m = MsgSetMintApprovalConfigResponse{} }
+func (m *MsgSetMintApprovalConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMintApprovalConfigResponse) ProtoMessage()    {}
+func (*MsgSetMintApprovalConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b98949d196cbfc84, []int{11}
+}
+func (m *MsgSetMintApprovalConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMintApprovalConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMintApprovalConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMintApprovalConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMintApprovalConfigResponse.Merge(m, src)
+}
+func (m *MsgSetMintApprovalConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMintApprovalConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMintApprovalConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMintApprovalConfigResponse proto.InternalMessageInfo
+
+// MsgCreateEmissionSchedule defines a Msg for registering an emission
+// schedule. The identifier and the emitted amount of the schedule are set by
+// the module.
+type MsgCreateEmissionSchedule struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// schedule is the emission schedule to register.
+	Schedule EmissionSchedule `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule"`
+}
+
+func (m *MsgCreateEmissionSchedule) Reset()         { *m = MsgCreateEmissionSchedule{} }
+func (m *MsgCreateEmissionSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateEmissionSchedule) ProtoMessage()    {}
+func (*MsgCreateEmissionSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b98949d196cbfc84, []int{12}
+}
+func (m *MsgCreateEmissionSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateEmissionSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateEmissionSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateEmissionSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateEmissionSchedule.Merge(m, src)
+}
+func (m *MsgCreateEmissionSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateEmissionSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateEmissionSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateEmissionSchedule proto.InternalMessageInfo
+
+func (m *MsgCreateEmissionSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCreateEmissionSchedule) GetSchedule() EmissionSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return EmissionSchedule{}
+}
+
+// MsgCreateEmissionScheduleResponse defines the response structure for
+// executing a MsgCreateEmissionSchedule message.
+type MsgCreateEmissionScheduleResponse struct {
+	// id is the identifier of the registered emission schedule
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCreateEmissionScheduleResponse) Reset()         { *m = MsgCreateEmissionScheduleResponse{} }
+func (m *MsgCreateEmissionScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateEmissionScheduleResponse) ProtoMessage()    {}
+func (*MsgCreateEmissionScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b98949d196cbfc84, []int{13}
+}
+func (m *MsgCreateEmissionScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateEmissionScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateEmissionScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateEmissionScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateEmissionScheduleResponse.Merge(m, src)
+}
+func (m *MsgCreateEmissionScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateEmissionScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateEmissionScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateEmissionScheduleResponse proto.InternalMessageInfo
+
+func (m *MsgCreateEmissionScheduleResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelEmissionSchedule defines a Msg for removing an emission schedule.
+// The tokens already emitted are kept by the recipient.
+type MsgCancelEmissionSchedule struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// id is the identifier of the emission schedule to remove.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelEmissionSchedule) Reset()         { *m = MsgCancelEmissionSchedule{} }
+func (m *MsgCancelEmissionSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelEmissionSchedule) ProtoMessage()    {}
+func (*MsgCancelEmissionSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b98949d196cbfc84, []int{14}
+}
+func (m *MsgCancelEmissionSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelEmissionSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelEmissionSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelEmissionSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelEmissionSchedule.Merge(m, src)
+}
+func (m *MsgCancelEmissionSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelEmissionSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelEmissionSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelEmissionSchedule proto.InternalMessageInfo
+
+func (m *MsgCancelEmissionSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCancelEmissionSchedule) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelEmissionScheduleResponse defines the response structure for
+// executing a MsgCancelEmissionSchedule message.
+type MsgCancelEmissionScheduleResponse struct {
+}
+
+func (m *MsgCancelEmissionScheduleResponse) Reset()         { *m = MsgCancelEmissionScheduleResponse{} }
+func (m *MsgCancelEmissionScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelEmissionScheduleResponse) ProtoMessage()    {}
+func (*MsgCancelEmissionScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b98949d196cbfc84, []int{15}
+}
+func (m *MsgCancelEmissionScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelEmissionScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelEmissionScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelEmissionScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelEmissionScheduleResponse.Merge(m, src)
+}
+func (m *MsgCancelEmissionScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelEmissionScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelEmissionScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelEmissionScheduleResponse proto.InternalMessageInfo
+
+// MsgUpdateParams is the Msg/UpdateParams request type for mint parameters.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the mint parameters to update.
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b98949d196cbfc84, []int{16}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b98949d196cbfc84, []int{17}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateMintAuthority)(nil), "cosmos.evm.mint.v1.MsgUpdateMintAuthority")
+	proto.RegisterType((*MsgUpdateMintAuthorityResponse)(nil), "cosmos.evm.mint.v1.MsgUpdateMintAuthorityResponse")
+	proto.RegisterType((*MsgSetDenomMintLimit)(nil), "cosmos.evm.mint.v1.MsgSetDenomMintLimit")
+	proto.RegisterType((*MsgSetDenomMintLimitResponse)(nil), "cosmos.evm.mint.v1.MsgSetDenomMintLimitResponse")
+	proto.RegisterType((*MsgUpdateGuardian)(nil), "cosmos.evm.mint.v1.MsgUpdateGuardian")
+	proto.RegisterType((*MsgUpdateGuardianResponse)(nil), "cosmos.evm.mint.v1.MsgUpdateGuardianResponse")
+	proto.RegisterType((*MsgUnpause)(nil), "cosmos.evm.mint.v1.MsgUnpause")
+	proto.RegisterType((*MsgUnpauseResponse)(nil), "cosmos.evm.mint.v1.MsgUnpauseResponse")
+	proto.RegisterType((*MsgSetIBCMintRoute)(nil), "cosmos.evm.mint.v1.MsgSetIBCMintRoute")
+	proto.RegisterType((*MsgSetIBCMintRouteResponse)(nil), "cosmos.evm.mint.v1.MsgSetIBCMintRouteResponse")
+	proto.RegisterType((*MsgSetMintApprovalConfig)(nil), "cosmos.evm.mint.v1.MsgSetMintApprovalConfig")
+	proto.RegisterType((*MsgSetMintApprovalConfigResponse)(nil), "cosmos.evm.mint.v1.MsgSetMintApprovalConfigResponse")
+	proto.RegisterType((*MsgCreateEmissionSchedule)(nil), "cosmos.evm.mint.v1.MsgCreateEmissionSchedule")
+	proto.RegisterType((*MsgCreateEmissionScheduleResponse)(nil), "cosmos.evm.mint.v1.MsgCreateEmissionScheduleResponse")
+	proto.RegisterType((*MsgCancelEmissionSchedule)(nil), "cosmos.evm.mint.v1.MsgCancelEmissionSchedule")
+	proto.RegisterType((*MsgCancelEmissionScheduleResponse)(nil), "cosmos.evm.mint.v1.MsgCancelEmissionScheduleResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.evm.mint.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.evm.mint.v1.MsgUpdateParamsResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/evm/mint/v1/tx.proto", fileDescriptor_b98949d196cbfc84) }
+
+var fileDescriptor_b98949d196cbfc84 = []byte{
+	// 869 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xbd, 0x6f, 0xc3, 0x44,
+	0x14, 0x8f, 0x03, 0xe9, 0xc7, 0x03, 0x5a, 0x35, 0x84, 0x7e, 0xb8, 0xc5, 0xa4, 0x6e, 0x89, 0xaa,
+	0x94, 0xda, 0xf4, 0x13, 0x61, 0x28, 0xa8, 0x09, 0x08, 0x55, 0x10, 0x09, 0x12, 0xb1, 0xb0, 0x14,
+	0x37, 0x76, 0x5d, 0x4b, 0xb1, 0xcf, 0xf2, 0x39, 0x51, 0x2b, 0x24, 0x84, 0x18, 0x61, 0xe1, 0x7f,
+	0x60, 0x61, 0xec, 0xc0, 0xc2, 0xc2, 0xdc, 0x09, 0x55, 0x4c, 0x0c, 0x08, 0x55, 0xed, 0xd0, 0xbf,
+	0x81, 0x0d, 0xd9, 0x67, 0x5f, 0x3e, 0xee, 0x9c, 0x26, 0x11, 0x4b, 0xd5, 0xbb, 0xf7, 0xbb, 0xdf,
+	0xef, 0xfd, 0xde, 0x3b, 0xbf, 0x6b, 0x61, 0xb5, 0x89, 0xb0, 0x83, 0xb0, 0x6a, 0x76, 0x1c, 0xd5,
+	0xb1, 0xdd, 0x40, 0xed, 0xec, 0xaa, 0xc1, 0x95, 0xe2, 0xf9, 0x28, 0x40, 0xf9, 0x3c, 0x09, 0x2a,
+	0x66, 0xc7, 0x51, 0xc2, 0xa0, 0xd2, 0xd9, 0x15, 0x17, 0x74, 0xc7, 0x76, 0x91, 0x1a, 0xfd, 0x24,
+	0x30, 0xf1, 0x75, 0x0e, 0x47, 0x04, 0x27, 0xe1, 0xa5, 0x38, 0xec, 0x60, 0x2b, 0x8a, 0x60, 0x2b,
+	0x0e, 0xac, 0x90, 0xc0, 0x59, 0xb4, 0x52, 0x63, 0x2d, 0x12, 0x2a, 0x58, 0xc8, 0x42, 0x64, 0x3f,
+	0xfc, 0x8d, 0xec, 0xca, 0x7f, 0x08, 0xb0, 0x58, 0xc3, 0xd6, 0x97, 0x9e, 0xa1, 0x07, 0x66, 0xcd,
+	0x76, 0x83, 0x93, 0x76, 0x70, 0x89, 0x7c, 0x3b, 0xb8, 0xce, 0x1f, 0xc1, 0xac, 0x9e, 0x2c, 0x96,
+	0x85, 0xa2, 0xb0, 0x35, 0x5b, 0x59, 0xfe, 0xf3, 0xd7, 0x9d, 0x42, 0xcc, 0x7a, 0x62, 0x18, 0xbe,
+	0x89, 0x71, 0x23, 0xf0, 0x6d, 0xd7, 0xaa, 0x77, 0xa1, 0xf9, 0x0f, 0x61, 0x2e, 0x4c, 0xf5, 0xac,
+	0x7b, 0x38, 0xfb, 0xcc, 0xe1, 0x57, 0x9c, 0x5e, 0x61, 0xed, 0xbd, 0xef, 0x9f, 0x6e, 0xca, 0x5d,
+	0xc2, 0x1f, 0x9e, 0x6e, 0xca, 0x5b, 0x3d, 0xf5, 0xb8, 0x22, 0x15, 0xe1, 0x67, 0x2d, 0x17, 0x41,
+	0xe2, 0x47, 0xea, 0x26, 0xf6, 0x90, 0x8b, 0xcd, 0xd0, 0x72, 0xa1, 0x86, 0xad, 0x86, 0x19, 0x7c,
+	0x64, 0xba, 0xc8, 0x09, 0x41, 0x9f, 0xd9, 0x8e, 0x1d, 0x4c, 0x6c, 0xb8, 0x0a, 0xb9, 0x56, 0x48,
+	0x10, 0xf9, 0x7c, 0x69, 0x4f, 0x56, 0xd8, 0x1e, 0x2b, 0xfd, 0x52, 0x95, 0xd9, 0xdb, 0x7f, 0xde,
+	0xc8, 0xfc, 0xf2, 0x74, 0x53, 0x16, 0xea, 0xe4, 0xac, 0xf6, 0x2e, 0x6b, 0xba, 0xc4, 0x35, 0xcd,
+	0xe4, 0x2d, 0x4b, 0xb0, 0xc6, 0xdb, 0xa7, 0x86, 0x7f, 0x13, 0x60, 0x81, 0xd6, 0xe4, 0x93, 0xb6,
+	0xee, 0x1b, 0xb6, 0xee, 0x4e, 0xec, 0xf6, 0x00, 0x66, 0xac, 0x98, 0xe3, 0xd9, 0xc6, 0x52, 0xa4,
+	0x76, 0xc4, 0xda, 0xdb, 0x18, 0xd2, 0xd3, 0x24, 0x4b, 0x79, 0x15, 0x56, 0x98, 0x4d, 0x6a, 0xec,
+	0x47, 0x01, 0x20, 0x8c, 0xba, 0x9e, 0xde, 0xc6, 0xe6, 0xc4, 0x8e, 0x0a, 0x90, 0x33, 0xc2, 0xca,
+	0x11, 0x3b, 0x75, 0xb2, 0xd0, 0x54, 0x36, 0xe3, 0x35, 0x7e, 0xc6, 0x44, 0x5e, 0x2e, 0x40, 0xbe,
+	0xbb, 0xa2, 0x39, 0xde, 0x0a, 0xd1, 0x76, 0xc3, 0x0c, 0x4e, 0x2b, 0xd5, 0xb0, 0x37, 0x75, 0xd4,
+	0x0e, 0x26, 0xcf, 0xf5, 0x04, 0x72, 0x7e, 0x48, 0x10, 0xdf, 0xb5, 0x22, 0xef, 0xae, 0xf5, 0x0a,
+	0xf5, 0xdd, 0xb4, 0xe8, 0xa4, 0xf6, 0x0e, 0x6b, 0x6c, 0x33, 0xed, 0xa6, 0xf5, 0x52, 0xc9, 0x6b,
+	0x20, 0xb2, 0xbb, 0xd4, 0xe8, 0xdf, 0x02, 0x2c, 0x93, 0x70, 0xf4, 0xd9, 0x79, 0x9e, 0x8f, 0x3a,
+	0x7a, 0xab, 0x8a, 0xdc, 0x0b, 0xdb, 0x9a, 0xd8, 0xee, 0x29, 0x4c, 0x35, 0x23, 0x86, 0xd8, 0x6f,
+	0x89, 0xe7, 0x97, 0xd5, 0xeb, 0x75, 0x1d, 0x13, 0x68, 0xc7, 0xac, 0xed, 0x72, 0x9a, 0x6d, 0x96,
+	0x51, 0x96, 0xa1, 0x98, 0x16, 0xa3, 0x25, 0xb8, 0x17, 0xa2, 0xdb, 0x5a, 0xf5, 0x4d, 0x3d, 0x30,
+	0x3f, 0x76, 0x6c, 0x8c, 0x6d, 0xe4, 0x36, 0x9a, 0x97, 0xa6, 0xd1, 0x6e, 0x4d, 0xde, 0xf2, 0x4f,
+	0x61, 0x06, 0xc7, 0x1c, 0x71, 0x15, 0x36, 0x79, 0x55, 0x18, 0xd4, 0xeb, 0xad, 0x01, 0x25, 0xd0,
+	0x3e, 0x60, 0xab, 0xb0, 0xcd, 0xad, 0x02, 0xdf, 0x84, 0xbc, 0x0f, 0xeb, 0xa9, 0xc1, 0xa4, 0x0e,
+	0xf9, 0x39, 0xc8, 0xda, 0x46, 0x64, 0xf1, 0xc5, 0x7a, 0xd6, 0x36, 0xe4, 0x9f, 0xe3, 0xba, 0xe8,
+	0x6e, 0xd3, 0x6c, 0xfd, 0x6f, 0x75, 0x21, 0x2a, 0xd9, 0x44, 0x65, 0x0c, 0x6b, 0xdc, 0x3c, 0xe4,
+	0x0d, 0x58, 0x4f, 0x0d, 0xd2, 0x16, 0xff, 0x2e, 0xc0, 0x3c, 0x1d, 0x48, 0x9f, 0xeb, 0xbe, 0xee,
+	0xe0, 0x89, 0x0d, 0x1c, 0xc3, 0x94, 0x17, 0x31, 0xc4, 0x6d, 0x15, 0x79, 0x6d, 0x25, 0x1a, 0x7d,
+	0x17, 0x9a, 0x1c, 0xd2, 0x0e, 0x58, 0xbf, 0xeb, 0x43, 0x46, 0x2a, 0x21, 0x92, 0x57, 0x60, 0x69,
+	0x60, 0x2b, 0xf1, 0xb6, 0xf7, 0xef, 0x34, 0xbc, 0x50, 0xc3, 0x56, 0xbe, 0x0d, 0xaf, 0xf2, 0xfe,
+	0x1e, 0x28, 0x73, 0xbf, 0x3d, 0xee, 0x5b, 0x2b, 0xee, 0x8d, 0x8e, 0xa5, 0xb7, 0x06, 0xc1, 0x02,
+	0xfb, 0x26, 0x6f, 0xa5, 0x10, 0x31, 0x48, 0xf1, 0xed, 0x51, 0x91, 0x54, 0xf0, 0x02, 0xe6, 0x06,
+	0xde, 0xc4, 0x37, 0x87, 0xa6, 0x9d, 0xc0, 0xc4, 0x9d, 0x91, 0x60, 0x54, 0xe7, 0x0b, 0x98, 0x4e,
+	0x9e, 0x28, 0x29, 0xed, 0x24, 0x89, 0x8b, 0xa5, 0xe1, 0x71, 0x4a, 0x69, 0xc3, 0xfc, 0xe0, 0x8b,
+	0x52, 0x4a, 0xf7, 0xdf, 0x8b, 0x13, 0x95, 0xd1, 0x70, 0x54, 0xea, 0x1b, 0x78, 0x8d, 0x3f, 0xd3,
+	0xdf, 0x4a, 0x27, 0x62, 0xd1, 0xe2, 0xc1, 0x38, 0x68, 0x2a, 0xfe, 0x2d, 0x2c, 0xa6, 0x4c, 0xd3,
+	0xb4, 0x1e, 0xf0, 0xe1, 0xe2, 0xe1, 0x58, 0xf0, 0x3e, 0x7d, 0xfe, 0xd4, 0x4a, 0xd5, 0xe7, 0xc2,
+	0xc5, 0xc3, 0xb1, 0xe0, 0x54, 0xff, 0x6b, 0x78, 0xb9, 0x6f, 0xd4, 0x6c, 0x0c, 0xbd, 0x79, 0x04,
+	0x24, 0x6e, 0x8f, 0x00, 0x4a, 0x14, 0xc4, 0xdc, 0x77, 0xe1, 0x50, 0xa9, 0xbc, 0x7f, 0xfb, 0x20,
+	0x09, 0x77, 0x0f, 0x92, 0x70, 0xff, 0x20, 0x09, 0x3f, 0x3d, 0x4a, 0x99, 0xbb, 0x47, 0x29, 0xf3,
+	0xd7, 0xa3, 0x94, 0xf9, 0x4a, 0xb6, 0xec, 0xe0, 0xb2, 0x7d, 0xae, 0x34, 0x91, 0xa3, 0xb2, 0xe3,
+	0x25, 0xb8, 0xf6, 0x4c, 0x7c, 0x3e, 0x15, 0xfd, 0x33, 0xb1, 0xff, 0xdf, 0x00, 0xda, 0x9e, 0x8a,
+	0xe9, 0xfb, 0x0c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateMintAuthority defines a governance operation for rotating the account
+	// allowed to mint native tokens through the mint precompile. The authority
+	// is hard-coded to the Cosmos SDK x/gov module account
+	UpdateMintAuthority(ctx context.Context, in *MsgUpdateMintAuthority, opts ...grpc.CallOption) (*MsgUpdateMintAuthorityResponse, error)
+	// SetDenomMintLimit defines a governance operation for setting the supply
+	// cap and rate limit of a denom. The authority is hard-coded to the Cosmos
+	// SDK x/gov module account
+	SetDenomMintLimit(ctx context.Context, in *MsgSetDenomMintLimit, opts ...grpc.CallOption) (*MsgSetDenomMintLimitResponse, error)
+	// UpdateGuardian defines a governance operation for rotating the account
+	// allowed to pause minting and burning through the mint precompile. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateGuardian(ctx context.Context, in *MsgUpdateGuardian, opts ...grpc.CallOption) (*MsgUpdateGuardianResponse, error)
+	// Unpause defines a governance operation for lifting a pause set by the
+	// guardian. The authority is hard-coded to the Cosmos SDK x/gov module
+	// account
+	Unpause(ctx context.Context, in *MsgUnpause, opts ...grpc.CallOption) (*MsgUnpauseResponse, error)
+	// SetIBCMintRoute defines a governance operation for routing the tokens
+	// received over an IBC channel to a canonical native denom. The authority is
+	// hard-coded to the Cosmos SDK x/gov module account
+	SetIBCMintRoute(ctx context.Context, in *MsgSetIBCMintRoute, opts ...grpc.CallOption) (*MsgSetIBCMintRouteResponse, error)
+	// SetMintApprovalConfig defines a governance operation for setting the
+	// approvers of mint proposals and the mints that require one. The authority
+	// is hard-coded to the Cosmos SDK x/gov module account
+	SetMintApprovalConfig(ctx context.Context, in *MsgSetMintApprovalConfig, opts ...grpc.CallOption) (*MsgSetMintApprovalConfigResponse, error)
+	// CreateEmissionSchedule defines a governance operation for registering a
+	// programmatic issuance of a denom, minted by the x/mint BeginBlocker. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	CreateEmissionSchedule(ctx context.Context, in *MsgCreateEmissionSchedule, opts ...grpc.CallOption) (*MsgCreateEmissionScheduleResponse, error)
+	// CancelEmissionSchedule defines a governance operation for stopping an
+	// emission schedule before it emitted all its tokens. The authority is
+	// hard-coded to the Cosmos SDK x/gov module account
+	CancelEmissionSchedule(ctx context.Context, in *MsgCancelEmissionSchedule, opts ...grpc.CallOption) (*MsgCancelEmissionScheduleResponse, error)
+	// UpdateParams defines a governance operation for updating the x/mint module
+	// parameters. The authority is hard-coded to the Cosmos SDK x/gov module
+	// account
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateMintAuthority(ctx context.Context, in *MsgUpdateMintAuthority, opts ...grpc.CallOption) (*MsgUpdateMintAuthorityResponse, error) {
+	out := new(MsgUpdateMintAuthorityResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.mint.v1.Msg/UpdateMintAuthority", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomMintLimit(ctx context.Context, in *MsgSetDenomMintLimit, opts ...grpc.CallOption) (*MsgSetDenomMintLimitResponse, error) {
+	out := new(MsgSetDenomMintLimitResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.mint.v1.Msg/SetDenomMintLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateGuardian(ctx context.Context, in *MsgUpdateGuardian, opts ...grpc.CallOption) (*MsgUpdateGuardianResponse, error) {
+	out := new(MsgUpdateGuardianResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.mint.v1.Msg/UpdateGuardian", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Unpause(ctx context.Context, in *MsgUnpause, opts ...grpc.CallOption) (*MsgUnpauseResponse, error) {
+	out := new(MsgUnpauseResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.mint.v1.Msg/Unpause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetIBCMintRoute(ctx context.Context, in *MsgSetIBCMintRoute, opts ...grpc.CallOption) (*MsgSetIBCMintRouteResponse, error) {
+	out := new(MsgSetIBCMintRouteResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.mint.v1.Msg/SetIBCMintRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMintApprovalConfig(ctx context.Context, in *MsgSetMintApprovalConfig, opts ...grpc.CallOption) (*MsgSetMintApprovalConfigResponse, error) {
+	out := new(MsgSetMintApprovalConfigResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.mint.v1.Msg/SetMintApprovalConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateEmissionSchedule(ctx context.Context, in *MsgCreateEmissionSchedule, opts ...grpc.CallOption) (*MsgCreateEmissionScheduleResponse, error) {
+	out := new(MsgCreateEmissionScheduleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.mint.v1.Msg/CreateEmissionSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelEmissionSchedule(ctx context.Context, in *MsgCancelEmissionSchedule, opts ...grpc.CallOption) (*MsgCancelEmissionScheduleResponse, error) {
+	out := new(MsgCancelEmissionScheduleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.mint.v1.Msg/CancelEmissionSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.mint.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateMintAuthority defines a governance operation for rotating the account
+	// allowed to mint native tokens through the mint precompile. The authority
+	// is hard-coded to the Cosmos SDK x/gov module account
+	UpdateMintAuthority(context.Context, *MsgUpdateMintAuthority) (*MsgUpdateMintAuthorityResponse, error)
+	// SetDenomMintLimit defines a governance operation for setting the supply
+	// cap and rate limit of a denom. The authority is hard-coded to the Cosmos
+	// SDK x/gov module account
+	SetDenomMintLimit(context.Context, *MsgSetDenomMintLimit) (*MsgSetDenomMintLimitResponse, error)
+	// UpdateGuardian defines a governance operation for rotating the account
+	// allowed to pause minting and burning through the mint precompile. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateGuardian(context.Context, *MsgUpdateGuardian) (*MsgUpdateGuardianResponse, error)
+	// Unpause defines a governance operation for lifting a pause set by the
+	// guardian. The authority is hard-coded to the Cosmos SDK x/gov module
+	// account
+	Unpause(context.Context, *MsgUnpause) (*MsgUnpauseResponse, error)
+	// SetIBCMintRoute defines a governance operation for routing the tokens
+	// received over an IBC channel to a canonical native denom. The authority is
+	// hard-coded to the Cosmos SDK x/gov module account
+	SetIBCMintRoute(context.Context, *MsgSetIBCMintRoute) (*MsgSetIBCMintRouteResponse, error)
+	// SetMintApprovalConfig defines a governance operation for setting the
+	// approvers of mint proposals and the mints that require one. The authority
+	// is hard-coded to the Cosmos SDK x/gov module account
+	SetMintApprovalConfig(context.Context, *MsgSetMintApprovalConfig) (*MsgSetMintApprovalConfigResponse, error)
+	// CreateEmissionSchedule defines a governance operation for registering a
+	// programmatic issuance of a denom, minted by the x/mint BeginBlocker. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	CreateEmissionSchedule(context.Context, *MsgCreateEmissionSchedule) (*MsgCreateEmissionScheduleResponse, error)
+	// CancelEmissionSchedule defines a governance operation for stopping an
+	// emission schedule before it emitted all its tokens. The authority is
+	// hard-coded to the Cosmos SDK x/gov module account
+	CancelEmissionSchedule(context.Context, *MsgCancelEmissionSchedule) (*MsgCancelEmissionScheduleResponse, error)
+	// UpdateParams defines a governance operation for updating the x/mint module
+	// parameters. The authority is hard-coded to the Cosmos SDK x/gov module
+	// account
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateMintAuthority(ctx context.Context, req *MsgUpdateMintAuthority) (*MsgUpdateMintAuthorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMintAuthority not implemented")
+}
+func (*UnimplementedMsgServer) SetDenomMintLimit(ctx context.Context, req *MsgSetDenomMintLimit) (*MsgSetDenomMintLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMintLimit not implemented")
+}
+func (*UnimplementedMsgServer) UpdateGuardian(ctx context.Context, req *MsgUpdateGuardian) (*MsgUpdateGuardianResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGuardian not implemented")
+}
+func (*UnimplementedMsgServer) Unpause(ctx context.Context, req *MsgUnpause) (*MsgUnpauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unpause not implemented")
+}
+func (*UnimplementedMsgServer) SetIBCMintRoute(ctx context.Context, req *MsgSetIBCMintRoute) (*MsgSetIBCMintRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIBCMintRoute not implemented")
+}
+func (*UnimplementedMsgServer) SetMintApprovalConfig(ctx context.Context, req *MsgSetMintApprovalConfig) (*MsgSetMintApprovalConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMintApprovalConfig not implemented")
+}
+func (*UnimplementedMsgServer) CreateEmissionSchedule(ctx context.Context, req *MsgCreateEmissionSchedule) (*MsgCreateEmissionScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEmissionSchedule not implemented")
+}
+func (*UnimplementedMsgServer) CancelEmissionSchedule(ctx context.Context, req *MsgCancelEmissionSchedule) (*MsgCancelEmissionScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelEmissionSchedule not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateMintAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMintAuthority)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMintAuthority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.mint.v1.Msg/UpdateMintAuthority",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMintAuthority(ctx, req.(*MsgUpdateMintAuthority))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomMintLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomMintLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomMintLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.mint.v1.Msg/SetDenomMintLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomMintLimit(ctx, req.(*MsgSetDenomMintLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateGuardian_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGuardian)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGuardian(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.mint.v1.Msg/UpdateGuardian",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGuardian(ctx, req.(*MsgUpdateGuardian))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Unpause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpause)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unpause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.mint.v1.Msg/Unpause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unpause(ctx, req.(*MsgUnpause))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetIBCMintRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetIBCMintRoute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetIBCMintRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.mint.v1.Msg/SetIBCMintRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetIBCMintRoute(ctx, req.(*MsgSetIBCMintRoute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMintApprovalConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMintApprovalConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMintApprovalConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.mint.v1.Msg/SetMintApprovalConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMintApprovalConfig(ctx, req.(*MsgSetMintApprovalConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateEmissionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateEmissionSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateEmissionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.mint.v1.Msg/CreateEmissionSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateEmissionSchedule(ctx, req.(*MsgCreateEmissionSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelEmissionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelEmissionSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelEmissionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.mint.v1.Msg/CancelEmissionSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelEmissionSchedule(ctx, req.(*MsgCancelEmissionSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.mint.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.evm.mint.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateMintAuthority",
+			Handler:    _Msg_UpdateMintAuthority_Handler,
+		},
+		{
+			MethodName: "SetDenomMintLimit",
+			Handler:    _Msg_SetDenomMintLimit_Handler,
+		},
+		{
+			MethodName: "UpdateGuardian",
+			Handler:    _Msg_UpdateGuardian_Handler,
+		},
+		{
+			MethodName: "Unpause",
+			Handler:    _Msg_Unpause_Handler,
+		},
+		{
+			MethodName: "SetIBCMintRoute",
+			Handler:    _Msg_SetIBCMintRoute_Handler,
+		},
+		{
+			MethodName: "SetMintApprovalConfig",
+			Handler:    _Msg_SetMintApprovalConfig_Handler,
+		},
+		{
+			MethodName: "CreateEmissionSchedule",
+			Handler:    _Msg_CreateEmissionSchedule_Handler,
+		},
+		{
+			MethodName: "CancelEmissionSchedule",
+			Handler:    _Msg_CancelEmissionSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/evm/mint/v1/tx.proto",
+}
+
+func (m *MsgUpdateMintAuthority) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMintAuthority) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMintAuthority) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MintAuthority) > 0 {
+		i -= len(m.MintAuthority)
+		copy(dAtA[i:], m.MintAuthority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MintAuthority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMintAuthorityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMintAuthorityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMintAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomMintLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomMintLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomMintLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomMintLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomMintLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomMintLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGuardian) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGuardian) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGuardian) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGuardianResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGuardianResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGuardianResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetIBCMintRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetIBCMintRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetIBCMintRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetIBCMintRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetIBCMintRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetIBCMintRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMintApprovalConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMintApprovalConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMintApprovalConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMintApprovalConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMintApprovalConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMintApprovalConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateEmissionSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateEmissionSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateEmissionSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateEmissionScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateEmissionScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateEmissionScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelEmissionSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelEmissionSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelEmissionSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelEmissionScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelEmissionScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelEmissionScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateMintAuthority) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MintAuthority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateMintAuthorityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDenomMintLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Limit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetDenomMintLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateGuardian) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateGuardianResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnpause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnpauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetIBCMintRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Route.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetIBCMintRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetMintApprovalConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetMintApprovalConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateEmissionSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Schedule.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateEmissionScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelEmissionSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelEmissionScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateMintAuthority) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMintAuthority: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMintAuthority: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMintAuthorityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMintAuthorityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMintAuthorityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDenomMintLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomMintLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomMintLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDenomMintLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomMintLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomMintLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGuardian) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGuardian: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGuardian: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGuardianResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGuardianResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGuardianResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnpause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnpauseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpauseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetIBCMintRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetIBCMintRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetIBCMintRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetIBCMintRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetIBCMintRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetIBCMintRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMintApprovalConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMintApprovalConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMintApprovalConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMintApprovalConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMintApprovalConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMintApprovalConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateEmissionSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateEmissionSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateEmissionSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateEmissionScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateEmissionScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateEmissionScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelEmissionSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelEmissionSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelEmissionSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelEmissionScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelEmissionScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelEmissionScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
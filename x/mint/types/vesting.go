@@ -0,0 +1,44 @@
+package types
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// MergeVestingPeriods merges two periodic vesting schedules into one that
+// vests, at any time, the sum of what both schedules vest. It returns the
+// start time of the merged schedule, the earliest of both, and its periods.
+func MergeVestingPeriods(
+	startA int64, periodsA vestingtypes.Periods,
+	startB int64, periodsB vestingtypes.Periods,
+) (int64, vestingtypes.Periods) {
+	// vested holds the amount vested at each point in time of both schedules
+	vested := make(map[int64]sdk.Coins)
+	addPeriods := func(start int64, periods vestingtypes.Periods) {
+		time := start
+		for _, period := range periods {
+			time += period.Length
+			vested[time] = vested[time].Add(period.Amount...)
+		}
+	}
+	addPeriods(startA, periodsA)
+	addPeriods(startB, periodsB)
+
+	times := make([]int64, 0, len(vested))
+	for time := range vested {
+		times = append(times, time)
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i] < times[j] })
+
+	start := min(startA, startB)
+	merged := make(vestingtypes.Periods, 0, len(times))
+	previous := start
+	for _, time := range times {
+		merged = append(merged, vestingtypes.Period{Length: time - previous, Amount: vested[time]})
+		previous = time
+	}
+
+	return start, merged
+}
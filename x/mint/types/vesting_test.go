@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func TestMergeVestingPeriods(t *testing.T) {
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("uusdc", amount)) }
+
+	testCases := []struct {
+		name       string
+		startA     int64
+		periodsA   vestingtypes.Periods
+		startB     int64
+		periodsB   vestingtypes.Periods
+		expStart   int64
+		expPeriods vestingtypes.Periods
+	}{
+		{
+			"same schedule",
+			100, vestingtypes.Periods{{Length: 10, Amount: coins(5)}, {Length: 10, Amount: coins(5)}},
+			100, vestingtypes.Periods{{Length: 10, Amount: coins(1)}, {Length: 10, Amount: coins(2)}},
+			100, vestingtypes.Periods{{Length: 10, Amount: coins(6)}, {Length: 10, Amount: coins(7)}},
+		},
+		{
+			"interleaved schedules",
+			100, vestingtypes.Periods{{Length: 10, Amount: coins(5)}, {Length: 20, Amount: coins(5)}},
+			105, vestingtypes.Periods{{Length: 10, Amount: coins(1)}},
+			100, vestingtypes.Periods{{Length: 10, Amount: coins(5)}, {Length: 5, Amount: coins(1)}, {Length: 15, Amount: coins(5)}},
+		},
+		{
+			"later schedule after the end of the first one",
+			100, vestingtypes.Periods{{Length: 10, Amount: coins(5)}},
+			200, vestingtypes.Periods{{Length: 0, Amount: coins(1)}, {Length: 10, Amount: coins(2)}},
+			100, vestingtypes.Periods{{Length: 10, Amount: coins(5)}, {Length: 90, Amount: coins(1)}, {Length: 10, Amount: coins(2)}},
+		},
+		{
+			"earlier second schedule",
+			100, vestingtypes.Periods{{Length: 10, Amount: coins(5)}},
+			50, vestingtypes.Periods{{Length: 60, Amount: coins(1)}},
+			50, vestingtypes.Periods{{Length: 60, Amount: coins(6)}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			start, periods := MergeVestingPeriods(tc.startA, tc.periodsA, tc.startB, tc.periodsB)
+			require.Equal(t, tc.expStart, start)
+			require.Equal(t, tc.expPeriods, periods)
+			require.Equal(t, tc.periodsA.TotalAmount().Add(tc.periodsB.TotalAmount()...), periods.TotalAmount())
+		})
+	}
+}
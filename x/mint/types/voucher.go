@@ -0,0 +1,37 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewUsedVoucherNonce returns the given nonce of a voucher signed by the given
+// minter.
+func NewUsedVoucherNonce(minter sdk.AccAddress, nonce math.Int) UsedVoucherNonce {
+	return UsedVoucherNonce{
+		Minter: minter.String(),
+		Nonce:  nonce,
+	}
+}
+
+// Validate performs a stateless validation of the used nonce. Nonces are
+// uint256 values, as signed in the voucher.
+func (n UsedVoucherNonce) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(n.Minter); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid minter address %s", n.Minter)
+	}
+
+	return ValidateVoucherNonce(n.Nonce)
+}
+
+// ValidateVoucherNonce checks that the nonce fits in a uint256.
+func ValidateVoucherNonce(nonce math.Int) error {
+	if nonce.IsNil() || nonce.IsNegative() || nonce.BigInt().BitLen() > 256 {
+		return errorsmod.Wrapf(ErrInvalidVoucherNonce, "nonce must be a uint256, got %s", nonce)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestUsedVoucherNonceValidate(t *testing.T) {
+	minter := authtypes.NewModuleAddress(govtypes.ModuleName)
+	maxUint256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+	testCases := []struct {
+		name    string
+		nonce   UsedVoucherNonce
+		expPass bool
+	}{
+		{
+			"pass - zero nonce",
+			NewUsedVoucherNonce(minter, math.ZeroInt()),
+			true,
+		},
+		{
+			"pass - max uint256 nonce",
+			NewUsedVoucherNonce(minter, math.NewIntFromBigInt(maxUint256)),
+			true,
+		},
+		{
+			"fail - invalid minter",
+			UsedVoucherNonce{Minter: "invalid", Nonce: math.OneInt()},
+			false,
+		},
+		{
+			"fail - negative nonce",
+			NewUsedVoucherNonce(minter, math.NewInt(-1)),
+			false,
+		},
+		{
+			"fail - nil nonce",
+			UsedVoucherNonce{Minter: minter.String()},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.nonce.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
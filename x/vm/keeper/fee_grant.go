@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// WithFeegrantKeeper sets the feegrant keeper used to refund the leftover gas
+// of Ethereum transactions to the fee allowances that paid their fees.
+func (k *Keeper) WithFeegrantKeeper(feegrantKeeper types.FeegrantKeeper) *Keeper {
+	k.feegrantKeeper = feegrantKeeper
+	return k
+}
+
+// SetFeeGrantTransient stores the fee grant that paid the fees of the given
+// Ethereum transaction, with the allowance the AnteHandler left after charging
+// it. The allowance is nil if the AnteHandler spent and removed it.
+func (k Keeper) SetFeeGrantTransient(ctx sdk.Context, txHash common.Hash, grant feegrant.Grant) {
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.TransientFeeGrantKey(txHash), k.cdc.MustMarshal(&grant))
+}
+
+// GetFeeGrantTransient returns the fee grant that paid the fees of the given
+// Ethereum transaction, or false if the sender paid its own fees.
+func (k Keeper) GetFeeGrantTransient(ctx sdk.Context, txHash common.Hash) (feegrant.Grant, bool) {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.TransientFeeGrantKey(txHash))
+	if bz == nil {
+		return feegrant.Grant{}, false
+	}
+
+	var grant feegrant.Grant
+	k.cdc.MustUnmarshal(bz, &grant)
+	return grant, true
+}
+
+// DeleteFeeGrantTransient removes the fee grant of the given Ethereum
+// transaction once its leftover gas is refunded.
+func (k Keeper) DeleteFeeGrantTransient(ctx sdk.Context, txHash common.Hash) {
+	store := ctx.TransientStore(k.transientKey)
+	store.Delete(types.TransientFeeGrantKey(txHash))
+}
+
+// RefundGrantedGas refunds the leftover gas of an Ethereum transaction whose
+// fees were paid by a fee grant. The leftover gas is refunded to the granter,
+// and the fees of the leftover gas are added back to the current allowance, so
+// that it is only charged for the used gas.
+//
+// The allowance is left as is if it was spent and removed by the AnteHandler,
+// or if it was revoked or changed during the execution: the given grant holds
+// the allowance the AnteHandler left, which is compared with the current one.
+func (k *Keeper) RefundGrantedGas(ctx sdk.Context, msg core.Message, grant feegrant.Grant, leftoverGas uint64, denom string) error {
+	if k.feegrantKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "fee grants are not enabled")
+	}
+
+	granter, err := sdk.AccAddressFromBech32(grant.Granter)
+	if err != nil {
+		return err
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(grant.Grantee)
+	if err != nil {
+		return err
+	}
+
+	if err := k.refundGas(ctx, msg, granter, leftoverGas, denom); err != nil {
+		return err
+	}
+
+	// the allowance was spent and removed by the AnteHandler
+	if grant.Allowance == nil {
+		return nil
+	}
+
+	// the allowance was revoked or changed during the execution
+	allowance, err := k.feegrantKeeper.GetAllowance(ctx, granter, grantee)
+	if err != nil || !k.isChargedAllowance(grant, granter, grantee, allowance) {
+		return nil
+	}
+
+	// NOTE: the fees are represented in 18 decimals, while the allowance is
+	// spent in the evm denom. The AnteHandler rounded the fees of the gas limit
+	// up, so the refund is the difference with the rounded up fees of the used
+	// gas.
+	gasFees := func(gas uint64) sdk.Coins {
+		amount := new(big.Int).Mul(new(big.Int).SetUint64(gas), msg.GasPrice)
+		return types.ConvertCoinsFrom18DecimalsCeil(sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(amount))})
+	}
+	refund := gasFees(msg.GasLimit).Sub(gasFees(msg.GasLimit - leftoverGas)...)
+	if refund.IsZero() {
+		return nil
+	}
+
+	if !refundAllowance(allowance, refund) {
+		return nil
+	}
+
+	if err := k.feegrantKeeper.UpdateAllowance(ctx, granter, grantee, allowance); err != nil {
+		return errorsmod.Wrapf(err, "failed to refund the fee allowance of %s to %s", granter, grantee)
+	}
+
+	return nil
+}
+
+// isChargedAllowance returns true if the current allowance of the granter to
+// the grantee is the one of the given grant, by comparing their encodings.
+func (k Keeper) isChargedAllowance(grant feegrant.Grant, granter, grantee sdk.AccAddress, allowance feegrant.FeeAllowanceI) bool {
+	current, err := feegrant.NewGrant(granter, grantee, allowance)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(k.cdc.MustMarshal(&grant), k.cdc.MustMarshal(&current))
+}
+
+// refundAllowance adds the refund back to what the allowance can spend. It
+// returns false for the allowances it does not know how to refund.
+func refundAllowance(allowance feegrant.FeeAllowanceI, refund sdk.Coins) bool {
+	switch allowance := allowance.(type) {
+	case *feegrant.BasicAllowance:
+		// an empty spend limit is unlimited
+		if !allowance.SpendLimit.Empty() {
+			allowance.SpendLimit = allowance.SpendLimit.Add(refund...)
+		}
+		return true
+	case *feegrant.PeriodicAllowance:
+		if !allowance.Basic.SpendLimit.Empty() {
+			allowance.Basic.SpendLimit = allowance.Basic.SpendLimit.Add(refund...)
+		}
+		allowance.PeriodCanSpend = allowance.PeriodCanSpend.Add(refund...)
+		return true
+	case *feegrant.AllowedMsgAllowance:
+		inner, err := allowance.GetAllowance()
+		if err != nil || !refundAllowance(inner, refund) {
+			return false
+		}
+		return allowance.SetAllowance(inner) == nil
+	default:
+		return false
+	}
+}
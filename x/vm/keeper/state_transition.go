@@ -279,7 +279,14 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 	if msg.GasLimit > res.GasUsed {
 		remainingGas = msg.GasLimit - res.GasUsed
 	}
-	if err = k.RefundGas(ctx, *msg, remainingGas, types.GetEVMCoinDenom()); err != nil {
+	// the leftover gas is refunded to the fee grant that paid the fees, if any
+	if grant, found := k.GetFeeGrantTransient(ctx, tx.Hash()); found {
+		err = k.RefundGrantedGas(ctx, *msg, grant, remainingGas, types.GetEVMCoinDenom())
+		k.DeleteFeeGrantTransient(ctx, tx.Hash())
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to fee granter %s", grant.Granter)
+		}
+	} else if err = k.RefundGas(ctx, *msg, remainingGas, types.GetEVMCoinDenom()); err != nil {
 		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From)
 	}
 
@@ -35,6 +35,7 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientFeeGrant
 )
 
 // KVStore key prefixes
@@ -47,10 +48,11 @@ var (
 
 // Transient Store key prefixes
 var (
-	KeyPrefixTransientBloom   = []byte{prefixTransientBloom}
-	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
-	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
-	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientBloom    = []byte{prefixTransientBloom}
+	KeyPrefixTransientTxIndex  = []byte{prefixTransientTxIndex}
+	KeyPrefixTransientLogSize  = []byte{prefixTransientLogSize}
+	KeyPrefixTransientGasUsed  = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientFeeGrant = []byte{prefixTransientFeeGrant}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
@@ -62,3 +64,9 @@ func AddressStoragePrefix(address common.Address) []byte {
 func StateKey(address common.Address, key []byte) []byte {
 	return append(AddressStoragePrefix(address), key...)
 }
+
+// TransientFeeGrantKey defines the key under which the fee grant used to pay
+// the fees of the given Ethereum transaction is stored.
+func TransientFeeGrantKey(txHash common.Hash) []byte {
+	return append(KeyPrefixTransientFeeGrant, txHash.Bytes()...)
+}
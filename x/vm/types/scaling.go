@@ -66,3 +66,18 @@ func ConvertCoinsDenomToExtendedDenom(coins sdk.Coins) sdk.Coins {
 	}
 	return convertedCoins.Sort()
 }
+
+// ConvertCoinsFrom18DecimalsCeil returns the given coins with the amount of the
+// evm coin converted from 18 decimals to the decimals of the evm denom, rounded
+// up.
+func ConvertCoinsFrom18DecimalsCeil(coins sdk.Coins) sdk.Coins {
+	evmDenom := GetEVMCoinDenom()
+	convertedCoins := make([]sdk.Coin, len(coins))
+	for i, coin := range coins {
+		if coin.Denom == evmDenom {
+			coin.Amount = ConvertBigIntFrom18DecimalsToLegacyDec(coin.Amount.BigInt()).Ceil().TruncateInt()
+		}
+		convertedCoins[i] = coin
+	}
+	return sdk.NewCoins(convertedCoins...)
+}
@@ -239,3 +239,61 @@ func TestConvertAmountTo18DecimalsBigInt(t *testing.T) {
 		}
 	}
 }
+
+func TestConvertCoinsFrom18DecimalsCeil(t *testing.T) {
+	nonBaseCoin := sdk.NewInt64Coin("btc", 10)
+
+	testCases := []struct {
+		name     string
+		amt      int64
+		exp6dec  int64
+		expEmpty bool
+	}{
+		{
+			name:     "zero amount",
+			amt:      0,
+			expEmpty: true,
+		},
+		{
+			name:    "amount less than the conversion factor is rounded up",
+			amt:     1,
+			exp6dec: 1,
+		},
+		{
+			name:    "exact amount",
+			amt:     2e12,
+			exp6dec: 2,
+		},
+		{
+			name:    "remainder is rounded up",
+			amt:     2e12 + 1,
+			exp6dec: 3,
+		},
+	}
+
+	for _, coinInfo := range []evmtypes.EvmCoinInfo{
+		testconstants.ExampleChainCoinInfo[testconstants.SixDecimalsChainID],
+		testconstants.ExampleChainCoinInfo[testconstants.ExampleChainID],
+	} {
+		for _, tc := range testCases {
+			t.Run(fmt.Sprintf("%d dec - %s", coinInfo.Decimals, tc.name), func(t *testing.T) {
+				configurator := evmtypes.NewEVMConfigurator()
+				configurator.ResetTestConfig()
+				require.NoError(t, configurator.WithEVMCoinInfo(coinInfo).Configure())
+
+				coins := sdk.NewCoins(nonBaseCoin, sdk.NewInt64Coin(coinInfo.Denom, tc.amt))
+				res := evmtypes.ConvertCoinsFrom18DecimalsCeil(coins)
+
+				exp := tc.amt
+				if coinInfo.Decimals == evmtypes.SixDecimals {
+					exp = tc.exp6dec
+				}
+				expCoins := sdk.NewCoins(nonBaseCoin)
+				if !tc.expEmpty {
+					expCoins = expCoins.Add(sdk.NewInt64Coin(coinInfo.Denom, exp))
+				}
+				require.Equal(t, expCoins, res)
+			})
+		}
+	}
+}